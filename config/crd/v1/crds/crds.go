@@ -29,17 +29,21 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<]o#9r\xef\xfe\x15\x05\xe7a\xee\x00K\xbeE\x1e\x12\xf8m\xd6\xe3E\x84\xdd\xcc\x19;\x8e\xf3\x10\xe4\x81\xea.I<w\x93\x1d\x92-\x8f\x12\xe4\xbf\aU$\xfbK\xfdAy=w{\xc1\xf0e\xc6-\xb2X\xac*\xd6\x17\x8b\xbcZ\xadVW\xa2\x92\xcfh\xac\xd4\xea\x0eD%\xf1\xabCE\u007f\xd9\xf5\xcb?۵Է\xc7\x1f\xae^\xa4\xca\xefྶN\x97\xbf\xa2յ\xc9\xf0\x13\ue912NjuU\xa2\x13\xb9p\xe2\xee\n@(\xa5\x9d\xa0ϖ\xfe\x04ȴrF\x17\x05\x9a\xd5\x1e\xd5\xfa\xa5\xdeⶖE\x8e\x86\x81ǩ\x8f\u007fZ\xff\xd3\xfaOW\x00\x99A\x1e\xfe$K\xb4N\x94\xd5\x1d\xa8\xba(\xae\x00\x94(\xf1\x0e\xb6\"{\xa9+\xbb>b\x81F\xaf\xa5\xbe\xb2\x15f4\xd7\xde躺\x83\xf6\a?$\xe0\xe1\xd7\xf0#\x8f\xe6\x0f\x85\xb4\xee\xe7\xce\xc7_\xa4u\xfcCU\xd4F\x14\xcdL\xfc\xcdJ\xb5\xaf\va\xe2\xd7+\x00\x9b\xe9\n\xef\xe03MQ\x89\f\xf3+\x80\xb0\x1c\x9er\x15\x10>\xfe\xe0!d\a,\x85\xc7\x05@W\xa8>>n\x9e\xff\xf1K\xef3@\x8e63\xb2rL\x14\x8f\x18H\v\x02\x9eyY`\x02\xf9\xc1\x1d\x84\x03\x83\x95A\x8b\xcaYp\a\x84LT\xae6\bz\a?\xd7[4\n\x1d\xda\x064@V\xd4֡\x01\xeb\x84C\x10\x0e\x04TZ*\aR\x81\x93%\xc2\x1f>>n@o\xff\x82\x99\xb3 T\x0e\xc2Z\x9dI\xe10\x87\xa3.\xea\x12\xfd\xd8?\xae\x1b\xa8\x95\xd1\x15\x1a'#\x9d}\xebHU\xe7\xeb`y\x1f\x88\x02\xbe\x17\xe4$N\xe8\x97\x11\xa8\x88y \x1a\xad\xc7\x1d\xa4m\x97\xcb\x12\xd2\x03\f\xd4I\xa8\x80\xfc\x1a\xbe\xa0!0`\x0f\xba.r\x92\xc2#\x1a\"X\xa6\xf7J\xfew\x03ۂ\xd3<i!\x1c\x06\x01h\x9bT\x0e\x8d\x12\x05\x1cEQ\xe3\r\x93\xa4\x14'0H\xb3@\xad:\xf0\xb8\x8b]ÿj\x83 \xd5N\xdf\xc1\xc1\xb9\xca\xde\xdd\xde\ue94b\xbb)\xd3eY+\xe9N\xb7\xbc1\xe4\xb6v\xda\xd8\xdb\x1c\x8fX\xdcZ\xb9_\t\x93\x1d\xa4Ì\x18y+*\xb9b\xd4\x15\xef\xa8u\x99\xffC\x14\x00\xfb\xa1\x87\xab;\x910Zg\xa4\xdaw~`\xa9\x9f\xe1\x00m\x00/_~\xa8_EKh\xfaD\xd4\xf9\xf5\xe1\xcbSW\xf6\xa4\x1dR\x9f\xe9\xde\x11Ȗ\x05D0\xa9vh<\x13wF\x97\f\x13U\ue94fE\xb7\x90\xa8\x86\xe4\xb7\xf5\xb6\x94\x8e\xf8\xfe_5Z\x12r\xbd\x86{V1\xb0E\xa8\xab\x9c$s\r\x1b\x05\xf7\xa2\xc4\xe2^X\xfc\xe6\f J\xdb\x15\x116\x8d\x05]\xed8\xec\xec\xa9\xd6\xf9!\xea\xb2\t~y\x85\xf0\xa5¬\xb7ah\x94\xdcɌ\xb7\x05\xec\xb4i\xf5\x85WW\xeb\x1e\xc8\xf1-\xeb'ۉ\xbapϼ\xd5\xed\x93\xfe\x15\xad\x93ٰ\xd7\x00\xa9O\xa3\x83\"Rh\xe1\xf5\x80\ue006\xe4\x87\u007f\xe0-y\x06\x13\x98\xa5\x16sޑ\xe2\x05A\x04\xecyk\x17\x05T:j!\v\xdbSDv}\x06\xc9\xd3v\xabu\x81b\xa8%\xf0kV\xd49\xe6\x8d\xda>\xa3\xc1`u\x0fg\x03ؤ\t\xa9hא\x11!\xf4T\xfb+)\xe6\x91\xc5\t\x83@r+\x95\x87\xc7:\xf7\x80\xa3\f\xa2&\x1d\x96#\xb8M\x8a\x99od*Ŷ\xc0;p\xa6\xc6\t\xca\bc\xc4i\x82.Ѽ\xa7\x92\xa5\xe9\x1f\xb4H!3\xb6?\x8d\xae`\xcaxk%\xcc9F\xf0{&\xcaA\xeb\x97%B\xfc\v\xf5i\xf5\x1ed\xec%\xc1\x16\x0f\xe2(\xb5\tK\x0ffh\x8b\x80_1\xab\x1d\x8eɿp\x90\xcb\xdd\x0e\r\xc1\xa9\x0e¢\xf5\xa6o\x9a \xd3[\x99\x9a\x99f\xe6\xd9:ZF\x92\xa4\xf2ʧP\xa7\r=\xdcW\xb1\x11\xa2d4\xc8mQ\xb9<ʼ\x16\x05He\x9dP\x99_\x8fh\xf0:_\x0f\xcc1\xf9\fg\xaf\x0e#\xe6ĉ\x9ej\xd4\nA\x1b(\xc9\x1e\x9cw\x1dZ\xb0\xb6M-{+H;i/\xa2\xa6.І\xa9rֹ\xad\x0e\xb8\x99\x04\xddp\xc4\xfb\x12\x85\xd8b\x01\x16\v̜6\xe3\xe4Xb\xb2o)zm\x82\x8a#\x1a\xae\xd5ݴ\xd4va3 \x81\xd4\xf6\xebAf\ao\xe6I\x82\x18\x0e\xe4\x1a-\xefrQU\xc5ij\x91\xb0\xc4\xf90\xc9\xdcFo\xdb\u0096\x1f\xc2\x1b\xdb\xfcmKЍm[В}\xca6\xe2\x00N\xcf.\xfb\xff'a\xa3\xda\u007f\x83\xd0nΆ\xbe\xaf\xd0\x12I%\xb9\xf3\x9b\x1d`Y\xb9\xd3\rH\x17\xbf.A$g\xa5\x9d\xff\xef\x981\x97K\xfcf8\xf2]%~\x96+K\x10\x89+\xcd\xf4\u007f\x87Lac\xf1%؊d\x86\xfc\xd2\x1du\x03r\xd70$\xbf\x81\x9d,\x1c\x9a\x01g~\xd3~y\x0fb\xa4\xd8;j\xa5p\xd9\xe1\xe1+y^\xb6M8%\xd2e8\xd8\xfb\xafџ\xef\x1b\xe6\x05\xb8\xc0\x01\xaa4X\xfa\xc0\xf7\x89\xa9\xd9~a\x8f\xea\xe3\xe7O\x98ϑ\a\xd2$\xefl!\x1f\a\xc8v\xa7\x0eNy\xea2\x82\xeb\xd3\xc47>\xa5q\x03\x02^\xf0\xe4=\x16\xa1\x80\x98#h\xa2\x89H\xe7\x9c8\x9c[a!{\xc1\x13\x83\tɒ\xc5ѩ\xa2\xe0\xdb\v\x9eR\xba\r\bH8I\x1b\x92@DI\xfa\xc0\x84\xe0\xd8:\x9dx\xc0\x89\xaf\xa8\x8b\x96\x17\a\xe9\x8a$\xb6H\xfb7,\xb3a['iȌ\xfd`=\x8bh\x17\x1cd\x95\xb8P2s`\x91wKL}=\x8bB\xe6\xcdD^\xee7j\xda\x1b\xee\xb7\xcf\xdam\xd4\r<|\x956d\x1f?i\xb4\x9f\xb5\xe3/߄\x9c\x1e\xf17\x10\xd3\x0f\xe4\xed\xa5\xbc\xda&:tsh\t\xc2\xed\xdb\xc6Gx\r{\xa4\x85\x8d\xa2\xb8%Ѓ3\xa2~\xbay\xfb\xd0oem9I\xa6\xb4Z\xb1\xa9\\\x8f\xcd䉝\bR\x9b\x1eG\xceQk&\xf5\x13&\x82}\"K\xe2\xc7\xfb\x1co!2\xcc!\xaf\x99\x98\x9c\x99\x14\x0e\xf72\x83\x12\xcd~\xcept[E\xfa=\r\x85D\xad\xebۅ\x12\x96f\xdac\v\xaa;_FfE;7\xa1Wd\xf6b\u05c9\x84\xe4t\xd7\xe5\x15\xb1\x89e\xffc\x91\xba\"\xcf\xf9,I\x14\x8f\x17h\xfc\vxqn\xfb=b\xdeB\x96\x82\x93\x8c\xffCf\x8e\x05\xfa\u007f\xa1\x12\xd2$\xec\xe1\x8f|4T`ol\xc8bu\xa7\xa1\x19\xa4\x05\xe2\xefQ\x14\xe7\xa9\xee\x91\xc5i\xd2-XxC\xaewg\x1e\xcb\r\xbc\x1e\xb4\xf56u'q4\xa5\xdao\xd2\xc2\xf5\v\x9e\xaeo\xce\xf4\xc0\xf5F]{\x03\u007f\xb1\xbai\xbc\x05\xad\x8a\x13\\\xf3\xd8\xeb\xdf\xe2\x04%JbR7>\x82Ku\x95)\x96\x8c\x9e\x00\rlΝ\xc8͝\xc3:I\x0e+m]2*\x8f\xda:\x9fY칥\x97d\xb1\xc0\xcbP\xc8^\x81\xd8\xf9\x93?m\xe2\x99\x0e\xa9\xbdA\u0095\xb8f\xe75,\xb1\xb1Ɉy\xa0\x14X]\xb7;\xd8\xeb\xd3k\u007f\xd0Ó\x88\x8c\x9d\x8bE\xb8\x95\xd1\x19Z;/\"\t\xdaz!I\xd8$\b\x85\x0f`\xfc\x81\xc9|R2\xb6t\x87\x94\x88t\xa1+\xff\U000354fd\xa4\xcdO\u007f/\tߥx\x01\xefٲ\x14Ó\xc1$\x14\xef\xfdȸM\x02 \x1f\x1a\x98}\xcd[=݃\f\x82\xf4{0ӥT\x1b\x9e\x00~xw\xb3\xde(I|\x8b\xe3~\x1fǶDo>\xf0\xeeM\xf5\x884g\xee\r\xf68w\x9e\xe7&G1\x11\xa4Ү\x9bN \xb8\x95\xce?X\xd8Ic]\x17\xd1T\xa1\xa8\x17v\u007f\xdb.\x8d\x9cԃ1o\n\x9c\xfe\xecGv\x12Y\a\xfd\x1a\xcfW'\x0f3\xc7\x1a\x1f\n!\xc8\x1dH\a\xa82]+N\xbf\xd0V\xe7)<\v\xbc\x82N&Y\x9a\x82\xa0\x86\xaa.\xd3\b\xb0b\xa9\x93j6O\xd3\xed\xfe\x93\x90ŷ`\x9b\x93%\xeaz\xd6p\xb6\xadǶ'?\xb2wP^\x8a\xaf\xb2\xacK\x10%\x91>5\xec\xd9\xf9\xe2\x98\x1e\xc7\xe1UHǖ\x83\xe0\xb2\x19q\x9a6UU\xa0Kݑ[\xdci\xc3\xfb\xd9\xca\x1c\x1b\xc3\x1c\xa4@+\x10\xb0\x13\xb2\xa8M\xa2\x86\xbc\x88\xb6\x97\xc4\x1aAY\xbc_\x10\x916\xf9\x8aI\x91\x90\x88Mt\x16\xe7\xb5ue\xd2]\xc5G\x83i\xee\xd9RR:\xbag\x95\x91$K\xfa\xbd=\xb4 bB\x9d\xbe\xbbhg\xed\xbb\x8b\xb6о\xbbh\x93\xed\xbb\x8b\xb6ܾ\xbbh\xa1}w\xd1b\xfb\xee\xa2}w\xd1\xe6\xba\xcdi\xeb%\x8c|\xc5\xfdď\x8bX$\x1cOϡ8\x03?TS\xdc\xfb\xea\xfb\xd4\n\xcb\xcd\xf8\xa8\x91\xba\xdaPֿ\xe2\x1b\tc\x12\xd0\x16]\xb4\xa6\xa4)\xb9\xa4\r\x12\xc5\xdb\x17\x10/\x14a&\x95S\x8eWߦ\x14\xfc,\x95\xf9\xf4\xebL\x9b2\x9bXh\xaa\xe3$#t\x887\x1b\xc8\xed\xed\u0590\xf4\xebu\xd8ύ\x98\xfe\xcdkP\x13Jq\x16\np\xe6\vs\xe7\xe85\b=\xfa\x043\xbd\x82\xd1\xdf\r\xbd\x16\xaad\xa6kc\xc2I\x10:q\xfca\xdd\xff\xc5\xe9P)\x03\xaf\xd2\x1dF\x96\xf2z@\xc5gXj\xdf-{\x8d\xf2\x16\xae\x98\f\xe9\bڀ\x92\x05\x93sFZ{\xe4\x85?W>\x84\xbbx_·\x1fi\xb54o\xae\xa0\xe9W\xc8L\xa8\xe8K\x8f\x8c\xd2\v\x85\xd3kd\xe6\x8bZ.\xa9\x8c\x19ֽL\x02]\xae\x87I\x89\x1c\x17j_\xdeP\xf1\x92X\xed\xf8\x9b\x0f\xc6RjZ\xdeTɲX\x10\x98X\xbfүL\x99\ayA\xd5J\x12q\x96+T.\xaeK\tu \xb3\xebH\xaeF\x19\xa93\x99\x05<Y\x832W]\xb2\x90\x95:\xaf<I\xaf)\x99\x05\xcd\xf5&˕$\xefW/\xfa\x1e>\xf0\xb4\xaaY\xac\x06Y\xf4\x91\xe7\xf1[\xac\xf7\xb8\xa4\xcac\x91bo\xac\xe8h*6&潴\x8e\xa3_\xa71\x014\xa5zc\xa2:c\x02\xe2l\xcdFjM\xc6\x04\xec\x05\xb3;+%3?\x8e_\x84\x84E\xfbV\xfc\xb5$\xea\xad\v\xd3&G3롧\xa29\x8bb?\xe35\x98sPf\x1f/NR\xaf\xae\xd7?\xc6rݔ\x84g\xf0\xb3T\xb9\x97\x13\x12\xf4\x8e\x9f\xc0\x17\x85\xb9(\xa6qWZ\u007fo\x1c\xe8 ҰX\t\xc37ɷ'\x9f\xad\xb0kx\x10١\xdf\x11\x0e\xc2RLZ\x8e\xbaa\xd7M\x98v\x1bGї\xeb5\xc0O\xba\x89\x84\xbb\u05ec\xac,\xab\xe2\x04\xb5E\xb8\xee\x0fy[\xcc1*\x01V\x89\xca\x1et\xbc\x03\xbb\x10v|\xe9\xf7\x1e\x89\xe8\xe3\rج\xd0u\xde@\x9f`\x9eP'x|f߇\xef\x0ef\xed=\xca\xe0\xdf\xc4Hbx\xcd\xf2\xc7\xf7\x8f\xf0\xad\xd3F\xec\xf1\x17\xed/#/Q\xa2\u07fbw\x13=谘q\x8b\x05Yb\x84\b\xe1Z\xf4\x00X\x9bH\x0f\xbb\xa1M~\x10\x96c\xeamf\xff9W,,\xe6\xe9\xe9\x17\xbf\x00'K\\\u007f\xaa}6eU\tc\x91\xa8\x19\x17\xe6\am\xe9\xbf\a\xfd:\xa6\xf0tX\xf3\x8fC\xbc\rr\xb2\x9e\x936\x17a\xef\xafMG\xc1\x8b$Z\x12\xd4\xe7\xf1Q\x9d@\xaf\xc3$\xbf\xcb\xf5ع\xc4\x14\x9c\xce\xeb\x12\x14X\xfbb\xbb\xf7\xbd\xfb;\xe5\xb1Lݿw\xc2\xd5v\xf9\x06>w\x8b\xefm\x84#\x9f\xda\xf0\xc5]\x0f\xc2_t}\xd3%\xfc\x90\xa1\uef412ϧ\xfb\xf3\x11\xfc҅\xc9=j\x9c\x1bonӿ\n\xdbd\xc1GM|\vΏd\x0f\x9a\xa0a\x0exD\x05Zqқ\xaf\xc4\xfa\xd7X\x86cƒI\x1d(!\xab^W\x85\x16y\xdc\xe1\xd1f\x85\x17<\x9eX\u007f\x99#\x9a\x0fv\x06&\xbf\x18\xb0\xd3f\x8c\b\xe7\n\xd3\x1b\x96;ȅ\xc3\xd5(\xd0$\xdd7*l|>\xb4xo\x9e;\xf9\x1dćK\xf1q\x03\u007f\xbaT\xa2\xb5b\x1f/̿\x92\x02ۣB\xb6\x9b#\xeb\x0f\xf1L{\x1aѿ.\xeeS*\"s\xb5\b\x13\xc4lR\xa7ׇ1\xb3R\xe8=\xecd\xc1]\xc3\xd3\x1eA\xb3O\xa9\x1d\xa9\x1c\xeeq\xe8\xae\xe2\xd7J\x9a\x14K\xf0\xd0t$\xdap>\x8d\xb5A\xfb\x04\x0e\x16r/I\x8d\x12\xb3\xf7\xc2l\xc5\x1eW\x99.\n\xe4҅s\xbc\xbe%\xaf=\xec\xd1'nΖ\xf6S\xb7o\xf4\xa7\x82\xb0{8\xf1ś\x9b`\xa1\xc7\x1d\xd4R\xfcE\x9b\x1b(\xa5\xa2\u007f\xc8\r\xe3\xc84\x0e\xbe\xc8\x1e\xf0\xeb\x02\vx?R\x9f\xe6@\xbb\xa3\xdd0\x8aٔ\xff0~\x88\xb9\x82\xcfxn\xee\xfc\xb9$\xe6\x9c{\x19{ׇ\xbalԣ\xd1{\n\x9dF~\xbc\x8f\xbal\xe4\xb7Ga\x9c\x14Eq\xf2\x93L\xce>\xf2\xc3'$m2iR\xc6\xc9\x1a\xb0\\\xa2l\xe8\xd6\xc6iRyI\xe0\x13ŭ\xae]o\x83\xb6\x1b|D,\xe2\x9ck\xf8\xac\x1d\xc64\x9e\xec\xc3$\xbb\x8a֭p\xb7\xd3\xc6\xf9\xb0p\xb5\x02\xb9\v&j\x04.ixN]\xfbgv@\xba6}\xd2J/{\x9f\x06\x85e\xe9u\xfc\xd8\x0f\x9f.\x89,#\x0f\bo\xad\x13ň\xd6\xf8M\xd9j\xf6\x05H\xfa0\xff\xb7\x11\xe3xF\xf0M\xb7\u007fS\xe2_\x97[\x1f\x1d18O9>\xfd\xf7\x1a\xb3\x98ʣl\x11\x15\xbc\x1a\xe9\x1ci\xa9nn\x1f\x1c饢\x00\xaba'&\x1et\x98ӗ\xfc\xbbv\xa2\xd8L\xe7\x94\xfaNg\xd39.\x8b\x87\x9f/N\x13[\xb6L\x82\x89e\xf9\xea2i\xe3Xbev\x10jOBet\xbd?D\xb9\x9c\xb07S\x99\xf8\x9a\x90\x82\xaa\xa8\xf7$\xea!7\xeej\xa3:qqȖ\xe7\x1dtE\xf62\x89i\xc8\x0eƧ\xden\xc3\x13\r\xab\x9d\xd1\xe5*\xf0\x82\xf3\x067!^5R\x93SF\xd1\xd5\x04\xd0\xf6.4\x8bAU\xa1\x02a\x03>\t\xa5o\xf3l\x9d\v\x1e\x9d0.\xd5\xd5\xfb\xd2\xeb\xbc\xe0\xe51\xe4q|\xbf\x84hܗ\x00\xde\x0f\x1fݣ\xb8Y\xc5W\xe6|\xb4\xefE\xc1\x92\xf3g\x90\x03\xa8\xd1\xf3\x8a3\xb7\xad\xe7\xa4\xf5\xd1\xff\xeb\xfag\xc7\xc6\xc2<\xa4xjσ\xee\x83sT\xda\xe5-\xc4\xe0]\x8d\xd0\xe3\x0fr\xe7\x0fR2\xc2\xfa\x8f\u007f\xf3\xf3\xd1c\x92\xcf\xf2a\xd6]aO\xa4\xf1;\xe0\x13V\x063ڽc\xcbx,\x90\xfc\b\x8b\xd8\xf7\x84>\\\xe4H\xf6\x03X\xfb\xd19,\xab\xd1\x19g\"\xd8vؔ\xb2\x14\xb1\xc3\xc8B\xe2\x03\x88\x11X(-\x9a\tY/XP\xe3\xc4\\\xb6\xa0f\xd8Ԃl\x9d\x91\xd2\xda\xd5\xe3欉\x03\xdfyu\xaf\xc2(\xa9\xf6K{\xec\xdfC\xb7\x91x(@\x18\x89\x88F\x96\xd1\xc4H\x8b\x11Q' \x8a8N\xbcK6\b\x92\xde)$\x1a\xb5\x03g\x1fY\x81杽\x1df\n_\xda,\x85\xc82$q\xfd<|\xe8\xf4\xfa\x9a\xff\x88o\x99\xf2\x9f\x99V\xde\xdc\xda;\xf8\x8f\xff\xbc\x82\x90\x06{\x8e\x8f\x96\xd2\xc7\xff\v\x00\x00\xff\xffy\x8fd\x10\x14V\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKo#\xb9\x11\xbe\xebW\x14f\x0f\xbe\x8cZ\xb3\xc9!\x81.\x81F\x93\x00\x83x\xd6\xc6\xc8q\x0eI\x80\xa5Ȓ\xc45\x9b\xec\xf0!\xad\x12\xe4\xbf\aŇ\xba\xd5ݲ\xe4A\xb2ˋ->\x8aU_\xbdٓ\xe9t:a\x8d|F\xeb\xa4\xd1s`\x8dğ=j\xfa媗\u07fbJ\x9a\xd9\xfe\xfbɋ\xd4b\x0e\xcb༩\xbf\xa23\xc1r\xfc\x84\x1b\xa9\xa5\x97FOj\xf4L0\xcf\xe6\x13\x00\xa6\xb5\xf1\x8c\xa6\x1d\xfd\x04\xe0F{k\x94B;ݢ\xae^\xc2\x1a\xd7A*\x816\x12/W\xef?T\xbf\xab>L\x00\xb8\xc5x\xfcI\xd6\xe8<\xab\x9b9\xe8\xa0\xd4\x04@\xb3\x1a\xe7\xb0f\xfc%4\xce\x1b˶\xa8\fOwU{ThM%\xcd\xc45\xc8\xe9\xea\xad5\xa1\x99C\xbb\x90(d\xb6\x92H\x1f#\xb1U\"v\x9f\x89\xc5u%\x9d\xff\xf3\xe5=\xf7\xd2\xf9\xb8\xafQ\xc12u\x89\xad\xb8\xc5\xed\x8c\xf5?\xb4WOa\xedTZ\x91z\x1b\x14\xb3\x17\x8eO\x00\x1c7\r\xce!\x9en\x18G1\x01ȘEjS`BD-0\xf5h\xa5\xf6h\x97F\x85Z\x9f\xee\x12踕\x8d\x8f('Y \v\x03E\x1ap\x9e\xf9\xe0\xc0\x05\xbe\x03\xe6`\xb1gR\xb1\xb5\xc2\xd9_4+\xffGz\x00?9\xa3\x1f\x99\xdf͡J\xa7\xaaf\xc7\\YM:z\xec\xcc\xf8#\t༕z;\xc6\xd2=s\xfe\x99))NZ\a\xe9\xc0\xef\x10\x14s\x1e<MЯ\x84\x10\x10D\b\x05!80\x97\xef\x01\xd8'*\x11\xa3qN\xd5\xe0\xae3\xb6\x89\x15x\xeeQI\xfc\xd3L\xe6\xbeC\xb6\x18~50\xda3\xba\x8b-^\"v\x06\xc5'ܰ\xa0|WTҒ\xea\xda\xe5\xb9X\r\xf2J\xa4Sg7~:\x9bK\xb7\xae\x8dQ\xc8\x12\x95\xb4k\xff}\xb2B\xbeÚ\xcd\xf3fӠ^<~~\xfe\xed\xeal\x1a\xc6\f\xa9\xe7\x14\xa48\xd6\xd1\xcd\x0e-\xc2s\xf4\xbf\xa47\x97E;\xd1\x040럐\xfbV\x89\x8d5\rZ/\x8b\xb3\xa4\xd1\tR\x9d\xd9\x1eOw\xc4v\xda\x05\x82\xa2\x13&;\xca\xfe\x82\"K\nf\x03~'\x1dXl,:Ծ\v\uf271\r0\x9d٫`\x85\x96Ȑ/\a%(\xa8\xed\xd1z\xb0\xc8\xcdV\xcb\u007f\x9dh;\xf0&\x1b\xafG\xe7{4\xa3\u007fj\xa6\xc8T\x03\xbe\a\xa6\x05\xd4\xec\b\x16\xe9\x16\b\xbaC/nq\x15|!{\x97zc\xe6\xb0\xf3\xbeq\xf3\xd9l+}\t\xce\xdc\xd4u\xd0\xd2\x1fg1\xce\xcau\xf0ƺ\x99\xc0=\xaa\x99\x93\xdb)\xb3|'=r\x1f,\xceX#\xa7\x91u\x9d\x82f-\xbe\xb39\x9c\xbb\xbb3^\a^\x9bF\x8c\x9a\xafh\x80\"f\xb2\x82t4I\xd1\x02MS\x84\xce\xd7?\xae\x9e\xa0\\\x1d\x95\xd1G?\xe2\xde\x1et\xad\n\b0\xa97h\x93\x127\xd6ԑ&j\xd1\x18\xa9}\xfc\xc1\x95D݇߅u-=\xe9\xfd\x9f\x01\x9d']U\xb0\x8c\x19\v\xd6\b\xa1\x89~_\xc1g\rKV\xa3Z2\x87\xffw\x05\x10\xd2nJ\xc0ަ\x82n\xb2\xedoN\xa8u\x16J.\xbc\xa0\xafQ/^5\xc8\xcf\xfcG\xa0\x93\x96,\xdc3\x8f\xd1/z\xb8f\x17\xbf\x9cL\xcb\x18wn\x1a\x8cst\xee\x8b\x11\xd8_鱼8m<\xe3\xb1A[K\x17\xd3\"l\x8c\xedg\fv\x8a\xc0\xddQ\"U5XC\x1d\xea!#S\xf8\x8aL<hu\xbc\xb0\xf4W+\xfd\xf0\xa2\v\x8a\xa4\x91X\\\x1d5\u007fD+\x8d\xb8\"\xfc\xc7\xde\xf6\x13\x04;s\x80M4k\xedՑb\x90;j>\x8c\xb6e,\x1e?\x97ț\x1c(\xfb[ƪ\x82E\xf6\\\xb3\x81\x0f \xa4\xa3\x02\xc0E\xa2C\xb0\xa8<\xa3\xf59x\x1b\xde$>7z#\xb7C\xa1\xbb5\xcd%\x8b\xb9B\xba\x87\xdc2\xdeD\xa1\x89\xac\xa3\xb1f/\x05\xda)\xf9\x87\xdcH\x9e9\t6e\xae\x8dD%\xdcP\xd2\v^\x16E\xb1(ȫ\x99\xba\xa2\xc3\xe5ic,\x8d\x99\xd4ɂ[\x021\xd8\xd8:\xa7T\xedQ\x8bS5rƍ\x89Qˡ\x80\x83\xf4\xbb\x14\x0e\u0558\xdf\xc1\xab\xbeG\xe3\x05\x8fc\xd3=ޟvH;S\x02Ep\xc8-\xfahm\xa8\xc8|Ȕ*\x80/\xc1ŀڏ\x13e\xc4B\xad\x9c~\xc1\xe3\x10h\xb8\xa6\xdc\\\xc2\\g\xf9\x8eJ\xe7°\xc5\rZ\xd4~4\xa8Sgb5z\x8cq]\x18\xee(\xa4sl\xbc\x9b\x99=ڽ\xc4\xc3\xec`\xec\x8b\xd4\xdb)\x01>\xcd\x1e4\x8bm\xc5\xec\xbb\xf8\xe7\x82\xc8O\x0f\x9f\x1e\xe6\xb0\x10\x02\x8cߡ%\xadm\x82*\x86֩o\xde\xc7\x1c\xfb\x1e\x82\x14\u007f\xb8\xfb\x16\\L\x93<\xe7\x06lV\xd1\xfa\x8fT\xa8E\xa6\b\xa2UҊ\xb1@\x99\x92\x94]gm\xa6X3f\x88c\x15fwP`\xa2\f2\x16Q_p\x18L_q\xb3\\\xec^\xf1\xb1RHK-$\xa7B\xec\xdc7J\x83!\xce\xea\xed\x11\xc1\xfa\x15\xf8\xa5\x880.x\x12 \xe7\xc3+\x1c?t\xf7\xb6mY\nO9\xc79\xf4T@9\xd0H9\x90\xd9!r1(p\xa35y\xa37\xc0N\xa1\xee\xce\xf5c\xfc\x1b#\xc4:\xf0\x17\x1c\x01~ \xcaǸ\xb1`\x9c\x8e\x11/\xc1a\f\xbe\xd7\u0600\xeb6\xce\xd9\x12\xed-\xbc,\x17\xb4\xf1\x94&\x19,\x17\xb0\x0eZ(,\x1c\x1dv\xa8\xa9C\x90\x9b\xe3\xf8]4\x9e\xeeW\x05\xd5Xa\xe4\x1a\xbf`;.C\x8a\xe1sX\x1fGj\x82\x1b\x84l,n\xe4\xcf7\b\xf9\x187\x16\xc0\x1b\xe6w \xb5\x93\x02\x81\x8d\xc0\x9f\x8a\xb5\v\x82\x9e\xf2\xffC\x8e\"ߠ\x9e\u05fc=\xb1\xf3\x16\x87/\x18_\xf1\x9fǼ\xed\x84B\xf9\x9d#\xffy-xɏG%ڟ\x1e\f\xfe\x94*,>\x92*Ϙy\x1e\x9ex\xa5R+\xcf\x16c\xceLu\x81\xb1\x16]c\xb4\xa0\xe6\xe9\xb6:\xade\xf9\u007fW\xad\x8d\xabuz\x1e\xe5zkE\v7\xb5*\xf1\x89\xe6\xcd\xcdJz\xb8\xea\xb6\x02f\xed\xa8Sl\xfb\x95\x9e\x8c\xbfH\x9b\xf2\xaeӧP?\xac!\xe8X\xa9Ō_\xc1\xdf5|\xa2ޖ\xb2\x93\x98\x13\xdfv\xcc\x00\xa4\x03m\x0et\xbcC/\x92\x00\xa3S\xbe\xa6n\x8di\x91\x9b\xe1\xb8t\x90JQƶX\x9b\xfdhƦBӢ:\x02sd:\xfb\xdfT\x1f\xaaw\xbfZ\x17\xa4\x98\xf3\xd4Ԡ\xf8\x8a{9|\xe5\x19\xa2{?8Q\x1c\xff\xe4\x0e\xf4\xe3\xc7\xd2,\xcfl\xde\xf6\xe3\b\x18\x1b\xa9\xa8\x16\x1c\x89\x13m\xc50|\x8f\xfc\xb8\xba\xbfs\xb1\x84G\xed\xc7ʾ\x03Z\x8c\x1d\x13\n\xaa\xe2M~\x97\bΣ\x1d1\x80\x93\xf6\xa2\xceA\x19\xbd\xed9N\x1a\xf9\x95\x82*\xb4dPƂ@O\xa9Io\x81\xef\x98\xdeb\xfb\n\x95\xf9\u007f\x9dS2\x9f\x9eʹ\x16\"\xf5%\xf3\xb8I\xa3Or\xacL\x1f\xbc\x00\xb7\x9b\xc7_\u007f\v\xf7E\xb3\x17ۜ+\xb8\x0f\xf6\x97,M\xa0N}\xfb\"\u070eooo\x87\xcf\xcd7 \xf1ַ\xf0W\xde5\xe0\xc0\\\xfb*\xfe\xeb\xe1PS\xb5z\xb5\x04\xfe\x92v\xa5\xe7\xc3|\x04\xd8\xda\x04\xff\x9agލ\x19t~\xee\u007f\v\x8f\xf1#Ƶ\"\x83\xf6\x14\x8d\xf0`\xa9\x95l_\xc5bP\x18\xcb-\xb7?/-z\xdfZ\xbak\xc3/17\xc85\x9ak\a\x93)_v\xf4\x9aA\xee΄\xf5饸p\x9e36\xfc\xfb?\x936yS\x86l<\x8a\x1f\xfa\x9f\xdaޥ\x00R\xbe\x97ş\x9c\xaa\x9a\xf4\xad\x10\xfe\xf6\x8fI\xba\x18\xc5s\xf9\xc0E\x93\xff\r\x00\x00\xff\xff\x04\x0e\x95\xf5\xa5\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V=s\xe36\x10\xed\xf9+v.\xc55\x11u7)\x92Q\x97\xf8\xae\xf0$\xf1x\xec\x1b7\x99\x14\x10\xb0\x127&\x01dw!\xc7\xf9\xf5\x19\x00\xa4%Q\xf4\xc5)\u008e\xfb\x85\x87\xf7v\x97lV\xabUc\"= \v\x05\xbf\x01\x13\t\xffR\xf4\xf9M\xda\xc7\x1f\xa4\xa5\xb0>|l\x1eɻ\r\\%\xd10ܡ\x84\xc4\x16?\xe1\x8e<)\x05\xdf\f\xa8\xc6\x195\x9b\x06\xc0x\x1f\xd4d\xb3\xe4W\x00\x1b\xbcr\xe8{\xe4\xd5\x1e}\xfb\x98\xb6\xb8M\xd4;\xe4R|:\xfa\xf0\xa1\xfd\xbe\xfd\xd0\x00Xƒ\xfe\x85\x06\x145C܀O}\xdf\x00x3\xe0\x06\x1c\xf6\xa8\xb85\xf61E\xc6?\x13\x8aJ{\xc0\x1e9\xb4\x14\x1a\x89h\xf3\xc1{\x0e)n\xe0\xe8\xa8\xf9#\xa8z\xa1O\xa5\xd4O\xa5\xd4]-U\xbc=\x89\xfe\xfcZ\xc4/4F\xc5>\xb1\xe9\x97\x01\x95\x00!\xbfO\xbd\xe1Ő\x06@l\x88\xb8\x81\x9b\f+\x1a\x8b\xae\x01\x18\xf9(0W\xe3\x8d\x0f\x1fk9\xdb\xe1`*~\x80\x10\xd1\xffx{\xfd\xf0\xdd\xfd\x99\x19\xc0\xa1X\xa6\xa8\x85\xd5\x05\xfc@\x02\x06F\x14\xa0a\x04\a\xc1#\x04\x86!0BE*\xedK\xd1\xc8!\"+M\xfc\xd5\xe7\xa4uN\xac3\b\xef3\xca\x1a\x05.\xf7\f\nh\x87\xd3Mэ\x17\x83\xb0\x03\xedH\x8012\n\xfa\xdaEg\x85!\a\x19\x0fa\xfb\aZm\xe1\x1e9\x97\x01\xe9B\xea]n\xb5\x03\xb2\x02\xa3\r{O\u007f\xbfԖ|\xcf|hot\x12\xf9\xf8\x90Wdoz8\x98>\xe1\xb7`\xbc\x83\xc1<\x03c>\x05\x92?\xa9WB\xa4\x85_3M\xe4wa\x03\x9dj\x94\xcdz\xbd'\x9dFƆaH\x9e\xf4y]\xba\x9f\xb6I\x03\xcb\xda\xe1\x01\xfb\xb5\xd0~e\xd8v\xa4h51\xaeM\xa4U\x81\xee\xcbش\x83\xfb\x86\xc7!\x93\xf7gX\xf597\x8c(\x93ߟ8J7\u007fE\x81\xdc\xcbU\xf6\x9aZoq$:\x9b2;w\x9f\xef\xbf\xc0tt\x11c\xce~\xe1\xfd\x98(G\t2a\xe4w\xc8U\xc4\x1d\x87\xa1\xd4D\xefb \xaf\xe5\xc5\xf6\x84~N\xbf\xa4\xed@*SKf\xadZ\xb8*{\x04\xb6\b):\xa3\xe8Z\xb8\xf6pe\x06쯌\xe0\xff.@fZV\x99طIp\xba\x02\xe7\xc1\x95\xb5\x13Ǵ\xa3^\xd1kah\xef#ڬ`&1gӎl\x19\x0f\xd8\x05\x86\xa7\x8el7\r\xed\x8cݗ\x01o\xcf\x1c\xcb\x03\x9d\x9fZ&/\xa5\xb9\xe7\xd5\xcbCю\x18g]\xb8:)\xf6&^\xd4h\x92\xff\xc8Lə\xb8\xb1\x89\x19\xbd\x8e\x95ʶXJz+\x17\xc8\x1c\xf8\xc2:\x03\xf5\xb9\x04\x95\xef\x9c!/`\xfc\xf3\x98\b\xda\x19\x85'\xe4<\x066\xa4\xbcgЁK\x17\xfc\x8d\xb4tX\xc5\xca\xc2F\x0e\x16Eڋ8R\x1c\x160}E\x9d\xfc\xe4o\xa8\xd9\xf6\xb8\x01儯(k\x98\xcd\xf3\xcc\x17;#\v\xadpF\xc1m\x8eY\xd2\x00\xebV\xc7\u007f\x17\xa1\xd0\xed\xd3py\xd2\nn\xf0i\xc1z\xedo9\xec\x19e\xde\xf2\xd9y[\xd9+\xdf\xd47\xb2\xb4ؔ\x17F\xc9\xfbΝ\xb0(\x1a\xd8\xec'^\x8f-l\xacŨ\xe8n\xe6\u007f\x1d\xefޝ\xfd>\x94W\x1b\xbc\xa3\xfa\xd3\x04\xbf\xfd\xdeԪ\xe8\x1e\xa6\xbf\x81l\xfc'\x00\x00\xff\xff\x8c\xdb\x1fܮ\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdc6\f\xbd\xfbW\x10\xe9!-\x10{\x12\xf4\xd0bn\xed&\x87\xa0i\x10\xec\xa6{)z\xd0\xc8\x1c[]YREj6ۢ\xff\xbd\xa0dχǳ\xbb9T7K\x14\xf5D>>\xd1U]ו\n\xe6\x16#\x19\xef֠\x82\xc1/\x8cN\xbe\xa8\xb9\xfb\x91\x1a\xe3W\xbb7՝q\xed\x1a\xae\x12\xb1\x1f\xae\x91|\x8a\x1a\xdf\xe2\xd68\xc3ƻj@V\xadb\xb5\xae\x00\x94s\x9e\x95L\x93|\x02h\xef8zk1\xd6\x1d\xba\xe6.mp\x93\x8cm1f\xe7\xd3ѻ\xd7\xcd\x0f\xcd\xeb\n@G\xcc\xdb?\x9b\x01\x89\xd5\x10\xd6\xe0\x92\xb5\x15\x80S\x03\xae\xa1\xf5\xf7\xcez\xd5F\xfc+!15;\xb4\x18}c|E\x01\xb5\x1c\xdaE\x9f\xc2\x1a\x0e\ve\xef\b\xa8\\\xe6\xed\xe8溸\xc9+\xd6\x10\xff\xb2\xb4\xfa\xc1\x8c\x16\xc1\xa6\xa8\xec9\x88\xbcH\xc6uɪx\xb6\\\x01\x90\xf6\x01\xd7\xf0Q`\x04\xa5\xb1\xad\x00ƻgX\xf5x\xbbݛ\xe2J\xf78\xa8\x82\x17\xc0\at?}z\u007f\xfb\xfd\xcd\xc94@\x8b\xa4\xa3\t\x9c#8\xc3\f\x86@\xc1\x88\x00\xd8\xefA\x81r\xa0\"\x9b\xad\xd2\f\xdb\xe8\a\xd8(}\x97\xc2\xde+\x80\xdf\xfc\x89\x9a\x81\xd8G\xd5\xe1+\xa0\xa4{P⯘\x82\xf5\x1dl\x8d\xc5f\xbf)D\x1f0\xb2\x99\xa2\\\xc6\x11\xb9\x8efg\xc0_\xca݊\x15\xb4\xc2*$\xe0\x1e\xa7\xf8`;\x86\x03\xfc\x16\xb87\x04\x11CDBWxv\xe2\x18\xc4H\xb9\xf1\x06\r\xdc`\x147@\xbdO\xb6\x152\xee02DԾs\xe6\xef\xbdo\x92\bɡV\xf1D\x87\xc30\x8e1:ea\xa7l\xc2W\xa0\\\v\x83z\x80\x889N\xc9\x1d\xf9\xcb&\xd4\xc0\xaf>\"\x18\xb7\xf5k\xe8\x99\x03\xadW\xab\xce\xf0TT\xda\x0fCr\x86\x1fV\xb9>\xcc&\xb1\x8f\xb4jq\x87vE\xa6\xabUԽaԜ\"\xaeT0u\x86\xeera5C\xfbM\x1cː^\x9e`\xe5\a\xa1\x19q4\xae;ZȜ\u007f$\x03\xc2\xfaB\x98\xb2\xb5\xdc\xe2\x10h\x99\x92\xe8\\\xbf\xbb\xf9\f\xd3\xd19\x19\xf3\xe8\x17\xe6\xec7\xd2!\x05\x120\xe3\xb6\x18K\x123\xf3\xc4'\xba6x\xe38\u007fhk\xd0\xcd\xc3Oi3\x18\xa6\x89̒\xab\x06\xae\xb2\xd2\xc0\x06!\x85V1\xb6\r\xbcwp\xa5\x06\xb4W\x8a\xf0\u007fO\x80D\x9aj\t\xec\xf3Rp,\x92s\xe3\x12\xb5\xa3\x85I\xc9.\xe4kV\xea7\x01\xb5dO\x02(;\xcd\xd6\xe8\\\x1a\xb0\xf5\x11ԡ\xf2\xc7\x006'\x9e\x97+7\x83S\xb1C\x9e\xcfΰ|\xceFr\xfc}\xafN\x85\xe6[l\xbaF\xb4\x82F E=\xbek\xce<^\xc6\x00\x8b\xec]D2\x91X\xc2 q\x15)\x10\x91:\xc6t~\xb4\ftiX>\xa0\x86\x9f3\xe6\x0f\xbe{t\xfd\xca;\x16\xba?jt\xebm\x1a\xf0Ʃ@\xbd\u007f\xc2\xf6=\xe3\xf0<\xcb\xe9A\xde?R\xe7\x86\xd7(R\x8e\x97/1\x1a\\#%{\xe1\xb8\v\xb4\x9eF~\xbe\x9eΑ<\x80S\x8edK\xd1t\x04i\v\xa2CF:\xc8˽\xe1~\xd1#\xc0}ot\x9f7\xe6\x04\x8br\x11ym\xb2\x0e|=|\xa9\v\x13q\x81du&\xdf´\x80?\x9b\xbeP͗\x0e\xa8\xc7\n{\x96\"\xb0\xe2D_\xa1\t\xd9~\n\xb5N1\xa2\xe3\xd1K~#\xe7\x1b\x9e+\nS%\xfdv\xfd\xe1\tex{\xb0\xcc]\xa02\xae\xa0\t\x11k2\x9d\xbc\xec\xb2&ڐk\xf6<\x18e\x9cv\x1a\xa7\x81Z\xcc(~\t&f\x05|\x02\u2efda\x110t\xe5q\x9a\xf7R\xd9!R~\xf8\xb5\x9a\xb7\x1c26\b-Zdla\xf3P\x94\xf8\x81\x18\x87s\xdc[\x1f\a\xc5k\x90G\xabf\xb3@#\xe9w\xd5\xc6\xe2\x1a8\xa6K,[\xbcx\xe8\x15-\x94\xe1ɝ?\x89\xcd\x121\xf6\xc5\xf8(3\xe0\xa2^\xd6\xf0\x11\xef\x17f?E\xaf\x91\b\xcf\xcb\xe8\xe2M\x16\x8b\xe0l\x92\xa4\xb3h\x8f\xa246\xac\xc73i\xb3\xef\x94&\xc4c)\xc1?\xffV\x87\xaaRZc`l?\xce\u007f\x14^\xbc8\xe9\xfc\xf3\xa7\xf6\xae5\xe5\x1f\a~\xff\xa3*\ac{;5\xf42\xf9_\x00\x00\x00\xff\xff\xcbT\xc3P]\r\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_o\x1b\xb9\x11\u007fק\x18\xf8\x1e\xdc\x03\xbc\xd2ݵh\v\xbd]\xec^\xe1\xf6\xce1\"7/A\x1eF\xcb\xd9]\xd6\\\x92%\xb9RԢ߽\x18\x92\xab?\xab\x95d\x1bH\xba/\x89\xc9\xe1p\xfe\xcfo\xa8IQ\x14\x13\xb4\xf2#9/\x8d\x9e\x03ZI_\x02i\xfe\xcbO\x9f\xff\xec\xa7\xd2\xccV?N\x9e\xa5\x16s\xb8\xed|0\xed\a\xf2\xa6s%\xddQ%\xb5\f\xd2\xe8IK\x01\x05\x06\x9cO\x00Pk\x13\x90\x97=\xff\tP\x1a\x1d\x9cQ\x8a\\Q\x93\x9e>wKZvR\tr\x91y\u007f\xf5\xea\x87韦?L\x00JG\xf1\xf8\x93l\xc9\al\xed\x1ct\xa7\xd4\x04@cKs\xb0F\xac\x8c\xeaZZb\xf9\xdcY?]\x91\"g\xa6\xd2L\xbc\xa5\x92/\xad\x9d\xe9\xec\x1cv\x1b\xe9l\x16()\xf3h\xc4\xc7\xc8\xe6]d\x13w\x94\xf4\xe1\xefc\xbb\xbfJ\x1f\"\x85U\x9dCu,D\xdc\xf4RםBw\xb4=\x01\xf0\xa5\xb14\x87\a\x16\xc3bIb\x02\x90u\x8fb\x15Y\xbbՏ\x89U\xd9P\x8bI^\x00cI\xff\xfcx\xff\xf1\xf7\x8b\x83e\x00\xeb\x8c%\x17d\xafZ\xfa\xf6<\xba\xb7\n ȗN\xda\x10\xed}\xcd\f\x13\x15\bv%y\b\r\xf5B\x91\xc82\x80\xa9 4҃#\xebȓN\xce=`\fL\x84\x1a\xcc\xf2\x9fT\x86),\xc81\x1b\xf0\x8d\xe9\x94\xe0\bX\x91\v\xe0\xa84\xb5\x96\xff\xde\xf2\xf6\x10L\xbcTa\xa0l\xe1\xdd'u \xa7Q\xc1\nUG7\x80Z@\x8b\x1bpķ@\xa7\xf7\xf8E\x12?\x85ߌ#\x90\xba2shB\xb0~>\x9b\xd52\xf4\x91\\\x9a\xb6\xed\xb4\f\x9bY\fJ\xb9\xec\x82q~&hEj\xe6e]\xa0+\x1b\x19\xa8\f\x9d\xa3\x19ZYD\xd1u\x8c\xe6i+\xbes9\xf6\xfd\xf5\x81\xacaþ\xf5\xc1I]\xefm\xc4@;\xe3\x01\x0e5\x90\x1e0\x1fMZ\xec\f\xcdKl\x9d\x0f\u007fY<A\u007fut\xc6\xd0\xfa\xd1\ueec3~\xe7\x026\x98\xd4\x15\xb9\xe4\xc4ʙ6\xf2$-\xac\x91:\xc4?J%I\x0f\xcd\xef\xbbe+\x03\xfb\xfd_\x1d\xf9\xc0\xbe\x9a\xc2mLoX\x12tV` 1\x85{\r\xb7ؒ\xbaEO_\xdd\x01li_\xb0a_\xe6\x82\xfd\xca4$NV\xdb\xdb\xe8\xcb\xc7\t\u007f\rj\xc2\xc2R\xc9\xdec\x03\xf2IY\xc92\xa6\x06T\xc6\x01\x0eɧ\a\x8c\xc7\x13\x97\xbfT1\x16\xc18\xac\xe9W\x93X\x0e\x89\x06\x92\xbd\x1b;\xd3\xcb\xc6u%%1e\xe6\xe0\x13\xe5\x11S\x00\xd5\x1f^7\xe4(\x9eq\xe4\x83,9\xb8\x8c\x97\xc1\xb8\r3f\x0e$\xa6G\x1cN\xb8\x81?m\x04]\xd0\xe3\xc1\b\x1a\x13\x9b\x8fBh0E룉Y\xe3:\xad\x8fo\xe1\xcf\xe8W\tf\x8d\xb8 W\xbe\x11\xc1QE\x8e4ga*\\\xd6\xc4\xf2\x16P\xea>[S\xe1\x87`F$[&\x17\x90\x80a@\xc0٠\x803U}T\xe2\x9f\x1f\xef\xfbJ\xde\x1b1\xcb\x1e\x8e\xef\xbd`\x1f\xfe*IJ<bh^p\xf7\xf5}\x95.\x8b5-\x18@\xb0\x92J:h\x12 \xb5\x0f\x84\x02L5ʑ\x81\x04p\xe2;\xca'nR\x05˥r\xd7Z\xd8\xf6\x80\\;\xa5\x80\xbf-\xde?\xcc\xfe:f\xfa\xad\x16\x80eI\x9e\x19a\xa0\x96t\xb8\x01ߕ\r\xa0g5\xa4#\xb1\xe0\x9di\x8bZV\xe4\xc34\xdfA\xce\u007f\xfa\xe9\xf3\xb8\xf5\x00~1\x0e\xe8\v\xb6V\xd1\r\xc8d\xf1mY\xee\x83F\xfad\x8e-GX\xcb\xd0\xc8a3\xddZ\x80\xc3+\xab\xbd\x8e\xea\x06|&0Yݎ@\xc9g\x9a\xc3\x15\x97\x9f=1\xffù\xf3߫\x13\\\u007f\x97R\xfb\x8a\x89\xae\x92p\xdb>\xbc\x9ft;!S\xe69Y\xd7\xe4\"p\x19\xfbbS\xe1R\xfd=\x18\xc7\x16\xd0f\x8fEd\xcc\xdeK\x85\x92đП~\xfa|R\xe2C{\x81Ԃ\xbe\xc0O u\xb2\x8d5\xe2\xfb)<\xc5\xe8\xd8\xe8\x80_\xf8\xa6\xb21\x9eNY\xd6h\xb5a\x9d\x1b\\\x11x\xd3\x12\xacI\xa9\"\xe1 \x01kܰ\x15z\xc7q\xbc!Xt\xe1l\xb4\xf6\xe8\xe7\xe9\xfd\xdd\xfby\x92\x8c\x03\xaa\x8e\x95\x98\xbbf%\x19\xcd0\x8cI\xbd8F\xe3Q3\xef?ߥ\xf0\t\x06\xca\x06uMI_\x82\xaa\xe3\xee8\xbd~K\x1e\x1fC\x92\xfe\x1b\x81&\xc3\xc2\xf1\u007fk\xee/T.\"\xe8\x17(\xf7\xb0\x17\xe5g\x95\xe3Y\xc5i\n\x14\xf5\x13\xa6\xf4\xacZI6\xf8\x99Y\x91[IZ\xcf\xd6\xc6=K]\x17\x1c\x9aE\x8a\x01?\x8b\xe3\xc6\xec\xbb\xf8ϛu\x89\x83\xc2K\x15\x8a\xc4\xdfB+\xbe\xc7\xcfޤT\x8fa_\xdeǮ\x17\x19Y\r\xcfrZ\xac\x1bY6\xfdp\x92k\xec\x89d\x92\x8c\x84E*ͨ7_=\x94٠\x9dc\x896E\x1e\x80\vԂ\xff\xef\xa5\x0f\xbc\xfe&\vv\xf2E\xe9\xfb\x8f\xfb\xbbo\x13\xe0\x9d|S\xae\x9e\x00\xe0)F\xac\xb9\x17l\xcaJ\x92\xbb\x00\xcc>\x1c\x10\xf7\xd0q\x04\xb1ni^\x85\f\x03\xd6#P\f\x85\x88\xcf\x1e\xa8\x1e\xcf\x02\xb6\xb3\x168P\xe3\tk\x0f\xe8\b\x10Z\xb4\xec\xb9g\xda\x14\xa9\xc5[\x94ܟ\xb9\x05g̳$@k\x95\x1cmŹ\x91g\x10\x9a\xf1>\x0f\xdaX\xfbS\xba\x8f\xfa!q\xb8`\xff4\xe0\x8cA\xf6,@\xc27[\xd8\x1e\f,\xc7R\xf4\f(>iE\x9eK\x19\xad\x1d\x8aX\x8c\x0fP\x03\x1a\x1e(\x06Kֈ\xc1\xcaa$\x0e6\x93~/\x9a*\x03\x86οb\xae\x8c\xf4\xbdMS\x15\t\x99K\x84\xd0o\x9d,K\xc3\xe8\xf4\xf0i\xed\xbc{o\x8fO\xc4G\x1c'\x92pA\xb6\x1c\xb39\xca\xd6\xe8\xfb;\xc6FC\xd8c\x97Nƺ\xcd\xdcHD\xe8\xc8ȶB\xa9H@\xff\xb67<3\xc2u\x9f˒*.r\x9dU\x06E?\x90e\xf1\xb6\xf0\x8c\xe7\xf5\xf8:r\xed\xcf\xf0\xec<\x898ɏ\x18\xe1\x18\xb2UƵ\x18\xe6 0P1\xcaTwJ\xe1R\xd1\x1c\x82뎷\xcf\x14\x8b\x96\xbc\xc7\xfaR*\xfe\x96\xa8Ҝ\x9a\x8f\x00.M\x17\xb6\x83\xeaAQ\xb8\xf69\xa6^7+\x8f\x8e\x80\x87\xe1\x8c\f\xd1}\x86\xaaJ\xc53\xfb\x85`\xf7 \x1c\xa5Z\xd2x\xab{KM\x00\xb0\r\xfaK\xa6zd\x9a\xb1\x04\xdbV\xaf\xb3\x19\xc6\x1f\xe9\xae=\xbe\xa5\x80\aZ\x8f\xac\xde\xebGgjG\xfe8p\x8a>\xbeF\xaay\x01\xbf\xc4lx\x95\xfe\xf9\xa2K&\xc8d\xd0\x18\xd5'\xb3\t\xa8@w\xed\x92\x1c\xdba\xb9\t\xe4\x0f\xcb\xf9ثD\x9cfvf\xdc;\xdf\xfb/q\xca\x03Z\x89:\xbe\x1erv\x05\x03Bz\xabp3¸W$\"\x16N..\x01\xbbx\xee\x93ڒ\x8b[\xaf}M\x892\xdd\x19}\x02_\xf7\xf9,u\xf8\xe3\x1f\xce\xe0\x1b\xa9\x03Ճ\xe6\x90\xf7ٜ\xef\xf8\x96\xafsÙ\xd6\xed5Zߘp\u007fw!\n\x16[\xc2>\x1bv@)־\xf8\xb6\x99\x89r(\x8c\xb9j[[^\x95\xaa>\xa0\v/mE\x8b\x03\xe2\v](r\x1e\xefA\v\xb2\xe88\xd3\xe3K\xf8\xed\xf0\xb7\xa6\x1b\xf02>\xef1\xdeJ\x00,\rߞ\x9b\x13\x03K\xe3h\xa4d\xc2q[9h\"\x87\xe2\u007f\xcb\xfe1\x1a'G\x8bQr\xb1\xc7;?\x11\xe7\x95\x1d\x86\xc1\x92\xa7\x03\x12\x0f\xc3\xdfӮ\xd2\xebM\xff\x03Y\xfc\xb34:Ae?\x87O\x9f'\x90\x9f\x8d?\xf6\xbf{\xf1\xe2\xff\x02\x00\x00\xff\xffTTw\xa4\x84\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs\xe3\xb8\x11\xbe\xebWty\x0f\xceV\r\xa9\xddI*I\xe9\xb6kgSJv=\xae\x913\x97\xa99@DS\xec\x98\x04\x18\xa0)YI忧\x1a \xf4\xa4\x1ev\xd5Lx\xb1\x85G\xe3\xeb\xaf\x1f\xe8&GY\x96\x8dTK\x9f\xd0y\xb2f\x02\xaa%|a4\xf2\xcb\xe7\xcf\u007f\xf69\xd9\xf1\xf2\xc7\xd13\x19=\x81\xbbγm>\xa2\xb7\x9d+\xf0\x1eK2\xc4dͨAVZ\xb1\x9a\x8c\x00\x941\x96\x95\f{\xf9\tPX\xc3\xce\xd65\xbal\x81&\u007f\xee\xe68\xef\xa8\xd6\xe8\x82\xf0t\xf4\xf2\x87\xfcO\xf9\x0f#\x80\xc2a\xd8\xfeD\rzVM;\x01\xd3\xd5\xf5\b\xc0\xa8\x06'\xd0Z\xbd\xb4uנC\xcf֡ϗX\xa3\xb39ّo\xb1\x90S\x17\xcev\xed\x04\xb6\x13qs\x8f(j\xf3h\xf5\xa7 \xe7c\x94\x13\xa6j\xf2\xfc\xf7\xc1\xe9_\xc9sX\xd2֝S\xf5\x00\x8e0\xeb\xc9,\xbaZ\xb9\xe3\xf9\x11\x80/l\x8b\x13x\x10(\xad*P\x8f\x00z\x02\x02\xb4\xacWq\xf9c\x94UTب\x88\x19\xc0\xb6h~z\x9c~\xfa\xfdlo\x18\xa0u\xb6EǔԋώYwF\x014\xfa\xc2Qˁ\xf4[\x11\x18W\x81\x16{\xa2\a\xae0\x81B\xddc\x00[\x02W\xe4\xc1a\xebУ\x89\x16\xde\x13\f\xb2H\x19\xb0\xf3\u007fb\xc19\xccЉ\x18\xf0\x95\xedj-n\xb0D\xc7ర\vC\xff\xde\xc8\xf6\xc06\x1cZ+ƞ\xe3\xedC\x86\xd1\x19U\xc3R\xd5\x1d\xbe\x03e44j\r\x0e\xe5\x14\xe8̎\xbc\xb0\xc4\xe7\xf0\x9bu\bdJ;\x81\x8a\xb9\xf5\x93\xf1xA\x9cܹ\xb0M\xd3\x19\xe2\xf58x&\xcd;\xb6Ώ5.\xb1\x1e{Zd\xca\x15\x151\x16\xdc9\x1c\xab\x96\xb2\x00\xdd\x04\x97\xce\x1b\xfd\x9d\xeb\x03\xc0\xdf\xeea\xe5\xb5\xd8ֳ#\xb3ؙ\b\xcev\xc6\x02\xe2m@\x1eT\xbf5j\xb1%Z\x86\x84\x9d\x8f\u007f\x99=A::\x18\xe3\x90\xfd\xc0\xfbv\xa3ߚ@\b#S\xa2\x8bF,\x9dm\x82L4\xba\xb5d8\xfc(jBsH\xbf\xef\xe6\r\xb1\xd8\xfd_\x1dz\x16[\xe5p\x17b\x1c\xe6\b]\xab\x15\xa3\xceaj\xe0N5X\xdf)\x8f_\xdd\x00´τ\xd8\xebL\xb0\x9b\x9e\x0e\x17G\xd6v&R\n9a\xafô0k\xb1\x10\xf3\t\x83\xb2\x95J*Bl@i\x1d\xa8\xa3\xf5\xf9\x9e\xe8\xe1Еg\xae\x8a箝\xb1uj\x81\xbf\xda(\xf3p\xd1\x01\xb6\x9f\x87\xf6$p\x92Yb\x18c/\x1c|\\y$\x14\xa0N\x9bW\x15:\f{$\x8bQ!\xeee=\xb1uk\x11\x1cT\xd2\xf9\x91\x84\x13\x86\b*[}A\x8dG\xdb\a\x84\xc3\x12\x1d\x1aq\xf7\x98!Z\x1b\xf2\b+2),b\x8a\x05\xb6\x03Z\xcc#\xeaa\x88\xa7\xa9\x873\xd9s\x10\xf0O\x8fӔ1\x13\xc3=t>>\xf7\x02=\U00094135~T\\]q\xf6\xed\xb4\x8c\x87\x85\xdc\xc1\x16\x14\xb4\x84\x05\xee%c \xe3\x19\x95\x06[\x0eJ\x94[\x1b$\xc0\x1c\xf6;\xde\xc5Lѧ\xa4m\n\x17\xeaAI\x8e\"\r\u007f\x9b}x\x18\xffu\x88\xf9\x8d\x16\xa0\x8a\x02\xbd\bR\x8c\r\x1a~\a\xbe+*P^\xd4 \x87z&3y\xa3\f\x95\xe89\xef\xcf@\xe7?\xbf\xff2\xcc\x1e\xc0/\xd6\x01\xbe\xa8\xa6\xad\xf1\x1dPd|\x93\xfe\x92ϐ\x8ftl$\u008a\xb8\xa2\xc3KkÀxW\xaf\xf6*\xa8\xcb\xea\x19\xc1\xf6\xeav\b5=\xe3\x04n$\xcaw`\xfeG\x02\xeb\xbf7'\xa4\xfe.\x06Ѝ,\xba\x89\xe06\xf7\xddnDnAr\xa5\x18\xd8\xd1b\x81.\x14\bCOHޒ\x12\xbf\a\xeb\x84\x01cwD\x04\xc1b\xbd\x98\x8fP\x1f\x81\xfe\xfc\xfe\xcbI\xc4\xfb|\x01\x19\x8d/\xf0\x1e\xc8DnZ\xab\xbf\xcf\xe1)x\xc7ڰz\x91\x93\x8a\xcaz<Ŭ5\xf5Zt\xae\xd4\x12\xc1\xdb\x06a\x85u\x9d\xc5zC\xc3J\xad\x85\x85d8\xf17\x05\xadr|\xd6[S\x95\xf1\xf4\xe1\xfe\xc3$\"\x13\x87Z\x84|'\xb7SIR5H\xb9\x10\xef\xbc\xe0\x8dG\x97fz|\x17݇-\x14\x952\v\x8c\xfa\"\x94\x9d\xdcB\xf9\xed[\xe2\xf8\xf8\xeaO\xcf@\tp\x988\xfeo\x97\xe8\x95ʅJ\xf5\n\xe5\x1ev\xbc\xfc\xacr\xd2\x188\x83\x8cA?m\v/\xaa\x15ز\x1f\xdb%\xba%\xe1j\xbc\xb2\xee\x99\xcc\"\x13\xd7̢\x0f\xf8q(\xed\xc7߅?o\xd6%\x14\xe4\xd7*\x14\x16\u007f\v\xad\xe4\x1c?~\x93R\xa9V\xbc\xfe\x1e\xbb\x9d\xf5\x05\xcc\xe1^\t\x8bUEE\x95\x9a\x80>Ǟ\b&\x92\x8aS\xc7Ԭ\xcc\xfa\xab\xbb\xb2\x10\xda9A\xb4\xce\xfan3SF\xcb\xff\x9e<\xcb\xf8\x9b\x18\xec\xe8\xaa\xf0\xfd\xc7\xf4\xfe\xdb8xGo\x8a\xd5\x13\x85n\xf4\x91\xd6N\xb5PY\x12\xba\vu\xd9ǽũ\xae\x1c\xa8\v7k^U\x18z\xa3Z_Y\x9e\xde_\xc01\xdb,L\x18\xb6\x06\xe8\xcb\xc1$K\x1c\xf7l\x15x\x06O\x14u\x01K\xac\xed\x87j\xec\x1eI\xac9\u0088Ե\x01\xcfp\xb0\xbe\x16\xa1\xb4dR@\xed#̆;\x87\x835\xad\xd5\a#\xfb\x9ep0\xb95\xcd\xc1DT\U000aad8a\x15w\xfe5\x8dUؐ\x98\x8d\xf1ͽ\x98Pܾ\xb9\xb5*\xac\x14\x8e\xfb\xaf\x98\xce[\xf9\xeexGx\x8f\xe1tD\xc7\xd4`\xe8W\x02\x0eX)\x9f\x0e\x19\xb2(\xecȋ[CN\x15q\xa8CY'Ug\xa9\xa8F\r\x9b\x97\\\xf0$\x1dfh\xe8o\x87\xaa\x98$\xa8\xf3\xa8C\xef9\x00\xfax_i]\xa3x\x02\xd2\xc6g\"\xe2h\x85\xe9\xeaZ\xcdk\x9c\x00\xbb\xeex\xfaL\x005\xe8\xbdZ\\\x8a\xa0\xdf\xe2\xaa\xd8\xf1\xf5[@\xcdmǛ\x96\xaf\x0f\xa5\x9e\x8a[\xdf{\xc1\xeb\xda\xceJ\xf9KP\x1ee͐\xc7m\x82\xfa\xbc\xcbɃ\xa6k\x8e\x8f\xc9\xe0\x01W\x03\xa3S\xf3\xe8\xec¡?\xb6L\x96\f8\xd0\x04d\xf0K\xf0\x8eW\x11\xd0\x1ft\x89\x83~\x19T\xb6N\xdemY\xd5`\xbaf\x8eN\x88\x98\xaf\x19}b$\xa5\x86\xa1\x1e:\xd4\xde[&\xb7\x12R\xb6\x8b\xa2\xfan\xa2P&\xbcR\x12\xffe\v\x9a|[\xab\xf5\x80ܤI\xb8^\xc5}%\x8e\xb6\x1e\x93\xa2P\xc2?̽\xb6\xf7\x0f\xa0\xee\xad9Q\r\xa6\x90!\xc3\u007f\xfcÙۘ\f\xe3\xe2 \x95\xf6\xf3B\xe8\xcfr\xca\xd79\xe1̅\xefY9\xbe6\xed\xcd\xf6\x16_\xcaxA\xf4p\xbe\xdbM]ǉj\xff\x98o\x99\xa3\x06\x89:\x1a\f\xc8\xf5\x8e\xec\xfe\xbdY?\xb2\xbd\xd9T!\xc5\x1c\xea\x87\xc3O\r77{_\x0e\xc2\xcf\xc2\x1aM\xf13\t|\xfe2\x82\xfe]ڧ\xf49@\x06\xff\x17\x00\x00\xff\xffñ\x1b\xae\xa0\x19\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xc1n\xe36\x10\xbd\xfb+\x06\xdb\xc3^*y\x17=\xb4ЭM[ h\x12,\x9cE.E\x0f\x145\xb2\xa7\xa1H\x96\x1c:u\xbf\xbe\x18J\x8aeY\x897\v\xacn&g\x1e\xdf̛\x19ҫ\xa2(V\xca\xd3\x03\x86H\xceV\xa0<ῌV~\xc5\xf2\xf1\xa7X\x92[\xef?\xae\x1e\xc96\x15\\\xa5Ȯ\xdb`t)h\xfc\x15[\xb2\xc4\xe4\xec\xaaCV\x8dbU\xad\x00\x94\xb5\x8e\x95,G\xf9\t\xa0\x9d\xe5\xe0\x8c\xc1Plі\x8f\xa9\xc6:\x91i0d\xf0\xf1\xe8\xfd\x87\xf2\xc7\xf2\xc3\n@\a\xcc\ue7e9\xc3Ȫ\xf3\x15\xd8d\xcc\n\xc0\xaa\x0e+\b\x18\x99t@\xef\"\xb1\v\x84\xb1ܣ\xc1\xe0Jr\xab\xe8Q˱\xdb\xe0\x92\xaf\xe0\xb8\xd1{\x0f\x94\xfap6\x19h3\x02\x1d\xf2\x96\xa1\xc8\u007f,n\xdfP\xe4l\xe2M\n\xca,\x11\xc9ۑ\xec6\x19\x15\xce\f䀨\x9d\xc7\n\ue10bW\x1a\x9b\x15\xc0\x90\x82̭\x18\x82\xdc\u007f\xec\xb1\xf4\x0e;Փ\x06p\x1e\xedϟ\xae\x1f~\xb8?Y\x06\xf0\xc1y\fLc|\xfd7\x11v\xb2\n\xd0`ԁ<紿\x17\xc0\xde\n\x1aQ\x14#\xf0\x0eGR\xd8\f\x1c\xc0\xb5\xc0;\x8a\x10\xd0\a\x8ch{\x8dO\x80A\x8c\x94\x05W\xff\x8d\x9aK\xb8\xc7 0\x10w.\x99F\na\x8f\x81!\xa0v[K\xff=cG`\x97\x0f5\x8aqH\xf2\xf1#\xcb\x18\xac2\xb0W&\xe1\xf7\xa0l\x03\x9d:@@9\x05\x92\x9d\xe0e\x93X\u00ad\v\bd[W\xc1\x8e\xd9\xc7j\xbd\xde\x12\x8f\x05\xad]\xd7%K|X\xe7ڤ:\xb1\vq\xdd\xe0\x1e\xcd:ҶPA\xef\x88Qs\n\xb8V\x9e\x8aL\xdd\xe6\xa2.\xbb\xe6\xbb0\xb4@|\u007f\u0095\x0f\xa2m\xe4@v;\xd9\xc8\xd5\xf6\x8a\x02Rn@\x11\xd4\xe0\xdaGqL\xb4,Iv6\xbf\xdd\u007f\x86\xf1\xe8,\xc6<\xfb9\xefG\xc7x\x94@\x12F\xb6\xc5Ћ\xd8\x06\xd7eL\xb4\x8dwd9\xffІ\xd0\xce\xd3\x1fS\xdd\x11\x8b\xee\xff$\x8c,Z\x95p\x95\xbb\x1cj\x84\xe4\x1b\xc5ؔpm\xe1Juh\xaeT\xc4o.\x80d:\x16\x92\xd8/\x93`:\xa0\xe6\xc6}\xd6&\x1b\xe3\fyA\xaf\xf9\\\xb8\xf7\xa8E>ɠ\xb8RK:\xf7\x06\xb4.\x80:\xb3/O\xa0\x97[W\xbeZ\xe9\xc7\xe4\xef\xd9\x05\xb5\xc5\x1b\xd7c\u038df\xdc~Y\xf2\x19\xc9\xc9d\xe9\xdb\x18\x97\rϰ\x01x\xa7xҿ\xac\xc8>\x8f\x81\xc5x^\x11!\v\xa1\xa4\x9d\xad\xb2\x1a\u007f\xcf\x15e\xf5\xe1BL\xb7\v.\x12\xd2\xce=\x81k\x19\xed\x14t\xe0\xba\x10I\x8d\x10\x92}\x13\xd9~~_7Rx-a\xb8@t33\x1f\xf3\xde&c\x06\xacB\xbb\xce+\xa6\xda\xe0\xf2\x91\xf2I\xd9P\x8fr\xe8{\xff\xeb\xf3\xbdw&u\xf8|\xdd\\\x88\xe0\xe1\xd4zZ8\xfd\xc2@EB\x81pzq\x9e~C\xadD\xf0\xae\x19H\f\x05\x1d%\xbe7\xc4 \x92S\xc0\xd9\x04-\x96\xdbcf\xb3Tm3\x93\xb9Ƴ\xedY\xfe\xbeh|\xb0\xe2\x14\xdf2@\xb2Øl\x9dB@\xcb\x03L\xbeQ\xbfz\x84\x18\x15y\xd2>\xf2\xa2\xbaP\x017\xe7\x1e#1\x01\x03\x96\x85i\xbf=\xa9\xf9-\x94E[\xea\xb4օNq\x05ra\x14\x02tf!\xef<U\x1b\xac\x80C:\xdf~m\xae`\x8cj{)\xba\xdbު\xbfl\a\x17P\xb5K\xfcB\xeayw\xce\x02.\xc8q\x81\xa9ߩx\x89\xe7'\xb1Y*\x88\xe7\xf9}\x99\x02\xdaԝ\x1fS\xc0\x1d>-\xacnP5\xe7}\\\xc0\x9d\xe3\xe5\xad\x17#\\슳\xc5(\xef\x92f\xa2s\xec\x1byX9\xf6\x90\xd2\x1a=cs7\u007f\xbd\xbf{w\xf2\x18\xcf?\xb5\xb3\r\xf5\u007f=\xe0ϿV=*6\x0f\xe3\x03[\x16\xff\x0f\x00\x00\xff\xff\x83\xf9\xd9\xe0\xf4\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\xbd}s#\xb7\x910\xfe\xbf?\x05Jq\xfd$]Dʛ\\\xf2\xbb\xdbJ=)eWvT\xf6jU+e\xfd\xa4\x1c\x9f\x0f\x9ci\x928\x81\xc0\x04\xc0P\xe2\x9d\xef\xbb?\x85\x060/\xe4\x90\"0Ծ\u0603\xab\xbax\xa9\x99\x1e\xa0\xd1\xe8wtӂ\xbd\a\xa5\x99\x14/\t-\x18<\x1a\x10\xf6_z|\xffoz\xcc\xe4\xf9\xf2\xc5\x17\xf7L\xe4/ɫR\x1b\xb9x\aZ\x96*\x83\xd70e\x82\x19&\xc5\x17\v04\xa7\x86\xbe\xfc\x82\x10*\x844\xd4\xfe\xac\xed?\tɤ0Jr\x0ej4\x031\xbe/'0)\x19\xcfA!\xf0\xf0\xe9\xe5W\xe3\xff\u007f\xfc\xd5\x17\x84d\n\xf0\xf5;\xb6\x00m\xe8\xa2xID\xc9\xf9\x17\x84\b\xba\x80\x97D\x816R\x81\x1e/\x81\x83\x92c&\xbf\xd0\x05d\xf6c3%\xcb\xe2%\xa9\xff\xe0\xde\xf1\x13q\x8bx\xe7^\xc7_8\xd3\xe6\xdb\xe6\xaf\xdf1m\xf0/\x05/\x15\xe5\xf5\xc7\xf0G\xcdĬ\xe4TU?\u007fA\x88\xced\x01/ɵ\xfdLA3ȿ į\t?;\xf2\xb3^\xbep \xb29,\xa8\x9b\x0f!\xb2\x00qqs\xf5\xfe\xf7\xb7\xad\x9f\t\xc9Ag\x8a\x15\x061\xe3\xe7F\x98&\x94\xbcǵ\xd9\t\xe0&\x103\xa7\x86((\x14h\x10F\x133\aB\x8b\x82\xb3\f\x91XA$DN\xab\xb74\x99*\xb9\xa8\xa1Mhv_\x16\xc4HB\x89\xa1j\x06\x86|[N@\t0\xa0I\xc6Km@\x8d+X\x85\x92\x05(\xc3\x02b\xddh\xd0Q\xe3\u05f5\xb5\x1c\xdb座Hn\t\bܔ=\xca \xf7\x18\xb2\xb35s\xa6륭/\xc7/\x89\n\"'\xff\x05\x99\x19\x93[P\x16\f\xd1sY\xf2\xdc\xd2\xdd\x12\x94EN&g\x82\xfdw\x05[ۅڏrj\xc0\xefw=\x980\xa0\x04\xe5dIy\tg\x84\x8a\x9c,\xe8\x8a(\xb0_!\xa5h\xc0\xc3G\xf4\x98\xbc\xc1\xed\x11S\xf9\x92̍)\xf4\xcb\xf3\xf3\x193\xe1\xfcdr\xb1(\x053\xabs<\nlR\x1a\xa9\xf4y\x0eK\xe0\xe7\x9a\xcdFTesf 3\xa5\x82sZ\xb0\x11N]\xe0\x19\x1a/\xf2\xdfT\xdbvܚ\xabYY\xca\xd3F11k\xfc\x01\xc9|\xc7\x0eX\x82w\xb4\xe4^u\xab\xa8\x11m\u007f\xb2\xd8ywy{פ3\xa6ױ\x8fxo\x10_\xbd\x05\x16aLLA\xb9MDj\xb30A\xe4\x85d\xc2\xe0?2\xce@\xac\xa3_\x97\x93\x053v\xdf\xffY\x82\xb6\x04-\xc7\xe4\x152\x152\x01R\x1695\x90\x8fɕ \xaf\xe8\x02\xf8+\xaa\xe1\xd97\xc0bZ\x8f,b\xf7ۂ&?\\\u007f\xd8a\xad\xf1\x87\xc0\xbc\xb6\xec\x97?\xfd\xb7\x05d\xad\x13c_cS\u007f\xcc\xc9T\xaa\x16s\xb0\xaf\x8c[@\xbb\x0f\xad\x1d\xee\xf4[\x0e\xb6\xfe\x97\xb5\xa9\xfc\xa5z\xd0ҏ\x9dD)\xd8?K@\x16\xe7N,l\xb0\x94\r\x90$\xcc\x0f\xc9b\xbc\xf1\xf7-8\xb5\x03\x1e3^\xe6\x90W\xdcvc-k3\xbe\xdcx\x01\xc5\x11e\xc2ҿe\xffvڢ\xfe\xabe\xa7\x1d3\xa6\n\x88\xa5@&\x1c<\xc2\x04.\xb6\x13\xd3v0\x03\x8b\x8e\xc9\xed\\\x1dA9G'\x1c^\x12\xa3J\u0602\x19\xaa\x14]mAL\x90\xcd\xfb\xe2\xa5z\xde3\x04\xce2h\n\n\x87\x1a'd\xa8ڜ\x11\xf9\xa4\xb12\x97\xf2\xfe)L\xfc\xd5>S\xf30\x92\xa1\x8eC&0\xa7K&\x95_\xbb\x17)\x13 \xf0\bYiP\xcco\xc0-\x91\x9dJE\n\xa9\xcdv,l?\x89\xc4\x1d\x8em[\xb8\x13\x85\x1b+\xf3\x8c#l\xb1]h\x8b\x89H\x01v\xae\v{\x12\xebg\x95,ݳ\xebܾ\x81\xf0n\x8c\x90\tՐ\x13\xe9i\xa0\xe4\xa0\xfd\xb7rdO\xf5);\xdb\n\xbaZ\xbc\x93\xbb\x9cN\x80\x13\r\x1c2#\xd5&&\xf7\xc1\xa7\x1b\xfbp\x8e-x\xec\xe0!\x9e\xf7zN\\/l\aHb\x95\x8e\x879\xcb\xe6N$Z\xdaD8$\x97\xa0\xf1\x18Y\xb5m\xb5m\x91䩽\xf7\x1f\xd9u\x90\xea\xf1đZ\x87\xd7u\xb8\xea\xb1\a\xf3\xa9\xc7\x13l\xa8\x8d\xd9Z_\xed\x94#\xf5\xf8e\"6\xf0\xd5\x04\xa2\xbd\xdax\xf5\xb0D\x8b&\x86U}\xaf\xa6\x04\x16\x85Y\x9d\x11f¯OA\xa4\x9c7\xbe\xff\x19oL<\xc5_\xad\xbfyP\x8a߹+OA\xb4\xbbR}\xfe3\xdc\x14\x14\x16\xb7^V\xec\xbd!\xdf5\xdf:#lZmH~F\xa6\x8c\x1bPk;\xd3\xeb\xbc\x1c\x02\x19\xfb\xc8;;\x16\xd4d\xf3\xcbG\xab\xd9\xe8\xda\x1d\xb3'^\xd6_v\nbИۂ\xf9\t\xb8\x04\x8d9\xa6`\xe1\x8c\xc4;\xc4f\xfd\vj\xd7\x17ׯ!߅\x1e\xb2\x1f\xe5m,\xe4bm\xb2\xcdO{\xadw\xdfexէ\xb2 \x9c\xf9\u007fF(\xb9\x87\x95\xd3X\xa8 vs\xa8\xfd\xd0\x16[b\x139\xe8\x87@\"\xbb\x87\x15\x82\xf1\x8e\x85'\xdfޗ\x14ܸ\x87\xd5>\x8f\xad!\xd0\xceɛ{\x0e\x93\xf6\aD\x04\x9a\xa1\xfb#\x8f\xa0\x93(\xf0\xa2\xa7\x17G\xf6g$a\x04\xdc',\xb3ڶ\x863\r7\xf6X\xbb-\xb2\xa7`Ί=\x17\x8a\xbe4\rxZ\x82\x9b\xe8=\xe5,\xaf>\xe4\xe8\xfeJl׆\xdb\xe3Z\x9a+qF.\x1f\x99\xb6S\x139y-A_K\x83\xbf<\v:\xdd\xc4\x13\x90\xe9^\xc4\xe3%\x1c۶xh\xfa\x9b\xf6 n7\xae\x9c[\xa1\xda\x1e\xa6ɕ\xb0\x86\x8b\xc7\az\x0f\xdd\xe7vˇ\xf6X\x94\x1a\x1dJB\x8a\x11\x8a\xcaqח\x1c\xb2\xf7\x04)UkG6\xa7V}\xd4}pO\xb0wV\x92\xb8\xf7\x9d?\x94\xd3\f\xf2`m\xa2\x17\x8f\x1a\x98\xb1\x8c,@\xcdv\t\x8e\xe6(,\u007f\xdfo\n{r]7\")l?\xd1\x1e\x86g\xdd\xf9ӓ\x19ٓ\xbb\xc7Sa\xb3\x9f|t\x8b\xf3n\xfb\xa3O\xaf\bE,\xea\x1fOb\x97\xe69FZ(\xbf\x89\xe0\xf8\x11{\xb1)\xfb\xddĜ\x84\\\xd0\u009e\xdf\xff\xb1b\x0e\t\xfa\u007fIA\x99\xda\xe3\f_`ЄC\xeb]\xef&j~\xc6~\x81ib\xf7wI\xf9\xa6[\xb8cq\xd2\xf2\x16\xe0N\x90\xcb\xe9\x86\xc6rF\x1e\xe6R;\x99:e\xc0\xbb\\6\xed\xc149\xba\x87\xd5\xd1\xd9\x06\x1f8\xba\x12GN\xc0G\xb3\x9bJ[\x90\x82\xaf\xc8\x11\xbe{\xd4G\tړ\x12\xf7zLt:}\xeb\xd1\"\x8b\xa6\xe3\xb7\xf6\xf8z5w\u05ec\xf7\xa2\xc3Bj\xf3\xd7n\x87ݖ\xf9܄7ںi\x87\xdf\xebI\x9d\xdd\xfb\xb0*\xa6j5\xb9\xa9\x01\xe5\x9dx\x8e\xd1\x06\v\xa0\xa7m\xf4\x94\x93\xaer\xd0\xd1\xcaQn\x11\xfc\x04U\xb8\x00\xc0>S\x8c\xd1\x1a-^\"\xf5\xed\xcbǆ\x8fўP\xfb\xef\xe6B\x0e\xad\xd5fr\xb1\xa0\xeb!\xaf\xbd\xa6\xfaʽ\x19h\xda\x03r\xbb\xaff%\x9e\xcb\xfdս@C\x18\xecz`f\xce\x04\xa1\xe1\xf8\x83\xf2\x04EI!\x9f\xe6Dn̩&\x13\x00\x11\xd0\xf7$kp\xe3y\xe5\xf5\x82\x89+\xfc\x00yqp\xf9Njt%mg@u\xb5\xa1\xd5\x0f(q\xf6U\x8ddN\x1e栠E\x15\x9b\x0eo\xab1\xee\tRH\xd3\xf4+X\xb8\x85̏5\x992\xa5Ms\xa2\xfb\x12\\\xa9\xf7%\x87\xc8\x1d\xb6\xab\xbbc\v\x90\xa5I\u0603\xcb\xfa\xedV\xb4rA\x1f٢\\\x10\xba\x90\xe5\x1e\xc2\xdd\r+_آ\n)\xfa\x1dx\xa0\xcc \xbb\xb3p\xd1\xc3b\xa4ݥ\x82\x83\xd9w\x8b'0\xb5\xec(\x93B\xb3\x1cT\by\xbb\x9de\xd2\x1e\xdc)e\xbc\xec\n\xdft\x8dX3U\\*\x95d\xa5\xbeuo6\xbc\x86s\xf9\xd0F\xd0\xde(\x98\xd3%\x106%\xcc\x10\x10\x99\xdd\x17P\x8ee\xe3'<2\x105{\x93\xe5~\f\xde\x0e\x10\xe5b?\x04\x8c\xf0d3\xb1\xd3)\xd6|\xfck\xca\xf8sl\x9b\xa5\xbc\xf4\xa3\xf1}\xfd\xf6\a9\x1a\x15S\xd9_\x84M\x80\xbc\x03\x9a\xaf\xc2\xf9\xa0\xc6XS\x15i@\x12U\x8a&G|\x86\x93\x11c\xdf\xf9Y\x1c\xd2pc\x82\xed\xb1\xb1k\xfe|f\x9aڎ\x05\xf1\xacڎ\xfd@%\xe8R\\3W-\x00VT\x06\xc5\x19\xe7^QM\x84\xe63\x01k\xa0B\xee\x9c^V|z=\xda%\xf2l\t\x83w\xae.FuYs\xf3zC\xb3\x91\xfc\x16}\x04\xbc\x83w%K\xf2@\x85\tD_)s\x85ܓ\xeacw\xd5\r\xaaf\x11Ood\xd6\x05\x955\xa4\xb7\x810j\x85\xe9V\xfbN\xda\rk\x9a\xe62\xbb\xb7\xeaȂ\xce\xe0\xf8X\x93Wo^[R\xb1Z\x87\x15\x19\x11\x12\xc1\r\xe6\"\xb1\x85\x92K\x96[\xd5\xe9=U\x8cN\xb85\x82\xa7\xa0@d\xa0ɗ'\xef/\xde\xfdt}\xf1\xe6\xf24\n\xb85\x9dᱠ\xc2\xd2`\xa9\x834\xafv\xdf.\x00Ē)),\x82\xe2\xb0q5%\x94,\xc3l\xb3*\x13͚Z|鵹(\x88Պ\x83#\x84\x89\xa24\xc1;\xfa\xc08'\x938\x88\xa5\xc8\xe6T\xcc,^_\xcb\xd2\xce\xf3\xcb/\x11+\n\xf22\xf3\a3\n\xa2?L_\x9e\xf9p\x16\xe5\\>h\x94-\xa03Zx\x1cG\xc1ll/\xd1+a\xe8\xe3K\xc2\xc60&G_6\xfet\x14\x05\x13\xb1U(i\x97\xe9\xe2\x11\x0e\x8b\x9c\x19P\x94\x93\xa3&不\xbf\xb4넼I\xa0\xf85\x01KP\xce\x0ep$\xb7\xaf\x03\xdf\r\x053\xaar\x0eZ[\x9e\xfb0\a3\a\xe5\xddJ\x9e\xc8 \xc6\xeb\xec\x86T\xf6|ufJֹ\x91Q\x10C\x1e\xe5}\x95\b<f\xf2<\x97\x99>7T\xdf\xebs&\xacH\x1d\xe5\xd4\xd0Q\x83\xe9\x9e;i8\xf2\xf2y\x14,\xe9Qu\x1c\xcf\u007f\xa3J!\x98\x98\x8dh\xf5\x14\x13#:\xd2s\xe0\xfc8b\x96Q\xe2\u008dh{\xb7\xf9ZL\x80!\xd21\xe1F\x9b\xa3_V\f\xdc}yL\xae\xa5ٕ\x81\xb6}T\"\fq<\xee\xe4\xf1\x97\xd7w\xef\xfe~\xf3\xf6\xea\xfa.\x96\xb57\xc5\xc2vV\x9f\xc6$[b\xa1\x83\xd5\xc7\xed\xfe.\xb1\xd0f\xf5Qp\xb7\x88\x85\rV\x1f\x87\xd8\x0e\xb1\xb0\xc9\xea\xe3X\xf0\xa6X\xd8\xc2\xea\xa3\xc0\xae\x8b\x85\xad\xac>\nj[,lc\xf5Q \xbb\xc5B\a\xab\x8f\x17B\x9bb\xa1\xcd\xea\xe3 n\x17\vk\xac>\nl\xb7X\x18X}\xc7kq\xac\x1e\xc42\x99\xcd\u007f\xe7ͯ\x06+\xaa\xf6<\x8e\x0e\x8dČ\x03&\xda|\xaeK+x^̷]\x82b\xf9\x9e\xb6\xd3*Ds\xb1Q\x90I}\x1cB\xc66\x8a\xb5ʢ\x8dc1)V\x9a\x1bOEκ\xc7f<\xcdߚH\xc7\ai\xe0dL\xde\xf8\f\x03J^\xfdt\xf5\xfa\xf2\xfa\xee\xea\xeb\xab\xcbwqH!\xe9g\x87\x84\xa4\x91\x9e\xa89\xee0\x0f\x13\xf0\xb2[s\x88\x16\xc8n\x14\n\x96L\x96\x9a\xaf\xaa\xf4\xf6\xfeG\u05cd\xf5\x93\xebS\xcaVD\x83Z\xb2,e\xb6\x9dS\xeb\xa3\xea\xb8\xf1\xa4\u0093\xbe\xfa'Ԟ\x04\xc0\xdbmb\xaf\xfc\xa4\x90\xd6!-c\x0f\xf2\x19\xecc7\x9e\xb0\x92\x13 \x1eV\x81j\xccr\xa7\x1a\x95\x00t\xb7\x8dM\xf6N\\l\x0eT\xbf^Ô\x96\xdcyێ\x8e\xc61\xba\x8c\x1b}Y\xec\xd7J\xee\x19@i\x8e\x16\x9b\xbdu\x97\xbcB\xc4\xe00B\xe8\xd8'ƶ\xd4\x0e\x1di\xaf\xba\xc1|\xeed\xb0)\xa3\xf2\xe6\xea\x91.\xe5\x89\vIO\xd9\xec\r-\xbe\x85\xd5;\x98\xa6\x80XG;\xe6\xcc\xfa\xf4\xd2XӠ\x1e\xa8\xf5\xb8\xa9\xa5pžx!1\x19\xc5]\xa3\x85\x93;\x9f\xfd\x8c:\xacEOڒH\xbf\x83\x15F\x9av\x17F[\x95i\xa8y\xc9\x10+\u007f\x88\xd9\xd7pˤȠ0\xfa\\.\xad\xee\x00\x0f\xe7\x0fR\xdd[K쁙\xf9\xc8\xc5\xc3\xf49^\xc39\xff\r\xfeO\x8f\xd9ݽ}\xfd\xf6%\xb9\xc8s\"\x91Ֆ\x1a\xa6%wiw{g\xfav\x8d\xba\xa8\xc0\x19^l?#%\xcb\xff\x1c\xcfl\xc38\x00m\xc8\xc2eb\x1e\x88>n1\x92\xbf\nR\xaa\a\xae,\v\xaf8\x02\x91\n\xc3o\xfb\xa4\xc1n\x1f!a\xd9+\xba=\xd1>\x91\x92\x03]/\xe2\xb0\xdf\xd8?4\xdc5\xf6K\a\xee\x1a\x11\xe1㮁'\xe00R\xe3\xb8\x16\x1b\xfb\xa5\xb3v\x0fop\x162\u007fItY\x14R\x19]\x15,\x18[F\x10\x17ϨG\v\b\xde\xed;#\xffY\xfd\x88wG\xf4\x0f\xc7\xc7\u007f\xfa\xf6\xf2\xef\xff\xe7\xf8\xf8\xc7\xffL\xfdN\r\xb3Qk\xe6\x10\x80u\x01\xd9X\xc8\x1c,\xcb>s\xff\xf4\x96\xd7E\x86\t2\xd7=У\r5\xa5\x1eϥ6W7gៅ̯nz\x82D\x18:A\x05%\aQ\x02\xb6\x15~\x89\x19-J\x0f\x05bz\vM_K\xc6\xd2\xfb\xd7\xf6\xc8\xdcP3\xdf?Ůk<(f\f\b\xb4UA-4\x91\xd33\xcb\x1d\xd1\x14\xe8û%9Z\xbe\x88\x8cP\xb6\x00\xf4\x17lӀ\xa2\x03m#b۳\x9b>\x1c\x8b\x04צe\u007f\xc1KPeS\xf6\x00zqs\x15\n\x0f}D\xc4\xf7\x95lն}\f\xf9\x16\x12ο~\x169\x17\xa0\xf7\x13uuN\xb1\xbb\x83\xb1\xdfM\xde\xed\x833\xacPDE^W):q?\x8e\xb3\xa2Le\xe6\x1e\xc2\x02\x16R\xad\xce\xc2?\xa1\x98\xc3\x02\x14\xe5#m\xa4\xa2\xb3d\xf1\x13\xa6\x8aS\xac\xff\xe5>\x97\xca\xf9\x1b(\u061ci\\\x12O\x03\xaa\x02\x92\x95\xcaZ;|\x15t\x14\xc8?\x9a|\xab觻DҾ\xa3M\xe4u\xb2z?[\xb3\xe6\x1f\xe8\xc6YJ^.@\x9fUVJ\x0f\xc0\xe8\xd2\x14K\xb2\xa4J\u007fT\x8b+gK\xa6\xf7M\x97\xee\x1aT\xac\xde&\xb2&\x82<\xd6-\x82\t\x03\xb3\x1e&\xda\xe8\x10\xc8\xe84\x1f}\xf9\x88\x1e\x9b-KS\x94\x98<\xbc\xa0\xa6\x8aJ=\x162\xcds\x17F\xa3\xa0O\xdet\x98\xbeHqc\xbbQPc@\x89\x97\xe4?N\xfe\xf1۟G\xa7\u007f>9\xf9\xe1\xabѿ\xff\xf8ۓ\u007f\x8c\xf1?\xfe\xe5\xf4ϧ?\x87\u007f\xfc\xf6\xf4\xf4\xe4\xe4\x87o\xdf|sws\xf9#;\xfd\xf9\aQ.\xeeݿ~>\xf9\x01.\u007f\xdc\x13\xc8\xe9響L\x9e\xf2\xe3\xa8\xf6Ќ\x980#\xa9F\x8e\b\x9e,\xf6\xb0k\x04\xe4\x1e\x8a'\xbd\v\x9aH_\xc1K\x9a\x1a\xdbGd\x1d}U\xab^h\xe8\xa9Yi\xc8\x14\x98O\xcf\xe7\xec\xe6\x15\xd4pw\x8b\xa92\xf8\u007f1n\xe8\xfe\xa6\xa7CSm\xb7`\xb1C\x82\x01\xfa\x1e`1\xb4\xbf\xc4:\x12\xfe\v\xf7\x90\x10\x11\tcp\x95\x0f\xae\xf2m\xe3\x97\xee*\xbfu\xe7\xa7\xf6\x93\xa3\xb7\xbb\xdf\xd9\x1c\xfc\xe4I\xd2.\xf9\xe5\xb4պ\x9a\xdcQ\xaf$\xce01\x9706\xb4ߙOX\x17\x92#\x85,JN\xf7\xbe\x1d[\xcdc3sh3\xb90\n\xa6\x17\xafua\xd0:/ݥ\"D\x1f\xc1\xcd\\7r\xc19a\xc2\tI\xfc\xd8\x03\xe3qv0\xa659\xaf\x03\xa1._qi\xd1\xf00\a\x91t\xb7\xd2\r\xa6\x896T\x19&fc\xf2\xbd\x85\xe5\xb41\x9f\x8b\xc2\x04Y\x94ܰ\"2!\xa9\xb2\xb0\xaa\xda$\x84j-3F\x8d\xc7p\xb4@\xe5T\x9b\xb0%\x98\x96c\xe8=f\\f\x90\x83\xc8\x00kY\x95\x91r0\xec\xf9de1z)\x96nn\x94\xe4\xa5K)\x86h\xee\xd3=\xb7\x8f\x9d\xeej\x8f\xafO\xad\xa9\xb3^#\x99\"\xfa\xb8\x1d\f9\xadK\x89U\xf1\xdd8x\xe9*v\x95\xfd\x92d\x86l\xe8\xd6u|\xbaҌS\xa2\xaaJ.>p\x16P\xba\x9a\xbbUŭ\x15\xd5D}\xe1SSo\x9fE\xb5=\xa4Z\xdbS\xa5\xed\xa7\xce\xeeRe{X<\xf5\x89:D\xb2F?\x05\xb4\x87\x12X(\x98\xb2Ǟ\x1c\xe6BT{DX\x0e°)K\xb2\x13\xacΤ\xa0\x00\x81i\xc2@\xb3\xb9+\x83)\xdaI6)4\xfd\td\xe8;\xcf\xc1a\x18\xfa횟c\xe0\xe6\x037\xdf1\x06n\xbe{\xf8\xe3\xf4\x19\xb3\xf2\x0fh)\xe3\xcd\xe5\xf4\xebկ\x1b\xf7\x9f\x91#<\xc7]\xf9\xea\xbcօU\xce\xf1\x8bq\xc7\x12\x8b\xc0\xe2ѳVd%\xe4\\1}\xf9@\xe6l\x16\xeb\x11\xe3\xb0\x04\xee\xf5{\xb2\xa0\x82\xce\\)M#C\xa8.\xf6v\x84eI\x8a\xe5\xad\xc2k\xb8T+8-\x9b\xe2\x92\xc6\xd1r\xdd;N\x13\xce\ue07c\x86\x82˕\xaf\x98)rrk\xa8\xb1l\xe9\x16L\\\x02\\\x12\xf3\xc0\xd5ܔ\x9c\xdfHβ\xa8\x98C\x9b\xf4\xae\x90抒sR \xa81y+ 6,s\xc1\x1f\xe8J\x9f\x91kX\x82:#W\xd3kin\x9c\xb9Y\xdfO\x89;\xbc\xd2\x03%lJ^\xba\xfeh\xc4\xd0\x19\xba.\xaa\xfauqD\xa1Z\x13s\xc2\xe4\x81\xe9\xbevz\xb4\xc0\xdc8\x80\xbf\xc1\xafZ\xd1\xe9\xfe\xfd\xec\xe4\xc3\xd9\x14\xb2U\xc6\xd3y\xd6E\x86ɮu\xf9\xf5\xfa\xdcƹ\x8cV\xda\xc0\"\x94\rC\a\n\xc32\x93\x85\x14\x1a\\A\xbd\x14\x0fW\xb5B\xe70\xd3=\xf78U\xc9+\xa46\xb7\x86\xaa=˴գ}Jo\x02\x18K\xfe\x19\xe5\x1cr\xc2\x16\v\xc8\x195\xc0\xe3\xfd\xe4\xa1\x02h\xb3ܣkw\xe9\x8aI&\xe9\rs*r\x0e\n\xeb\x15z\x1f`\v\xbe\x01\xb5`\x82\xc6\x16\f!Uz\x17\xba,!'4ˤ\xca}-\xb8Pً\xaax\x8d\xbf\xe2x\xa8+5$\xcfz\xbe^4\xe4\t\x97ٽ&\xa50\x8c\xd7\xe5!CmHߨ1\x1aj\x12\x8b\xa9\xfesT\x9d\x89\x11\xf6#;\xffM\xfd'\xfc!V\xf9\xedc\xf9\xecW\xcfws\xacՠ\x04$\rL\xa6\x94\xf1b+\f\xcc\v\x96V}\xb1DU\xd7W\xad\x04M\x9a\x91\x81\x05\x88\xdb]u(\xb2M\xac\x8bF\xef#\xab\x90\xb8\xd17G!\xa1\x16Ps\xec([\x9c\x1ck\f\x85m9\x13Ь_̰&jz\b\xb3y\x82\x1d?\xf2\x16jz\x10\x98)\xec1\xb2jԶts\xef\x93̯\xa44\xe4\xe4\xf8\xfc\xf8t#\xa8u\x9c\x0eu\xca88\xe9\xea\x8a,e\xd5f%\x83\xd4lQ\xf0\x15\xee\xcfq\x8e\x1d\x9d\xfcuXU\xa6ň\t25\xbbˡ \xd4\x19ђ\x18EC\x97\x81\xf4\xb9Zh\x16\xb8Q\xa5\xd7UN\x8e\u007f>>#`\xb2\xd4|`B\x1e\xa486HFcr'I\xa9\xeb\x89'\xc3\\ɒ\bpu\x00\xe0\xb1\xe0,c\x86\xafP\xcc'Ô\xa5q\xc5\x17\xb1A\"\x16ں|d\xc6\xdf\xd3I\a;%_\xe1iw\xaa\x02\xa1\xd6\x18Z\xc2\xf9\x1c(7\xf3\xf4\xfb}\x96.\x85\x14\xa3\xff\x06%\xb1\x8c\x97\xf0\x10S\xbd;\t\xb1\xb3\xe68@\xc6I\x8a\x1ba\xfd\xed\xc4$\x06\xab |\x03\xd1*'\xd9\xe8Hzww\xf3\r\x98\xb6\bKB\x87\x9dQ\xc8\xcfG\x976\xa8\xa9T\x1d-w\x9f\x1e}\xe5\xdf\\\xea$̐\xcd~\xadڸ\xee\x13\xceH\x11i>g7\x8cl\xa7%\xfb\x8cFru\x93\x9e\x88\xf5wYZlM脯\xaa*\xb2\x1a\f9\xb2SOO{f\x02\xf7\xf3\xaf@s\xac\xdb+\xb4\x01\x9a\xa8\"\x1d\xe0\xa85\xe6r\x18\xa5\xc6\xf5ݝ;\x90=v\xb4Y\x02\xcb\xd3\xfe\x18\xcfT:\x9bt\x15^\x14\x14\x8e\xfd\xfa9~$&\xb9\xc1+\xdc.\xf8\xdf'\xbdr\x13ih\u007f\xec\x96\xe8k;'\x16\xef\b\x83\t\x9c&\x1e\x8a\x1e\xb3\xeb\x9f%Lzg\xab\x92\xae\b\x98\xc3U/\x98\xfe\xeee|Z\xda\xfa8\xc8\xfd\x92\xe4\x12^\xcd\xf1\x9ch\xc2\xe9}|<\xf5K\xb5$i\x89\x88\xed\xd7\xfba\xa2\xe7\r\x05\xd2[\xdf\xc2\xcb<\xc9\u05cd7/\x1b\x1bIh\x96\xa5\x15\x8ar\xc3w/G\x86\xa5A-c\x13\x1c\xebћ\xc4\n\x19\xef\xbf\f\xa3ׅ\xb7\xc3\\w;\xc8e\xb7\x8ez\x89\x8a\x88r1\xe9\xc1I\xaa\x02\x18\xca\xd4\x04\xe37\xbe\x873\xa5*\xb6y\x8d\xd3\v!\xdc>\xfa\x1e\xaa0T̀\xbc\xb03\xfd\xe3\x1f\xfe\xf0\xfb?\x8c\x11\r\xc9PC`\x99\nruq}\xf1\xd3\xed\xfbWX\xc4-\x95ʟ\xe5f\x1b\x96mH\x96?\xed\xc8<\x82\xb2\xd8+5\x16:\xeb\xb3\xc3\xd6\xd6\xf0\xfeo\xe7\\֩q\xb6\xe60\x12\xd9\xcdG\xe23}\x84\xd8\b\x0fч\xb6\xb3MV\xdc\xca\xec\xfe\x00\x96\xf6\xf1ݫ\x1b\a\xaa6\xb6\x93v\x81\x8a\xe0bfb)\xf9\xd2\xf5\v\xbc{u\x83\bJ\xdbY\xfb6\xc6\a\xd0շ\xb2s\f7\xe1]jN\x12T\xb6(|\xc7LJ\x14Pδa\x19~\xab\nS$\xda\xf7\xf2>%\x8b\xe7\x93\xf1+\x1c\xbf\r\xe9@\xe8bH>\xcdk\xae\x89\x96\x8b\xa1\x0f\x8bh\xb8&R\xaft\r\x1a\xc9\xc15\x12'\xea\xa5\xea\xa7\xc7\x0f\x1aɧ\xad\x91|n22\xf9\xd5B\xc1\xad\x91EϬ\t\a\xe4@9\x13\xa1\x13ݶ\xa4\x06\x92'l\xa9\xeb\x1d}qsUy\xc7e+\x11\x01\x93W\xa2\xa1\xea2\x9b\x87،\x00\xad\xcf1=\xa2,\x9c\xe7+4\x94\x8c\x8fX\x15\n\xb0\v\x9f\x14gUE\x02D\a\b\xf7#\x98,\xfe\xb4\xa0O\xc6\xe7\x8e\xf8xbخ\xbei\x18\x99\xa2z\x0eة\x02\x1e\x99ѡ\xdb5\xd5R\xb8\x10\xae\xdf>&\xe3\x03\x98L\x93\x82j\xed\x02w\xa6^\x84\xfbȍ̏\x13\xa2\xb7\x8d\t\x91\x99\xa2\x19\x90\x02\x14\x939\xc1\xaa\u007f\xb9|\x88\x9f\xe7\x04fL\xe8@\xbfv\xa2\xe1`X]\t\x92\"\xc2u\xe7\xd9w\xad.Rخ\xbc4\x99L\xe0\xc3\xfeu\x8f\xc5\xf5\x04\xa2諓8M{|J\xca\xf9\xaa>\xa8ᦧ9\xfc&mf\x12\xa5\"\xa1^\xf7z&Q|4\xb0\x95yd\x8fB\x9d\x95ԇ\xfc[\xd4ɴ=UټG\x9b/\x12\x9f\xbe<\xa46=9\x86Ԧ\xbdǐ\xda4\xa46\r\xa9MCjӐڴ\x17\x88!\xb5\xa9\x9aѐڴc\f\xa9MCj\xd3\xd3cHm\x1aR\x9b\xea1\xa46\xed5\x86Ԧ=Ɛ\xda4\xa46m\x1dC qHm\xfa5\x06\x12\x87Ԧ\xb8ׇԦ\x881\xa46\r\xa9Ma\f\xa9M\x91c\xd0H\x86Ԧ_\xa3F\xf2\xb9\xc9\xc8^\xd5\xc5\"_\vy<7JNz\x94\x19\xbb\xc1X=\xcb|\x1a\x90\x9c&\xd7\xd6q\xd3\x19\x93W\xad\xf4\f߄\xdfUi\x89\x82\xe8\x13}\xea\xf4\xa4\xbe\xf5z\xa2k2\x85\xa2`\xfa\xbc\x90\xee\xff\xd59\x05\x8dd\x02\xe7_\x8b\x11\x0e\xa9\xc27%\x8b\xe0\xa9\f\x82$^\xb7;{\x003\x01\xa2a\x1e2s\xa0\x8fv\xd3#c`G\xb6@\x00\x9b\xc4\\\xbb3\x05\xd6\"\xfeɩ >K`3\xda\xdf'\xe1\x02S\xe16\"\xfd\x89\x10\xab\xec\x80mQ\xfe4\x95\\\x1f>\xc2\xff\f\xd1\xfd\xc3G\xf6wD\xf5\xc9J\x96I0\xb7D\xf4}d>\x916;\xa3\xf9!*\x9f\x06\xb3;\x92ߊȧ\x12S\xaf(~\x8f\xe0TO\xe5:ݓ\x9c\xac)\xf9d㻹\x02=\x97<\x9a\u05f6\xf8\xec\x1b&آ\\X6\xa1-{d\xcb*\x9b9\x9eFB\x9e\x93\xd3:\\\x18\xce\x02f9`\x13K\xcaxJ\xa9:,\xad7\xa7\xe8\x9f\xd0e\x96\x01\xe4VN\xbe\xaeC\xe0\xd10\u007f?\xaeV\xee\xbaj0M^\xc4R\x9ek\xa8\x88\xf6\xdd\xef\u007f\x97\xb4\xfb)\x96ab\xc2\xc6\xd3\xc9\x1a\b9\x1a\x93\xfd\x135\xfa\xa8\x1b\xa9\x8e\x94\xe7I\xceؑ\x98A\xfe\x9e(\x1av$e\x10\x96&f\x0f\x94\x90ыs\xf6L\xc4ؑ\x84\xe1q\x94\xa8\x80l&`\xac'R\xa4\xa1<=\xf9\xa2\x87l{\xae\xa4\x8b\xed\t\x17\xa9$Iz'[\xf4O\xb48`\xb7\xc3:s\xa0ww\xfc^.\xba\x03x\x0e{&U<\x17Z\x0e\x91B\xf0\x11\xbb\xcf&\xefj\x9f䉞\x89\x13}\x92&R\x13&v$K\xf4\xf14\xf7L\x94\xe8E>\xa9\xe1\x88\xe4PD\xff0D\xef\x10Ď\x84\x88>=a;C\x0f\xa9\xfd\v\xc3h\x87\x1d\xd6\xc2\a\x89ja3\xe4p\xd0\xd0\xc1\xc1\xc3\x06\xe9I\f\xbb\x13\x18\x1a\x89\b\xa98\xdcL^蓄Ѓ\xa2S\x99\u007fRP%\x99i3\xc1\f\xa3\xfc5p\xba\xba\x85L\x8a<Z3Zk\x88T\x9dW\xed\xc09\xcb<>\x13\xa2u\x1bsN}\xe7L\xc8Å\xda\x10\r\x89g\xad\xa8>\x12\x8aq\n\xbbzӾ=\xf9q\xe3\x16䣹\fܕ\xd2C\x10\xc1_\xe5\x03\x91S\x03\x82\x9c0\x11\xe8 ޏZ;\vj\u007fQu\xac\xed__|\x15Ϲ\xdcd>_\xc7\x0e\xba\xb6\xb4~>\xbf\x9e\xff\xc0\xe1\x1d{\x1e\xf0\xb4\x8c\xf7ѷ\x9c{\xceA\xd8\xe6\xefћW\xb7\xe1{\x81\xf3\x0e\xdc\x04\xbdԾlC\x02\xccϔ\xa8\x92\xd3ΞL9#\t\x9d\xc7v\xa5\x9bթc\xd1`\xb7\xa4\x9a\xd5ic\xf1\x13ݖf\x96\x942\xf6\xd1=\x9ckib\xe9\xe6\xe7\x96\x141\xaf\x9e%*\xf1\xc9\xe9a\x83\x1d\x169v\xa4\x81\rv\xd8'd\x87}\x1e\x16F\xa3\xd6\xc97\x8afps053\xb0+\x92\x97\x8az\x91\x11\x14\xbc${\xc32\x19\x01\x90;NU\x15\xae\xc1\x8a+Ӓ'\x14\xaf*\v)\xda՟\\NE\xb3\x88K4P\x9f\xedұj\xaf(\xa5\x9c\xd0BI\xa7\xf6\x11U\na\xa5\xae?K\x16)\xd6V\xd2i\x12\xb2Y\xb3G\xb3\x99\xdd.\xabba\x15\x1c\x96 _\x1e\xe6 \x82\x96\xe9'lg7\x95*c\x13\xbe\"s\xcaS\xc2/\x0f\xcc\xcc\t%\xf7\x8cs?\xcd1\xb9\x05C̜\xe9Dg*\x97b\x86\x9bA݄᱀̪\x1d\x19\a*\xca\"m\xfdVY]\xc9R\x85\xf5\xfb\xb6qa\x96)I\x1b\x82\xf1\xb3\xb0\xd5\xc7z\xf7\x81M@\xacKP,5\x84:M\x0fL\xc3Y\x1f̆6\xa3\xee\x1c\xb8u\x17J.Y\x0e9\x99\xac\xd2\xe8_樵\x8e\xc9{\x84\x17\xf8\xbe\x90b$`F\xadm\x14\u007fR\x9d\x10wg\xde\xcd\xd3գ\x109˨I\xb0\xb14\x16֫\xcb\xe9\x91%\xa3\x88\x85\x06\xe5F\x03=\x11\x92HT\x8aK\xc1\xcc\nc\xa3\xf3Ґ\\>\x88\xd31\xb6\x9eMaR\x94L\xc0P\u007f\xafյ\x12D\x81\xa5\t\b:\xe1)\xca\tf\xe2\xdeu\x12(\x99\x025eBw\xbf\x195\xd0\xe9\x0fp\xf4p\xd8\xe3\xc0\xb4\x8f\x80NI)4Dߟi؇\u007f\xfc\xd7\x0fg\x1f\xb2\x05\xc8\xd2|R\x0e\u00879\xcb\xe6M\u007f\x03[\x80&\xb2\xecsm\xcdH\xf2\xc2O\xab\x9b\"\x9e\xb9}\xe4/Ϋ\x98\xa45Ɔ\xd8;\xe2F\xeb\xd5\xfc\xaa\xc4\xe9\xa8uS\xcb\xc3^_\xdf\xfe\xf4\xdd\xc5_.\xbf\x1b\x93K\x9a͛eH\x05\xa1VnD\xc1D\xb92\xa7K \x94\x94\x82\xfd\xb3t\x9d\xc7\xc9I\xf5\x9dӐ\x83\x1f\x057-_?\xc9R\xb4\x82\"\x8a\t\xb46\xe8;\xa6\xb1\xd1+B\xf1\xe9\xacR\x03\x99*\xb9\x88cJ-\xeb\x91\\Z0\xce[\x84\x96\xe6\x1c\x14\x90\x19[F\nY\v\xd57G\xa6yH*\xc6#lO\x8f\xd5b\xe9D\x96\x91&\xd0\x1c\x88\x00cOw\x15\xe1\x92B\xb7jږ\x1at\\~\xf9\xa4\xc4T\xeaB\xb1\x05U\x8c\xaf\x9a\x93\xb4\xea\xeb\xb5\f~\xb8U\xac\xa8m\xa2\xf0\xf5\xdb\xcb[r\xfd\xf6\x8e\x14\n\xcbz\xba\x9c\xe1h\v\xd2n/\x99\x80\xdd \xb7\xe1\xf9\x98\\\x88\x95\xfb\x90\xe3\xe5\x91Z\x06g\xda\x00Z*ޕ\x10\x9a\xd6\x1f}5\xc6\xff;\xb2;\xa8bCDUzy\xb6q\xc9\xc6y.\xd8$\xf2\x1e).\xbdA\x03=\xef\xd8$\xa4z\xad]\x9a\xf0뺱\xa8WP\xb8\xbe\xec\xb1ҒV$\x8d[\x88\xccО?\x9e\xec\xd3Iw\x80f\xcd%\xc5{\xdd:\x1d^\xd3\xcaa\xe5\xe85\xc1\xdf#Eì\xba\xba\t\xe4\xe84j\x94\x04\t@-\x1dZ\x9d\x84\xe5n\x82.A\xe2\x8c|E\xfeD\x1eɟ\x12 \xfe\xf1\x0f\u007f\xf8\xfd\x1f\xe3\xfdY}\xf4\x89>ʨ\xf3v_\xdd\xf4\xdc\xe7\xef-\x1b\xb3\x90\xec\xce\x18I&,鎋\xb3\xec\r(+&<\xc5\xc4㲇\xc7\xd6.\xe1\x93${\x97\x85q5\xad\x95/g\xf4'@\xac\x9c\xb0[\b?\xc5\x17K\xfe\xe4\t\xdfN\xf1\xafR\x9bk\xcfΘn\xcc8\x89\"\xfc\xe1&\vj\xb2y\x9b\xdfZ\x13\"\xe9\xd8\xd7\xe5\x97I.1\x96\xe5\xae\x03\xcdYB\xbe\xf0\xc7;\xbai\xe9\xb3-Jݤ\xa8>\xactͭ\x8f~\n\xaf\x97\xbb\x82\xe5\x89<\xa1\x90\xb97\x18\xec\x92\U000c640c\xb5\x18\xdc\xd8b7\xf8(EZ\xf1\x97\xfab\xbe\xe5\x85\x19\x15\xee&\xf1\x14\x94\u009bf)(]a\xc6$\xcb \x81,{p\xc1BI#3\xc9Sh\v\xb5Ɨ\xe4\xeeU|\x19\xc5v機\x03\xfah]\xb4\xfaM2a\xfe\xed\xf5͙\x9d\xd2\x19\x91\x8aܾ\xba\xbb\xe9w\x95\x89\x90\xa3\xbbW7G\x1fpOҢS\xa3\xb6.\x17\xf9n\xa0\x828\xeb\xacOE\x81\xd8D\xe7V\b\xd0Z0\xa3\x05-F\xf7\xb0\x8a\xd2yӱ\x94\x84\xa3\xcdI\xbb\xc5/\xe8\xfe7\xc1\x14М}B\xc5\x14<\x97\xaa\xe7\xd5]Ua!\x97\x91N#\xb4\xf6\x02t\x10y!\x990\xba\xab\xd4B\x14\xd8M\x93\xf1\x93IY\x1cJ-t\x8c\xa1\xd4\xc2\xd61\x94Z\x18J-\f\xa5\x16\x86R\v\xddc(\xb50\x94Z\xf8\xac\x92\xa7\x87R\v\x8d1\x94ZH\x18C\xa9\x85mc(\xb5\xb0\xd7\x18J-l\x8e\xa1\xd4B\xe7\x18J-t\x8c\xa1\xd4¾c(\xb5P\x8d_\xce\x15\x9f\xa1\xd4§z\xc5g(\xb5\xb0\xcf\xf8<.B\r\xa5\x16\x86R\v\x01/C\xa9\x85\xa81\x94ZX\x1bC\xa9\x85ϕ\xa8\x86R\vC\xa9\x85\xa1\xd4B\xf7w\u007f\xedv\xd8Pj\xe1S\xb5\xc3>\x0f\vc(\xb50\x94Z\x18J-\f\xa5\x16\x86R\vC\xa9\x85\xa1\xd4\xc2Pja(\xb5\xf0+\xf2*&i\x8d\n\xb4,U\x16g\a\xb7\x89\xec\x95\\\x14\xa5\x01\xf2.\x80\xaa\x94娅\xa3,a\xbay\xa3\xff\xc3v\"̤\x98\xb2\x99W\xf4\xce\x17T\xd0\x19\x8c*\xfc\x8c\xea\xfbw\xe7\x1f\"7\x9e\xb3\x05\x8b+\xb2`G]\xb1ই\x87#Ѡ\xeekN\xf74\xa6\vj\f(\xf1\x92\xfc\xc7\xc9?~\xfb\xf3\xe8\xf4\xcf''?|5\xfa\xf7\x1f\u007f{\xf2\x8f1\xfeǿ\x9c\xfe\xf9\xf4\xe7\xf0\x8fߞ\x9e\x9e\x9c\xfc\xf0\xed\x9bo\xeen.\u007fd\xa7?\xff \xcaŽ\xfb\xd7\xcf'?\xc0\xe5\x8f{\x029=\xfd\xf3\x97\xd1S=\xb0q\xda>\x8f\xdf!\xe5\xd4)Eȷ\x17\xf4\xd12\xd8xRX\xc8R\x18w\xcb\xc6\x1d\xf3\xeaD\xb84\xac\xd8CI>\x95\x83I\xfa\x18\xda>\x1fm8\x9f\x11c8\x9f\xee|\xbe\xf3\xb4\xd3>\xa1\xd1s\\x\x95i\xc7\t\x8d\x86\x19\x047\x1a\xba\xd5<\x99&r\xc1\x8c5\xa7S\xae\x197\n\xa9\xe0\x95\x94\xa6\x8b\xda\xf1\xaax-o\xeanS0ݼ\xa0Ѹ\x14.\x83훢\x96RQ\xc7)\x90\xe7\x8cr\x982\x01\xb9SO\u007f}\xfc.\xe95\rY\xa9\x98Y\xbd\x92\xc2\xc0c\x94c\xbf}^nۀ\x88ی\xf8C\x13&Dd\xe1\xae\x1d\xadU\bÛ\u007fq*+\x10U\n\xf4g\xb9:\x17`\x9cs\a\xcdp\xbcٳ6\xf9(\xf0\xc1\xf5\xe2<Z\xff,ْr\x10\xa6\x01\xfd\x06\x8d\xe3\xe6\a\x9eCC6T\xdf\xd7T\t#k*Ux;\x0fhş\xe0\xd1|\x10\xed\x18U\x8f\x1bŖ\x8c\xc3\f.uF9\x9e\x96~\xe6\xf2\xc5\x16\xa8\xd1\aޢBI\xae\xc9\xc3\x1c,'\"4\xb8\x10\xb1\xc8\u008c&$e/\xec^\x15ar\xda\xf9:\xad\xa2WPe\xa9\"\xf8(\xa3\x01c-\xa2\x89\x94\xdcߘ\xe4\xabz\xfe,-\x04%\xe4O\x02\x1e~\xb2\xb3\xd5d\xca\xe9\xacrMj0\xa9i\xa2\xf5Q\xadܱ\a\xdb0\xa6Q\xae\x13\xca\x1f\xe8J\u05ceﴫ\xe5\x0e\xe2K\xf2\xe2\x14\xf9\x03դ\x9acN~w\x8a\x19V\xaf.n~\xba\xfd\xfb\xedO\x17\xaf\xdf\\]\xa7\xf1q\xbbg\x10\x19\xf3\xcfhA'\x8c\xb3\x14\xc5s#s\xbc\t\f\x05g\x9e\x9f\xe7J\xc6_YB|\x87XH-\xdf\xfay\x97\x9a\x15\xe1\x90즭\t\xc7\xfb.\x15\x15\xa6rz7\xc8Q\x95°\xc5\a\xbd\xd4M\xf3\xfe\x17\xba/\xf2\x1c\xf2~(9\xdc]\x98Wa\x1a\xab\xba ]\x12TBn\xde\xde^\xfd\xdf5\xda\\\x15}\x12\xf4?\xc2]TB\xecA\xea\xbd\xc7\xef\\\xfd\x8aa\x97w\x8e\xcf\xef\xc6q\xa5\a\xf4\xcbI|W\x8av9\xdb\x1an\x82~\x92Ø\xdcT\x91\xe2\x16\xb4\x06S\x8f\xe7u\n\x88\x05)\f\xa3\x9c\xaf\x9a\x9a\xb0\x91X\x93!>\xa8)\xb6\xe4\xaeO)\xd7ь<]\x1a[E\xe6\x8d5\xdf{\xedb\x05\x85\xe4 \xa4\xf1\x1e\xbf\xa4\xd3 \xa7\b\x8d8\x9fB\xe3\xb2@K\xe2%)\x99\xb50f:\xe0\xfc\xa6\x9a9F\x98\xe2S>4\xac\x9bq^\x18W^\x86\xa4\xa0\xbf\x02\x9acM\x99\x82\x9a\xb9\xcbS]P}\x0f\xb9\xfb!QǮB\xb2v\xc6\xd5\xd2\xefV\x05$\xc7SQ\xb7vٿ\x18\xe7\x8d\xf7\xc6\xf6(\xefE\U000f70af\xdeIi\xbe\xaeʘ\xf4\"\xe4ｵԎ\x03E#e\x8ee\xbb\xed\xfcF\xb8\x89X\xb6\xa5Yi\xc5S_\x8a\x95\xf0\x81\x19\x84*Ņ\xfeF\xc92Z\x15\xd8Pֿ\xb9z\x8d\xfc\xb2\xf4\x192¨\x15\x96\xa6Ja\x12\xed3W\xd9c\u007f\xf39MI\xd96\x15{\b\xe1z\xf2\x86\xae\b\xe5Zz\xc31!\x18\xdc\xe5!!\xdeU\x93r3z\"\xcd|ݧ\x83\xeca\xf3;\xf1\x05\x8c\xea\x04\x9bʓi\x97\xd0ǅ\x84`\xe9=hR(\xc8 \a\x91ES\xef\xc7I\x83@ʿ\x96²\x97^\xb4\u007f\x15\xf2\u007f\x9c˸\x9fU\x8f\x99Jަ\xa7\x98\xaf\x84̥Ԡ\\r\x98*!m\xe3\xbf-'\xc0\xc18G\t\x16\xb9\xa5\xc6y\xfe\u0602\xce\xe2O\x135\x95(4\x92\x80Х\x02\xef47$\x97\tf\x80\xaf#e\x97\xfe\xb7\xab\xd7\xe4+rb\xd7~\x8a\xe4?\xa5\x8c\xa7T}\xc1\xdb\x1fk܄M\xc3\x14-J\xe3u\x02\x81ƾr\xac\xfa\x8c\bIt\x99\xcd\x03NS\xbcC\xc1y\xe5oH\xe1\x15\xb6\x815}\x02\xac\xa9\xa7`\xfd\x9b\x06\xd5[\xae\xfe\xed\x03\xc8\xd5>n0˛ڻ\x86\f\x85,\xc0М\x1a\x9a\x12|+E\xa3:\xe2\xdaQH\xa1\xdd\xddG\x01I;\x1a\xe6\xaf\xec(|\x1c)\xad\xe1;&\xcaGw;\xa0\xbfC\xf9\xf6\x12\xc1\x11\x1fJJ\x91(\x13 \xb4(8s\xe5\xfb\xd6Z\xc4\\\xb5H7m\xef7MM\x14\x0f\x94siՌ\x84\xf0\xb8\xa2\"\x97\x8b\x8d\xc5[C\x14h\x82U\xdcXp\xc7\xe1\xdcv\xd8\xe2ew}8\u007fm\x87\xad\x8f\xeb\x9e\xc3\x12\x12\xaa\x94\xaf7Q\xb2P\xacA\x1a\xa8\x06\xc1&y?9\x9d\x00w\xaa\xa1;9z\xf3\xe4${E\x13\x9d\xaaJ\xf2\xfe%/\xdeI\x0e.7> ɂ\xfd\xc5\xe0\b_\xee\x8b#\xf4>\xb5p\x94\xecE\xff\x14qT&hxd\x1dGVMl\xe3Ȃ\xfd\x85\xe0(9\x04\xa1!\xcb䢸Qr\xca\xe2\x0f\xeb\x86\xe8\xf7\xe0\xea\xe4\x9cx\xd1_j\xe8\xca\"G=\x12\x81\xc7k\xe4~2T5.=Q\xe3d\x9e\xbf\xc5\x15\r\xf4\xffk\xa8\x10ȵ\xcf\xd6\xf4\n\xff\xd5\xf8\xd96\xf3\x85\n\x99\a@\x1fT\xbaɌr\xecH\x94F\x17d\x9d6\xd6\x01\xf6\xb8\xcfE\\c;\x0f'\xe4\xf4aK\x16\xfc%\xc13@Bs?\x99C\xa3v\xbc\xbb\x80w\xe7\xee\xcbX\xd8I\x80õ8\xab\xa7\x84\xe4\xab<Į\xec\x17Ӧ+}\xa9\xec7UO%\x8bp\x10yj%\xa8\x82\x9a\xf9\x19Q\xc0\xf1\xe2^`h\xf7Ρu\x9c\xb6O\x8d\x05\a\xce\x106\x0e\xf5l&E\xda%v\\5\x86\x05\x82F<E\x1eh\x19\xfc\xd1w\x81\xd8\x12\xfa\xa4|\n\xd2\xfcȭ\xa4\xf2x\xa6E\xd5\xec\xb8g\"\xf7\xf7\xc6Z\xc8\xf7\xae\xb0\xb4\xb3\xe7\xec2\xbc\xf5\xc9\xf2&s}I\xfe\x91v\xf6\xaa\r#\xa3ͣ\x9d\x04\xb1\xc9\x0e:\x8ev\x12L\xc7\x0e\xde9s1\xd4T\x1a\xf5tE\x13\xe7\xc5j\x06;+\x04$䲆Qq\xaf\xbf\t<\x83\x96E\x8e\x88\x90\xbd8Y\xcd\x19\x03\r\x1c}\xd8\xf3\x95^\xbe!A\x1dNV\xa9\x1e\x98\xc8\xe5\x83>\x947\xe5{\a.\x98Ιew\x86\x89YB\nF\xedQ\xa1\x9c\xb72\xf4\x0e\xe1R\t\x9c\xa0ꓺ\xe9:H\xf6\xd9;bn\xfb\x12{\xfb\x0f\xb6\xb87jwE\xbc3e\x87{ù+\xa2A~\x1c\xf7\xc6l\xa1\xe9+e\xbfk\x18\xe5\xb7E|\x87\x1f\xb2N\xcb\u07fc\xb9\xbdh\x83L\xe3\xec\x98\u05eb\x9czla\x12\x9a/\x98\xd6L\n\xf2\x00\x93\xb9\x94\xf7IpOB\xea\xfc\x8c\x99y9\x19gr\xd1Ȣ\x1fi6\xd3\xe7\xfed\x8f,vҚ\x9c0\xc1í\a\xe7!\x14F\x87\x88\x81]L\x9a\x96Ua\x15\t\xd0w*\xf4\t\xae\x9bh\xbfN-R\x857\x16>\xb8J\xb5I\x8a\u05c9\x05ş \xc7d\xbc\xf8\xea2\x8djO\x8e0\xeb}I\x13\xb7v/]\xe8\xe7÷\x11p\xa6Z\x06\xba\u007f\x1b\x81\xbfְH\x0e\xae8D\xa2\xddǦ\xad\x86\u07b5B\xe2\"ډ\xb6\xe41\xd6n\xf3S<n:\x1d\x92\xeax\x90pT\xd0\xdbċ9\x1d\xa1\x83\x00\xe5\x8d\x15hI\x10\x83\xb13\x97B*w\xbc\xadJ/EB\xcfo7\xd0\u007f\xe5\xf2͐f\xbd\xa2\xd1خW\xe9\xe9\x9ev\xf8t8Lo\xc3\xda@Vmq\x8e\x9d\xf42\xf5\x0f\xcc̱}\xd3\x1cZ\x1fHǬ\x02\x8d\tK\x82\x80RR\xf9{#!\xd1 \xb5\xae\xb2\xd3\u007f\xf0r\x8be\n\xd4\xfe\xebX\xf7ɠ%\xad\xf6\xb1\xf6\x03\xdar\x1c\x98N!C\x93\xbd\xb1sI\xc0]\x88\xe6\xa4\xee7毆[&d\xbf\x99x\xbc\x16\xec\xd1b\xa0\xc9\x06zb!\xf4\xc5\xea\x06y:&\xe4*\xcd\x10\r\x17\xbb\xcf,\xa7iB\xf77\x8bRIA\xb4:S\xe3&\xfap^\x9ad\x00\xcc\xea\xb53J\x97\f)\xf9\x16\xa4\x99sq\x101\x8c\xb9\x17\x1e\x98=\x82\x9e\t\xa5\xfa\xc46\xf376\xf21Ҝc\xeb9\x1c\xc1?\x96\x1cCؑ\xcbAX|\x18\x97\xb8\x9c\xa9\x83\xe6s\x90-9\x1d\xe9\xd9M\xe4\xb93\x9c\xc8\xf3E\x9b\xc9\x01\"\xce\xe4\xa3Dy\xd2.~\xbb\x8a\xce=\xdb\xfc\xde6\xa04<\x9aV\xf5\x88Z\xbb\x17\xa7\xae\xaa}U\x15\x9b\xafB5~\xf6߱9\xf3\xed\xf6\xf3B\xbab\x03\xcdR\xf7\xbe\xafi\x9c\x9aR\n\xc3x\b^-\nn\x8d\xc7\u058c\xa3\xb3!\x11V\xa3\xdf\xf0Y\x85\x8c\xba\xbd\xb1/\xf4\x1fw^\xfe\v\xc5P\xd5\xd28\xd4\xf3\xbe\xa9>\xe5\xa2\x1f\x91\x1a\xb0o?\x8f%ی\f\xf16\x92\xb3\xe9\x14\xc2\r\xe7H\xb1WPE\x17\xd6p\xd0ħ\xfeN`\xc6\xdc5\xd3J\xb5\x8a\x8cPTE\xc2Μ\xba\xc7\fY\xb0\xd9\xdcyi\b\xc5R\x94\xf1\xe5&\x8d$\\Ҝ \x17\x97\x8a<P\xb5\xb0\x16\v\xcd\xe6X\xbf\x91\n\x92\x97\xd1\a\x1f;ɭF\xdaP\x03D\x16\xe0JJ\xb8\xbd\xb1\xf8vյ\xe2\xc8th>=4\x9f\xde{\fͧ\x87\xe6\xd3C\xf3\xe9\xd814\x9f\x1e\x9aO\xef=\x86\xe6\xd3C\xf3\xe9\xa1\xf9\xb4\x1bC\xf3\xe9\x8414\x9f\xde6\x86\xe6\xd3{\x8d\xa1\xf9\xf4\xe6\x18\x9aOw\x8e\xa1\xf9t\xc7\x18\x9aO\xef;\x86\xe6\xd3\xd5\xf8\xe54=\x1b\x9aO\u007f\xaaMφ\xe6\xd3\xfb\x8cϣ5\xdc\xd0|zh>\x1d\xf024\x9f\x8e\x1aC\xf3\xe9\xb514\x9f\xfe\\\x89jh>=4\x9f\x1e\x9aOw\u007f\xf7\xd7n\x87\rͧ?U;\xec\xf3\xb00\x86\xe6\xd3C\xf3\xe9\xa1\xf9\xf4\xd0|zh>=4\x9f\x1e\x9aO\x0fͧ\x87\xe6ӿ\"\xafb\xe2M\x91\x9cE\xb5lۣ[@J\xed\x93P\xbb\xd32\xb2r:\x05\x85\xc2\x17g\x17\x94\xa3\xb4K`\xa13T%\xba}F,6\nT@sW\xf3\"\xce/\xd89\xadP\x84\x14ۗ\xb9{\xa9\x91\xe1\x01r\xf9\xf6\xeb\xda\xe9\x9a\xd2\xea \xedv \xae\xe7\xad\xc8\xd2\xef\nՄ\xd0Q\x9d5\x0e\xb7.\x01?\xe3R\xfb{\xb2\x88\xeclN\x85\x00\xee\x95n\x16\x87\xd99\xd5d\x02 \x88,@8\xb5\x85\x12\xcdČ\x03\xa1\xc6\xd0l>\xb6+\x88\xf3\x9ey\"\xf0]\xeb\xea\x99j\xa3\x80.\x1c1(X\xc4\xf6\x19\xb4S$4SRk\xb2(\xb9aE5I\xa2\x01KeD&-]M\xeb\r\xc6$\xf1\xfa\x02\xeaY\xb5\x8a\xe89\xba2h\x8d3o\xa82g\xd8\x0evQ\x98\x95\xbbK\x15'\xf8\xb0k\xa7҆d\x9c\x810~ծ>#\xce\xf3\x8c\xc4\xe6\xc6\xe3\xf5]\xb7\vڣV\xe4\xe8\x15)\x8cv7}\xd2&꧘3\xed\xbdo\xfa\x8cP\x13\x04e4\xd1\aZB\xb2\x0f\n\x9c\x9b\xb5\xff)q\x9auI\u007f]_5\xab\x99\xe1\x94\xd38\xc500\xa5\xb3V-\x87\xda>\xc4$wd\xabQ`\xb1\xec\x90\xc3\x02\x1e\x1c\x01K\xcb? \x03\xb6D\u007f\x90\xe5\x8cQ\x10\u05f9\xe8\xb33ц\xee\xfa\x06\xb4\xa63\xb8\x89L\xb1\xd9\xe6 \xc6,\x9b\x9a\xb8\"\r.\xacFfdC\x87\xab/\xa0\xb4-\xd0(\xb0\v\xb7\xc6\xca\xe6|P\xcc\x18@\"\xc6\xceU\x98y\x18\x99\a\xbe1\xb9\xe6\xf5\x987\xe1\x83\xeeC\xb1Tk\xf5)\x91\xbbK\x1d\x13 \x13\xc5`J\xa6LP\xee\xefa\xc4]8\xc2~\x16T[ҤZ\x83B\x9f\x8bw;\x05\xdc\xc4\x11\xec\xf7\x1e\x91F\x95\"\xa3\x8d.\x97X\xfd\x8eM\xc9\f\xefzD\x1a\x13s*ȿ~\xf5\xef\u007f$\x93\x95Ղ\xd186\xd2P^m \a1\x8b\xac\xed\xef\xc5S\xbb\x0eYE\t\x9c-X\xac[\xc8\x1a\x03\xbf\xbb\x9f\xb4\x03\x8d\xe79,\xcf\x1b\xf49\xe2r\x16\x87\xd3W\xe1~eug2F\x91Or\xedw\xb0\x01\xc9Y\xb6Jf\x04\xa1y\x0e\x99\xcb\a\xe7\xca\xebyb\xeb+\x8e\x85,J\xeeR2\xbe\x0e\x95%\xa3@\x96\x1a6\xabau\xf2\xc1Xj\bS[\xef\x10\xee\xaeL\xf9\xa5\xc4)-\xbe\xf0\x9c\x0f\x8dW=s\xd0O\xfc5\xe5|B\xb3\xfb;\xf9\x9d\x9c\xe9\xb7\xe2R\xa9\xc86\xfbH\xfd\x01\x1f\x9cZ-f^\x8a{ly[\xd7\x1a\x96q\xd2V\x96\xa6(M\xb8\xe4\xddt\xef\x86͌\xae\aY)h\xc13\\\xcf\x0e\x1e\xed\xb9E\xf7l\x1c;\xf0\xc5w\x1cw\xe1rV\xcd[\af\x10{#\xe8w_\xfd\xeb\xbf9\x96E\xa4\"\xff\xf6\x15^\x19\xd5gN\x88\xa1n`\x15\xd9\x05\xe5<6\x98\xd5d0\x96\xe8\xc7\x1dL\xe2\xd9y\x84Ig\a\xcfbr\xdf\xdd\xfd\x1d\xedmf4\xf0陫=\x12<\x88Q@\x8fQ\x89;\xf6R\x16\x8b\xdc|\x04\x83v)y\xb9\x80װdY\\\x84\xbf\x85\xea\x16\x94\x10\t\xe2L\x1b\"\xe3\xaa@L\xb8\xcc\xeeI\xee\x015\xeef\xac\xf7\xb1\x8e\xc1L\xc2-\x94\xad\xab\xab\uf7d0\xd8fD\vZ\x14U-\aE\x1fZ\x8bE^\x12}\x01\x85\xa6\x06\xaaӳ:\xdctc\x15\xf6\xf0n\x03\xab5\xa0@0E\xac\xf4s\xc3\u07fc\xdehH\x15:\xe8%\xe5\x1a\xf8=qz\x9a\xdd9\xe4\xcc\xf1\xe1\xf5\x1eI\x0fiwzZ8\x16U\xae\xc0\x82\x1ao\xd3$\xe6\xcf \xd5\x16\xa04\xd3V\x81y\x8fg\xe2\x15\xa7l\x91~\xc3?\xa5!A\x8f\x16\xb0)y\t\xa3\x06\x9dF\xbe\x18\x8d\xe8^u\x8f\xe2\xee\xb68\x96\x86-}ӹ\xfe\x8d\xcc= dծ\r\xb35e\xa3\xc9a[\xa1\x87^\nG_\xb6\xff\xbe\xc6Q\x93\xeb\xbbu\xc6\x1fg<@\x0e\xa6g\xf6\x1f\x83}\xe3\xe4\x0f\xc0\xbd\x91o\xfbe\xf4\xad;\xd7t\xd8x\x82j\x98^\xdeG2v\xb9\xb1\t\xe0-\x05\xf9\xe9\x91\xe3\x97\xc7\x1f\x94\x87;t+Y\xd0\x19Z#=\xb1\xbe\x0e\xae_\xa1Yk&#Īg\f\u0085\xbc\xaam\x9e\x04\xd4]\xa8\xaf\xe5p0\x9f\xb0\xf2X\x02\xc4\a\xba\"T\xc9R\xe4.\xf6P\a\xa5ެ\xa1\xe3Z\x8a\x94)\xfb路4Uմ\xc54\x01&ȋ\xf1\x8b\xaf>7\xc1\x8f+Y\x13\xfc\x89\x85\x9f\x1b|\xeb\x83b!\xb4l\uf2497\xde\xc5ZwXO*;\xe9b@\b\xe4A1\xe3\xa9\xf9\x81i '\xb1^\xf30\xa4jֲ<m\xbb\xf4\x92z\xb7\xf7+z\xaa\xcb\xc93H\x06\xc7\xd0\x13ЃL\xa8\xcb\x17\xaf\xd3av\x88\x95&ҏR:}\x9c\xb8\xd9\x1c\xbb\xaaW\xa7\x1f\xf4\x90\xf8-\xbb|,\x12:ǵ\xb6\xed\xf2\xb1\xa0\xe8\xf5/\xea\xfdK9'\xb5\b߾\u007f\tp\xb7\xab\x05\u007f\x819]&\xc9?\xcd\x16\x8cS\xc51\xb5\xec\xd6a\x92LJC@,\x99\x92\"\xe9\xf6\x05!K\xaa\x18V\x1bW\x80\xb5 3\xd0\xe4˓\xf7\x17\xef0C;\xa5p\x97+\xd3\xe9\xf7\xa7Ԯ\xbcx_\x8c6\x16\xb9~\bj\x92N\x80\xeb\x0eA\xc0\xa7\xa5L\xd4\x00\x02~iB\xaa\x12!\x8bҔ\x94c\xc1\xb6\x8c\x97\x9a-?\xa4,J\xb5\x1c+]\xfb\x17d8\xfa\x92\x81\xafY\x14\xbfY+\x8fX3\xf2\x8d\n\x84\xd1\t\x1b\xa8\f\xd6\x15n;\xd3j\"\xe984\xa8j&\t{\x87\xba\xaf\x9e\xea\xae\x10\xfa\x9eo\xb1\xa9Kk\xd9\x06\v:\xfb\b\xae\xf5X\x9a\x8e\xa2\xcahz\x8c\xa3D\x9f\xf7\xb9\xdf\xd4\xdbj\xb1{\xd3\xf7\\s^\xc7\x05}ĄJ\x8a\xc7u\xcf\x15\xca)\u0382\xbc\a\x0eJ\x06\xb1\xf4@\x99\xa9\xee\x9b2\xc1Ltg\t4\x9c\\=\xe5\xfd\b j\xeb\xf7ޗ=\x1f|z۞\"\xb3\x9dd\xf5\xe4,v}\u007f\xc7\xcbLd\xbc\xcc\xe1\x15/\xb5\x01\xf5\x0e\xb4,Ug\xf4c-\xba\xdc\xf9V\xe3R\xe9\x83\x0f8e\ue451\xced\xd1\xc9\x1eT\xfdr\xa5\xcf\xf8I\xe5\xa1\xe0\x04\xdeO\xaenҸ\xca^\xdaH\x05[*k\x8b\x92\xf3\xb5K\x8d\x9d}\x13\xecsV;\xd9r\xb7k\x97\xfd\x10\xa6h\rI]нQ\xd6x\xc1%\xe0k\xce2t؋\xf0\a\xf7_v\xd6\xfe#\x1d+t{\xe9\x92P1/\v\xa3\xb3g\x98\\!\xea/\xb8\n\n\xeeÛ\xcb\xdf\xea\x14\xdcy\x90\xf6BZ\x17\x1d\x86\x89D\x12Y\xfd\xfc\x1a\xc2\x02\xe5샯M\xb2ib\xac\xa6A\xff܄f\xf7e\xf1i\xa1\x0f;P\xdf\x02G\xdd\xe0\t\xd4}\xd7|֡m\x01\x86._\x8c\xdb\u007f\xb1\xb65\xe3\x06\xb3\x90;U\xb3\a\x97\ti\xb1\xe6.\x9c\xe5l\xc9\xf2\x92\xf2\x16\x056pV\xa3\x16/\xa42ޕ \x85%q\xfd\xfb-\x1cW\x17\x06\xa3\xcf\xean/0:~\xac\xfa\xedSa\xbbYp۽\xb8\xf6\x8aâ\x8f\xe3\xfav\xe0:\xe0ѳvk?lM\xb3\xbd\xf3\x05\t\xc3s\xb8\xf2\x8b\xeb\xd7\xdbԛ\x9d.\xfb\xd6T/vLǟ\x99j\xc3wua\xf0\x8a\x98\xbf\xf3\xa5\xcf\b%\xf7\xb0:sɯ\u0097\xa1\xf7@\\\xd7`\xaf6\xdc\xc3vUž\xec\xe0mC\xcc>\x0e\xfc{\xd8\xe9\xfbj\xa1\xe3\x1eVU\xd8\x1d\xf1b\u007f\b\x01\xd0\x1a\x15\xbe\x8d\xfbnٿ3ʹ\x97\xbe\x11\xb0\xb6\xf7\xf4+4+\xb0\xc4\xe7HŮ\xe1X\xfbV\xcdR\xe89+\x9eJ\x8e\xa1\x98\xb2-\xa7\x01\xfbU\xf3^\a\xde\xd1ߕ8#\xd7\xd2\xd8\xff\xb9|d\xfa\x89\v9v/_K\xd0\xd7\xd2\xe0ӽ\x91㦶7j\xdc\xe3H\xd2\xc2\xf1H\xbc\xa4\x84ߨ\x96y\xf5\xf4\xfd\xf7\n\xc5L\x93+a\x19\x95\xc7AuYQ{\xf0\xcd;\x86\xc8\xd5vk\xa2\xee\xdb-\xf8\x0e\xad\xf6\x1bM\xcc5?\xb5\x1b\xe5\xadi\xb8)8\x8f\xb6\xfb\v&h\x17\x9cf\x90\xfb>\x13v㍢\x06flw\xfb\x81\x05\xa8\x19&\x1ad\xf3]\xab\xda#t\xb8\xa7\xe2}\b\x15y;\xab\x19Uh\u007f\x0e\x15\xda\xcb\x10\x14\x9f[\xb0\x11:\x89Q~\xf3$G{\x12c\x9b\xb2\xc8}\xda\vsZX\xca\xff\x1f˞\x91\x88\xfe\x97\x14\x94)=&\x17\xfe\x86ʖ\xef6\xdf\xf0\xbaN\x13\xb8\x85\xcb4\xb1\xbb\xb0\xa4\x1c\\\xa1b*\b\xec,\xbf\"\xa7\x1b\xd2\xf2\x8c<̥F\xc9P\a\x91\x8e\xeeaut\xd6:![ ڇ\xaf\xc4\xd1Y\x15/k\x1d\xcaJNa\b\xe3\b\xffv4\xde\x10\xb0[`?!vwRɎ?VZ\xf7\x1b\x97ڴ\xb9\xf3\xfb\xd2\xc7N\xdaب\xc0\xd8\xfcf\x8b8\x9a\xcaqˬ\xe8\xfa$U30]&\x88ט1\x95aL.\xc4j\x03.^\x8c\xebT\xb9\xbd\x11W\xd1Y\xd1j_\x84--1C\xa2\x01\xca'.\xe9nC\xd8>\xb8\xb9k;6\x05\x05\xa8Zµ\xcc\xe1F\xaa\xae\xfc\x8ev\xbcf\xfd\xf9\x0e\x8b\xb6\x81\x14\xc9s̳\xc7G;拺\xb1\u05cb\x0fi|\xfa\xef\u07fc\u007fj=\xef\xaa\aw/\x84bo\r\xb7_\x1d\xeb\xb0ﻻ6\x82\x16z.\r9\t\x97\xda3.\xcb\xdc\xdf\xecW\x1d\xf1\x9e\x1e\xab\xd4\xd9\x1c\xf2\x92Cw\xd3\xc1\x8d2\x95\xe1Ѡ\xfb\x95\x82\xfd\xb3l\xb7\xe8\r\x1e*\xfft\xd7A\xa8qR\x99\xd6\r\x8f\xaaeG\u007f\xc1\xfd\f_\xf2V\xa4\x87\xbc%\x15\xbe\tҝ\x05\xa9\r\xdeS\x12\xa6Qt-\x98\x9d\x99o\xcf\xe1\x1f\xef\xbcf\x17ְ\xed8t\xb0\x8fn\xe1:\xf2_݈\x88o9V.\x97\xbe\r\xa3\x8b\xe6n]\xce}F\vS\xaa\xd0\xfe\xbaT\xd8\x0f\xacnaB\x03\xe6<\x8aZ`\xb7\x1b\x06\xde/Ȥ\xb8c\vІ.\x8a'(\xe4\xd5\xe6\x1bv\x03\xa4\xcauU\xe9\xa4\xe9\"\xa8\x9bnuYŴn\xf5\x96\x8f\x1b\xb0\x1d\x18\xd4\xc9,h\xc8\t,A\x10\u007f\xc9\a#\xef\xce\x01\xd1\x01\xf4\x0e\x8d\x13\xb5\xc4X@\x80\x83\xf9\xbeS\xa9\bvի\xa6\xbeI\x11\xe1\xeaxN\r\x8c:o\x12\xeeu\x12;\xa5\x8e\xeb\x91\xfb\x04\x82/]#]\x94@\x19\xe6\x89\xd9\xed\xe5ܽ\x1dn\x1e\xf8\xab~\x0f\xa0\x80\xcc@X\x14wr\x1c\xafʺVO\x16\xb1\xfe\x04W\x0e\x9c;\xd7\x1d\xab\xa4<\xb4\xf0\xc5\n8A\xaalu\x99\xe1#\x9d\xb7\xacv]\xa0\xf77>\xde\x01\xd5]yK-D|\xdd|\xd6\xdb*\x0e\a\xb8\xf4\x8c\xba>y\xaec)S\xd0I\xfa~J\x12\xbf\x1cq\xc6\t)\xe6T?\xc5.o\xec3UK\xaeơ\xac8\xe5\xbb-s\x02Q.6\x81\x8f\xc85<t\xfc\xfa5\x12\xfd{\xdfV\xb9\xe3(\x8dȕ\xb8Qr\xa6\xba\xaaĎ\xc2\xc1ꠐ\x11\xb9\xa1\xca0\xca\xf9\xea\xeb\xeen4\xe1\xebQ\xb8\xf3SyRKp\x8fՊ(\x13\xee\xfcYJ\xa5\x93Ы\xdao챮\xe9\xb8c\x93\xc3G\xc7\xd6\b\x85\xe0\xa8`m\xa0\x98\x82\xa5\xcd\b\xa6S\xa9\x8cS|G#¦\x9e\u007fv)\x1f\x94qT\xe1\\\x10\x8d0S\x1b\x88\x95@\x92\x8aP\xb1\"\n\x89\x15[\u007f-\xe8ʹ\xe0i\x96\x95\xf6x\x9ekC\xbb\xe4L/\x87\x1c\x1a\x95\x9eȶX{m_p\xf3\xf9\xaa\xfb~U\xa7\x04\xc19\xd4a\xa7l\xc7\x19\xb6V\x03\xc3[\xfd\x95\x0e\xaa\xed)\xeb\xb6D\x9e*\xaa\x81w\"\xaf\xb6\x1b\xc8\xed\x80[\xf5pX\x80\xbbR\xb9\xb1\f\xd9T\x91\xb7\xbb\x13\x99\x0e\xaf\xda=\xcb\xe6XQ\xcf̕,gU\xbb\xf4m\ft\x9b[\xb1\xc4K\xbf\x05/g\x96\xac\xbd\xa3ϔJ4\xac\x17\xef\xfa\xcb\xeb\xe9\xee\x02\xba\x1b\x85;twݒxO)\x80\xad\x87\xfbI\xf6\xea\xba\xfb\xa7+\x91\x97\x15K\xbd\xdcG6\xbf_{|-\x90b\xa5t\r\xd1\xcb\xd3\x0e䜰\xa9\xf3\x9afv֛\xc9;\x1f8 \xf2@\x95`b\xf6\xd4\xe2\xbf\xf7\x8fu\xa8&\x1eB\x87rұ\x88J]\x89RN\xc2$\xb7\xe4\xfaT\nK\x0f\xf5\xa4\xf3\fm\xfc\x88\x84\x9c7\x90\xec\xbf\xe4\u007f\xa9\xd5zW\xe6\xc2\a*\x1dn\xef\x99\xc8_\x86\x84\xc0\x82\x97\x8ar\xff\xcfL\n\xc7\x16\xf4K\xf2Ï_\x84\x05\xbd\a\xa5\xab\x1f\xff_\x00\x00\x00\xff\xffu\xec\xfc\xa4\xa5\xec\x01\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<\xcdn\xe4\xb8\xd1w?E\xc1\xdfa\xbe\x00\xee\xf6\x0erH\xe0۬ǋ\x18;\x99\x1d\x8c\x1d_\x82\x1c\xd8R\xb5\xc5X\"\x15\x92j\xbb\x13\xe4݃*\x8a\xfak\xa9E\xf5x\x90\xcd¼\xd8-\x91\xc5\xfacU\xb1X\xe2\xd9j\xb5:\x13\xa5|@c\xa5VW J\x89/\x0e\x15\xfd\xb2\xeb\xa7?ڵԗ\xbb\xf7gOR\xa5Wp]Y\xa7\x8b\xafhue\x12\xfc\x88[\xa9\xa4\x93Z\x9d\x15\xe8D*\x9c\xb8:\x03\x10Ji'豥\x9f\x00\x89V\xce\xe8<G\xb3zD\xb5~\xaa6\xb8\xa9d\x9e\xa2a\xe0a\xea\xdd\x0f\xeb?\xac\u007f8\x03H\f\xf2\xf0{Y\xa0u\xa2(\xaf@Uy~\x06\xa0D\x81W`\x93\f\xd3*G\xbb\xdea\x8eF\xaf\xa5>\xb3%&4ۣ\xd1Uy\x05\xed\v?\xa8\xc6\xc4SqW\x8f\xe7G\xb9\xb4\xee\xe7\xde\xe3O\xd2:~U\xe6\x95\x11yg>~j\xa5z\xacra\xda\xe7g\x006\xd1%^\xc1g\x9a\xaa\x14\t\xa6g\x005a<\xf5\xaaF}\xf7\xde\xc3H2,\x84\xc7\t@\x97\xa8>|\xb9}\xf8\xfd]\xef1@\x8a61\xb2t̞\x80\x1eH\v\x02\x1e\x98@0\xb5(\xc0e\u0081\xc1ҠE\xe5\xa8Gip\x150L\x1b\x90\x00\xda@\x89F\xeaT&\xf0\xa3H\x9e\xaa\xd2\x0f\xb6\x99\xae\xf2\x146\b\xa6R\xebf@it\x89\xc6\xc9\xc0B\xdf:*\xd3y:\xc0\xf8\x1d\x11\xe5{AJ\xba\x82\x16\\\x86\x811\x98\xd6|\x00\xbd\x05\x97I\xdb\xe2\xcf\xe2\xef\x01\x06\xea$\x14\xe8\xcd\xdf1qk\xb8CC`\x02։V;4āD?*\xf9\xcf\x06\xb6\x05\xa7y\xd2\\8\xac\xe5\xda6\xa9\x1c\x1a%r؉\xbc\xc2\v\x10*\x85B\xec\xc1 \xcd\x02\x95\xea\xc0\xe3.v\r\u007f\xd6\x06A\xaa\xad\xbe\x82̹\xd2^]^>J\x17\x96J\xa2\x8b\xa2R\xd2\xed/Y\xeb\xe5\xa6r\xda\xd8\xcb\x14w\x98_Z\xf9\xb8\x12&ɤ\xc3\xc4U\x06/E)W\x8c\xba\xe2\xe5\xb2.\xd2\xff\v\x12\xb5\xefz\xb8\xba=\xe9\x97uF\xaa\xc7\xce\vV\xe8#\x12 \xcd\xf6\n\xe3\x87z*ZF\xd3#\xe2\xceכ\xbb\xfb\xae2I;\xe4>\xf3\xbd\xa3a\xad\b\x88aRm\xd1x!n\x8d.\x18&\xaa\xb4\xd4R9\xfe\x91\xe4\x12Ր\xfd\xb6\xda\x14ґ\xdc\xffQ\xa1u$\xab5\\\xb3\xfd =\xac\xcaT8L\xd7p\xab\xe0Z\x14\x98_\v\x8b\xdf]\x00\xc4i\xbb\"\xc6Ɖ\xa0k\xfa\x86\x9d=\xd7:/\x82\x99\x9a\x90WX\xe3w%&\xbd%C\xe3\xe4V&\xbc0`\xabMk\x02lך\x856\xbej\xa16=\xd4}\xf8\xfc\xa8\xb5\xb96Z\x01\xbe\x90\xec\xdb\xd5L\xba\U000dc862\x15f*Ex\x1e\xc0\x84\xdaĬ\x0f\xdeLp\x93_aQ\xd2r\x9dA\xf1\xbe\xeeF(\x12\x8f\xd2\xc6\x1dy\x83\x82\x8dyӵU\x83\x03\xa3\xc2\xd3eH\xfc\xdaɴ\xb6\x1a\a\xdc<\xceQ\x8f\xd8VT\xb9{\xd0yU\xa0\xbd\xd7_\xd1:\x99\x8c\xf5\x1c\x10\xf1qt`\x907Z\xe2\xb0\xcb\xd0\xd0\xe2\xe4\x17l\xefF\xe1\x02\xaf\x19\x8b)\x9b<\xf1\x84 `\xe39@\xb63ϡ\xd4)\xec\xfcL\xb0\xd9\a\xa4\x0fe\xd3\xcag\xa3u\x8eb\x8ck\xf8\x92\xe4U\x8ai\xe3\xf2F\xf92\xa0\xf6\xe6`\x10\a\aB*\xd22rń\xaaj\xdeN\xd0\xc9\xfeJ\x18\x042\x14Ry\x98 Y\x05k\x92ǉ\x92\x0e\x8b\t<\x8fj\xa4o\x14\x84\x88M\x8eW\xe0L5\xa6\xeb\x01\x860F\xec\x8f\xf0,\x04PKX\u058c\xa9\xcdy.\x13$f5F\x9b\xb9Ƭ\x99\xa0\xef\u007f\x90a\x99\xd6O1L\xfa\x13\xf5k\x9d\x13$\x1c\xa7\xc2\x063\xb1\x93\xda\xd8a\x84\x83/\x98T\x0e\xa7֑p\x90\xca\xed\x16\r\xc1*3a\xd1\x06\x93r\x8cY\xc7M\x045s\\\xf0\at\xb5B'\xe117\xa6HaS<\t\x15\x18q\xb2\xd8U\tR\xa5r'\xd3J\xe4 \x95uB%\x9e>\xd1\xe07N\x1f\xcc)\xc4\x01\xfe\xde\x00\a*HJ=Ϧ\x15R8Zh3\xae\x1c\xa1\x1d\x82\x99f\xc3F\x90\x05\xd4S\xee\xa8m\x86\"\xfa\x1a\x95\x94]jkw.ZI\xf9\xa00\x17\x1b\xcc\xc1b\x8e\x89\xd3f\x9a=1J\xe0[\xac\xfd\x9c\xe0\xec\x88%m}\x06)\xea\xac\x11m\x9b\xd3\xf0\x9c\xc9$\xf3\xf1\x1bi\x19ÂT\xa3e\x8b!\xca2\xdf\x1f#\x1ab4\xa3\x9el\xceh\xb4-\xc2|\f\xe1N\x19\x92\xb6E\xda\xe0\xb6\xcdX\xe3>\xd7\x1b\xb5ycz\x0fM\xf5M\xca~{0\xfc\xf5\x95\x9d\xd8-i\u007fw\xbb\x05,J\xb7\xbf\x00\xe9\xc2\xd3\x18\xa8\x14`\xb5x\xfc\xc6\x04w\xdaj\xb9\x1d\x8e~\xf5\xd5\xf2*Rk\xd0\xf8\x8d\b\x8d\x9d\xd5]\xed\xab\x16\t\xecSw\xe4\x05\xc8m#\xb0\xf4\x02\xb62wȱ\xd4\x1c\xa2\x9d@gVr\xafɠX\xdfK\xad\x10.\xc9n\x9a-mĈ\x01\xaf\x86\x00|\\\x1e\xf60,\x83\b\x90\xd0\x04\x15\x9c\x05\x91\x06\v\x9f]\xb9\xe7\xf5\xd1>\xe1\b\xf0\xc3珘α\f\xe25\xf5\x80\xa8\x0f\x83H\xa7\x8b\x02\x13\x18\x05\xb2C\x14\x87i\xcd\x1e\xcf\xe7\xd0.@\xc0\x13\xee}d5\xba\xb9\x1ck$Zр4\xc8\t=6#O\xb8gPu\x86.\n\xde\x12U\xf1\xed\t\xf7\xb1]\aL%\xfc\xea\x1c\x85\xe7.=`*b\x96R\xdb\x1a\xa6\xd6k\a\x9c\x8e#\x16\x96\x19\xa5\xd0\x02\xc7O$\xbb\x11X/-\xfd\x84\xfbw\u058b\x8fVM&\xcb\x05\x1c \x83\r\x16y\x85\x85|\xec\x83\xc8e\xdaL\xc6\xebd\x01\xc4[u\x01\x9f\xb5\xa3?7/\xd2\x12\x8a*\x85\x8f\x1a\xedg\xed\xf8\xc9we\xb1'\xe2D\x06\xfb\xc1\xbc,\x95w\vėE\xf3\xb78\xb0\v%\x15m\xc4&-\xdc*ڟy\xfe,\x11S\x86\x019\x8fVQY\xce\xe8*\xadV\xec\xa6\xc3l\v\x80v\xf1\xaaE\xa5MOR\x17\v!\x8e\xa2X\xa3wO\xdeʿ9ȅ\x1fk\x06\xcb\\$\x98BZq\xbe\x9d\x13\xef\xc2\xe1\xa3L\xa0@\xf3\x88P\x92߈W\xaa\x05\x96ܷ\x13\xb40>\xb4\b\xadv\vi\x1cb+Z\xf5\x91=\x83\x98\xa3\xbaOdُw\x8f\xa3\x92\xdd;\xc7CQ\xdc\x17iʩg\x91\u007fY\xe8Y\x16\xca\xeb0\x06\xf1H\xfa\xf0\xa3\x10\x9c\xec\xfd\x17\xb9WV\xef\u007f\xc7yC!\x8d]\xc3\a>\xdc̱;>d\t;SE\x81$L\xa4\x05ғ\x9d\xc8)| \xe3\xad\x00s\x1fL\xe8\xedA\x04\x15gb\x9e3m\xbd\xcf\xdfJ\xcc\xf9t\xeb\xfc\t\xf7\xe7\x17\a\xd6\xeb\xfcV\x9d\xc7\xc1$\x9b\u007f`\xb4\x9a\xa8E\xab|\x0f\xe7\xfc\xee\x9c\x03\xb3%K\xe4\x84\xe0m\x81VGw\xe5\xe3\xe7%[\x01\xdak\x87\xa8\x85\x067\x87\xb4\x14\xc2\xcfQ\x11\xadӥ\xb6n\x11Z_\xb4u>\x01\xd8\v\xb7G2\x841\xbb\xbf:k\bb\xebЀuڄ\x03Q2\xbb\x83\x049I\xde\xce˞D\xddd#=`\xdad\x9e\xb7\x16\xc2\xdb\xf4s\u007fRJ\xff\xcf\xc3L8Xbإ\xd1\tZ;\xafJ\x91\x9ec&a\xdb$k\x85\u07fcm\xa3LsL*9\xb4e\xa18\xb1\xf6\x84\x8d\xcd\xcdK'\xefLf\x88~Ǩ\xf2)8\x02\x17\xbd\x14\x85\x18\x1e\xceG\xa3{\xedG\x87\x05X\x03\xf3\x1b&\xf3X\xb1QY\x167\xd7*\xf9k\v<\n\xa9ny\"x\xff݂\x15\b\xa6\x1cO\xdd\xca\\\x87\xf1\xad@\x9a\a\xb1\xfbW\bG͚\xcfj\f\xf6${x\x92\x11/)\xa0`Zi\xd7M\xd6\xd43\xbd\xb3\xb0\x95ƺ\x16\xe1\x05P\xa5\xe5\xe3\xe4\xef\xbb\xc7T7Ɯ\xbc\xc5\xfcŏ\xee\xa4\x153\xfd\\\x17F,\xd9X\a\xe6gb\x87 \xb7 \x1d\xa0Jt\xa58\xe1E悦Y\x00\xd1\v\xd1;\x93H\x9f\xd9\x19\xac\xaa\"\x9e!+\xd6N\xa9f\xb3c\xdd!?\t\x19\x97\x9d\x82\xd3\xc4\xead\x81\xba\x9au\xedm\xebWv\xf8ѽ\n\x98B\xbcȢ*@\x14$\x96%\xfb\xc6-cӔ\xcbxY?\v\xe9\xf8Џ`\x93x\x96Y\xd3D\x17e\x8e\x0ea\x83[m\xd8\x1eX\x99b\x13>\xd4\xf2\x1f\xad7\x99j\x02\xb6B\xe6\x95Y`\xa3\x17Kf龭6O\xaf\xbf\x19\x8bGd\xc5̌L\xba/\b\x9a\xe7\xfdGi\x96\x85\xcc_\f\xbe~hZ\x1aIZ\xaa\xe7\xa2\xd3Y\x98\x1c\xbd\xf6\xa3\xd3Zy\x85\xdaO\x85\xa7\xb3P\x19\x93\xb7\xf0\xb4io\xe1\xe9[x\xfa\x16\x9e\x0e\xda[x\xfa\x16\x9e\xbe\x85\xa7\xe3\xed-<\xed\xb4\xb7\xf04\xda\u007f\xc4`\xe8\xbf::\xd2!\n\xab\xc8\x12\x8c9\xb4g\xe6\xaa+\x8d\xae\xf3\xca:4K*\xa4o\xc7G\x8e\xd4\xd0'\xbeˊ\xbf֚Қ\xb6t\xa5uzM\xc94-ɰ\x98\xfc\xb7\x18\x11Qxt\x19\xf4t\xb5}l\x01\xdd\\\xd9\\\xbfv\xbc)W\xf3\xffM0\xc4\xe90}-=\xff\x8dO\xb7\xe6\xaa_\xfb\xc6\xfb\x80\x80\U0006fcae<\xb2\xacm\xa6\x98\xedx!\xfe\x94\x87\x0f\xbc\x1c\x1c.\xf4\x99iz\x85߿j^FT\x9bMטէ\x96\xe8\xc4\xee\xfd\xba\xff\xc6\xe9\xba\xe2l\x82\xb2g\xe92\xff\xfd\x11m]\xd5c\xb7\xac=\xe8i\xfd\xedߐ\xc7\x13\x10\xb5\x01%s/\x80\x00\xa1\xc7~\xf8\xa5\xf4[\xe4\x93\xd7\xf9\xfcF-\xbe.mi5ZS?4\xefU\xbe\xa1\x06m\xd9\a\x02\xb3\xf5f1HCL\x95\xd9x\xfd\xd8\f\xd4%\xb5e\xb1{\xf0\x88:\xb2\xf8\xea\xb18\xf6\x00\u007f\xb1\x1b[3\x16\x1d\xb5\xc5և}\x9f\xaa\xb0\xc8Z\xb0N\x85\xd7,\xc8\x13+\xc0\xa2\x19\x16W\xed\x15]\xe3թܚ\xe7֑ʮ\xf1z\xadY\x90c\xf5\\1UZQ\xb8F\xd7f5\x15W\xf3\x99\xc4o\xaa\xc8z\xfd\xda\xef\u05cc\xf3\x8f\xd7WEUUE\xed\x05\xe6q\x8e\xaa\x9bZZ-\x15\xc5ե\x95QM\xd5ӑ\x89\xa3\xea\xa1\x0ek\x9d\x8e\x912[\x055]\xe1t\f\xecX\xedSD]\xd3\x11\x90݊\xa7\xc5a\xc0\xac6\xcdt\x18\xff\xaa>\xb4y_\x9b\xff74\xf0[\x89\xd6&E3\xbb+Y\x82\xfa,\xda\xfd|\xe5`\xfe\xc1\xe7:\xe1cr\xea\xd5\xdd\xf1LEQ\xba\xf9|$\x81\x9f\xa5J\xbd&\xd1b\xe9\xc44|C\x05\x17\x985a\xd6t\xc5m\x1b\xd1\x0ev[\x16KAF=\x85\xcd\xdeg\x85\xec\x1anD\x925\x1d' \xf2̙\xb0\xb4\xb3/\x84\x83\xf3f\x1b{\x19Fғ\xf35\xc0O\xba\xc9 t>\x0f\x9d\x80keQ\xe6{\xa8,\xc2y\x1fз\xed\xc2&u\xc7*Q\xdaL\x87\x9b\x04\"6bw\xfd\x11#\xf9\x92p\x8f@\x92\xeb*mf8\"n\xa1\xf6\xf0\xe5\x81#9\xfez:i\xbf2\xaf#\xb5\xb0\xaf\x1a|\x84>\x01r\xea\xf2\x88E,\x9bΪX\xa7\x8dx\xc4O\xda߫\x11ó\xfe\x88\xde\xd5*\xb5]\r9ֺhrR\x93=mC\x80\xed\xd1K\xbd\xda\xda$\x14a;ergֹsy\x04q\xf7\xf7\x9f<AN\x16\xb8\xfeX\xf9\fת\x14\xc6\"q:\x10\xea\am\xa6-a\xa6\x9f!\xd75\x1f~\x1c\xd2a\x90\x8fz8\x99v\x125\xfe\n\x8b\xa0\xbe\x81u1*\xff0>\xb2\xb3Y\xee\b\xf1XNLo'a\tku\"\xd9\x16q\x8a\x82OX\xbe\xdf\x1d\v\xc7\"\xb2#&\xa3\xb2\xf8˳B\xf35,T{\xab\xbc\xa4fn]\xf9\xcb\xc1\xc0 \xe01\xf3A\xf6o\xd0}\xcc署A\xd6\xdf\xfd\xe5\xcd83.\xdcCsȺ\x99\xf5?\xbd\xf6\xc7#\xea\xd5\xf8\xd5/\xab\xe66\x9a\xb3\b\xceZ'\\ec.\xf8᎐\x88\xd2U\xa6v\xafIe\xf8\xc2\t\x02\x82\xfe>\x86Ӯ\xf8ɅuQ\xb2\xfc\xd4tl3\f\xd6\xf9\xf3\xa8`\xa0\xe0YX\xbe;ǟF\x8d\xba\xe6沠\xc9\xdbs\xbcw\xbd\x82T8\\\x11\xfc\xd3\xc49\xba\x0e\xf8\x82\x8e\x19J\xbfP\x9f\xe6t\xbcf4\x0f\f\x17{\xdcM\xa1>~ܹ\x82\xcf\xf8<\xf2\xf4F\x11\x11\x87\x9bQ\u007f\xa6\x89)g(Ʈ7;J\xe2\xae\x19\xc5\a\xca#֢o\xe6\x06\xdd\a\x99j\x91\xe7\x1d\x88\xfe\xf0xL\xac\xff/\xb7>m\x94\x10M\xbf;\xe81i\xb8\x8e\x1a\xad)\x835\xba\xa4\x0e\x1eZ4;L;JR\xfb\xf0\xfaI\xbb\x00E\x92`\xe9\xeaÏ\xee\xed\u007f\xe7\xe7\xfc#\\\xee\xc7?\x13\xad|Lm\xaf\xe0\xaf\u007f;\x83\xda\xd7>\x84\x1b\xfc\xe8\xe1\u007f\x02\x00\x00\xff\xff_q\xf0n+Q\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V͎\xe44\x10\xbe\xe7)J\xcba/$\xbd+\x0e\xa0\xdc\xd0\xc0a\x05\x8cFӫ\xb9 \x0en\xa7\xd2mƱCU\xb9\x87\x06\xf1\xee\xc8vҝN\xd2̀\x84o\xb1\xeb端~REY\x96\x85\xea\xcd\x13\x12\x1b\xefjP\xbd\xc1\xdf\x05]\xfc\xe2\xea\xf9\x1b\xae\x8c\xdf\x1c?\x16\xcf\xc655\xdc\x05\x16\xdf=\"\xfb@\x1a\xbf\xc3\xd68#ƻ\xa2CQ\x8d\x12U\x17\x00\xca9/*^s\xfc\x04\xd0\xde\tyk\x91\xca=\xba\xea9\xecp\x17\x8cm\x90\x92\xf1\xd1\xf5\xf1C\xf5u\xf5\xa1\x00ЄI\xfd\xb3\xe9\x90Eu}\r.X[\x008\xd5a\r\x8c\x14\x95DI`\xc2\xdf\x02\xb2puD\x8b\xe4+\xe3\v\xeeQG\xc7{\xf2\xa1\xaf\xe1\xf2\x90\xf5\aP9\xa0m2\xb5M\xa6\x1e\xb3\xa9\xf4j\r\xcb\x0f\xb7$~4\x83To\x03)\xbb\x0e(\t\xf0\xc1\x93\xdc_\x9c\x96\xc0L\xf9Ÿ}\xb0\x8aV\x95\v\x00־\xc7\x1a\x92n\xaf46\x05\xc0\xc0T\xb2U\x0e\\\x1c?fs\xfa\x80\x9d\xcaN\x00|\x8f\xeeۇOO_m\xaf\xae\x01\x1adM\xa6\x97\xc4\xf7Jd`\x18\x14\f(@<(\xad\x91\x19t B'\x90Q\x82q\xad\xa7.\xe5\xe8l\x1a@\xed|\x10\x90\x03\xc2S\xa2|\x88\xac:\x8b\xf4\xe4{$1#\x1b\x83ڥ\xfa&\xb73\xac\xefc8Y\n\x9aXv\xc8\xc9\xd3@\t6\x03\x03\xe0[\x90\x83a \xec\t\x19\x9d\xccQ&~ZP\x0e\xfc\xeeW\xd4R\r<pLV\xb0M\xac\xd6#\x92\x00\xa1\xf6{g\xfe8\xdb\xe6HHtj\x95\x8cur9\xc6\t\x92S\x16\x8e\xca\x06\xfc\x12\x94k\xa0S' \x8c^ \xb8\x89\xbd$\xc2\x15\xfc\xe4\t\x13\x995\x1cDz\xae7\x9b\xbd\x91\xb1\xeb\xb4\xef\xba\xe0\x8c\x9c6\xa9\x81\xcc.\x88'\xde4xD\xbba\xb3/\x15\xe9\x83\x11\xd4\x12\b7\xaa7e\x82\xeeR\xe7U]\xf3\x05\r}\xcaﯰ\xca)V\x16\v\x19\xb7\x9f<\xa4\x86\xf8\x87\f\xc4v\xc8\xf5\x91Us\x14\x17\xa2\xe3Ud\xe7\xf1\xfb\xedg\x18]\xa7d\xcc\xd9O\xbc_\x14\xf9\x92\x82H\x98q-RNbK\xbeK6\xd15\xbd7.W\x97\xb6\x06ݜ~\x0e\xbb\xce\b\x8f\xb5\x1bsU\xc1]\x1aE\xb0C\b}\xa3\x04\x9b\n>9\xb8S\x1d\xda;\xc5\xf8\xbf' 2\xcde$\xf6m)\x98Nѹpfm\xf20\x8e\xb9\x1b\xf9Z\xe9\xeem\x8f:f0\x92\x18\xb5Mktj\x0fh=\x81ZS\xa9ބ$i\xfcK,\xc3$\xc9hf\xf3%\xf6\xe7\xebh\xd6\xc7Iz9(\xc6\xf9\xe5\f\xd3C\x94\x99\xfb\xb7\xa6E}\xd2\x16\xb3\x89<M\xf0u(\xf1\xa0\v\xdd\xd2g\t\xf7\xf8\xb2r\xfb@>N\xd64ׯύڀ\xfc\xbf\xd9\x1b\xb7\bw\x1eY\x96J\xff\xb0騞\f\xe8\xc1\x10Pp.\xf6\xedbB& \xf3I\xbe\x901\x82\xdd\n\x9aU<\x9f\\\xeb\xd3&\xa0\xa2c%\xb9\x9fpH\xf6\xe0'\xe3Z1x;\xd7\xf9,\x87כ\b\xcd'\xfdI\xff\x9br\x1c7\x86p\xd5w\x99P\xad>D\x8fk\x8c\xaf\xf7׀2X\xabv\x16k\x10\nK\xed\xac\xab\x88\xd4i^5c\xa9]\xf6\xa9W\nh\xa1\x10\xfb\xe4\xe5\x80\xeeV7\xc0\x8b\x9aO\xf9+ϰ;\xddR\xbd;/\x87˖ʥ[C\x9cݥ\x98\x15\xce\xdeD\xcaj\xf6rI\xafn\x1e\vB\xb6S\xd9qf\\\xb5Ƹ\x88,c\xb8\ta5ً\xcbd\xbe\x99\x84\xc7\xe2I\xed\xa7\x01s\u061d\xff\xf4c \xc3H\x86?\xff*.\xd39.s\xbd`s?߂߽\xbbZgӧ\xf6\xae1y\x89\x87\x9f\u007f)\xb2cl\x9e\xc6\x1d4^\xfe\x1d\x00\x00\xff\xff;,8\xce>\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x8f\xdb6\x10\xbd\xebW\f\xd2C.\x95\x9c\xa0\x87\x16\xba\x05n\x0fA\xd3`\x11\xa7\xbe\x14=\xd0\xe4Ȟ.E\xb2\x9c\xa1\xdb\xed\xaf/HQ돕\xb7Y\xa0э\xc3\xe1\x9b7o>\xec\xa6m\xdbF\x05\xdabd\xf2\xae\a\x15\b\xff\x16t\xf9\xc4\xdd\xfd\x0fܑ_\x1d\xdf6\xf7\xe4L\x0f\xeb\xc4\xe2\xc7O\xc8>E\x8d?\xe2@\x8e\x84\xbckF\x14e\x94\xa8\xbe\x01P\xceyQ\xd9\xcc\xf9\b\xa0\xbd\x93\xe8\xad\xc5\xd8\xee\xd1u\xf7i\x87\xbbD\xd6`,\xe0s\xe8\xe3\x9b\xee\xfb\xeeM\x03\xa0#\x96\xe7\x9fiD\x165\x86\x1e\\\xb2\xb6\x01pj\xc4\x1e\x8eަ\x11٩\xc0\a/\xd6\xeb)XwD\x8b\xd1w\xe4\x1b\x0e\xa8s\xec}\xf4)\xf4p\xba\x98 *\xaf)\xa7mA\xdbT\xb4\x0f\x15\xad8Xb\xf9\xf9\x19\xa7\x0f\xc4R\x1c\x83MQٛ̊\x0f\x93\xdb'\xab\xe2-\xaf\x06\x80\xb5\x0f\xd8\xc3\xc7L1(\x8d\xa6\x01\xa8\xf2\x14\xca\xed,\xc0\xdb\tQ\x1fpTS.\x00>\xa0{w\xf7~\xfb\xdd\xe6\xc2\f`\x90u\xa4 E\xe4\xe5D\x80\x18\x14\xccL\xe0\xaf\x03F\x84mQ\rX|D\xae\xa4\x1fA\x01f\xfe\xdc=\x1aC\xf4\x01\xa3\xd0,\xf0\xf4\x9d\xb5י\xf5\x8a\xd7\xebL}\xf2\x02\x93\xfb\n\x19\xe4\x80s\xfahj\xb6\xe0\a\x90\x031D\f\x11\x19\x9d\x9c\xcau\xfa\xfc\x00ʁ\xdf\xfd\x81Z:\xd8`\xcc0\xc0\a\x9f\xac\xc9\xedx\xc4(\x10Q\xfb\xbd\xa3\u007f\x1e\xb1\x19ė\xa0V\t\xd6ʞ>r\x82\xd1)\vGe\x13~\v\xca\x19\x18\xd5\x03D\xccQ \xb93\xbc\xe2\xc2\x1d\xfc\xe2#\x02\xb9\xc1\xf7p\x10\tܯV{\x92y\xac\xb4\x1f\xc7\xe4H\x1eVeBh\x97\xc4G^\x19<\xa2]1\xed[\x15\xf5\x81\x04\xb5\xa4\x88+\x15\xa8-\xd4\xdd\xd4\xed\xa3\xf9&\xd6A\xe4\xd7\x17\\\xe5!w\x11K$\xb7?\xbb(\xed\xfeL\x05r\xa7O\x8d0=\x9d\xb28\t\x9dMY\x9dO?m>\xc3\x1c\xba\x14\xe3Z\xfd\xa2\xfb\xe9!\x9fJ\x90\x05#7`\x9c\x8a8D?\x16Lt&xrR\x0e\xda\x12\xbak\xf99\xedF\x92\\\xf7?\x13\xb2\xe4Zu\xb0.\xbb\x06v\b)\x18%h:x\xef`\xadF\xb4k\xc5\xf8\xd5\v\x90\x95\xe66\v\xfbe%8_\x93\xd7Γj\xe7\x03V\x97؍z-O\xf2&\xa0\xbe\x18\xa0\x8cB\x03\xd5\xc9\x1e|\xbc\xd2U\xcds\xbe\x8c\xd7]\xb8/\x0f8L;~\xa0\xfd\xb5\x15@\x19S~!\x94\xbd\xbb\xf9\xf6\x19\xc1\x16\xf2^\x97H\xb9Q\a\x1f3\xa3#\x19\x8c\xed\x9cge\x92bM\x98\xd0\x1a\xee\x9e@\xdeм&Y \x9fҼ\xe0qW\xdd2\x93,\xf4\xfcl\xdaPX\x17fY\x9fj\x8f\xb7\x18,d\x9c;\x9c\"^\xcdj\xfb\x18\xe0\x8bzG\x94$~y\xf7\x94g\xd5sW;H\xa7\x18\xd1I\xc5\\ش\xffO\a\x85\x83b\xfc\x0f͗#\xdc\xe5\x97s\x19,\r\xa8\x1f\xb4\xc5\t\x10\xfc\xb0\xd0m/\xa2\x9c?ti|ʭ\x85wGEV\xed,.\xdc\xfd\xea\xd4\xcdۛ\xc5_\xac\xe7\x13#\xe7ujz\x90\x98&\xec\xdae\xd5r\xaa\xbe\xd2\x1a\x83\xa0\xf9x\xfd\xaf\xe7ի\x8b?.娽\x9b\x86\x95{\xf8\xed\xf7fBE\xb3\x9d\xff\x81d\xe3\xbf\x01\x00\x00\xff\xff\xbf\xca\xff\xa71\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=\xfdo$\xb7n\xbf\xef_A\xb8\x05|\xd7\xe7]'hя\xfd\xe5\xe1\xe2sZ#\xc9Ÿs\xae?\xdcK\x01y\x86\xbb\xab\xe7\x19i\x9e\xa4\xb1\xbdi\xfa\xbf\x17\xd4\xc7|\x7fh|Nz)\xec9 \xd9\x19\x89C\x91\x14I\x91\x94f\xb5^\xafW\xac\xe0\x1fQi.\xc5\x16X\xc1\xf1Ѡ\xa0_zs\xf7\xafz\xc3\xe5\xf9\xfd\u05eb;.\xd2-\\\x94\xda\xc8\xfc=jY\xaa\x04\xdf\xe2\x8e\vn\xb8\x14\xab\x1c\rK\x99a\xdb\x15\x00\x13B\x1aF\xb75\xfd\x04H\xa40Jf\x19\xaa\xf5\x1e\xc5殼\xc5ےg)*\v<\xbc\xfa\xfe\xabͿl\xbeZ\x01$\nm\xf7\x1b\x9e\xa36,/\xb6 \xca,[\x01\b\x96\xe3\x16nYrW\x16zs\x8f\x19*\xb9\xe1r\xa5\vL\xe8]{%\xcbb\v\xf5\x03\xd7\xc5\xe3\xe1\xc6\xf0\x8d\xedmod\\\x9b\xef\x1a7\xbf\xe7\xda\xd8\aEV*\x96Uo\xb2\xf74\x17\xfb2c*\xdc]\x01\xe8D\x16\xb8\x85w,G]\xb0\x04\xd3\x15\x80\x1f\x8e}\xe5\xda#|\xff\xb5\x83\x90\x1c0\xb7$\xa2_\xb2@\xf1\xe6\xfa\xea\xe3?~h\xdd\x06HQ'\x8a\x17D\x81\x80\x18p\r\f>\xdaa\x81\xf2\xe4\as`\x06\x14\x16\n5\n\xa3\xc1\x1c\x10\x12V\x98R!\xc8\x1d|Wޢ\x12hPW\xa0\x01\x92\xac\xd4\x06\x15h\xc3\f\x023\xc0\xa0\x90\\\x18\xe0\x02\f\xcf\x11^\xbd\xb9\xbe\x02y\xfbWL\x8c\x06&R`Z˄3\x83)\xdcˬ\xcc\xd1\xf5}\xbd\xa9\xa0\x16J\x16\xa8\f\x0ftvWC\xaa\x1aw;\xc3;%\n\xb8V\x90\x928\xa1\x1b\x86\xa7\"\xa6\x9eh4\x1es\xe0\xba\x1e\xae\x95\x90\x16`\xa0FLx\xe47\xf0\x01\x15\x81\x01}\x90e\x96\x92\x14ޣ\"\x82%r/\xf8/\x15l\rFڗf̠\x17\x80\xfa\xe2\u00a0\x12,\x83{\x96\x95xfI\x92\xb3#($\x12A)\x1a\xf0l\x13\xbd\x81\x1f\xa4B\xe0b'\xb7p0\xa6\xd0\xdb\xf3\xf3=7a6%2\xcfK\xc1\xcd\xf1\xdcN\f~[\x1a\xa9\xf4y\x8a\xf7\x98\x9dk\xbe_3\x95\x1c\xb8\xc1Ĕ\n\xcfY\xc1\xd7\x16uA\x03֛<\xfd\xbb \x00\xfa\xb4\x85\xab9\x920j\xa3\xb8\xd87\x1eX\xa9\x9f\xe0\x00M\x00'_\xae\xab\x1bhMh.\xf6\x96:\xef/?\xdc4e\x8f7Ŋ.G\xf7\xba\xa3\xaeY@\x04\xe3b\x87\xca\xf6\x83\x9d\x92\xb9\x85\x89\"u\xd2G?\x92\x8c\xa3\xe8\x92_\x97\xb797\xc4\xf7\xbf\x95\xa8I\xc8\xe5\x06.\xac\x8a\x81[\x84\xb2HI27p%\xe0\x82\xe5\x98]0\x8d\xbf9\x03\x88\xd2zM\x84\x8dcAS;\xd6\x7f\x04e\xeb\xa9\xd6x\x10t\xd9\b\xbf\x9cB\xf8P`Қ0ԋ\xefxb\xa7\x05줪\xf5\x85SW\xf5t\x1d\x9f\xb2t\xa5\xb8cef>ک\xaeo\xe4{Ԇw\x10\xea!\xf5v\xb0S@\n5<\x1c\xd0\x1cP\x91\xfc\xd8\avJ\xf6`\x82e\xa9\xc6\xd4\xceHv\x87\xc0<\xf6vjg\x19\x142h!\r\xb7ǀl{l5mo\xa5̐\x89\xceS|L\xb22ŴR\xdbzft\x97\xbd\x0e\xa4L\f\xe3\x82f\r\x19\x11BO\xd4OI1\xf7@\x020\x85@r˅\x83gu\xee\x01\a\x19D\xff\xb8\xc1|\x00\xb7Q1s\xff\xc8T\xb2\xdb\f\xb7`T\x89\xbdǮ/S\x8a\x1dG\xe8\x12\xcc{,Y\xaa\xf6^\x8bd<\xb1\xf6\xa7\xd2\x15\x962\xceZ1\xd5\xc7\b\xbed\xa2\x1c\xa4\xbc\x9b#\xc4\x7fP\x9bZ\xefAb\xbd$\xb8\xc5\x03\xbb\xe7R\x91Ec&\x98\xa1[\x04|Ĥ4\xd6[\xe8^\xcc@\xcaw;T(\f\x14\a\xa6Q\x13)\xa7\b2>\x95\xe9\nL\x18|\xd8\x19G\xcdH\x92T;\xf21\xd4iBw\xe7U\xf8#D\xc9h\x90\xdb\"R~\xcfӒe\xc0\x856L\x10p\x9a\xca\x15^\xfd\xf1L2\xb9\x87\xb3S\x87\x01s\xe2DK5J\x81 \x15\xe4d\x90\xfbM\xf5j\xf0\x05\x00\xa3þe\xa4\x9d\xa4\x9b\xb7\xaa\xccP\xfbW\xa5V\xe7\xd6:\xe0l\x14t\xc5\x11\xe7Kd\xec\x163Иab\xa4\x1a&\xc7\x1c\x93\xe3\xf5\xda\b\x15\a4\\\xad\xbbi\xa8\xf5\xc0&@\x02\xa9\xed\x87\x03O\x0e\xce̓\x04Y\x1b\x00\xa9Dmg9+\x8a\xec86\xc8Y\xceGL\xf4\xe8)\x1f3\xf9\xfb\xb4\rҳ\x9c\xb4UφU$\xcaV\xe2\x00FN\xc0\x84\xff\xa7\x84\xe5\xa2+yє\xbd\xeau}^\xa1%Y\xe5\xa87p\xb5\x03\xcc\vs<\x03n\xc2\xdd9\x88,\xcb\x1a\xef\xff\x033f\xb9\xc4_u{>\xab\xc4Ore\x0e\"q\xa5z\xfd\x1f\x90)\xd6X|\xf0\xb6\"\x9a!\xdf7{\x9d\x01\xdfU\fI\xcf`\xc73\x83\xaaÙϚ/\xcfA\x8c\x18{GW\xceLr\xb8|\xa4\x10H\x15u\x01\x88\xa4K\xb73\xf0\xa6?\xdf6\xcc3p\xc9\xd1\xfa[\xc9\x15\xe6\x14\x89\xd9\xc0\xcd\x01[w\xac\xef\xff\xe6\xdd[L\xa7\xa4.R\xf2z\x03y\xd3A\xb6\xf9j\xef\x94\xc7\x0eû>\xd5\xfa\xc6\x06\x03\xf4\x190\xb8ã\xf3X(\xc4R\xa0b\xf4\xa2\x91\x95N\xf7Rhc+v\xfa\xdf\xe1т\xf1\xc1\x92\xd9ޱ\xa2\xe0\xa3\x1dx\x8ci\xd6! \xe1ĵ\x0f\x02\x11\xdb\xe9\x06\x8d\xcdފ\x96\x01\xafd*]4\xc7\xebE\x8a$\\\x81\xf6O\x18fŶ:F\xe3\x18{J\x01\x96\xcc\xc6\x0e\xf4\x81\x17Q\x90\xad\xe1$ɲ\xb3%\x84\xbe>\xb2\x8c\xa7\x15\x8en%q%\xceVQ\x00\xe1\x9d4W\xe2\f.\x1f\xb9\xf6\xd1Ƿ\x12\xf5;i\xec\x9d߄\x9c\x0e\xf1'\x10\xd3u\xb4\xd3K8\xb5Mth\xc6\xd0\"\x84\xdb\xfd\xbb\xdaY9\xab\xd8\xc35ų\xa4\n\xf4\xa0\x87\xfeu\xd3\xf6\xa1\xfd\x97\x97\xda\xd0\xeaEH\xb1\xb6\xa6r3\xf4&KZ\xbd\x8a\x80G1>\xd5\xe2H\x1f\xb5\xea\xa5\ue151`o\xc8\xf3\xb2C#z*,2\x8a\xa6CZZb\xda\xc8$3\xb8\xe7\t\xe4\xa8\xf6\xb8\x9a\x05h\xff\x15\xa4\xdf\xe3P\x88ԺO\x92\xb08\xd3\x1e\xfe\xbc\xea\xee\x84l\x87\xae5\xcd܈V\x81ٳMG\x02\x92\x9f3\"kb\xad\xff1K]\x96\xa66\x97Ĳ\xeb\x05\x1a\x7f\x01/Z\xb3\xb7\x81\x18\x89\x1c\x83\x9c\x154\x7f\xff\x9b̜\x15\xe8\xff\x81\x82q\x151\x87\xdf\xd8\xd4P\x86\xad\xbe>\x8a\xd5|\r\xbd\x81k \xfe\u07b3\xac\x1f\xea\xee\xff\x91\x82\x15\x80\x99\xf5*\b\xbb\xae\xc7r\x06\x0f\a\xa9\x91\x04\x01v\x1c\aC\xaa\xed\x8bk8\xb9\xc3\xe3\xc9YO\x0f\x9c\\\x89\x13g\xe0\x17\xab\x9b\xca[\x90\";\u0089\xed{\xf29NP\xa4$F5\xa3U\xd8v\x15)\x16\xb4\f\r\x9e\x00u\xac\xf2N\xb4,ܬ>S\x0e\v\xa9M4*\xd7R\x1b\x1b\xa4j\xbb\xa5K\xa2X^\x86|\xf4\n\xd8\xcee\xfe\xa4\n9\x1dR{\x9d\x80+qMOkX\xa6\x1a\x111\a\x94\x16V'\xf5\fvQ\xda\x13\x97\xe8\xa1\xff\a\x96ГiT\tn\xa1d\x82ZO\x8bH\x84\xb6n\x91\xb2O\xb3*@\xc8\xdc\x02\x86\x82wsA\xc9\xe5\x0e)\x11i\xaeM\a\xd5\xcb\xc7F\xf4\x92\t\x1b+\x9e\x15\xbe\xa5x\xd1EI0\xd6\xcd\fF\xa1x\xe1z\x86i\xe2\x01Y\xcd\xc1Ծ$]\xa5W\x11@[\xc2\xf9%\x98霋++Y\xf0\xf5\xb3\x9bu\b)#|\x8a\xe3~\x11\xfa\xd6D\xafn\xd8\xd9\x1b\x05\x12l\xfa\xec\xe1\x80\n[\x9c\xebǹ\xc9Q\x8c\x04IQ\xddF8\x81\xe0\x162=հ\xe3JW\vI\x8by$\xc4rf\xf6?\x99\xc3R\\*\xf5\xa4\x85ӏ\xaeg5P\n\x13>\x84\xfc\xeah2s\xe8\xb2I!\xa4\x18\f7\x80\"\x91%\xd5\x17\xd85\x04\xdaW8\x168\x05\x1dM\xb28\x05A\x17\x8a2\x8f#\xc0\xdaJ\x1d\x17\x93q\x9a\xfaZ÷\x8cg\xbf\x05ۨ,E\x96f\x1bѴ\xc36* \x92\xa5\xa9\xf4)\tg\xce\x1ey^\xe6\xc0r\"}\x14L \xbbKX\xb49\x0e\x0f\x8c\x1b\x9b\xf6!\xb8\xc4\x02\xd2g\x89̋\fM\x1c\xd1H\x1ev\x94\x9bJ\xa4\xd0<\xc5\xca0{)\x90\x02\x18\xec\x18\xcfJ5c\x94\x9eD\xdb%k\r\xaf,f[F\xban\xb1/_[\v\xb8z\x867\xc6h\xebBŻ\x8a\xd7\n\xe3ܳ\xb9\xa0\xb4W\xbaP(N\xb2$\x9f\xdbC\xf3\"\xc6\xc4\xf1\xc5E{q\xd1^\\\xb4\x17\x17\xed\xc5E{q\xd1^\\\xb4\x17\x17\xed\x8f\xe7\xa2\xcda\xe4*\xeeWO\xc4\"\"==\x85\xe2\x04|_Mq\xe1\xaa\uf0db3`'\x87*)\xba\xbd\x06\xeaj}Y\xff\xda\xeeH\x18\x92\x80\xe07U\xe5\xf0\xb7X\x97\\\xd2\x1a&\x88\xb7M\x02v<\xce\xd5BBMU\xdf\xf2^\xd5\xcev\xb5\xb4̧]gZ\x95لBS\x19^\xd2\x03\x1c\x8aԵ\x8dL6kH\xda\xf5:ց\x0e\x98nV\xd1>\xce\xe4Ԏ\"ڐd\x05D\x16\x8aMta\xee\x14\xbd:K\x8f6\xc1j\xa1\xfa\xa2\xe85S%3^\x1b\xe3\xe8D\xd5\xfa\xf7_o\xdaO\x8c\xf4\x952\xf0\xc0͡\a\x93\x8a\x95P\x00-\xafľY\xf6\x1a\xe4\xcd\xc8A:RBU\xf0̒sBZ[\xe4\x85\x1f-\xee,\xdb,%\xd9\xf4\xf2\xa3\x9b\\\x1ajӡ^\xb7\xcbT\x05M\xd0\xddv\xf1\xb1Y\x8d%\x82\x97\xa5\x8cF%\xeb3jd\xa6\x8bZ\x96T\xc6t\xeb^F\x81\xce\xd7\xc3Ĭ\x1cgj_\x9eP\xf1\x12jY&\xa0\xc2L\x9d\xcb\xe4\x14\x0fW\xa0Z4\xfa\xb1\x95,\xb3\x05\x81\x91\xf5+\xedʔi\x90\v\xaaV\xa2\x883_\xa1\xd2\"ML]\x8a\xaf\x03Y\xc5\xd4\x19\xcdV\xa3\fԙ\xac\x16V\xbb\xf8\x82\x9f\x89\xea\x92I\x88C\x95'\xf15%\x93\xa0m\xbd\xc9|%ɤ\x1eZ\xc0\xeb)\xb3\x16\xfe\xe6}\xe0qU3[\r2\xeb#O\xe3רw\x18FoI\x95\xc7,\xc5Zr\x1f_\xd1QUl\x8c\xbcwi\x1dG\xbbNc\x04hL\xf5\xc6Hu\xc6\b\xc4ɚ\x8dؚ\x8c\x11\xd83fwRJ&\x1e\x0eo\x84\x9c\xb7o\xd9\xef%QO\x1d\x98T)\xaaI\x0f=\x16\xcdI\x14[\x02\xffc睍ea\xedj:̚^\xff\x10\xcbeU\x12\x9e\x00\xed\avrB\x05K\r?\x81\x1e\xd8%V]\xbe[\xfb{\xc3@;+\r\x8d\x05#\xa5\x9b\xd2\xdeM\x1b\xda\xd4\x1b\xb8dɡ\xdd\x10\x0eLS\xd0&\x1ft\xc3N\xaae\xday\xe8EwN6\x00\xdf\xcaj%\\A\xd4g\xa0y^dG\nZ\xc2I\xbb\xcbR\azB\x02\xdc\xce\xd6\xf7\xcc\xe0\xf7<\xe7f;ͻ\xf7\xed\xd6 \xefQ)\x9e\xb6YGy \xb6GȤ\xdb\xd6{:\xc4:\xbf\xa3\x96\xc8\n\x19ϫp\x17\xd7\x1e̩n\xec\x9a\xf5\xf7\xf4\xe2\x91O\xcf\xcfT>\x88L\xb2\xf4;\xfeM1ؠ3\xfc\xb7\xcd\xf6\xc0\xdba\xbf\x00̍I\xee\b\xfdA\x90\x10\x06E4\x90\n\xf5\x19\xc5-\xbe\xe3ߜ\xeb\r|\x0592A\xbb\xce\x1cY\xfa#\xa6\xcbI\xd9\x16\xb80\xff\xfcO\x83-\x1c\xcb\xe9\x14\x80=\x0e\x19ͲX2\xf0\x9f\x8a\xd1a\x97Ew\xd0~x\x83P!p\xf2\xf7\x1f\xf3\xec$p\xdb\xc0\xdf\xd3\xee\xc8\xedj\x92\x1c\xef\xbb\xed\xedz\x0f\xc9XY5\xc1\x85\xd7_\x14\xa1BR\x14ò\xe0\xe5\xc0\xae_\xb8\x16\xa7\x06\xf0\xb1\xc8x\xc2Mv\xf4\x8b\x19ڶ\xa9\xaa}|\xa4\x80\xea<\b\xc9Ϡ\x1f\xd88\xcaĩDW\xd4B\x1b?\xed*љt\x8a\x17\x05\x14RLxZ\a\xe6\x06`rs\xea\xa6&\xa6P\x166\x9c\xe0\x11\xb0\xf1(!\x1dx\x0f\xf9ldo\xfd\x00`\xff\xea\xcd*\xda9\x9d䅧[S{4cE\xc0\x87\xf7\xff\xd6D\xb616?\xb4J\xef\xc0\x9b@\xa9@\xba\xb0\x93\xd6%\\\x06a\x86\xa6\x14s\U000bb869ǩ\x86Dq\x83\x8a\xb3!I\x9fVY\xe0\xf3\xdd\xc3\xcf:\xb4yc\x9b\x02\xaf\xf8\xea\x18O\v\x89@\x19\x12܊\xab#0\xa1\xc5\xed\xd5\xf2\xf4\xcf:\xc4\xf6F\x9f_>N=\x9f\xf5\x7fr\xf6\xf8\x81\xff2Z\x04\xc1\xc4\xf1\xc7\xdd8rs\xba\xb2\xd9j\x02\x89\x0e\xf1\x7fp8U\x02SQ\xdc@.5\x9d\x1d\xc25dL\xedq\x03~\x96\x8c\x80u\xf4\xa7\x8a \x06wB>\b\xd0\xfc\x17\x04\x81\xf7\x81\xa30\xa64g\r\xa4\x979f踘-\xfc\u05eb\xbf\xfc\xe9\xd7\xf5\xeb?\xbfz\xf5\xe9\xab\xf5\xbf\xfd\xfc\xa7W\x7f\xd9\xd8\xff\xf9\x87\xd7\x7f~\xfdk\xf8\xf1\xa7ׯ_\xbd\xfa\xf4\xdd\x0f\xff~s}\xf93\x7f\xfd\xeb'Q\xe6w\xeeׯ\xaf>\xe1\xe5ϑ@^\xbf\xfe\xf3ߏ \xf4\xb8\xbe\xab\x8e\x01Zsa\xd6R\xad\x1d\xe9'Ƒs\xf1\xe5I\x01\x17cR\x90!\xeb\x88\xc1\xcdDlòܞAP\xd0!C\xdaP\x00\xd0\xeb\xa4$c<\x0f?\xb8\x06:y\xc6\xde#U\xc3\n\x96ps\x1c\xdf\xcb\x14\xd6a\xd5\x1b\x84\v\x96\xbf\xe5\xaa\x03Ӷpnɋ\xc8>\x8b\xc8\x16\xf7I\x88\xd5oc\xc4\xe9\xfa\xe3E\x15\xdb\x0f\"5-\x0f\x13\x1c\xb2\xabxז\x96.\x95mp\xcb\xd6\r\xfcH\xae\x00\xd8\xf3\x82H.\x82\xe8Z&\x8eB}\x1e\xe6\xce\xd8\xc0\xeaE\x8d\xb0\xc4x\xcb\x0e\r\x97D\xff'`>\xcf\xdeو\b\xdc\x13\xf3\x01\xabg\xde/\x1b\xb2\x02\xb3p\x97핍a\xf5\x82=\xb2-b\xc5e\vVϻ76\xc2B\x84+\xd0w\xe1\xb0b\xb3\b\xb3P\x17\xed\x85\xf5q\xf4\b\xa0O\xde\a\xbb\x80t\xb1\xfb_[\x84\x8b\xc91\xac\xfeO\xf6\xbd\xf6s\x11\x93\xf9\x86\b\x88c\x19\x89\xf1\xacC\x04И\xbcDl\xee!Z\xff-\x96\x8d\xb9h\x7f\xfd7\x97\x93\x98\xcfL\x84\x16\x81M\x93\xcd&B\x10K\xb1oD\xf6\xa7\x90_\x12e^D\xe7ּ\x8a\xcfa\xac~\xff\x1d\xa9\xcbw\xa3\xce\x15\x94.މZ\xd9\xd9I\xb0ϱ\v5B\xc2f\x9b\xf8\xf8\xedEƴ\x1e\x97\x96\x96\x00|hu\x99\xf3LG \x86x\x98ny\xa6\xa5\x0e\xe7\xa19}\x18\xa2ˉC\xcf{\xa9\xa3 \x87\xbd\xd7\xd9%ȌZ\x8a\x98$Q~\xee\xdcLw\x04\xb99\x16\x91|\xf8X\xb7\xef\xad8}\x04j\xcf\xefQX\x059q\xd8\x1b\xa5\x19R`:\xcc>\x8a\xa5Q\x9a\xe64\x80\x03\x97\x8e8\xb3Q{|d\xb4y\bNj~;D.\x88\xdb'\xe3\xaf9\t\xeb\xcc\x13\xb2\x9e'\x85\x92$\x94\x98\x9e|\xd1l\x992\x1ak\x1f\x9e[-\x9cw3h\x8d#\xa4\x05+\xf4A\x9a\x1f\xe4=\xbe\x1dLQ\xb6'j\xa7y#\xf9\x16B\x84\xc4oJv\x86\tw\xf1\xe1\xaa\a\xb3~\xaf\x06\xc3\xeeP\x04?\xa0\x91\x06\xe2\x1a\x12Yp\x7f*j/=4\x003$\x8c\xce@S\x0f\n\x8a\x1bХ\xba\xe7\xf7>\xe0\x9aI\xad\xbb\x8a@\x1f\xb5\xc1|\xf34\x92\x0e\x17{\x86\xc1\xf9\x18G$M}\xeb!\x92\xfaca\x93L\x96iM\xba\x1eX\xa0\xb1ц\xa7\xeb\x8f\xf6h\x1b{\xa0fR\x1f.\xea\x1d\xf5P^\x17J\xeb\xc2\xe3o\x9e\xbf\xec\xd5\x13\xf9{ϙ9J\xb4[\xfb\xf5\xa8\x8d\x95\x05\x13\x18\x84$\x9cR\xc0z\x10\xc1\x8f\xa3\v\xac\xde]\x12\x04\xa9\xaa\b&,\x87\xec\xe2\x84>0&\x9b\x19\xcc\xcd\xcd\xf7n\x00\xb4\x85r\xf3\xb6T\x16\x8du\xc1\x94F\xa2f\x18\x98\xebtK\xff{\x90\x0f=\x98\x00\x99\xf4c\xfe\xa6\x8b\xb7B\"\x89\xabd^\x84})\xec\x1e\x03L;镙!\xfd4\xd2m@d}\xfe\xe3I'\x1e\x8f\x98\xfd\xfa,]\x10r\xc8\xf5\xf0\xc8Q\x92\x8b&\x81*\x85 \xaa\x162\xf5\xe9v]&\a\xef\x16в\x1e\xf3B*\xa6xv\f]\a\x80rQ\x1d)\xbdΙ`{L\xe1\x80Y\x81\xca\xefY\xe2\x94\xc31\xda\xe9<\x9f~\xb6ِg\x9dH\xcel\x06U\x11\x84Z\xcf0\xec\xe3p\xafFĪ1\xadhJ\x91v쁄Q8\x8dC\xf2)\xc5\xd0̿oV\xd1\xc6wBP\xc7M\u05c89\xa4C\xfa\xcb\xce[\x86\x0e\x12\xb7\xcd\xc2g\x03\xfcεR\xd9\xf3\x87\x1d\b\xab\\¶\x9a\xa1!\x8d\a\x9e\xfcF\x9b֧\x1c\xa6\xf9t\xd1\xefa\x0f\xecW\xa9C\x8dTH=E\x1e\x98\xae6\xf3\fz\xf358\xb79\xc8.\xb8\x13J)\xa7\x80\xe4\xbeIa\xf7\xee`Z'(;}\x06\xa06\xa1\xf8\xcdA.y\xdf1\xdc\xe1C\x04\xb4\x02\xd1\xf6c\x04\xa7z\x02\xa6U\x03\xe4\x06\x0e\x10A\xafƲ\xf8t\xfe\xfdz\x10h\xd4$\x1b\x146{\x12\x81\x9ea\x95\xddn痩\xf6\x18\x83pF\xbb\xed\r9j\xcd\xf6\xe8u\xd5\x03\x99\x9c=\nZ\xdb\x0fj\x17\xef\xf6ԛ\xaa\xbcs\xe2\x05\xceU\x86\xb3\xc4PM\xbd}A(\x8ao\xb4\x1a\xac\x8f\xc9\xe4\x9e*\xf7mS\xff\x85\x02o\x8b\xfb\x023\x95\xb9\xc2ǂ\xab\x18\xdb}Y5\xf4\xf9b\xf2)\xb8\xf6\xf3\x8d\xeea\xc6\xf7\x9c\f\x1f1{\xcf\xd4-\xdb\xe3:\xa1\x0f\xa4X\x97w\xf3\xbb\xf2\xda\xc1\x1e\xfcRGoh\xdf6ۆ\x80\xb0\x17v\a'|\xb8\xe3\xcc\xfbT\xfd\xf7ѕ\xb3\xbf\xd2A\xa49\x17\xf4\x1f\np\xdb W\xe8\xbcY\x82\xbf=$}\x06\xefkj\x13\xf0mj\xb7j1<\xe6\xf1\r'\xe3\xd7\xf0\x0e\xfb\x0e\x8a;\x01\x03S\x1b\xf6\x1d\xfa<I\xdd\xe4Z\xa1{㛑=\xf7\x94\xe8\xbfVrO\xa9\x95\x81\x87^C\f̤5\\3e8˲\xa3{\xd5@\x8b\xd1\ao\x91Ԏ\xd8/\xa2\xbfԦ5\x96\x0f\xd6j\xcc:\xfb\xd7c\xfd\xaa\x18\x8f\xfd\x80\a\t\x15M%v+\xcb!W\xa7\xbd\x13\xd3s\x93\xce\xd9Z{\xb9t\xcbH(\xb2r\xcf\x05U\xbeS\xf9\x8d\x8d\x10\x15\xb2(\xb3\x11u\xc4- :5AM\x81\x03\x85{r\xd0\x06\x9d\xe6Q3ߢ\x83\x1b\xbd7\xc3MS\xd7\x1a\x15\x83B\xe19\x8d\xcbϷ\x01\xb0\xb6\xf6Ɨµ\xf1ܬ\x96\xa7\x8b\xa2l\xf7\xc0x\x06\x8cW߂\xd3\xf8\x1cj# aڮ\xc7)\xc5(\xd58+\xe0^e9s\x16E\x82\x1f\\۠p\xac\xb9\x02\x85\xa6T\xa2Qyf\x87O\xa71\x8f\x80$?\xd1;'\x9b\xa7b=\xa2\x1c\x9f\xa4\"وq\x9aR\x94\x11\xcal^\xa5ͨ\xadXRXzӮ\xd38zT\xcd\xfb\xc7\x01bc\xda{\xb8O\xe6\x906L\x99e3\xecC\xab\xcb\xd4\xe4\x82\a6Fp\xff\xe6/cz\xfd6\x11\xbdB᠁ٮ&\xc9{=\xd2m\xc2.\x8d\xec\xa5\xea\xdb%\x85\xcfi\x96\x14\xbeX\xa5\x17\xab\xf4b\x95^\xacҋU\xfa\x03Y%'r\xdb\xd5$9\x83d\xceX\x1d\xafwOu\xad\x98{p\xebwn\xa8\xf4\xc8\xef\\\xb01\x89&L\x8aآ6k\xdc\xed\xa42\xce\x16\xad\xd7T\x95\xefb\x7f\x03p\xc9;\xb5{\x02\xdcg\x18\xc9a\xf5q\x9c*DG!\x0e\x1b\x83F\xa6mX\xc0@Ύ\x14\xf5\xe6\x82%\t%\x03\xf0\\\x1b\x96\xe1f)\x8d\xa7m\x85]}\x91\xfd\xc7\xf4\xa7\x11\tn\x11\xfc\xaaپ\x9a]e~\x8b\x8a\xe6\x97\x05\xe7(gO\x87r\xa1\xa8\xac\xcb\xdd\xf0w\x8b(\xe0AqcPt\xd2v\x86\x02>YF\xa9\xb9\x1d\x1b\xc8V\xcc\x05\xa2\xe82Ұ\xecj̒wFvS5\x0eò\xdd\xfb\x83\x93Ė[K\xb2A\xa8\xb4\xd9\xc9\xef\xab\xf6}\x89\x95Ɂ\x89=\t\x95\x92\xe5\xfe\x10\xe4\xb2\xe5'ԣ\x1f\x81\x9b\x96\x84\x94\xd7\x0f>d\xe8LS\xa3\xf0\xc6\xd7M\xa6\rtYr7\x8a\xa9\xaf\xd5\n\x9f\x02>\xf7\xbbRִ+%\xf8M\xb6F\xf5̗X*.)\xda=Q\x05Q\x7f+ǊAQБ\x1f\xda\xe3\x13q4\xe24['\xf4͜>^\xa4\x89[\xe1\xf3\t}\xfb\xc1\xef\xd6t\xfbx.\xba\x1fe\xa6}\x95\"|\x85\xd8\x16\xdbxQ\xa0\xf0J\xb5%o\bp/\x1eފ~\xb7\xd1\u05eb\xe5& J7\x0f\xaa\xfd\xfb*tw\x19\x13\x02\xaf#}\xcd`xu\xce\x0e\x05\xc3k\x88>l݃\b\xf0\x8a\xef\\alBX7>\xac<\xeb\xb3OZ\xb0'\x9b(\x1f\x86\x9d\x19\xfc\xe9d\x1c؆x\xab\x80.\xbc\xa5\x02ۄf\xef\xd00\xaei\a\a\xc9\x04\xb6Ç#H\x0fϠvfP\xbf1\x86\xaab0\x9d\x19\xc7Ǒncʒ\x85\x06=\xb0\x01\x85\xba0\xa1\xbb\x17w\xf39\x03\xaa|\xd1e\x03\xaa\xba\x8d\rH\x97\t\x1d\xb9\xbf+\x87\xcdY\xb5\xe4y\xe6\xd1=0E\xd9ֹ9\xf6\x9f\xbe\xd9@\xa2\xc9C\b\xab \xaf\x97)\xd5\xd4\x03\tu\xf2)\xb8(#\x16j\xd3\xcc4\x05\x1cG\xbe[\xdb\xc9>=S\xaei\xd0\x0e\xf4nZ\x05\x9a6\xe6\xb6\x7f\x93\xbfS\xa7\x7fY\x92 \xc9\xf3\xbb\xee\x87\xf0ONZߺ\xb7?\x13)\\\x9d\xab\xde§\x9f\xe9\x13\xf7\xa4\xc5S?\x1f\xf5\x16>\xfd\xbc\xfa\xdf\x01\x00\b\xe7(*4\x80\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_o\xe3\xb8\x11\x7fק\x18\xec=\xe4e-\xef\xb5E[\xe8\xa5ȟ\x168l\xb6\x1b$\xb9\xf4\xe1z\xc0\xd1\xe2\xd8\xe2E\"U\x0ei\xaf[\xf4\xbb\x17C\x91\xb2lɱ\xbdE\xbb\x91q\xb7\x12\x87Ù\xdf\xfc#\x87\xd9l6\xcbD\xab^В2\xba\x00\xd1*\xfc\xe2P\xf3\x1b\xe5\xaf\x7f\xa4\\\x99\xf9\xfa\xfb\xecUiY\xc0\xad'g\x9aG$\xe3m\x89w\xb8TZ9et֠\x13R8Qd\x00Bk\xe3\x04\x7f&~\x05(\x8dv\xd6\xd45\xda\xd9\nu\xfe\xea\x17\xb8\xf0\xaa\x96h\x03\xf3\xb4\xf4\xfaC\xfe\x87\xfcC\x06PZ\fӟU\x83\xe4D\xd3\x16\xa0}]g\x00Z4X\xc0B\x94\xaf\xbe%g\xacXam\xca@L\xf9\x1ak\xb4&W&\xa3\x16K^ze\x8do\v\xd8\rt\x1c\xa2X\x9dJ7\x81\xd9S\xc7\xec>2\v\xe3\xb5\"\xf7\xf18ͽ\"\x17\xe8\xda\xda[Q\x1f\x13+\x90Pe\xac\xfb\xebn\xe9\x19,\x88\xf5\x01 \xa5W\xbe\x16\xf6\xc8\xf4\f\x80J\xd3b\x01av+J\x94\x19@\xc4,(2\x03!e\xb0\x82\xa8\x1f\xac\xd2\x0e\xed\xad\xa9}\x93П\x81D*\xadj\x99$\xe9\x02Q\x19H\xda\x009\xe1<\x01\xf9\xb2\x02Ap\xbd\x16\xaa\x16\x8b\x1a\xe7?j\x91\xfe\x1d$\x06\xf8\x95\x8c~\x10\xae* \xeff\xe5m%(\x8d2\xc2\x05<\f\xbe\xb8-+@\xce*\xbd\x9a\x12\xe9^\x90{\x11\xb5\x92\xbd\xd5A\x11\xb8\n\xa1\x16\xe4\xc0\xf1\a~\xeb\x10\x02\x86\b!!\x04\x1bAq\x1d\x80u\xc7\x05\xe5QI\xeb\xd1Z\x91\xb4\x13\x9bE\x81\x97\x03.\x9d\xfc\xfc%J?`\x9b\x1c?\x1f9\xed\x1e\xdf\xeb\x15\x1ec\xb6\a\xc5\x1d.\x85\xaf\xddPU\xb1\xda);\xa1V\x8be.\xbbYq\xb4\xd3\xe4n\xef[\xb7\xea\u0098\x1a\x85\xcevT\xeb\xef\xc3\v\x95\x156!x\xf9ʹ\xa8\xaf\x1f~x\xf9\xed\xd3\xdeg\x98r\xa4\x83\xa0`É\x81m*\xb4\b/!\xfe:\xbbQT\xad\xe7\t`\x16\xbfb\xe9vFl\xadi\xd1:\x95\x82\xa5{\x06Ij\xf0\xf5@\xa6+\x16\xbb\xa3\x02\xc9\xd9\t;?\x8a\xf1\x822j\nf\t\xaeR\x04\x16[\x8b\x84\xda\r\xe1M\x8fY\x82\xd0Q\xbc\x1c\x9e\xd02\x1b\xa0\xca\xf8ZrR[\xa3u`\xb14+\xad\xfe\xd9\xf3&p&:\xafØ\"vO\x88O-jvU\x8f\xefAh\t\x8d\u0602E\x06\x01\xbc\x1e\xf0\v$\x94\xc3'\xf6w\xa5\x97\xa6\x80ʹ\x96\x8a\xf9|\xa5\\JΥi\x1a\xaf\x95\xdb\xceC\x9eU\v\uf325\xb9\xc45\xd6sR\xab\x99\xb0e\xa5\x1c\x96\xce[\x9c\x8bV͂\xe8\x9a\x15\xa6\xbc\x91\xdf٘\xce\xe9jO\xd6Q\xd4v\xbf\x905߰\x00g\xcc\xce\v\xba\xa9\x9d\xa2;\xa0\x95^\x05t\x1e\xff\xfc\xf4\fi\xe9`\x8c=\xa6\xc9-v\x13ig\x02\x06L\xe9%\xda0\x0f\x96\xd64\x81'j\xd9\x1a\xa5]x)k\x85\xfa\x10~\xf2\x8bF9\xb6\xfb?<\x92c[\xe5p\x1b*\x16,\x10|ˁ)s\xf8Aíh\xb0\xbe\x15\x84\xffs\x030\xd24c`\xcf3\xc1\xb0\xd8\xee\xfe\x98K\x11Q\x1b\f\xa4Zx\xc4^\x93Q\xfc\xd4b\xb9\x17?\x12IY\xf6p'\x1cr\xf0\x88=\x8e\x90B|\x92\xdb\x1e\xe9tp\xf3#\xca\x12\x89>\x19\x89\x87#\a\"_\xf7\x84{2\xb6h\x1bE\x1c\xfa\x04Kc\x0f+\x86\xe83\xf0\xf0I\x99*\x1f\x8d\xa1\xf6\xcdX\x90\x19<\xa2\x90\x9fu\xbd=2\xf47\xabbf?Ð\xfc\xebD|\xda\xea\xf2\x01\xad2\xf2\x84\xf27\a\xe4=\x04\x95\xd9\xc02\xb8\xb5v\xf5\x96s\x10mu\x19ُx\x02\\?\xfc\x10\x9d%\x06P\x8c\xb7\x88U\x0e\xd71r\xcd\x12>\x80T\xc4\x1b\x00\nL\xc7`\xf1\xf6\x8c\xc7\vp\xd6_\xa4~i\xf4R\xad\xc6J\x0f\xf74\xc7<\xe6\x04\xeb\x03\xe4n\xc3J\x9c\x9a\xd8;Zk\xd6J\xa2\x9dq|\xa8\xa5*9\xa1/\xd5\xca\xdb\u0cf0TXK\x1akz$\xca\xf8WZ\x94\xa8\x9d\x12uqB\x92\x9e\x90\x17uB\xe9\xaeJ\xed\x18\x84dc\x9bXR\xb5C-\xfb\xdd\xc8\xf0q&d-B\t\x1b\xe5\xaa.\x1d&\x9f\x1e\xd1\x1f\x8f=~^q;\xf5\xf9@\xf6\xe7\n\xe1\x15\xb7\x9c\x03Xd\xc2Ң\vކ5\x170v\xa5\x1c\xe0\x93'Ǣ\x1d\xe6\x89\xf4\x176ji\xf6+n\xc7@\x9f4n\xdc\u009c\x16\xf9\x8a\xb7\xceI`\x8bK\xb4\xa8\xdddR瓉\xd5\xe80\x9cz\xa4)\x89kj\x89\xad\xa3\xb9Y\xa3]+\xdc\xcc7ƾ*\xbd\x9a1\xe0\xb3\x18As\x16\x85\xe6߅\xffMJ\x04\xf0\xfc\xf9\xees\x01\xd7R\x82q\x15Z\xf0\x84K_'G\x1b\xeco\xde\x03\x97\x82\xf7\xe0\x95\xfc\xd3U6\xc1\xe9\x14.&\xd8J\xd4g`Ù^-\xb7\xb0\xa90\b\xc5\x10=uV1\x16\xb8R\xb2\xb1\x9bh\xcd.\xd7\xc87l5\xdca\x0e\xff81q\x05\x19\x8b4cw\xba$\xcc\xe2f\xb7\xc8\xdeT,m\xa4\x95\x96\xaa\x14\x0ei?6\xd2\x01#2;\x9e&c:\xec'\xe6\xd9%\x8aw\xee\x11\xeb\xe1\t\x89?\x0fiS턘\x9eb\x8d#tN\xe9\x15\x81F\xae\x81\u008e\x91\vI\xa14Zs4:\x03\xa2OuW\x14\xe5IJ\xe5\x17f\x88\x85/_\xd1M\x8d\x1c\xa8r\x13\b\x13\xc6\xdd4\x16\xcb\x13\x86\xd2|J\x8c3|\xbc\x14\xb7hϑ\xe5\xf6\x9a\t\xfb2)\xe0\xf6\x1a\x16^\xcb\x1a\x93D\x9b\n5\x9f\xa8\xd5r;\xbd\x16?\xcf\xf7O\tհÈ{\xfc\x84\xed\xb4\x0e]\x0e/`\xb1u\xf85J\xb6\x16\x97\xea\xcb\x19J>\x04\xc2\x04x+\\\x05J\x93\x92\bb\x02\xfen\xb36ɵw\xf8\x1c>\xc7,\xf2\x15\xe6y+\xda;q.\t\xf8\x84q\x91\x9d\xc0\xa0#\xebQ\x88\xd3R\xe6\xdf\xdf\v\xe6\xd9\x05\x1aY$\xa7\xcaG\xe1\xf0^5ʝ\x10\xe4q\x9f\x1aj\xfeo\f\x05\xa1\xe5FIW\xb1-$,\xf8\xdc\xc7\xc4\xe0\xa6\xcc\xe1\xac\xd0ħ\x9c\xd6HXsO\a\x81\xf7\xfd\xec\xb8|n\xec\xcf=\x91\x89\xc5\u0590r\xc6*$P\xe3$\f\xfb9\xf0\xe2m\xdc\xdb\xd9A\x9a\x8d\xae\x8d\x90\x1f\xd5M;Ip\x00\xd3ݐ>\x19\xad\x11_T㛞\x19\xd8x\xe0h\xcdtف\x04\fc`,\xd2{P\x1a>\xaa\x9b9\xe5\xf0\x01\x1a\x14\x9a@\x9b\xce\boG\xa9\xd2\xee\xf7\xbf\x9b\xa4\xe8\\\x83\x8f\xee+\xb4\x13\x14\xbe\xbdD\xf1\x1fۣj\xfb\xf6P\xe9\xa8\xde$\xd7tx\xf8\x06:\xbf\x11\xae\xb1\a\xa7\x8c\xfe\v\xe7\x01\xd4\xe5ľr\x0f\x90\x97\xf1\x8c7\x8e5\xa9\xc77\xe2\xc9\xee\x8dP\x1ak\x91Z\xa3%w\x1a\xce;\xd4\xecD\xbe8&\x8ef\x8d\xe9\x1c8\x033,\xf3\ac)\xd3eg@\xdd\xf53\x8b\xec(\xaa\x93g\xf1\xa70\xabG\x97\x013\vB\xbb\x1e\x1c\xee\xf7X\xc2\xff\xe7L\xffnp\xa8\xe7\xe6\x91\x06\xafC\x8a\f\xdb\xe3\x1c\xfe\xae\xe1\x8e\x1bA\xbc\x95\x93\x05\x1bڎm\x01\x1cN\xdalx\xfa\x80_`\x01F\xf3\xac\xb0\xe1\rM\xb7\x90\n\xbb\xa1\x8d\xaak>\xacXl\xcczr{˧2\x8b\xf5\x96;\xe3f\t\xeb\xdf\xe4\x1f\xf2w߬e\xc0=l\xee\x00\xa0|ĵ\x1a\xb7DG\xe8ޏ&\xa4\xc4\xd3G\x03\xbf\xfc\x92\x1aKs\x1b\xc9~\x19\xf1\x05X\xaa\x9a\xbb\x91\x135\xb5\xaf,\x13\xbd\xfb\x9b\xa7\xfb+\xe2\x1d\x94C=\xe8\xf5\xee\x9e\rw\x8a\xb9\xbb\x80\x92\xb3\x8e\x89=<O\x0em\x1e\x9d0t\x1f\x80^U\x1b\xaf\a\xd2z\x9bJ\xf5\xb7\x13Ç\xebn҅U\xf6\xba\xac\x84^\xa1\xec\x1a\xaf\xe1 \xb6Q\xd4-\x1c\x0f၀\xa2\x86\x13<\x93\x92\xacN\xa0\x85ڬ\xf8\x1e'\xb41\xb1_/\xff:\x93\xf2\xfd\xc1\xd9\xe6<~W\x92\x90N\xb6MR_\x8a|v\xacrp\n\x9e\xb9\xdd\xfd\xc9\x7f\x9f1\x01Ɨ3g \xb1?a\x1a\x8d\x81\x9f\xbeeR\xbeK\xda\xdd!};\x1c\x1a$:}`\xfc\xd4Q\xb1\xc6\"M\x01\xb10\u07bd\x15\x9bWS\xc1\x17/\xc7.\x911\\\xf9\x9d\x900\\\x02&\x8b\x94\xder\xe3e\xd7C揓\xc5%?;\xb3\xf6\xb7\x94\x13c\xe3{\xcb3\xf4\x9a,\xb6\xa3\x8f]\xc1\x1c\xd85\x82<\xfc\xe2\x17\xfd\xbdJ\x91\xed\x95l\xf8\u05ff\xb3]\xf5\xe6\xb6w\xebP\x0en\x87\xb9\xfdS\xc0\xbbw{\xb7\xcb\xe1\xb5\xe4m\r[\x9f\n\xf8\xe9g\xbe\x1cf\x8f\x96\xb1qD\x05\xfc\xf4s\xf6\x9f\x01\x00.\r;/\xd3\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4X\xcfo۸\x12\xbe\xfb\xaf\x18\xf4\x1dz\xa9\x95\x16\xef\xe1\xbd\a߶\xce.\x10l[\x04M7\x97\xa2\a\x8a\x1c\xdb\xdcP$\x973r\xd6\xfb\xd7/\x86\x92,\xeb\x87\xe3d\x8b\xd6=D\xe4p\xf8\xcd73\x1f)-\x96\xcb\xe5BE{\x8f\x89l\xf0+P\xd1⟌^\x9e\xa8x\xf8?\x156\\\xed\xdf-\x1e\xac7+X\xd7ġ\xfa\x8c\x14\xea\xa4\xf1\x1a7\xd6[\xb6\xc1/*de\x14\xab\xd5\x02@y\x1fX\xc90\xc9#\x80\x0e\x9eSp\x0e\xd3r\x8b\xbex\xa8K,k\xeb\f\xa6\xec\xbc\xdbz\xff\xb6\xf8_\xf1v\x01\xa0\x13\xe6\xe5_l\x85Ī\x8a+\xf0\xb5s\v\x00\xaf*\\\x81ld£wA\x19*\xf6\xe80\x85\u0086\x05EԲ\xe36\x85:\xae\xa0\x9fh\x16\xb6h\x9aH\xae\x15\xab\xeb\xd6G\x1ev\x96\xf8\xd7\xc9\xd4\aK\x9c\xa7\xa3\xab\x93r\xa3\xbd\xf3\fY\xbf\xad\x9dJù\x05\x00\xe9\x10q\x05\x9fT\x85\x14\x95F\xb3\x00h\x83\xcdP\x96m8\xfbw\x8d\x1f\xbd\xc3*\x13(O!\xa2\xff\xe9\xf6\xe6\xfe\xdfw\x83a\x00\x83\xa4\x93\x8d\xc2\xcf\x10*$$\x0e\t\tx\x87\x19\v\xd4Q&\xd0@y\x00\x95\x8d\x7f\xcb#`=\x87\xa3G\x00\x05\x1e\x1f!\n6b\xf4\f\xfb\xe0\xea\nA;e\xab\xe2h\x18S\x88\x98\xd8vL\xb6\x8b\xfb\xea9\x19\x1d\x01}-\xb1\x88\xff\xe0\xc1Hٴ0[>д\xe1C\xd8\x00\xef,A\u0098\x90\xd07\x854p\fb\xa4<\x84\xf2w\xd4\\\xc0\x1d&q\x03\xb4\v\xb53Rm{L\f\tu\xd8z\xfb\xd7\xd17\x01\x87\xbc\xa9S\x8cmZ\xfb\x9f\xf5\x8c\xc9+\a{\xe5j|\x03\xca\x1b\xa8\xd4\x01\x12\xca.P\xfb\x13\x7fل\n\xf8\x18\x12\x82\xf5\x9b\xb0\x82\x1ds\xa4\xd5\xd5\xd5\xd6r\xd75:TU\xed-\x1f\xaer\x03ز\xe6\x90\xe8\xca\xe0\x1e\xdd\x15\xd9\xedR%\xbd\xb3\x8c\x9a\xeb\x84W*\xdae\x86\xee%`**\xf3\xaf\xd4\xf6\x19\xbd\x1e`僔\x15q\xb2~{2\x91\xeb\xfa\x89\fHq\x83%P\xed\xd2&Оh\x19\x12v>\xff|\xf7\x05\xba\xads2\x06N\xa1\xe5\xbd_H}\n\x840\xeb7\x98\xf2:ؤPe\xc6ћ\x18\xac\xe7\xfc\xa0\x9dE?\xa6\x9f겲,y\xff\xa3Fb\xc9U\x01\xeb,%P\"\xd4\xd1(FS\xc0\x8d\x87\xb5\xaaЭ\x15\xe1\x0fO\x800MK!\xf6y)8U\xc1\xfe\x9fxY\xb5\xac\x9dLtju&_\xa7\xad}\x17QK\xea\x84=Yf7V羀MH\xa0\x06\xb6}\xbb\x9eoY\xf9\x95J?\xd4\xf1\x8eCR[\xfc\x10\x1a\x7fc\xa3\x11\xa6\xf7sk:`\"fҙ\xf2w\xe3\x1cD\x8f\xd4\x16'N\x01\\\xb7\xf8q\x87\t{Ų\x94\x17\xe1(\x8c'8\x97\xffZy\x8d\xee\x02\xf8u6\x02덐\x97\xf5G5\x05\xd9iv\xa7 %\n\x88\x18ϣ(Cp\xa8ƲD^E\xda\x05\xbe\xb9\xbe\x00\xe5\xeehؑg\x8d\x94\xdd\xc6b\xea(LHl\xf5\xd1'\x84\xcd\xc4'\x1cy{\x11[\x8d\xa8\x1c\x8f\xa5KX\x87֧\xd9\xce\xcb\xfb\xdc=*:\x9e8\x13\x9f\x90\xc5\xe0\r<\xee\xac\xde\xf5\xe1\xd2i\xac\tc \xcb!\x1d\xc0\xf2\xeb\xae\x12\xc0\xfa\x17\x85\xc7*m\x91\x9f\x1bޗ\xa1\xf54\xbc6\x1d\x93\xc3q\xe2\x16\x9a\xe3\xb2\xe7\xc3ұ\xb2Ј\x9e\xbd<\x8a\xdb\xfb\xf5\xb3\xf0\xdfޯO\x91\x9f\x05\xdd@|\xa2\x90\xbe\a\xb4H\xb7M8:\x84\x96\xf3J3\xb2\xe9[g<1,\xbf\xd1\xec(׳\xb3\xb7\xf7\xebg\xc91+\xaeG*y^\x90\xb3qG\xb9\xaeS\x92[\x135\xa3a\xf3\x0f%Y\x87*:\x1c\xde|\x9f\xce\xfez\xba\"\xdf{\x92i\x90\xb1\xadZqm\xa1\xc0\xa3\xa2\x89\xcb\xe3\xcer\xc0\xf6.\x9b\xd5\xf9.\xa6C2h\x00\xf7\xe8!x\xd8(\xeb\xd0@H\xad\xf2\xce\xf6{WF4-\xa1MH\x95\xe2|aƥ\xec2\xb1\x90\xfb\xbe*\x1d\xae\x80S\x8dϯA9\x83\x89\xd4\xf6R\xdb\x7fl\xac$\x83\xaa[\x02\xaa\fu{\"H+t\x01d%\x92\xcc\x16/\xc1\x11w\x8a.\xa1\xb8\x15\x9b\xb9*:v\xf0\xf92\x92\x1f\xfa\xba\x9an\xb1\x84O\xf883z\xe3oS\xd8&\xa4i\x05,\xbb\xb4\xcfdr\t\xbf\xe4t\xcf-:\x97\xfd\xa7xi1\\\xa2\xa65\x83]p]1\aV\x0e|]\x95\xcd)Y\x1e\x18\xa9#\xaa\x13\x90\x89Wy\x1f5\x03\x82{\x0f]\x86\xf3\x1b\x12\xe3L\x82\xcf7\xab\xfc2\x82\xeb\xe0g\xf2|Z\xe6\xd6\xf3\x7f\xff3k\xd1\xf0$\xaf\x1e[L3\x169\xe4\xf7\a\x9e\xdf\xfe\xfbw8\xa3\x85\xad\x1e&~\xae\x0e\xdd\r\x8c/K\x904T\xe2\xb9\xfbՏ\x93\x86\xd9X'\x83\x84i\x8f\xe6\xc4w{\x87mG\xfaSBi\x8d\x91\xd1|\x1a\x7fYx\xf5j\xf0\xc1 ?\xea\xe0M\xfePB+\xf8\xfaM\xbe\r\xe4Kn\xfbFL+\xf8\xfam\xf1\xf7\x00s \xf5\x80\x8b\x11\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XQ\x8f\xdb6\f~ϯ \xba\x87\xbe,N\x8b\rې\xb75\xb7\x01\x87\xb5š\xe9\xee\xa5\xe8\x03#1\x89v\xb2\xa4\x89Rn\xb7_?P\xb6/\x8e\xed4w+\x1a\xe7\xc52I\x91\x1fɏ\xb2g\xf3\xf9|\x86\xc1\xdcRd\xe3\xdd\x120\x18\xfa'\x91\x93;\xae\xee~\xe1\xca\xf8\xc5\xe1\xf5\xec\xce8\xbd\x84U\xe6\xe4\xeb\x0f\xc4>GEW\xb45\xce$\xe3ݬ\xa6\x84\x1a\x13.g\x00\xe8\x9cO(\xcb,\xb7\x00ʻ\x14\xbd\xb5\x14\xe7;r\xd5]\xde\xd0&\x1b\xab)\x16\xe3\xddևW\xd5\xcfի\x19\x80\x8aT\xd4?\x9a\x9a8a\x1d\x96ವ3\x00\x875-A6\xca\xc1z\xd4\\\x1d\xc8R\xf4\x95\xf13\x0e\xa4d\xbf]\xf49,\xe1\xf8\xa0Qk}i\xe2\xb8\u0084\x7f\x16\ve\xd1\x1aN\x7f\f\x1e\xbc5\x9c\xca\xc3`sD{\xb2kYg\xe3v\xd9b\xec?\x99\x01\xb0\xf2\x81\x96\xf0\x1ek\u200a\xf4\f\xa0\r\xb1\xb80o\x838\xbcn\xac\xa8=\xd5\x056\xb9\xf3\x81ܯ7\u05f7?\xacO\x96\x014\xb1\x8a&\b*}'A\xf9`\x88!\xed\xa9x\x01~\v\b\xab\xf55\xdcz\x9bkZ;\f\xbc\xf7\t\x12ޑ\x03\x9d\xa3q\xbbG\xa3\x00\b\x1bTw9@\xf2\xc5F{\xc7\xc9G\xdc\x11X\xafJ\"\xaaG\x95\x10}\xa0\x98L\agk\xe6X@\xbdՁ\xd7/%\xb0\x06\b\xd0R9\xad\xdf-8\xa4[,$\x86\xb47\f\x91B$&\xd7\xd4҉a\x10!t\xe07\x7f\x91J\x15\xac)\x8a\x19\xe0\xbd\xcfVK\xc1\x1d(&\x88\xa4\xfcΙ\x7f\x1fms\x17\xa8\xc5Dm~\x8f\x97q\x89\xa2C\v\a\xb4\x99\xbe\at\x1aj|\x80H\xb2\vd׳WD\xb8\x82w>\x12\x18\xb7\xf5Kا\x14x\xb9X\xecL\xea\x1aG\xf9\xba\xceΤ\x87E\xe9\x01\xb3\xc9\xc9G^h:\x90]\xb0\xd9\xcd1\xaa\xbdI\xa4R\x8e\xb4\xc0`\xe6\xc5u'\x01sU\xeb\xefb\xdbj\xfc\xf2\xc4\xd7\xf4 5\xc6i\x90\xceR\xdc_Ȁ\xd48\x18\x06lU\x9b@\x8f@˒\xa0\xf3\xe1\xb7\xf5G\xe8\xb6.\xc981\n-\xeeGE>\xa6@\x003nK\xb1\xe8\xc16\xfa\xba\xa4\x99\x9c\x0e\u07b8Tn\x945\xe4\x86\xf0s\xde\xd4&I\xde\xff\xce\xc4IrU\xc1\xaa\xb0\tl\brИHWp\xed`\x855\xd9\x152}\xf3\x04\b\xd2<\x17`\x9f\x96\x82>\x11\x1e\x7fbe٢\xd6{\xd0Q֙|\x1d\xfb|\x1dHI\xe2\x04;Q2[\xd3t&l}\x04\xec1±UϷ\xab\\M\xa7\xaf\x9bF\x7f\xdb\xf6\xf9Ph\xe0ϛ)\x9d\xce-a5\xe9\xca1\x8d\x8c\x8c\xc2#\xb1\xc0\xfd\x9e\"\x1d\xe9\xcb0\xe4\x12\x06i\xc9\xfeH\xf3\f\xe8\xf2W\xe8\x14\xd9\v\x11\xac\x8a\x10\x18\xa7\x05\xbfB@\xd8Td\xb3mG \x1b\x12\xe7C }·\x8d\xf7\x96p\xc8JM\xc7<r\xff\x05o֧\xd2}$\x8bz\a\xe7)\x97\x8fl\xca\xc4՝l\x106\xe5D.\xc1\xa1\xa8\x81\xb2hj0\t\xee\x91\xdb9 M\xf9,p\x9b\xb8nnWO\x8a\xe8\xe6v5U\x15g\\\x1bY\x84\x89\xa0\xbf\xca\xf9\xa6\fW\x16\x99/\xf9\xdf\x13\x9d\n\xa1\x1b\x8cJlA\xe6R\xa6#\x93\xa5\xef\x0e\xa6L:\xd1JT\a\x1f1>\x9c͎Hq\x17\xea\x88m\xe5\x1fI\xf6.\xfb\xc1\xfd\xdeX\x02\xa1\xcaa\xd3<\v\x97\xc3\t\xc2\x17\x90\x19\xa4c\x02\x9b\xf1\xa9cd\x11\xe0~\uf67e\xc6m\x19\x0e&\xd2`\xccͧ\xf9l 3\xe8\xceɧ7\xb7\xab\xc1\xfa)LO\xa2\xf5\x84)\x0fJ\xed\x1c\xb1\x17Ѯ\xd4T\x8eQj\x83\x9bU\xbf\xfd_Ԯ|\x1d,\x9d\x1e\xa0\xbf\x9c\xdc\xd5X\xa3\x9c\x9d\xa2n\xfcJ\xa6\xa6>KJ3\xb6\xbbL%\x0fz\x06\x1b\xddr\x9aS>j\xd2@\ar \x93\v\x8d%\r>\xb6\xd4M\xba5\xcfc\x8b[\x1fkL\xe5\xa4Ms\xb18\x92\x90\x17\x04\xdcXZB\x8a\x99\x9e^O2\xb1\x99qG\x17 z\xd7HI\xa6\xb0S\x01\xdc\xf8\x9c\x8eӫq\xff%\xb7\xf9\xab\x9e\xe3E\xd8#_\xf2\xe1Fd\xa6j\xe5\xb1\a\xcf\x15\x8b\\\xe4r=\xde`\x0e\xef\xe9~b\xf5\xda\xddD\xbf\x8b\xc4c2\x9aw\xe9-\xef6\xa7\xd7\x1c~/i\x9dRj\xb3\xfc,TZ\x1f.\x01ӊ\xc1\xdeۮd}B\v.\xd7\x1b\x8a\x82\xce\xe6!\x11w05-=\xb2\xd9\f\xd2>\xb8G\xfd\x8e\xaa\x1aCctϷ\xa3\\E\xe9ʻ\x89\f\xf7\xcb۸\xf4ӏ\x93\x12M\xe5\xc8\vʎ\xe2\x84D\t\xf7\xcdC\x9a\xde\xfe\xebw8\xc3t\xf2\xef\xe6\xd6\xf5Յ,u\x14z}\xd5հ\xd1r\xd2ޚ\x06bY\x911g\xd4q\x16\xfa\xed\xc8&\xf4\x98\x88\xb4P\x02Vϩ)N\x18\xd3S\x99q}\"|\x91\x14\x8b\xed)J\xfcv\x046\x99\x99\xd1\"S<\x90\xee\xd9nO2\xed\xcaqb\xa1R\x14\x12\xe9\xf7\xc3O&/^\x9c|\r)\xb7\xca;]\xbe\xff\xf0\x12>}\x96\x8f\x1f\xe5\x8cҾ\xe5\xf3\x12>}\x9e\xfd7\x00\xed\aޮb\x12\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VMs\xdc6\f\xbd\xebW`\xd2C.]m2=\xb4\xa3[\xeb\xe4\xe0i\xeb\xf1ؙ\\29pI\xacĚ\"Y\x00\\\xd7\xfd\xf5\x1dPR\xf6Ӊ{\xc8j/$\x81G\xe0=\x00R\xb3Z\xad\x1a\x93\xfdG$\xf6)v`\xb2\xc7\x7f\x04\xa3\xae\xb8}\xf8\x85[\x9fֻ\xb7̓\x8f\xae\x83\xab\u0092\xc6;\xe4T\xc8\xe2;\xdc\xfa\xe8ŧ،(\xc6\x191]\x03`bLbt\x9bu\t`S\x14J! \xadz\x8c\xedC\xd9\xe0\xa6\xf8\xe0\x90*\xf8r\xf5\xeeM\xfbs\xfb\xa6\x01\xb0\x84\xd5\xfd\x83\x1f\x91Ō\xb9\x83XBh\x00\xa2\x19\xb1\x03\x87\x01\x057\xc6>\x94L\xf8wA\x16nw\x18\x90R\xebS\xc3\x19\xad^\xdcS*\xb9\x83\xfd\xc1\xe4?\a5%\xf4\xaeB\xfdV\xa1\xee&\xa8z\x1a<\xcb\xef\xcfY\xfc\xe1g\xab\x1c\n\x99p9\xa0j\xc0>\xf6%\x18\xbah\xd2\x00\xb0M\x19;\xb81#r6\x16]\x030\xf3Q\xc3\\\xcd\x19\xef\xdeNpv\xc0\xb1r\xac\xab\x941\xfez{\xfd\xf1\xa7\xfb\xa3m\x00\x87l\xc9g\xa5\xf0b\xfc\xe0\x19\f\xccQ\x80\xa498H\x11!\x11\x8c\x89\x10\xa6H\xb9\xfd\x02\x9a)e$\xf1\v\x7f\xd3sP:\a\xbb'!\xbc\xd6('+pZ3\xc8 \x03.\x99\xa2\x9b\x13\x83\xb4\x05\x19<\x03a&d\x8cS\x15\x1d\x01\x83\x1a\x99\bi\xf3\x17Zi\xe1\x1eIa\x80\x87T\x82\xd3R\xdb!\t\x10\xda\xd4G\xff\xef\x17l\xd6<\xf5\xd2`d\x11y\xff\xf3Q\x90\xa2\t\xb03\xa1\xe0\x8f`\xa2\x83\xd1<\x01\xa1\xde\x02%\x1e\xe0U\x13n\xe1O\xa5\xc9\xc7m\xea`\x10\xc9ܭ\u05fd\x97\xa5el\x1a\xc7\x12\xbd<\xadk\xf5\xfbM\x91D\xbcv\xb8ðf߯\f\xd9\xc1\vZ)\x84k\x93\xfd\xaa\x86\x1e5anG\xf7\x03\xcdMƯ\x8fb\x95'-\x18\x16\xf2\xb1?8\xa8\xd5\xfc\x15\x05\xb4\x96'\xd9'\xd7)\xd1=\xd1>\xf6U\x92\xbb\xf7\xf7\x1f`\xb9\xba\x8aq\x04\n3\xef{G\xdeK\xa0\x84\xf9\xb8E\xaa~\xb0\xa54VL\x8c.'\x1f\xa5.l\xf0\x18O\xe9\xe7\xb2\x19\xbd\xf0R\x92\xaaU\vWu\x8e\xc0\x06\xa1dg\x04]\v\xd7\x11\xaë\xe1\xca0~w\x01\x94i^)\xb1/\x93\xe0p\x04\xee\x7f\x8a\xd2ͬ\x1d\x1c,3\xea\x19\xbd.4\xed}F\xab\n*\x89\xea\xed\xb7\xde\xd6\xf6\x80m\"x\x1c\xbc\x1d\x96\xa6=\u0085}\x83\xef\x9b\xf9\xf9\x86\xd6g\x82ѡtz\xf2l\xf2P\xb5\xf3\x84'U\xb8:\x00{\x11/b\xa4\xf0\xffd\xa6\xfa,\xdc\xd8B\x84Qf\xa4:-.9\xbd\x94\v$Jt\xb6{\x12\xd4\xfbj\xa4\xc3G\x8c\x8f\f&>͎ \x83\x11xDB\xc0hS\xd19\x83\x0e\\9\xe3o\xa6e\xc0i\x1a\xab\xb0\x99\x92E>\x98\xc1\xcb\xe3\x05\xc7\v1}E\x1d\xfd\xeb;\xd4l\x02v T\xf0\xecx\xf25D\xe6\xe9\xe4,\x0f\x86\xf1\x1b\x14ܪ\xcd%\rP%\xd0\xcdo\x8a\xa0\x7f\x8ce<\xbfi\x057\xf8xa\xf7:\xdeR\xea\t\xf9\xb4\xe4\xd5\xe5vb\xaf\xbeS_\xc8\xd2Ţ<\xdbd}\xe5\xb8\x03\x16Y\x12\x99~\xe1u_\xc2\xc6Ẑ\xee\xe6\xf4\xab\xe3ի\xa3χ\xba\xb4)\xba\xfa-\xc5\x1d|\xfa\xac\xdf\x06\x92\b\xdd\xfc\xde\xe4\x0e>}n\xfe\x1b\x00\x8c\xdb\x1fܮ\t\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdc6\x0f\xbe\xfbW\x10y\x0fy\vĞ\x04=\xb4\xf0\xad\xdd\xe4\x10t\x1b\x04\xbb\xc9^\x82\x1c42\xc7VW\x96T\x91\x9aɶ\xe8\x7f/(\xdb\xf3陝\x1c:\xde\xc3Z\xa4\xf8\xf1\xf0!%\x17eY\x16*\x98\a\x8cd\xbc\xabA\x05\x83\xdf\x18\x9d\xbcQ\xf5\xf83U\xc6/\xd6o\x8aG\xe3\x9a\x1an\x12\xb1\xef\xef\x90|\x8a\x1a\xdf\xe2\xca8\xc3ƻ\xa2GV\x8dbU\x17\x00\xca9\xcfJ\x96I^\x01\xb4w\x1c\xbd\xb5\x18\xcb\x16]\xf5\x98\x96\xb8L\xc66\x18\xb3\xf1\xc9\xf5\xfau\xf5S\xf5\xba\x00\xd0\x11\xf3\xf6O\xa6GbՇ\x1a\\\xb2\xb6\x00p\xaa\xc7\x1a\x1a\xbfq֫&\xe2\x9f\t\x89\xa9Z\xa3\xc5\xe8+\xe3\v\n\xa8\xc5i\x1b}\n5\xec\x04\xc3\xde1\xa0!\x99\xb7\xa3\x99\xbb\xc1L\x96XC\xfcۜ\xf4\u058c\x1a\xc1\xa6\xa8\xeci\x10YHƵɪx\".\x00H\xfb\x805|P=RP\x1a\x9b\x02`\xcc=\x87U\x8e٭\xdf\f\xa6t\x87}\xc6S\xde|@\xf7\xcb\xc7\xf7\x0f?\xde\x1f,\x034H:\x9a p\x9d\xc4\f\x86@\xc1\x18\x01\xb0\xdf\x06\x05ʁ\x8alVJ3\xac\xa2\xefa\xa9\xf4c\n[\xab\x00~\xf9\aj\x06b\x1fU\x8b\xaf\x80\x92\xee@\x89\xbdA\x15\xacoae,V\xdbM!\xfa\x80\x91̈́\xf2\xf0\xec\x91ko\xf5(\xf0\x97\x92۠\x05\x8d\xb0\n\t\xb8\xc3\t\x1flF8\xc0\xaf\x80;C\x101D$t\x03\xcf\x0e\f\x83()7fP\xc1=F1\x03\xd4\xf9d\x1b!\xe3\x1a#CD\xed[g\xfe\xda\xda&AH\x9cZ\xc5\x13\x1dv?\xe3\x18\xa3S\x16\xd6\xca&|\x05\xca5Ы'\x88\x98qJn\xcf^V\xa1\n~\xf7\x11\xc1\xb8\x95\xaf\xa1c\x0eT/\x16\xadᩩ\xb4\xef\xfb\xe4\f?-r\x7f\x98eb\x1fi\xd1\xe0\x1a\xed\x82L[\xaa\xa8;è9E\\\xa8`\xca\x1c\xba\x93\x84\xa9\xea\x9b\xffű\r\xe9\xe5A\xac\xfc$4#\x8eƵ{\x82\xcc\xf9\v\x15\x10\xd6\x0f\x84\x19\xb6\x0e\x89\xee\x806\xae\xcd%\xb9{w\xff\t&\u05f9\x18\aF\xb7\xcc\xd9n\xa4]\t\x040\xe3V\x18\xf3\xbe\x81yb\x13]\x13\xbcq\x9c\x1dhk\xd0\x1d\xc3Oi\xd9\x1b\xa6\x89\xccR\xab\nn\xf2\xa4\x81%B\n\x8dbl*x\xef\xe0F\xf5ho\x14\xe1\x7f^\x00A\x9aJ\x01\xf6\xba\x12\xec\x0f\xc9\xddO\xac\xd4#j{\x82i\x92\x9d\xa9\xd7Q\xab\xdf\a\xd4R=\x01Pv\x9a\x95ѹ5`\xe5#\xa8]\xe7\x8f\x00\xee\xba\xf6|\xe7\xca\xc3*\xb6\xc8ǫG\xb1|\xcaJ\xe2~ө\xc3A\xf3\x7f\xac\xdaJf\x05\x8d\x81\f\xd3\xe3\x87C\xff\x97c\x98g\xefl$\x13\x89\x05\x06\xc1UF\x81\f\xa9\xfd\x98N]˃.\xf5\xf3\x0eJ\xf85\xc7|\xeb\xdb\xe2D\xb8'\xbf\xf1\x8e\x85\xee\x17\x95\x1e\xbcM=\xde;\x15\xa8\xf3\xcf\xe8\xbeg\xec\xafӜ\x0e\xe4\xed!uA1ٳ\xc6\xeeP\xc6=\x9eOtT\xb8d\xe5\f\xf5\xa7'\x1fq\xcf\xd7Q\x0eɩ\x8e\xb2E\xea(\xff\xcb\xd5!:d\xa4\xdd\b\xda\x18\xeef-\x02l:\xa3\xbb<T2\td\xba\x11ym\xf2\xac\xf8\xfe\xf0\xa5wL\xc4\x19\"\x96\x99\xa03\xcb\x12\xfc\xc9\xf2\x99\x8e?\xe7\xa0\x1c\xbb\xb0\xb8\xc2\x06\xb1\xe2t\xd4A\x17\xe7F֟\xa0\xd6)Ft<Z\x11\xd0\xd5\U00046ab8\xaei\xa7n\xfb|w[\x17\x17k=9\xf8|w+\x873+\xe3\x86hBĒL\xeb\xb0\x01\x91\xc9\xfc\x90\xe5\x190\x86\xbf\xc3\xdb\xc8\x15\x15\xc5o\xc1\xc4<%\x9f\t\xf1\xddVQ\x90\xdat\xe8\x86\x03\xec\b\x9b\xc1 R\xbe\x1chu|-\x91g\x89РE\xc6\x06\x96O9Kz\"\xc6\xfe4\ue54f\xbd\xe2\x1a\xe4`+\xd9\xcc\xd0H\xee\xc4ji\xb1\x06\x8e\t\xbf'\xf1\xd0)\xc2gr\xfe(:s\xc4\xd86\xe3Q\xf6Uq\xddL-\xe1\x03nfV?F\xaf\x91\b\x9b\xeb3\x99m\x82\x93E\x92\v`\xb3\x87\xd2x\xa9\xdd_I\xcbi\x9el\x99<\xb6\x12\xfc\xfdO\xb1\xeb*\xa55\x06\xc6\xe6\xc3\xf1\xc7ċ\x17\a_\a\xf9U{\xd7\xe4\xcf#\xaa\xe1\xcbW\xf9\x04\x90\xf1ڌ\x17]\xaa\xe1\xcb\xd7\xe2\xdf\x01\x00f\xb0UD\x81\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec[_o\x1b\xb9\x11\x7fק\x18\xdc=\xf8\xc5Z\xe5p(\ue817\"\xe7$\xbd\xa0vΰ\x9d\xf4\xe1p\x0f\xd4r$\xf1\xc4%\xb7\x1c\xae\x1c]\xd1\xef^\f\x97\xbbڕ\xb8\xd2\xcamZ\xa0H\xd6@`\xfe\x19\x0e\x7f\xf3\x9f\xa4'\xd3\xe9t\"J\xf5\t\x1d)k\xe6 J\x85\x9f=\x1a\xfe\x8d\xb2͏\x94);\xdb~7\xd9(#\xe7pS\x91\xb7\xc5\x03\x92\xad\\\x8eop\xa9\x8c\xf2ʚI\x81^H\xe1\xc5|\x02 \x8c\xb1^p3\xf1\xaf\x00\xb95\xdeY\xad\xd1MWh\xb2M\xb5\xc0E\xa5\xb4D\x17\x887Ko_e?d\xaf&\x00\xb9\xc30\xfdI\x15H^\x14\xe5\x1cL\xa5\xf5\x04\xc0\x88\x02\xe7`\xacWK\x95\x871\xa4̆\xb2-jt6SvB%\xe6\xbc\xea\xca٪\x9cþ\xa3\x9e\x1c9\xaaw\xf3\xa1C\xe7Q\x99M\xe8Ҋ\xfc_\x93ݷ\x8a|\x18R\xea\xca\t\x9d\xe0#\xf4\x922\xabJ\vw\xdc?\x01\xa0ܖ8\x87\x0f\xa2@*E\x8er\x02\x10\x01\b\xacMAH\x19 \x15\xfa\xde)\xe3\xd1\xddX]\x15\r\x94S\x90H\xb9S%\x0f\xe9\xb3\b^\xb8\x15z\xf0\xbb\x12\x03#\x00\xbf\x935\xf7¯\xe7\x901.Y\xa7\x8b\xc1\x98\xc3Ӿ\x81\xfb\xe6@\xde)\xb3:\xb7\x14\x81D\xad\xb6\xe8P&V\xf2\xc2W\x94\xb5#nle|\x1cV\xaf\xfa\xe6`r\xbd4ov\x85\xee\xfc\xda~-<\xe4\xb6Ғ\x11\x86\x05\x8e\xe0f)\x94N\xb1\xf2.\xb4\x9f\xe0\xa3C\xaa\xd1\xf1\xecH?{$_\xaf\xfa\x90J\xe1\xeb\x86z\xc5\xedw\xe1\x17\xca\xd7X\x04s\xe1\xdfl\x89\xe6\xf5\xfd\xfbO\xdf?\xf6\x9aa\x18\x06\xd6WP\x04\xa2\x15;\xa3\xe2\xd7\b\x9f\x82\xca\x03\xa1ۢ\x8b:\x88\xd4R\x04\xb0\xcb0\xae\\\vB\xf0N\x18\n\nG\xdc\xf1\x93\xc87UI\xd7\xf0\x80\xe4\xadC\x02a$\xbcA\x8d\x1e\xeb\xbe\a\xfc{\x85\xe4)k)\x96Ζ\xe8\xbcj\x8c\xab\xfe:N\xa5\xd3z\xb0\xa3+\xdet=\n${\x13d\xf1bc\x12(#N̚_+\x02\x87\xa5CBS\xfb\x97\x1e\xe1\xb01a\xc0.~\xc7\xdcg\xf0\x18\x00 \xa0uP\x95ܚ-:\x0f\x0es\xbb2ꏖ6\x81\xb7aQ-<F\v\xdf\x7f\xac\x95\xce\b\r[\xa1+\xbc\x0ep\x14b\a\x0ey\x15\xa8L\x87^\x18B\x19\xdcY\x87\xa0\xcc\xd2\xcea\xed}I\xf3\xd9l\xa5|\xe3Ls[\x14\x95Q~7\v~Q-*o\x1d\xcd$nQ\xcfH\xad\xa6\xc2\xe5k\xe51\xf7\x95Ù(\xd54\xb0nxÔ\x15\xf2[\x17\xdd/]\xf5x=2\xe0\xfa'\xb8\xba\x13\x12`_W+R=\xb5\xde\xe8\x1eheV\x01\x9d\x87\xb7\x8fO\xd0,\x1d\x84\xd1#\n\x11\xf7\xfdDڋ\x80\x01Sf\x89.̃\xa5\xb3E\xa0\x89F\x96V\x99Zos\xad\xd0\xf8\x03\xa2T-\n\xe5Y\xee\xb5ց\xb7\x19܄\bÆ_\x95l]2\x83\xf7\x06nD\x81\xfaF\x10~q\x010\xd24e`ǉ\xa0\x1b\x1c\xf7\xff\x98\xca<\xa2\xd6\xe9h\x02\u0600\xbc\x0e}\xc0c\x899\x8b\x8f\x11\xe4\xa9m',\xad\x03q\xe43\xf6f;l\xba\xfc-\x95\xf6\xe8\x0e[\x0f\x98y\x17\x06\x81VAFi\xa7\x12\xbc\xb5p\xd8x\"\xd9\xe7\xa0\xfe\xde/A\xf9+\x02,J\xbf\xbb\x06ܢ\xdb\x1d\xd1b\xfb\xae{jO\xd4:)6\xca\x04ф\xd7b\xa4\x86\xf9\x18F\xa31\xa4d\xc7\x01(lP\x14v̀\x84Y\xccyk\xb5\U0003cd94@*I\x19\xceB\a\xa0<\x16\x03\x8c\x1d\xb0\xd6U\x86\xb7[4\xbe1\xfe\x86\xd3.\xa35\x9f\x03d\xe1\x98\xff\x11\x9c\x02\xa0\xa9\x8a!V\xa7Q\xae\x83\xddQ܃\xfd\t\x81\x0f\x8c\x1d0\xd4\xfdW\x0f\x10Ή]\xa2\xdf4\xe9\xdb\x18}hs=j\f\xa5\x97\x17r\xf4Y\x84}ױ\xd6\xc5\xc0\x9b$\f\xb59)\x93\xebJ\"\b\xb3c\x89\xf95\x12v\x98\xca\xe0\xa7\x04\xc10u\x80jKP\xeb\x0e\x1d(\x84\xcf\xd7a\x95\xb6\xf1\x05*\xf8o\x82\x1d\x14m\f\xd0\xf7a`ky\xf5\xbchmCzz\x9d$\v\x80\xd9*\x8b\xc9!X\a\xf7\xc2y%\xb4\xde\xd5M\xff}\x108\r\x92\x95\x1e\x85\xc3c3\xb6\x85\"H/jʞT\x84&*\xdf\x10\x12=\x15\n\x91\xbb?m\x84\xd9\x7f9`\x06b(\xffp\xe65\x9f\x9c\x04\xea秧\xfb\xc6\xfb\xc5,\xda.\xe1o\xb8X[\xbb\xb9\x86G-\xf2M0\xa1\x1bm+\x19\x1c\xe6a\xd2\x13\v\xbeM'\x1dn>.Y\xc5B\xe3\x1c\xbc\xab\xf0\xc2`#*\xbf\xb6N\xfdQ\xc7m\xcc\x1d\xfa\x11\x92\x7f}<\xab\xf5\xee\x18\\\x85\x00\x8a͆5#I\x12\x8e\x92\x86+\xda{\x85P\xcd\ve\x9a\xbc\xb0N\x17\xa3n\xf5\x18\x18 \xbeF!\xd1\x01\xa7\x88\xf0\xac\xfc\x1aP\xe4\xeb&\xc1K+\xd0\x19(\xcf\xc3\xc9\xdf\x06wC]\a0>\xed\xf1\xe2\x1dFļ\x05B\xcd).[A\x06pW\x91\x87\xc5\x10\x86\xfc\tN\xa6\x95l(lp\x97\xde\xdf(;\x88\x05\xe4\xb8-\\q\xd4i6\xe0p\x89\x0e\x8dO&\xc6|\x1a\xe3\fz\f'=\xd2\xe6\xc4uI\x8e\xa5\xa7\x99ݢ\xdb*|\x9e=[\xb7Qf5eyMks\xa3\x19\xb3C\xb3o\xc3\x7f\x83\\\x01<\xfd\xf2\xe6\x979\xbc\x96\x12\xac_\xa3\x83\x8apYiX*Ԓ\xb2N\x9dx\x1dR\x90k\xa8\x94\xfc\xf3\xd5d\x88\xde\b\x9cl\x90\xa3\xd0#\xc5\xcd)\xb4Z\xee\xe0y\x8d\x81A\x86,\x1a\x8fu\xc0\x01{\x83;(\xceJ\xbb.`\xe5\x19\xce\x17\xd6j\x14\x87\xa5k\xfd\xb1\x11(\x87r\x9e읲R&{N\xb8A\xfe\xc9\xc5\r\xba1\xfe\xe3\xe65\x0fd\x97!\xe0\xfe\xed\xdd\x14Mn%J\xc8ٲBƂ\xb0\xa8\x8c\xd4\xc8Y\x8bw\xd5p\x82թ\ueba8G\x80u(m\x06K\xeb\n\xe1\xe7\xb0\xd8\xc5\x13\x93\ve_{\x96\x01\a\xd0=W;\xe7*\xce\xeaX\x0f\xb5\x9f\xebeC ܯ\xd2ps\x91\xa3;#He\b\xf3\xca\xe1\xe3F\x95O\xb7\x8f\x9fЩ\xe5n~\x9e\xc3\xf7\xa9y \x15\xb1S%>mi\xdd}\xf4\x19I\x9a0 \xd2l\xf2\x12u\xaf\x9c\x1e\xc1\xfaǇ\xdb&\x84u\x16\xff\xf8p\x9b\xc1\xdb\xcf\"\xf7z\aք\xf8\xc3#Ӆ \x7f\x1f\x1fn\xa3MGC\x06B\x9f\xbdD\xcb*\xa7G\a\xe4\xfd\xaa'\xc3p\"\xe0&i\xc3p\x18\xee\x83s\x1d\xaa\xff\xa6\x8d\x80\xaa|=@QPLt\x94\xc9m\xc1Q\xfd\xb9N\x80\x9a\xfc\xf0\xe3\xc3m\x9d3\xe7\x0e%\x9f\x82\bM_\xc3\xf4\xd70\xfd5L\xffG\xc3\xf4\x89\xceB|~@\xef\x92FӃ\xe8\xae\x1d\xd8\xf8\x1bS\x15\vtA\xc3\xf82\r\x04\xd47!͕\xc9\x0e\x8e\xceq\xf9ǅ\xe5\xe4u\x8c[\x9fKkj\xcb\x0fe\x9f].\xafa\x81K>z\v\xc7v|\x06\xee8Q\x10\x14\xe9\xa7\f\xe8\r.E\xa5\xf9\xa0\xd0\u009f\x8e\a\x14ʨ\xa2*\xe6\xf0jr\xa1c9\xbe\xb7\xd9\xff\xa3\xe2l\x19\xf8x\x97*\x03C\xeb\x97(\xed\xd8-\xa5\xda\x0f\xb8zǥv\xe4JH\xe9\x90\x0e\x0f\x8e\xd8'\x87\xe4\x82)\x1e39\xc2\nז\xc6D\xb1\x9f-\xb5\x01\xac\x10Jǻ\xa5+\n\x04BLz\xd1\xf2\xa5 z\xb6N\x8e\x0e\xa7\xf7\xbd\t_<\xa66\xfc\xb1:|$t\xc3\x1b\xfd\x1a\xf9\xbeF\xbe\xaf\x91\uf151\x0f\xa0\xb4\xa3\xca\xd3{\xeb\x06\xfc\x10\x13\xc8\xfa\x01\xe6\xc7\x1fҪ|*X\xf0\xe7\xed\bN\x9el{\xb6\x1a}3\x0ezgo\xbfĩh\xa1\xcc\xfbp\xac\n\xdf]|j\n\xac\xe2n\xd8X{[m\x1c_\x03<OeW\xc3'\x94\x9c\x13p\x01\xd8ܠ\xb3P\x92\x14!\x8a\n\x04e\xede\x1f\xdf\xe1\x12\xfak0=j\\\x84*\x02i\xcd\xc9\xc2r\x00\x9ba\r\x9d\x06\xb7\x98h\xe6 \x96h\xf6vr\x81\x1a{,J~@0\x9f\x9cD\xf3)\x0ec4\x05\xfcł\xc7\xcf~\xd6L\x0eW4\xe0Є\xf3\x04F\xb4\xabS\xc9\x00V \x91X\xe1\xfeN\xfd\xf0^.\x81\xb7\xe0\x92y\xaa\x95A\xa0\xaa(\x84K\xa9Ht\xcd{B\xccrE\xa9\xd4\xee\x84@\xbc*\xd0V\xfe\x1c*\xf5(^am\x9fA[\xb3\xaa\x0fK\xda,Ux\xc6ȇ\x97\x04^lpo\xecG\x94ل\xe1\xfbW\x9c\rX#\xe92v\xb9\xeb\f\xaf\xbb\x12\x0f\xafLc\xd6x\xec\x00\"\xffI\x9f\x9b\xf2\v\xe9\xeb\xd1is'\x91\xe8\t\xb5{\xaa\xfd\xee\xe9>\xd1|\xea&c\x10\x97\xb4MM\xbb/\xcdN\xdaG\xfd\x06k>\x19\xc4\xf40U{\f\x13\x1a\x94\xf3\xcaqY\x1c\xc9p\xd2\xf3\xf2g\r\xad@\xc2S\xb03\xa2nߩ\x85\xc1\xc7%U_\xde\xc1v\x9f1y?\xdd.\x9bM.\x89G\x9dWkgX}\xb7\x1f9\x8a\xcf\xf0|\xee\x88$\x1c=\xa8\xbb\x8c_-\xc8G\xd0v\xfbǛ\xa79\xbfM\xcd\xe1=<\xafр\xe81\x0fς\xc2\"\x97Aܜ+\xf3[\xa1){\xa4Ʌ\xf9\xfb\t\x8f\xc1\xdc0\xf8\x95\xc3Kv|8eh\xc3L>\xaa\xc1\x11\xd1\xe0\xe8\x16\xf8\xbfڹ\xc3\x1cM#\xbadY\xd3\xdb\xf5\xc3\xc1\xf06\x81\xaa\x0f\x0f\xa8\t8\x05\x97\x945\xedfg\n\x93\xb7\xd3ݑK\xe5Rg\xea\x839֠\xfb\x89\f\xee\x1a+\xaa\xb9c\xe6\x1af\xcc\nD\x82\"\xf4\x04\x97M.\xaf\x00c\x8c\x1b\xe8=\xe0\xf9u\x1c|l\xed\r\x19(\x84\f\xf74\x91\xf1\x01\xaap\x94g\xa4x?g\xf9Co\r\a\xb8?\xff\xee\xe8(\x8f\x19 \v\xc1'\xfc\xbf<;\x8a\xd9\xdc(\f\xefb\xe6\x17aD笋\xefX\xc97Z\x10}ǉK\xb7\x11\\\x9d*\xeb{,}\xe8\xd4\t\xa6W\xdf\x7f9ў\xe5>(\xd2(\xf6\xc3\x03\xa2\x86\xff\xc8d\x97\xfb=\xc7(\xc1\xdb\x17s\xe4\x90*\xedG\xb1\xf4\x10\x866<\xd5\x13\x1bT\xa3]\xef^\xa6\xf4oN\xa4\xa6\xfcM\xfbo\xe3/ޤ\x1f\x8e\x86\x89}\x1e\a\xc2\xee\x0e\xf9\xfe*G\x94(\xaf\xf9ă\x03\xe5\x00Qv\xf9\x94\xb2\x80!\x90\xceG\xc8Qqr\x14(õ)\xc3\xcd~0\xd9\xc1\x96\x94\xec\b*\x9a\xec\xa9\x15%\xd1u\xa2\x82\x1d\x99\v\x1c\x1f)$i\x1e5\x86\x03\x00\xd9!\xcdO\xcaĪ\xbb\x18U\x8b\xc6\xd6\xda \x18k\a\xf8\xc7?'\xfb2B\xe4|\xfc\x87\xf2\xc3\xe1_\xf4|\xf3M\xef\x0ft¯\xb95\xf5\x9d<\xcd\xe1\xd7\xdf\xf8op؛\xcbx\xa6Gs\xf8\xf5\xb7ɿ\x06\x00\x1b\x95)\xb9\a5\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y\xdds۸\x11\x7f\xd7_\xb1\xe3{poƢri\xa7\xed\xf0\xedb\xf7:nr\x8e'\xce\xe5%\x93\x87\x15\xb1\x94P\x93\x00\x8a\x05\xa5\xa8\x9d\xfe\xef\x9d\x05H}B\x92\xedNr\xa1fb\x92\xc0\x0f\xfb\xfd\xc5\xd1x<\x1e\xa1ӟȳ\xb6\xa6\x04t\x9a\xbe\x062r\xc7\xc5\xe3_\xb9\xd0v\xb2\xf8i\xf4\xa8\x8d*\xe1\xba\xe3`\xdb\x0fĶ\xf3\x15\xddP\xad\x8d\x0eښQK\x01\x15\x06,G\x00h\x8c\r(\x8fYn\x01*k\x82\xb7MC~<#S<vS\x9av\xbaQ\xe4#\xf8p\xf4\xe2U\xf1\x97\xe2\xd5\b\xa0\xf2\x14\xb7\x7f\xd4-q\xc0֕`\xba\xa6\x19\x01\x18l\xa9\x04g\xd5\xc26]KS\xac\x1e;\xc7ł\x1a\xf2\xb6\xd0vĎ*9t\xe6m\xe7JؼH{{\x82\x123\xf7V}\x8a0o\"L|\xd3h\x0eoso\xdfi\x0eq\x85k:\x8f\xcd!\x11\xf1%k3\xeb\x1a\xf4\a\xafG\x00\\YG%\xdcaK\xec\xb0\"5\x02\xe8y\x8fd\x8d{\xee\x16?%\xa8jNm\x94\xa7\xdcYG\xe6\xe7\xfb\xdbO\x7f|\xd8y\f\xe0\xbcu\xe4\x83\x1eXKזF\xb7\x9e\x02(\xe2\xcak'\xc2-\xe1R\x00\xd3*P\xa2Jb\bs\x1a\x88\"\xd5\xd3\x00\xb6\x860\xd7\f\x9e\x9c'&\x93\x94\xbb\x03\f\xb2\b\r\xd8\xe9?\xa9\n\x05<\x90\x17\x18\xe0\xb9\xed\x1a%\x16\xb0 \x1f\xc0SegF\xff{\x8d\xcd\x10l<\xb4\xc1@\xbd\x847\x976\x81\xbc\xc1\x06\x16\xd8tt\x05h\x14\xb4\xb8\x02Or\ntf\v/.\xe1\x02~\xb5\x9e@\x9bږ0\x0f\xc1q9\x99\xcct\x18,\xb9\xb2m\xdb\x19\x1dV\x93h\x94z\xda\x05\xeby\xa2hÄ́\xf5l\x8c\xbe\x9a\xeb@U\xe8<M\xd0\xe9q$\xdd\b\xc3\\\xb4\xea\a\xdf\xdb>_\xee\xd0\x1aV\xa2[\x0e^\x9b\xd9\u058bhh'4 \xa6\x06\x9a\x01\xfb\xad\x89э\xa0\xe5\x91H\xe7\xc3\xdf\x1e>\xc2ptT\xc6\x0e(\xf4r\xdfl\xe4\x8d\nD`\xda\xd4\xe4\xe3>\xa8\xbdm\xa3\xc4\xc9(g\xb5\t\xf1\xa6j4\x99}\xf1s7mu\x10\xbd\xff\xab#\x0e\xa2\xab\x02\xae\xa3{Ô\xa0s\n\x03\xa9\x02n\r\\cK\xcd52}s\x05\x88\xa4y,\x82}\x9a\n\xb6#\xd3柠\x94\xbdԶ^\f\xe1㈾\xf6b\u0083\xa3J\xb4'\x02\x94\x9d\xba\xd6Ut\r\xa8\xad\a\xdc\x0f!\xc5\x0ep\xdeq\xe5JQ\xed!X\x8f3zg\x13\xe4\xfe\xa2=\xca\xde\xe4\xf6\f\xb4I\\\x11\xff\x94\xbf\x138pB?\x00\x05h\x86\xcd\xcb9y\x8a\xc6ቃ\xaeĸ,\xeb`\xfdJ\x80\x05\x81\xd4.O'\xd4 ?c\x15\x9d\xe1\xe3\xce*ʑ-[!\xcc1Y\xeb\xbdU\xb2\xc8w\xc6\x1c\x9e\"\x975\xcf\"\xccYu\x86\xae\xfeD\x04O5y2\xe2\x85)p9\x1b\xc3[@m\x06oM\xc9\t\x82=\xc0\x04\xf1\x1bQ\x01)\xd87\x88\xd3Fq*\xaag)\xfe\xf9\xfev\x88\xe4\x83\x10{\xda\xc3\xe1\xb9g\xe4#\xbfZS\xa3\xee1̟p\xf6\xe5m\x9d\x04%X\"(\x04\xa7\xa9\xa2\x9d$\x01\xdap T`\xeb,\xa2\x14\x12 \x8e\xef\xa9\xdfq\x95\"X\x1f*7\xa9Ed\x0f(\xb1S+\xf8\xc7\xc3\xfb\xbb\xc9\xdfs\xa2_s\x01XU\xc4\x02\x84\x81Z2\xe1\n\xb8\xab\xe6\x80,Jמ\xd4C\xc0@E\x8bF\xd7ġ\xe8\xcf ϟ_\x7f\xc9K\x0f\xe0\x17끾b\xeb\x1a\xba\x02\x9d$\xbe\x0e˃шi\x8b8ֈ\xb0\xd4a\xae\xcd(\v\t(uD\xcf\xf62\xb2\x1b\xf0\x91\xc0\xf6\xecv\x04\x8d~\xa4\x12.$\xfcl\x91\xf9\x1f\xf1\x9d\xff^\x1cA\xfdCr\xed\vYt\x91\x88[\xe7\xe1m\xa7\xdb\x10\x99<\xcf\xebٌ|,\\r\x97l\xa1\x05\x99\xf0#X/\x120v\v\"\x02K\xdcH\x81\x92\xd4\x01џ_\x7f9J\xf1\x06G\xe4\x05\xda(\xfa\n\xafA\x9b$\x1bgՏ\x05|\x94?ye\x02~\x95\xf0P\xcd-\xd31\xc9ZӬ\x84\xe79.\bض\x04Kj\x9aq\xaa\x83\x14,q%R\x18\x14'f\x8c\xe0Ї\x93\xd6:T?\x1f\xdf\u07fc/\x13ebP3#\xe4H֬\xb5T3R\xc6ė\xc9\x1a5\x1fA\xe4.\xe2\t\x99\xd5\x1c\xcdLꚨ\xa4\xba\x93\xf2\xa4\xb8\x1ce6\x9d\xf3\xe3Ò$\xef±4\xd9\x0f\x1c\xbf[r\x7f\"sbdOa\xeen\xcb\xcaO2'\xbd\x8a7\x14(\xf2\xa7l\xc5\xc2ZE.\xf0\xc4.\xc8/4-'K\xeb\x1f\xb5\x99\x8d\xc54\xc7\xc9\x06x\"\xa4\xf0\xe4\x87\xf8ߋy\x89\x8d\xc2S\x19\x8a\x8b\xbf\aWr\x0eO^\xc4\xd4P\xc3>=\x8f]>\xf4\x95\xd5\xfe^q\x8b\xe5\\W\xf3\xa19\xe9cl\x16\x12\xc4\x03[T)4\xa3Y}sS\x16\x81v^(Z\x8d\xfb\x06x\x8cF\xc9߬9\xc8\xf3\x17I\xb0\xd3Or\xdf\xdfno\xbe\x8f\x81w\xfaE\xbez\xa4\x00\x97\x9f\xc7@\xeft\xabC9:\xc9\xe3\x87a\x1d\x88'z\xad\x883%\ueea0\xbd\x14\x1b\x91B\xf6\x005\x1d\tMĒ\xd2}\xc8)C%7\xcd\xd4\xefr\xc9T\x02\xa7\r\x95\x10|GϬ\xe6\x94]\x9aƢz\xab\xdf8~\x82Jo\xb6\xd7\x0f5r\x8b_u۵k\xb0ĉ\xad\x85\xfa,$\f<\x89,\xac'\xbe\x92\xa4\xf2V\xbf\x99p\x01\xaf\xa0%4\x92\xaa\x920\xf2\xa5Nm}\x8b\xa1\x04m\u009f\xff\x94]\x91\x94+\xdd\xfb\x8c|fE\xe7\x9e\xc3\xf8o\xee(\u06dd\xdbg\xbag/\x8b:\xb4V\xbf\x03ϧ̝\x9c\xbdU\x129jM\xbe\x1c\x9d\x94Ň\x9dŃ82\r\xdazM1z\x86_\x06\x9ce4\x82J\xc5)\x1f6\xf7'-\xfa\xa4\xc3\xef\xb0\xf1\x11g\f\xe8\t\x10Zt\x12\xa8\x1ei5N\x15\xadC\xed\x85-\f\xc3\xf4hJ\x80\xce5:[y\xf6u뎧J%/\xac\x14\xcf\xd1CB(O\x13\x9e\xfa\xf9\\\x87\xda\x13 !\xb2\xafҤg\f\x16\xa6\xb9.\xfbD\x0fxT\x8a2\x86\x91\xe6d\x97\xc41Ls\xbd\xff\xde\x1a\xe9\x9f\xf7\x1e\xedG\x88\xf1\x9e%\xee\xbd\xcc\xf8\xd5\x11aJ[\xd5\xed\x19\xc8\xc91J\\?\xc84%\xcd\x10\x9b\xb3\x8eE\xba/\x1e\xa4TV\x9a\xb1\xddI\xf2i\xf5^\x1f\xee\x883K\xaf\x12qA\xb7b\xb3\xbd\x95-\x91\x873r\x93\x10\u0602K;ef\x11\xd1H\xc5NI\x1a\xb9\x1auC\xaa\x87\xe4b\x7fO\x06u\x1beJ\xb5T\xe4)\x0e\x0e\U000c77bcu7\"\xe3\xa98\f\xbc\xe4\x13\x98\x1d\x93\x928\x97\x13\x02\x8f\x8e\x05D\x19\x01\x8e\xb3\xa0g\x92\xe3\x89`\xd1\x123\xceι\xe2\xafi\x95\xd8\r\x0e[\x00\xa7\xb6\v\xeb\xb9\xccNP\xb8\xe4ަ\x8a\xe7\xd0\xe2\xb2\x13\x8f\x1dBd(2Xo\xdd5M\xdc\xd3\xf7\xf5\xeb>:}\xff\x88\x19jJ\x87Ǽ4&\x00\xb89\xf29Q\xdd˚\x9c\x83\xad\xa3\xd7I\x0f\x93\x1f\x99\xae=<e\fw\xb4\xcc<\xbd5\xf7\xde\xce<\xf1\xa1\xe1\x8c\a\xfb\xcaD\xf31\xfc\x12\xbd\xe1Y\xfc\xf7\a\x9d\x13A\xbf\f\xe6\xb6\x19\x9c\xd9\x06l\xc0t피\xc8a\xba\nĻ\xe1\xfc\x00\x13\xfa\xe6}#ƭ\xfd\x83\xfe\x12R?\x8f\xa8\xd0\xc8\xd0/zW\xb0\xa04\xbb\x06W\x19`7P(\xed\xb58\x97\x84\x80\x8d=\x0fN\xed\xc8\xc7W\xc53\xcb\xcdHӍ5T~\x93\x02\a\x928߬B\xfe\xf8\xff\xff\x84\x13\xa9\x9b\r:\x9e\xdbp{s\xc6\n\x1e\xd6\v\ao\xd0\xeb|'\x04F\xd5\x0fh\xbd)\x1c \xc2Vl)\x9ec\xaa\x1cЇuL=G\xea\xce\xe23Y(\"\xe7s\xd0\x039\x8cMA\xfc\xf0s\xbd\xffi\xf5\nX\xcb`2\xd6[\xa9\x00K\xb3&\x96\xe444\t9\xe0\x83\xb4\xb2\x93Dv\xc9\xff\x9e\xf9#k'\a\x0f#\xe5j\v\xbbo\x17\xfb'\x9b\x1aFf\xc5.\x90\xba\xdb\xff||q\xb1\xf3=8\xdeV֤R\x99K\xf8\xfcE>\xfaƯ$\xfdĂK\xf8\xfce\xf4\xbf\x01\x00\xd83\xb9\bs\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4ZOo\x1b\xb9\x15\xbf\xebS<x\x0f\xee\x02\xd6(\x9b\x16m\xa1\xdb\xc6\xee\x16n\xb2\x89\x11gs\tr\xa0\x86o4\xacgH\x96|#Y-\xfa\xdd\x17\x8f\xe4H#\r%\xd9\x06\x92\xdd\x11\xb0\u0590\xfc\xf1\xfd\xff\xa7L\xa6\xd3\xe9DX\xf5\x19\x9dWF\xcfAX\x85\x8f\x84\x9a\xbf\xf9\xe2\xe1\xef\xbePf\xb6\xfai\U000a0d1c\xc3u\xe7ɴ\x1fћΕx\x83\x95Ҋ\x94ѓ\x16IHAb>\x01\x10Z\x1b\x12\xfc\xda\xf3W\x80\xd2hr\xa6i\xd0M\x97\xa8\x8b\x87n\x81\x8bN5\x12]\x00\xef\xaf^\xbd*\xfeV\xbc\x9a\x00\x94\x0e\xc3\xf1O\xaaEO\xa2\xb5s\xd0]\xd3L\x00\xb4hq\x0e\xd6ȕi\xba\x16\x1dz2\x0e}\xb1\xc2\x06\x9d)\x94\x99x\x8b%ߺt\xa6\xb3s\xd8-\xc4É\xa2\xc8͝\x91\x9f\x03\xceǈ\x13\x96\x1a\xe5\xe9mv\xf9\x9d\xf2\x14\xb6ئs\xa2\xc9\xd0\x11V\xbd\xd2ˮ\x11n\xbc>\x01\xf0\xa5\xb18\x87\xf7\xa2EoE\x89r\x02\x90\x04\x10H\x9b&\x16W?E\xac\xb2\xc66\b\x95\xbf\x19\x8b\xfa\xe7\xbb\xdb\xcf\x7f\xbe\xdf{\r`\x9d\xb1\xe8H\xf5\xec\xc5g\xa0\xd6\xc1[\x00\x89\xbetʲ\x84\xe7pɀq\x17H\xd6'z\xa0\x1a{\xa2P&\x1a\xc0T@\xb5\xf2\xe0\xd0:\xf4\xa8\xa3\x86\xf7\x80\x817\t\rf\xf1o,\xa9\x80{t\f\x03\xbe6]#\xd9\fV\xe8\b\x1c\x96f\xa9\xd5\x7f\xb7\xd8\x1eȄK\x1bA\x98d\xbc{\x94&tZ4\xb0\x12M\x87W \xb4\x84Vl\xc0!\xdf\x02\x9d\x1e\xe0\x85-\xbe\x80_\x8dCP\xba2s\xa8\x89\xac\x9f\xcffKE\xbd9\x97\xa6m;\xadh3\v\x96\xa9\x16\x1d\x19\xe7g\x12W\xd8̼ZN\x85+kEXR\xe7p&\xac\x9a\x06\xd253\xec\x8bV\xfe\xe0\x92\x03\xf8\xcb=Ziú\xf5\xe4\x94^\x0e\x16\x82\xb1\x9d\xd0\x00[\x1b(\x0f\"\x1d\x8d\x8c\xee\x04ͯX:\x1f\xffq\xff\t\xfa\xab\x832\xf6@!\xc9}w\xd0\xefT\xc0\x02S\xbaB\x17\xceA\xe5L\x1b$\x8eZZ\xa34\x85/e\xa3P\x1f\x8a\xdfw\x8bV\x11\xeb\xfd?\x1dzb]\x15p\x1d|\x1c\x16\b\x9d\x95\x82P\x16p\xab\xe1Z\xb4\xd8\\\v\x8f\xdf\\\x01,i?e\xc1>M\x05\xc3\xf0\xb4\xfb\x8fQ\xe6Ij\x83\x85>\x84\x1c\xd1\xd7aX\xb8\xb7X\xb2\xfaX\x82|TU\xaa\f\xbe\x01\x95q Fa\xa4\u0603λ.?\vQ>t\xf6\x9e\x8c\x13K|g\"\xe6\xe1\xa6\x03\xda\xde\xe4\xce\xf4\xc4qda\x0f\xe5\xbf#80Ab\x89#P\x80\xa6?\xbc\xae\xd1a0\x0f\x8e\xb6\xaad\xf32^\x91q\x1b\x06f\x04\x94\xfb<\x9dP\x04\x7f\x94.\x9bN❠ڟ\xe1\xe7v\xb0\x15D\xa2\xc3\xf2\xc1\x9e\x91J5\xe8CL\x90\xcaaIƩ\x14\x87\xf7\x9f\xb5\xa2Z\xe9p>\xe6\x0e\x8e9)._\x81\xef\xca\x1a\x84\x87\xd9J\xb8Y\xa3\x163a팡\v\xb8\xad@\xd1e\x0e\xd2#]\x81\xd1͆Q}O\x17S\x99p\xe5\x15(Mfx)\aHp\x9d\xd6c\xb1\xf0c\x8d\x04'\xa8\x0e~ʑ\x94\xb7\xf3K\xaa\x05]zX\v\x15\u0081\xd2C\x8dp\x12\xe6\xe8JBitc](\xc26#\xe9\x93J\x82\x90rŢ\xc19\x90\xeb\xc6&\x12\xcf\n\xe7\xc4\xe6`\xcdr\xea\xf0\x84\x9a\xa2\xdd_7B\xb5g\x14}\x97;\x933\xdc\x1dx\x12\xe9\b\x17\xa0\f\x87\x17Ȓ\xb2\xc6v\x9cV\xe4\x15\xack\xdc3\x80\x90̢\xa2\x80jg\xbae\r\x02>\x87\x8a!\x83ZccэTԟ<\xaa\x8f\xa4\xf3d\x12\x19`k\x9e\xe7?\xd6\xc8s\xd24)\xa18\xacС\xe6t\x11͐\xa9O\x94\xf5i%I\x83\xcc\b\x13`\xb1\xb3\xe51\x89\xc7Cש\xea#K\xf0\xcfw\xb7}\xc5\xd1+:\x91N\xe3{ψ\x87?\x95\xc2Fr\xd4x\xc2ݗ\xb7U\xbc\x8c\xb1XN\x02\xac\xc2\x12\xf7\x8a\x19P\xda\x13\n\t\xa6\xca\"r\xd5\v\x9c\xa0\x1c\xa6\x13W1Ӧ\x94\xbe+\x81\xd8IAp\x8eW\x12\xfeu\xff\xe1\xfd\xec\x9f9\xc9o\xb9\x00Q\x96\xe8\x19H\x10\xb6\xa8i\x17\xad$z\xe5Pޓ ,Z\xa1U\x85\x9e\x8at\a:\xff\xe5\xf5\u05fc\xf4\x00~1\x0e\xf0Q\xb4\xb6\xc1+PQ\xe2\xdb\xf2\xa1\xb7\x19v?\x16\xc7\x161E\xd1#\x98!R%\xb6ׁ]\x12\x0f\b&\xb1\xdb!4\xea\x01\xe7p\xc1Yr@\xe6\xffؿ\xff\x7fq\x04\xf5O1\x01]\xf0\xa6\x8bHܶ^\x1c\x06\x86\x1d\x91\x1c+\x81\x9cZ.1\xefp\xfc\xf0\x11\\\xa1\xa6\x1f\xc18\x96\x806\x03\x88\x00\xcc\xd9-\xe6s\x94#\xa2\xbf\xbc\xfez\x94\xe2\x1d\x0e\xcb\v\x94\x96\xf8\b\xafc\xd0V\x9e\xa5\xf4c\x01\x9f\x82ul4\x89G\xf6ղ6\x1e\xf5$\v\x98\x92\x8c\x81Z\xac\x10\xbci\x11\xd6\xd84\xd3X\xafKX\x8b\rK\xa1W\x1c\x9b\xb1\x00+\x1c\x9d\xb4־J\xff\xf4\xe1\xe6\xc3<R\xc6\x06\xb5\xd4L\x0eWw\x95⪛SkX\x8c֨\xfc\x11D\xdf\x05<VMY\v\xbd\xc4>IU\x1d\x97\xd1\xc5\xe5$s\xe8\x9c\x1f\x8fK\xe7\xbc\v\x87\x12\xfa0p\xfcaE\xe8\x13\x99c#{\ns\xef\aV~\x929n\xac\x9dF\xc2\xc0\x9f4\xa5g\xd6J\xb4\xe4gf\x85n\xa5p=[\x1b\xf7\xa0\xf4rʦ9\x8d6\xe0gL\x8a\x9f\xfd\x10\xfe\xf7b^BC\xfbT\x86\xc2\xe6\xef\xc1\x15\xdf\xe3g/b\xaaﵞ\x9e\xc7.\xefS\x03px\x96\xddb]\xab\xb2\xee\x9b\xe8\x14c\xb3\x90\xc0\x1e\xd8\n\x19C\xb3Лon\xca,\xd0\xce1E\x9bi\x9a\xd6L\x85\x96\xfcw,\xcb\xca͋$ة'\xb9\xefo\xb77\xdf\xc7\xc0;\xf5\"_=\xd2(\xf2\xc7\t\xc2w\xaaU4\x9f\x9c\xe4\xf1c\xbf\x0f\xd8\x13\x9d\x92\xe83\x8dض\xed\xba\xf4\xa9\x98\x1c\xa1\xc6+\xa1\tX\xdca\xf69\xa5/\xe4R\xc1V<\xb7\xa0?]\xceI\xb3֍\x11\xf2\xadzc\xfd\x13tz3\xdc\xdf\x17\xf2\xadxTm\xd7n\xc1\"+\xa6b\xf2\xb3\x90p\xc0\x94\xe7\x8e\nު73_\xc0+hQh\xceUQ\x1a\xf9Z\xa72\xae\x154\xe7N\xec\xaf\x7f\xc9\xee\x88\xda\xe51\xd3\x12]fGg\x9f\xc3\xf8o\xf6(\u06dd=d:\xb1\x97E\xed'\x00\x7f\x00ϧ\xec\x1d\xad\xb9\x95\x1c:*\x85n>9)\x8b\x8f{\x9b{qd\xe6\b\xdb=\xc5\xe4\x19\x8e鵰\xbe6t{s\x86\x8e\xfb\xedƞ\x86]\xc0I\x06\xd6cq\xa0>\xd9\xf5\x9c\xa0\x87\x84[\"\xe5ێ=z>m7\xf6\xf4\xf4ËMnP\x11\xea\xd9z\xec\xb1\xd0O?\x06#\a֩\xe1\xea\x12\x8f-\x0e\xa0\xb3c\rg\fq\xdeQ<p\xc0\xd6\xd2\xe6YB\x88D\x9f\x11\xc0\xe7m\a~X\xc6'\x9e\aR\xb8\xe3\xf1\a+e\x04\t/Q\x13\xcf1\xb9kڧp\n\x8b\xdc\xe8\xec`\xcfa\x98\x9a\x1e\xb8\xc3\xc1\xe2\xce>\x0f\x162^\x7f\xc4\xe5\xb8\xeb\xeb\x0e\xc2\xce\xe9id8\xd0K6&u\n\xcdc\xe7Y\xc6/\x9fG\x96\x86\xbb\xc5\xfd\xdfeNk\xf9z|\"\f\xff\x9d\x8cԑjq7\x1a\x81\xb5\xf0\xfd%9\x8d\xc2\x00/\x1e\r\x85Ti\x9cD\x19z9n5+\xa1\x1a\x94=\xa6\x8f\x9e\xe0\xc3\x14<k\xed=P\xe7Qr\xdc\xcc\x11\xed'\xc7\x02,Ͼ\xa7\f\xf1\xdcd{\u0081Z\xf4^,\xcfyЯq\x17\x93.\xfa# \x16\xa6\xa3\xed\x9c'\xb9R\x12ťOVP<\x87\x18[\v\x7f\x8e\x94;ޓ\xb3\xb8\xadS\x9f69~Pw\x99\x11\xe1\x14\xde\xe3:\xf3\xf6V\xdf9\xb3t\xe8ǚ\x99\xf6\n\xcct\xfeS\xf8%Xǳ\x04\x90.:'\x83\xb4\rj\xd3\xf4\xd6mH4\xa0\xbbv\x81\x8e\x05\xb1\xd8\x10n\xc7\xd6}h\x18\xa1Bj\xb8w\x92\xdc!$M\xca\b\x95F\b\xa5\xd0<\xa6\v\xf6K\x06\xa4\xf2\xb6\x19\x8dd\x87\x9c\x84\x9a\x9a͗\xfdhg1\t\x1c\xd8\xfd\xc3Z\xf1\xcc\n1\x10uc4οIM\x02Q\xa0o6\x94\xbf\xfe\x9bV=\x9e\x84\xa3m<8c\v\xf7{\x9b\xcfE\xbc\x00\x9d\x8fw\xc3\xd05\x0eT\xfb\xd7|\xcf\x18\x95\x15\xd4\xe8e\xa0\\\x0e\xb0S\x8f\x93\xde\xec2\x1b\x0f8-\xa1|\x7f\xf8\xfb\xfc\xc5\xc5\xde\xcf\xed\xe1ki\xb4\f\xff\xe4\xc0\xcf\xe1\xcbW\xfeE\x9d\x03\x8aLm\xb6\x9f×\xaf\x93\xdf\a\x00\x06%\x03\xc5\xd5 \x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WOo\xe3\xb6\x13\xbd\xfbS\f\xf6w\xd8\xcbO\xf2.zh\xa1[\x9b\xb6@\xd0$X8\x8b\\\x16{\xa0\xa9\x91͆\"Y\xceЩ\xfb鋡\xa4X\x96\x948\xbb@\xa3\x1c\"r\xe6\xf1͛?bVEQ\xacT0\x0f\x18\xc9xW\x81\n\x06\xffft\xf2F\xe5\xe3OT\x1a\xbf>|\\=\x1aWWp\x95\x88}\xbbA\xf2)j\xfc\x15\x1b\xe3\f\x1b\xefV-\xb2\xaa\x15\xabj\x05\xa0\x9c\xf3\xacd\x99\xe4\x15@{\xc7\xd1[\x8b\xb1ء+\x1f\xd3\x16\xb7\xc9\xd8\x1ac\x06\x1f\x8e>|(\x7f,?\xac\x00t\xc4\xec\xfeٴH\xac\xdaP\x81K֮\x00\x9cj\xb1\x82\x88\xc4FG\f\x9e\f\xfbh\x90\xca\x03Z\x8c\xbe4~E\x01\xb5\x1c\xbb\x8b>\x85\nN\x1b\x9dwO\xa9\vg\x93\x816\x03\xd01oYC\xfc\xc7\xe2\xf6\x8d!\xce&\xc1\xa6\xa8\xec\x12\x91\xbcM\xc6\xed\x92Uqf \a\x90\xf6\x01+\xb8S-RP\x1a\xeb\x15@/A\xe6V\xf4A\x1e>vXz\x8fm\x96U\xde|@\xf7\xf3\xa7\xeb\x87\x1f\xeeϖ\x01B\xf4\x01#\x9b!\xbe\xee\x19%v\xb4\nP#\xe9h\x82h\\\xc1{\x01쬠\x96\x8c\"\x01\xefq \x85u\xcf\x01|\x03\xbc7\x04\x11CDB\xd7\xe5\xf8\f\x18\xc4H9\xf0\xdb?Qs\t\xf7\x18\x05\x06h\uf4ed\xa5\x10\x0e\x18\x19\"j\xbfs\xe6\x9fgl\x02\xf6\xf9P\xab\x18{\x91O\x8fq\x8c\xd1)\v\ae\x13\xfe\x1f\x94\xab\xa1UG\x88(\xa7@r#\xbclB%\xdc\xfa\x88`\\\xe3+\xd83\a\xaa\xd6\xeb\x9dᡠ\xb5o\xdb\xe4\f\x1f\u05f96\xcd6\xb1\x8f\xb4\xae\xf1\x80vMfW\xa8\xa8\xf7\x86Qs\x8a\xb8V\xc1\x14\x99\xba\x93\x80\xa9l\xeb\xffž\x05\xe8\xfd\x19W>Jn\x89\xa3q\xbb\xd1F\xae\xb6W2 \xe5\x06\x86@\xf5\xae]\xa0'\xa1eI\xd4\xd9\xfcv\xff\x19\x86\xa3s2\xce@\xa1\xd7\xfd\xe4H\xa7\x14\x88`\xc65\x18\xb3\x1f4ѷYqtu\xf0\xc6q~\xd1֠\x9b\xcaOi\xdb\x1a\x96\xbc\xff\x95\x90XrU\xc2U\xeer\xd8\"\xa4P+ƺ\x84k\aW\xaaE{\xa5\b\xff\xf3\x04\x88\xd2T\x88\xb0oK\xc1x@\x9d~\x04\xa5\xeaU\x1bm\f3\xe4\x85|M\xe7\xc2}@-\xe9\x13\x05\xc5\xd54F\xe7ހ\xc6GP\xb39R\x9eA/\xb7\xae<[\xa5\x1fS\xb8g\x1f\xd5\x0eo|\x8795\x9ap\xfbe\xc9g '\x93E:T\xfe^4\x9ca\x03\xf0^\xf1\xa8\x7fY\x19\xf7<\x06\x16\xe3y%\t\xf2\xdb*ig\xa7\x9c\xc6\xdfsE9}\xbc\x10\xd3킋\x84\xb4\xf7O\xe0\x1bF7\x06\xed\xb9\xce\x10Aj5&\xf7Md\xbb\xf9}]K\xe15\x06\xe3\x05\xa2\x9b\x89\xf9\xa0{\x93\xac\xed\xbf\x05\x85\xf6mPl\xb6\x16\x97\x8f\x94G\xca\xc6t\x87\x1e\xbb\xde\xff~\xbd\x0fަ\x16\x9f?7\x17\"x8\xb7\x1e\x17Nv\x1f\xa8H\x9c#F3P\x18j\x85 \xf8\xba'\xd1\x174I[|C\fR%&\xe2d\x82\x16\xcb\xed1\xb1Y\xaa\xb6\x89\xc94Ǔ\xed\x89~o\x1a\x1f\xac8M\xba\xf9\xf5\x01\x92\x1d\x06\xb1u\x8a\x11\x1d\xf70ү\xdf?B\xac\"\x1e\xb5\x8fܨ.T\xc0\xcd\xdcc &`\xc0\xa6ų~{R4C\x84\xe5Nk|l\x15W \x1f\x8cB\x80f\x16r\xcfS[\x8b\x15pL\xf8\xf6\x1a\x91\x01O\xa4v\x97\xa2\xbb\xed\xac$\"5\xb8\x80\xda\xfa\xc4/H\xcf\xfb9\v\xb8\x90\x8e\vL\xc3^\xd1%\x9e\x9f\xc4f\xa9 \x9e\xe7\xf7e\n\xe8R;?\xa6\x80;|ZXݠ\xaa\x8fK֞\x97\xb7^\x8cp\xb1+f\x8b$W\xc3z\x94g\xea\x1a\xb9_9\xf5\x90\xd2\x1a\x03c}7\xbd\xbd\xbf{wv\x19ϯڻ:\xffGB\x15|\xf9*\xd7m\xf6\x11\xeb\xfe~K\x15|\xf9\xba\xfaw\x00\x83\xf9\xd9\xe0\xf4\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}{s\x1b7\xb2\xef\xff\xfc\x14]J\xaah\xdf%\xa9\xf8\xe6n\uef6a\xd4Iim%Q%\x96Y\x96\x8e\xb7\xb6\xb29Yp\xa6I\xe2h\bL\x00\f%\xee\xc9\xf9\xee\xa7\x1a\x83y\xf0%\r\x00J\xb6\xb7H\xaa\x12\x8b\xe2\xf4\x00\x8d~\xa1\xfb\x87\x1e\x96\xf3\x0f\xa84\x97\xe2\fX\xce\xf1ޠ\xa0\xdf\xf4\xe8\xf6\xff\xe9\x11\x97\xa7\xcbW\xbd[.\xd23x]h#\x17\xefQ\xcbB%\xf8\x06\xa7\\på\xe8-а\x94\x19v\xd6\x03`BH\xc3\xe8cM\xbf\x02$R\x18%\xb3\f\xd5p\x86bt[LpR\xf0,Ee\x89W\xb7^~5\xfa\xbf\xa3\xafz\x00\x89B{\xf9\r_\xa06l\x91\x9f\x81(\xb2\xac\a \xd8\x02\xcf@\xa16R\xa1\x1e-1C%G\\\xf6t\x8e\t\xddl\xa6d\x91\x9fA\xf3\x87\xf2\x1a7\x90r\x12\xef\xcb\xcb\xed'\x19\xd7\xe6\xa7\xf6\xa7?sm\xec_\xf2\xacP,knf?\xd4\\̊\x8c\xa9\xfa\xe3\x1e\x80Nd\x8egp\xc5\x16\xa8s\x96`\xda\x03ps\xb2\xb7\x1d\xbaQ/_\x95$\x929.,\x9f\xe87\x99\xa38\x1f_~\xf8\xfaz\xedc\x80\x14u\xa2xNl\xa8\xc7\x06\\\x03\x83\x0fvn4\x00\xbb\b`\xe6̀\xc2\\\xa1Fa4\x989\x02\xcb\xf3\x8c'\x96\x895E\x009\xad\xaf\xd20Ur\xd1P\x9b\xb0\xe4\xb6\xc8\xc1H``\x98\x9a\xa1\x81\x9f\x8a\t*\x81\x065$Y\xa1\r\xaaQM+W2Gex\xc5\xd8\xf2ݒ\xa3֧\x1bs\xe9\xd3t\xcboAJ\x02\x84\xe5\x90\x1d\xcb0u\x1c\xa2њ9\xd7\xcd\xd46\xa7\xe3\xa6\xc4\x04\xc8\xc9\x7fbbFp\x8d\x8aȀ\x9e\xcb\"KI\ue5a8\x889\x89\x9c\t\xfeϚ\xb6\xa6\x89\xd2M3fЭw\xf3\xe6\u00a0\x12,\x83%\xcb\n\x1c\x00\x13),\xd8\n\x14\xd2]\xa0\x10-z\xf6+z\x04o\xed\xf2\x88\xa9<\x83\xb91\xb9>;=\x9dqS\xe9O\"\x17\x8bBp\xb3:\xb5\xaa\xc0'\x85\x91J\x9f\xa6\xb8\xc4\xecT\xf3ِ\xa9d\xce\r&\xa6Px\xcar>\xb4C\x174a=Z\xa4_\xd4\xcb\xd6_\x1b\xabY\x91\xe4i\xa3\xb8\x98\xb5\xfe`\xc5\xfc\x81\x15 \x81/e\xa9\xbc\xb4\x9ch\xc3h.fvI\xde_\\ߴ\xe5\x8c\xeb5\xa2\xe0\xf8\xde\\\xa8\x9b% \x86q1Ee\xaf+\xa5\x8dh\xa2HsɅ\xb17H2\x8eb\x93\xfd\xba\x98,\xb8\xa1u\xff\xbd@M\x02-G\xf0\xda\x1a\x15\x98 \x14y\xca\f\xa6#\xb8\x14\xf0\x9a-0{\xcd4>\xf9\x02\x10\xa7\xf5\x90\x18\xdbm\t\xda\xf6\xb0y\x95_.\xb9\xd6\xfaCe\xbc\xf6\xac\x97\xd3\xfe\xeb\x1c\x935\x8d\xa1\xcb\xf8ԩ9L\xa5Z3\x0ed\xcc\x1a\x85ݯ\xb4\xf4.\xb5\x9f,\xd8\xe6_6\x86\xf2\x97\xfa\x8b$?\xb4\x84\x85\xe0\xbf\x17hM\\\xa9\xb1\xb8eR\xb6HB5>+\x16\xeb\x83|\x80\xa7\xf4\x83\xf7IV\xa4\x98\xd6\xd6V?2⋭\v\xc8,\x18\xc6\x05\xc9?\x99\x7f\x1a\xb6h\xfeJ\xe6t\x8b$\x00S\b$\x81\\\x94\xf4\x80\v\xbb\b;9M?\xdc\xe0b\xc7\xe0\x1e\x9c\x1dX?\xc7&\x19\x9e\x81Q\x05n\xfd\xb9\xbc\x96)\xc5V{\x18S\xf9\xe6\xae|\xa9\xbf\xef\fB\xc6\x13l;\n\xbb\xb2\xb4\xd4\xcc\x10\x0f\xb6\x88\xc2'͕\xb9\x94\xb7\x8fq\xe2G\xfaNc\xc3 \xb11\x0eLpΖ\\*7w\xe7R&\bx\x8fIa\xac\x9b\xdf|\xa7\x05-*H\x05\xb9\xd4f?\x17\xf6k\xa2S\x8e}K\xf8 \v\xf7\x19\x8ej\x89i\xa2kFD\n\xa4\xb1.\xc8w5\xdfU\xb2(\xbf\xab{;o\x01\xb0\x8f#0a\x1aS\x90N\x06\x8a\f\xb5\xbbWj\xcdS\xa3e\x83\xbd\xa4\xebɗ~7c\x13\xcc@c\x86\x89\x91\xad\x00ć\x9f\xdd-\xc7\x1e>\xee\xb0!\xce\xf6:K\xdcL\xec\x01\x92@A\xc7ݜ'\xf3\xd2%\x92lZ:\x90J\xd4V\x8d(l[\xed\x9b\xe4\xa3k\xdfA\x91:\xabT\x17\xe5\xda\xe6mmL\xbcY[_\xb9\xc1\xd9Z\x1cv\xfb\x91\xe6\xf5\xaf\xc9X.6%\xaf3g/\xb7.=\xac\xd0\x12K9\xea\x11\\N\x01\x17\xb9Y\r\x80\x9b\xea\xd3\xc7(\xb2,k\xdd\xff3^\x18\x7f\x89\xbfܼ\xf2\xa0\x12\xff\xe0\xaa<F\x91V\xa5\xbe\xfdg\xb8(\xd6Y\\;_\xd1yA~n_5\x00>\xad\x17$\x1d\xc0\x94g\x06\xd5\xc6\xcaD\xe9\xcb!\x98\xd1\xc5\xdf\xd1{\xc1L2\xbf\xb8\xa7\xd4@\x9d\x8e\x00\xe8ȗ͋\x81\xb7#\xe6u\xc7\xfc\b]\x8ai~/\xb8\xc2\x05e(Fp3ǵO(\xb2\x84\xf3\xab7\x98>$u\x1d%ok\"\xe7\x1b\x83m\xdf\xdaE\xbd]\xa7\xe1B\x9fz\aa7\xcez\x00\fnqUF,\x94\x8e\xc8Q1\xbaў\xbd\xc4\xe6[\xa1\xcdCX\xf5\xbfŕ%\xe3\x12\v\x8f^\xddU\x14\\f\x00W]\xbe\xb6\xc1@\x1a\x93\xdb\ue55c\xa4\x0fhn\xf6\xa3\xce2\xe0\x8cLm\x8b\x1e[k/CR\xbd+\xde\aL\xb3^\xb6&\x9fQ.l\x9f\x92\x11\x99\xddf\xeb9\xcf;Q\xb6\x8e\x93$\xcbjK\x95&\xfa\xc02\x9e\xd6c,\xe5\xfeR\fz\x9d\b\u00954\x97b\x00\x17\xf7\x9c\xd2\"$%o$\xea+i\xec'O\xc2\xcer\xe0\x01\xcc,/\xb4\xea%J\xb3M|h\xe7\x9b:\bw\xf9s9\xb5rV/\x0fה\xfb\x91\xaa\xe2\a\xfd\xd1\xdd\xeea\xff\xb0\xfeZ\x14\xda\xd0\xeeEH1\xb4\xaer\xb4\xebN\x96\xb5\xbaׁ\x1ee#\xd5ڊl\x0f\xad\xbeiyÎdo(\xf2\xb2S#~*\xcc3J3W\xbbM\x9b\xc5c\x06g<\x81\x05\xaa\x19\xf6\x1e%h\x7fr\xb2\xef݆\xd0\xd1\xea\x06IX7\xd7^\xbd\x9c\xe9\xdeHo\xeez\x0fIs;|\xabZ\xecG\xbf\xba'y\x173#\xebbm\xfc\xf1(wY\x9a\xdaJ\v\xcb\xc6\x1e\x16\xdfc-ִ\xb750\x129\x06\v\x96\x93\xfe\xfe\x17\xb99+\xd0\xff\r9㪃\x0e\x9fۢI\x86k\u05fa4Q\xfb6t\a\xae\x81\xd6wɲ\xed\xb4\xf0\xf6\x8b\f\xac\x00\xcclTA\xa3یX\x06p7\x97\x1aI\x10`\xca1K{\x8fP\xa4\xb9\x9e\xdc\xe2\xead\xb0e\aN.\xc5I\xe9\xe0\xbd\xcdM\x1d-H\x91\xad\xe0\xc4^{\x12\x13\x04u\x94\xc4N_\x13;\x93\xbe{Ģ\x9d\xf8m2\xbe.\xcc\x1d\xf5\"\xe5\x90rf?\xeeN\xd8\xed\x19ϸ\xbab=6ݑ\xf7zt\x8f\xebrX\xb5Q\x15)\xb0\xa9A\xe5\x92x\xf6\xb3z\a0\xeaE\xd9ʵ9\xec\x18l\x9d\xa0cU\n\xd12\xf8A\x9a\xe0\n\x00]\x86\xe8\x135\x12_\x1e\xfb\xceƌ.\xee[9F&l\xc2tm\"\x87\x8ej\xa9\xba\xc36K^\x9d\x86\xfa\xba\xbc\xb2\x92iGȪ9S\xb3\x82\fKW\xdfߒ!\xaaj\xc0\x1d7s.\x80U\xe5\x06TN\xa0\x18\xe4\xf2qK\xe4\xf2\xd7L\xc3\x04QT\xec{\xd44t\x96AO\xddl\xbf\x17\\\\ڀ\x00^\x1dܿ\xd7\xd6\x12C\"\xf8\xd75\xab\xeb\x05\xad?\xb0\x1e\xa7\x13I\xa0\x05\x82\xbb9*\\\x93\x8a\xed\x847E\x8c\x1dIR\x16\xb2\x95W \xba\xb9L\xfb\x1a\xa6\\\xe9zGiGޑb\xa1\xbb\x8a\x83\xe7\n\xd3\xec\bz!\v\x13\xb0\x06\x17\xcdյ\x11\xa0\xd9.\xd8=_\x14\v`\vY\b\xd35\xa0\x9e\x82ዺ\xa4\xe8V\xe0\x8eqc\xcd\x1d\xd1%\xcbH{\xadD.\xf2\fM\xd7\xe8w\x82S*{$Rh\x9e\xa2\xaaJ\xde4\xf7\x82\x84\t\x18L\x19ϊ]\xe5\x9b\x03\xf0X\x8a\v\xa5\x82v\xa9\xef\xca+ka\"\xe7{\xb7ΠND\x89\x05s\xb6DJxq\x03(\x12Z\x17\xcau\x91ɶ\xb7p\xcc\x10\xb3]\xb5\xff}\xafn\x06\x9e\xde(\x8aE7\x06\f\xadfs\xf1`R\xacy\x0f\xe1{Ƴ\xa7X6\x92<'\xdc\x01K\xf7\xd7\xe6\xeagQ\x8dڨt$i$\x19\xb7\xf7\xc8\xd2U\xa5\x1f\xcc\x18ڪZ\xf5\x90\xa0\nѶ\x88O\xa0\x19>\xfb;7\x8aG\xbf\xd91\\\xa6\x1f\x82\xb3\x9d\xf5\xbc\x16\xf5R\xf0f5\x99\xb0$\x9e4ڡ\x1bԎN\a\x88\xe1\xe5\x1a\x01\x8a}\xaa\xc0\x99H7\xae\xc8#\xf2\x99 \xb0\x94\xea\xff\xb4'\xb3\xee\xd3\xc5\xd1%\x90gO\x19<:tY\x9bV\xbd\xd1l\x81ߚ\xc9t\xa4\xe8\x12\xbc+Y\xc0\x1d#\x94R)\xf4u0\x97ˎ>\xd7wU\xdd._\xcd<\xbe\xbd\xc1\x80\xfey\x15\xb2V\xf06\x14F\xad,ܪ렫\x84\x13B*\x93[\nG\x16l\x86\xfd\xbe\x86\xd7oߐ\xa8P\xd4A.\xc3\xc3#\xb8\x85-+\xb1\xb9\x92K\x9eR\xe8\xf4\x81)N\xa5\x1fP8E\x85\x82Ja_\xbe\xf8p\xfe\xfe\xb7\xab\xf3\xb7\x17/\xbd\x88S\x1e\x15\xefs&H\x06\v]y\xf3z\xf5i\x02(\x96\\I\xb1@_n\\N\x81\xc1\xb2\x1amR#\xd1h\xab\x95-]4\xe7E\xb1\x9eq\x85\x97\xe1\"/\x8c\xb3\x91pǳ\f&]\x03\x19\x17\f\x8ad\xceČ\xf8\xfaF\x164\xce/\xbf\xb4\t\x05\x85i\x918\xc5\xf4\xa2\xe8\x94\xe9ˁ+g\xb1,\x93w\xda\xfa\x16\xd4\t\xcb\x1d\x8f\xbdh\xb6\x96\x17\xf4J\x18v\x7f\x06|\x84#8\xf9\xb2\xf5\xa7\x13/\x9a\x96[\xb9\x924M\xbb莋\x197\xa8X\x06'm\xca~\v\x7fA\xf3Ĵ-\xa0\xf6n\x02\x97\xa8`҈\xdc\xc0s\xf5gL\xa5\x19jM6\xf7n\x8efna\x92\xd8\b\x19\xfad\x9d]<\xa0H\xbfv\"%\x1bl\xa4\x17\xc5\n\xc8z[\x03\x81\tJ\x99\xcaD\x9f\x1a\xa6o\xf5)\x17\xe4R\x87\x84s\x1c\xb6\x8c\xeei\xe9\r\x87\xce?\x0f\xab\x9d\xf4\xb0V\xc7\xd3/T!\x04\x17\xb3!\xab\xbf\xc5Ő\r\xf5\x1c\xb3\xac\xdf\xdb;\xa48w\x11\x10\x8f\x84\xeeb\x03\x12\x13\xbb,\xfaEm\xc0\xcb\\\xe3\x88j\x1e\xf5\xf6Ӄ,4.\xcc\xf2x\xb4\xd3\xc6_\\ݼ\xff\xdb\xf8\xdd\xe5Ս\x17\xe9\r\xb7\xb0\xdfԇ\x19\xc95\xb7\xb0\xc3\xd4{Q}\xd0-\xac\x9bz/\xba{\xdc\u0096\xa9\xf7\"\xba\xcb-l\x9bz/\x92;\xdc\xc2\x1eS\xefEv\xd3-\xec5\xf5^T\xd7\xdd\xc2>S\xefEr\xb7[\xd8a꽨\xeeq\v\xeb\xa6ޏ\xe2~\xb7\xb0a\xea\xbd\xc8\xeev\vGS\x1fm\xeaQ,\x83\xcd\xfc\xcfn\xfb\xd52E\xf5\x9a\xfb\x05\x01FZ\xc4\x01\x17\xebvnWT\xf0\xb4\x9c_\x9b߅X~`\xeb\xb0\nў\xac\x17eh\xd4\xc1\x91#\xcbʚܯ_\x8c\x17\xb2K\xebV9\xeb\xc0\x98\xab֩\x89p~\xb4y2\x82\xb7\x0ea\xc0\xe0\xf5o\x97o.\xaen.\xbf\xbf\xbcx\xefǔ\bݩA#\x91\xac\xe9\xef\xd8\x1ezS\x84G\"\ao\x87\\\xc9\f.\xb9,t\xb6r\x89\x9f\xb4\xbdz\x81\xaa\xebTmCs\x1d\xa4l\x05\x1aՒ'!\xa3\xdd9\xb4\x98P\xa7c\xc0\x13@\xf3\x81\xddp+\xec\t \xbc\x7fO삟\x00\x9a\a\xdd\x19?\xdd\xfe\xb8\xd3.9\x80\xe2a\x03\xa8\xaeaT\x00ч\xf7\xd8\xd0\x19\xb8\xd8~\xdb\xf0\xeb\rNY\x91\x95ٶ\x93\x93Q\xff\xd9M\xec\xf7Jv,\xa0\xec5\xb3\xd7\x16tPW\fZ\xb6\"\xc2\t\xf5\x1d0v-\xecИ\x86X\x04\x87\x9d\xac\xf6\x94^\xb8\xb9CxyW\x92\x9e\xf2\xd9[\x96\xff\x84\xab\xf78\r!\xb1\xc9v\x8b\x99u\xf0R߭A\xf3\xb2QO94\x7f\x9e\xc4\xf3\xc5\vQ\xfc(On\x1c\xfa\xd9ưĞ\xb0)E*V\\t\xb7sb\xfdV\x98\x17L\xb1·\x98\xae\x1b\xb7D\x8a\x04s\xa3O\xe5\x92b\a\xbc;\xbd\x93ꖒn\x94\n\x1a\x96\xf50}J\x13է_\xd8\xffE\x8c\xee\xe6ݛwgp\x9e\xa6 \xad\xa9-4N\x8b\xac\x84\xdduF\xfa\xeez7M\x05\x06@\xe7\xaf\aP\xf0\xf4\xbb~/\x90\xdc!dCڅeف\xe4\x83\xced\xf2\xe9\xaa\xf2R\xc1D\xa9v\x85\x8dE\xa04\x01\x95ߺ\xc0`\x1fGI\xbb@7\x98R\xc9\xf6\x89\x94\x192\xd1{\xe0\x8b\a(\r\x87Á#\xcbǻ\xdeV\x03\x0e\xe35\xfa\x8d\xdb\xe8\x06g\xdd\xfdr\x1b\xce\\\xa6g\xa0\x8b<\x97\xca\xe8\xbaa\xc1\x88\f\xc1\xa0\x17@\xb6\xd5\xf5`T\x9f\xed\x1b\xc0?\xea\x0f\xed\xd9\x11\xfdK\xbf\xff\xedO\x17\x7f\xfb\xb7~\xff\xd7\x7f\x84ާ\xa1\xd9\xea5s\b\xc2\x04\xaa\x19\t\x99\"\x99\xec\x81\xc5،\xdc\xce\xeb<\xb1\x00\x99\xab\b\xf6h\xc3L\xa1Gs\xa9\xcd\xe5xP\xfd\x9a\xcb\xf4r\x1cI\xd2\xd2У\xfeG\n\x02\xf65~\t\x96tG͉j0ͪێ\x95\xf7\xefIe\xc6\xcc̻C\xecv\xbd\xee\x147\x06\t\xe7\x01\x06Ղ\x12\xbb\x03J\x03ح@\x04]#\xe1d\xf9ʳBy`\xc76\xadXt\xa0e\xb4\xdcv\xe6&\xc6bթM2\x7fU\x8e\xa4FSF\x10=\x1f_V\x8d\x87>\"\xe3c=[\xbdl\x1fÿU\x80\xf3\xef\x9f\xc4\xcfU\xd4\xe3\\]\x9dN;+\xcf`TTC\xed@\xc6\x17ܝ\xc0\xab\xbb\x14\xbd(?\x1c%y\x11j\xcc\x1d\x85\x05.\xa4Z\r\xaa_1\x9f゠\fC\x82Q\xb1Y\xb0\xfb\xa9\x86j\x87X\x0f\xdc\xdd.\x90f\x9b\x05\xdb#}\xd9\v \xe9\xe0<I\xa1h\xb7\x93\xad\xaa\x18\x05ӏ\xe6\xdfj\xf9\xd9\xdd\")L\xc8\xeb\x82E\xe4^\xb3\xb1\x1f6\x8d\xb3\x94Y\xb1@=\xa8w)\x11\x84\x89\x1e\x8a%%v6\xda^=\xab}\x04H\xf9\x92\xeb\xaep\xe9]/&V\xef\x02M\x13\xfd\f\xdd$\xa85\xdc\fU4\x9d(fl\bҵ\xf3\x83:2T\x92\x85!\xb4\xc1T\xaa\x053\x95\xe5\xc4\xfb\\\x86e\xee\xaaWmk\x9b(\xc9&L_\x85\xa4\xb1\x9dB\x13*Y\x893\xf8\x8f\x17\x7f\xff\xd3\x1f×߽x\xf1\xcbW\xc3\xff\xff\xeb\x9f^\xfc}d\xff\xf1\xbf^~\xf7\xf2\x8f\xea\x97?\xbd|\xf9\xe2\xc5/?\xbd\xfd\xe1f|\xf1+\x7f\xf9\xc7/\xa2Xܖ\xbf\xfd\xf1\xe2\x17\xbc\xf8\xb5#\x91\x97/\xbf\xfb2x\xc8\xf7\xc3&C3\xe4\xc2\f\xa5\x1a\x96B\xf0h\xb3\x87.\xcc=;\x8c(\xf5\xdfW\x91HM\xf9\x10\x11[\xff\xf3\r\xad\xa2\xd8\x10\x19YiL\x14\x9aO/\xe7\\\x8e\xab\n\xc3\xcbSL\xf5\x86\xff#y\xe8ç\xa1㷞%\x9b\x9a}\v\x1d\v\x1c\x81-\xd0G\x90\xb5\xa5\xfd\xa5\xed#\xe1\xeep\x8b\x01\x15\x91\x83i\xd81U~L\x95\x7f\xa6\xa9\xf2\xebR\x7f\x9a<\xb9m\xcf\x11A\xf4\x98'\x0f͓\a_\x1c6۲'w\xef\x19F\x18\x88%\xf4-\xed\xef\xc4\x13\xba\xc0\x9b\x02\xb1\\\xe6\x055\x99\xeaE#\x87*\xbf_\xef\x89\xfd,\x96s\xafMc\xd0\x06\x97nG믂\xdbX78\xcf2\xe0\xa2t\x92\xf6f\x04,\xf1%\xaa\xb0\xcc:\x00\xa3L\x0f\xe0\x92\x00Twsܘ\xbe\x17Y\xae)\xeb\xaf\f\x17\xb3\x11\xfc\x95h\x95\b\x00\x87E\xe1\x02\x16Efx\xee\tH\xaawXuo\x12`Z˄\x13\xd0\xd7\"\xff\xbd\x1djƴ\xa9\x96\x84\xb8\a\x86\xddZ\xc4e\x82)\xc1{\b\xd4O=P\xbc\x88Vk>Y\x11G/Ĳ\x1c\x1b\x83\xb4(!\xc5\xe8m}v\x8f\xedc\xc3]I}\x1d\xb4\xa6A\xbdzQ,\x8b\xb9n\x01\xe4\xb4i%V\xd7wu\xefyB\xec\x1a\xfd\x12\xb4\rY\xe3\xcc\xcdZ}\xba\x8e\x8c\xbd\x89\x82m\x1c\xde{\xdemFx\x98\xbb7\xc4m\x02\xd5 \xba\xf0Ʌ\xb7O\x12\xda\x1e2\xac\x8d\fi\xe3\xc2هBو\x1dO\xa3Q\x87\x00k\xc4\x05\xa0\xc1q\x1cY(\x9c\xf2\xfb\xb3^\x14W\xcfE\xbd\xe5\x00\x9e\xd2\x03\x1c\xa6<h\x9f@1\x93\xc2\x1c\x85\x85\t#K\xe6䚪\xe0\xa7fy\x88L\x7f\x02\b\xfd2sp\x18\x83~\xbd\x91\xe78Z\xf3\xa35?Z\xf3`k\xee\xd4\xe936\xe5ϸS\xb6'\x97\xcfz\x81\x8b\xd6\x7f\xd3:\xffl3\x02\xed\x84\xe1\xa1\xce\xca\xd7\xfaZo\x19\xf5\xa9\xbd\xa3\x9fZ\xda&\xb0V\xf5\b\v_;9:\xc3B\xe7O`\xceg\xbe\x19\xb1\x8c\x1e\x7f\xe4\xe2{X0\xc1f\xb6\x13%\x99rW\xaa\xf3=\x1dA\x01\xa6\xe2ik{\\\x1e.\xd7\xe48\xc9Le\x92\xf9\xc9r\xf3\xec8jSs\x8b\xf0\x06\xf3L\xae\\\xc7L\x91µa\x86\xcc\xd25\x1a?\x00\\\x90\xf1\xb0\xb3\x19\x17Y6\x96\x19OV\xe1\xa2wI\x84 /\xe8X\x8e%5\x82w\x02}\xcb2\xe7\xd9\x1d[\xe9\x01\\љ\x99\x01\\N\xaf\xa4\x19\x97\xa7\"\x9b\xf3)^\x14\x8dtD\xe9\xe8\xc5\x19\xa5\x8c\xb4\x01\xc3f$t5\xe2\xca\x0f\x81\"\xd5\xda\xc0J\x80\xf8\x1dױ\xfbto\x87\xb9\xa5\x80_ػ\x92\xeb\xb4몟\\|2>\xc5d\x95d\xe16\xeb<\xa1\xff\xbb\x87\x12Q\xd0\xd1\xe8\xad\aI\x00\xbd\xd2\x06\x17U\xdb0\x9b\xdc\xe1\xb6\xcdd.\x85F2\x015\xb7\xbc\xe8\xd63,\x13f:r\x8dC\x83<\xea%{M\x996\xbf\xcb6\xb5t\\\x91!\xf1OX\x96Q\xf3\xa3\xc5\x02Sʬe~\x99*zW\x1d@k\xdeZ\xba\xf4\xb8K:\x90\x7f\x19V\xf7\x9a3\x91f\xa8l\xbfB\x97\x03\\\xa3O0U.\x98oÐ\x06\xdeeS\x96\x94\bM\x12\xa9R\xd7\v\xae\xea\xecŔ\x9f\xe0ѻ\xb6xd\tڞGNׇ\xefMy\x92\xc9\xe4VC!\fϚ\xf6\x90UoH\xf7\xa0Fo\xaaA&\xa6\xfe\xe7\xb0։\xe1\x9cZ\x11\x9f~\xd1\xfc\xc9~\xe0cvb\x94\xa2{?\xdfG\xf4\x82<\x15\x89\x86\x05SJ\x7f\xb7U\xbdi\x81\xa6\x92\xc2\x17\x12*g\x8b&-h\xef\xa8\x17@ն \xadi\xb8\a\xa2Z\xb3If\x8dL]\b\xd9\x18\xa6\a\xf6\x02\xda\xcb\xff\xf5\xb6Ł\x14\xeb!A\xc6\x05\xb6\xfb\x17s\xdb\x135\x98\xec\x9a\x06\x97\xf6\xc8\xedP\x83I\xa6\\\xd9\a\xb4\xacZ\xbd-˱ǀ\xf9\x95\x94\x06^\xf4O\xfb/\xb7\x8aZ\xfdp\xaaS\x9ea\xe9]\xcb&K\xd5H#\x06\xaa\xf9\"ϨJ\x84I?\xb5\xcf\xd9r\xc7aU!z\x814\xdd*W\r\xa1\x06\xa0%\x18Ū\xa7\f\x84\x8f\x95\xdaK\x11q\xa3\n\x17\xab\xbc\xe8\xff\xd1\x1f\x00\x9a$\x14\x0f\fp'E\xdfX1\x1a\xc1\x8d\xa4vS\xf5\xc0\x83iR\x93G\x81e\x13$\xbc\xa7\x02\x147\xd9ʺ\xf9`\x9a\xd4\xf5\x98\x8c\f=\x1c\xc75ں\xb8\xe7Ɲ\xd3\t';\x85\xaf(T0e\xa8@%Ɍ/\xf1t\x8e,3\xf3U/\x90\xac\xed.A\xcf?\xf9'5\x0f\xa66^\xc2Q\f3\xbcA\xb5\xb3\xe8\xa0:>\x8d\x10\x9d\xbbh\x92\x00?\xa0\x89v\xaf?\xde܌\x7f\xc0\xa6_x\xb8\x95\xa7\x11U\xf8|\x12\xf3\x1c\x15\xe1{?\x86\xff\xa3So\aq~?ңU)Y\xe36)\"d\xa9\xaa\x97\x91\xeb\xb0d\x87h\x84\xcbq\xa8\x06\x00\xfcM\x16Tj\x9c\xb0I\xb6\xaa\xbb\xc8R[\xa6\x13\x1az8\xec\x99\v\xbb\xcb\xfd\x11YJ\xd9\x102\xb1\xc8<w\xcc\aT\xb5\xd6X\x0e\xb2\xae\xaf\xcb\xe7\xee\xce\xcb\xe9\xf5\xa2P\xc75:\xd5\xc9\xfe\xc8\xeaT0M\xd7\xe1\x85\xeaA\xd6\xfc\xba1~$#\xb9\xae\r77\xe3r\x15\x1c7'\xc1\xe9~\xfaa\xd5\xe3\x8f\xcb)\xba\xde\xceE\xdc\x11\x00.\xec0\xadRD\x8c.\xd6\x02\xc5\x16~v\xf2\x9f\"\xbc\x92WQ4\xdd\xd9K\x7fX\xda\xc1պ\xd5_\xe6\xd3e\x93\x1d\xde\xc7\xe7S\x1c\xd42\x10\x88\xd8~\x0f#9\x11\x15\xee\x1c\"\u07b2\x87y\xe6g\xbd\x03\x88\x98=lL\xe5\x90$A\x1d\x11j\x97;Ak\xb0\xe8\xe8\xbf/\xc0\xf1\x80\"F\xf8\xc3P\xd6D\x1dx;\xccq\xb7\x83\x1cv[[\xe2\xb2خ@\x14\x8bI\x84%qYFbo#0n\u10c9֩\x83\x11\\\xd9\xe1Uh\x9c`\x8aU\bC}\xdd\xe1\x15\x8d\xf4\x9b?\xff\xf9\xeb?\x8f\xe0*\xc6dT\x85e&\xe0\xf2\xfc\xea\xfc\xb7\xeb\x0f\xafm\x13\xb7Q\xef\x13:\xd9f\xdb6\xe0\xd9!d\xe6ڒ\"\xeeQ\xd2`*U\xcc\n\xd3^\xc3\xe5\xbf\xc9HО&\xb0\xce\xd6~\x1bi㣏dgb\x9c\xd8\xd0*Q\xef\x99\x1d\x8fI\xf2k\xaa\xdc\a\x19\xc75\xe1\xe8\u07fc\x1e\x97\xa4\x9a\xcdv\x00M2\xb7\xc0l\xb6\x8bp\xe72[\x92\x900\xb8y=\xb6\f\n[Y\xba\xda\xd6\al\xaao\x85\xa69\t_Bs\x82\xa8R*\xb1,\xb6Pw\x05F\x8f~\xe1\x89\x1di]\xa6\b\xa2K#\xed\xf7\x9e?\xaa?X^\xa1\xff\xae\x82\x03\x01\xed\xd3\x03I\xc2fjb-\xc5\x10Lt=5\xd1\xff8\x96\xe2\x18\x91lG$\xa5\xab\x97*.\x8e?F$\x9fvD\xf2\xb9\xf9\xc8\xe0Ks\x85\xd7F\xe6g\xbd\b\x9d\xe8\x8fK\"\a\xc2LTO\xa2\xdb\aj\x804`IIɄm\xffTe\xc7\xe5\x1a\x10\xc1\x82W\xbc\xa9\xea\x82\xdaA\x97\xb5\x19\x81Z\x9fZxD\x91\x97\x99\xafꁒ\xfe\xfd{r\x85\xd4\xf8֞\x80\xa8:\x12Xv\x10\xc0\x9d>D\x93\xf8k\x8bM]9숫'V\xcb\x15\v\xc3H\x14\xd3sԴW\xc3{jb\xe4\x9evʹ\x14e\t\xd7-\x1f\x97\xfe\x05L\xae!g\x9a\x1e8S\x85\xe1\xe5$\xcar\xebX\xa6\xfd\x80\xeamk@0S,A\xc8Qq\x99\x82\xed\xfa\x97\xca;\xffqNpƅ\xae\x9e\xa4H\f\xad\x14\x83b%\f\xaa\bW\x8f\xfe\x19\xc1\xfb\xba'v\xe5=da\x12\x19`\x87\xe5\xb4\xcd\xc5M\x00\x91\xf7\xd1I\xfa\xb1\xeaS\xb0,[5\x8aZ\x9d\xf44\x87_\xa4m$Q(\x13\x9ayo\"\x89\xbc)\xae#\x8fH\x15\x1aTRk\"\xdetפ\x93\x13\b\x8b%\xf3\x88\xc7|U\xb5\x9c#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm:B\x9b\x8eЦ#\xb4\xe9\bm\xfa\xf4\xa1MA\x97U8\x9e1ew\xcez\x81\x8a\xd4\x1f[\x90\x02O\x1c\fHN\x1b\xf9\xf5\xa0\xd9\fg\x04ͳ\xa3\xaa\xc7\xe3\xd7]Z\xbc(:\xa0O\x03O\xd2\xcfݓ\xa9j\n\xa6OsY\xfe\xa7\xc1\x14\xb4\xc0\x04v\x84^h\x82P\xe7\x1b\x82\"x\fA\x10d\xeb\x1eF\x0fX$\x807\xcdC\"\ab\xa2\x1bW8\xf6\xbf\xf0A\xb4@E6\x80*\xecA\n\xac\x97\xce\xc3\n\xb2-\x94\xc0v\xb5?\x88\xa2\x9b'!\x04\xb6+\xfd\x81\x14\xdd\x14\xfbz_\x95?\x88.ׇ\xaf\xf0?Au\xff\xf0\x95\xfd\a\xaa\xfa\xb0\x92E\x10\xcd=\x15}W\x99\x0f\"\xb9\xa7\x9a_U\xe5\xc3h\xee\xae\xe4\xafU\xe4\x83\b\xc7V\xf1#\x8aS\x91\xc1ux&90܁\nl|3W\xa8\xe72K\xa3|\xda[.\xf8\xa2X\x90\x99\xd0d\x1e\xf9\xb2F3\xfb\xcbH\x85s\xb2>ݕ\xe1\x880O\xd1>Ē\xf1,\xa0&W\xb6֛3{\xf4J\x17I\x82\x98bڤ\xb0B4\xe4\xebQ=s[5\"\xcb\xf5\xcaW\xf2\b\x95\xc0\x8c\xdd\xdf}\xfd\xbf=\xaf\r\xdf\x19\x06\x026\x1e\akب\xae\x17\xf8\xec\xd9\b\xa0FL\xb8\x11\x9aHy\x1ap\xc6\x03\xc0\f\xea\x1d\x13D\xf3\x01P\x06p\x11\v\x82\x88\x01dDY\xceH \xc6\x03 \fǣ^L\xae\xa0\r\xc0\xd8\x04R\x04\x11\x8e\x00_D\xf8\xb6\xa7\x02]\xec\a\\\x84\x8a$D\x83-b\xacH\x93\x03\r\xbdv/r \xfa\xe9\xf8Q)\xba\xc8\xe0\xe6\x00\xa0\x8a\xa7b\xcb! \x04\x11|\x89ɭE\x01(b\xc0\x13\xc1\x11gl\xa8\x1b\x0e\x98x\x00,\x11\x93i\x8e\x04JD\x89Oh9\"\xf8\x94u|\x19\"\xba\x04\xf1\x00 \"4\x89V\xb1rK \x9a\x8cG\xc8\xd2\xc2F١\x0e\t\xca\xf2A\x10\xc5\xf5\x92\xc3AK\a\a/\x1b\x84\x83\x18\x1e\x060Tqu\x98\xfc\xc0n\xf0B\f\b!B\xa2C\x8d\x7fPQ%\xd8hs\xc1\rg\xd9\x1b\xcc\xd8\xea\x1a\x13)R\xef\xc8hmI\xfbN1\xe8\xf1\xa3%\xb9rgދ:j\x05s果\x89iu\xa0\xb6\xaa\x86xS.\xc3G`\xb6NA\xb37\xeb\xa7'?n\xdd\xe2\xe3\xa5\f\xca#\xa5\x87\x10\x82\x1f\xe5\x1dȩA\x01/\xb8\xa8\xe4\xc0?\x8f\xda$\v\x9a|Q\xad֤կ\xbe\xf2\xa6\xe9\x06\xf3\xf9&vljK\xeb\xa7\xcb\xeb\xb9\x1b\x1c>\xb1\xe7\bO\x8b,.\xb9G\x89Ǎ̞\xff\xe25\x8f\xe1{e\xc7]Y\x13\x9b\xa5vm\x1b\x02h~\xa6B\x15\f;{\x14r\x06\x01O\x1e{\bn\xd6@Ǽ\xc9\ue05a5\xb01\xff\x81\ue0d9\x05A\xc6>z\x86s\x03&\x16\xbe\xfd\xdc\x03\x11s\xe1Y\x10\xc9\bx\xd8q\x1f\x16\xb5\x0fs\xf1\\\t\x03;\xee\xc3>\xa1}\xd8\xe7\xb1\xc3h\xf5:\xf9\x81Z\x97\x8c\x0f\x16fV\xe6\n\xd2B1\xe72\xaahӓ.\xd4U\x18*\xb2k\x12\x82j\xdcX\xb6\x9a\x99\x16Y@\xf3\xaa\"\x97\xc2\xc5C\xae^Zv)j7q\xf1&\xea\xd0.;f\xed\x02\xa5\x10\r͕$\xb5DM\x9d\x17\x04\x15Q\x9d.\x11Sh\xaf\xa4\xc3<dk\xf9A\xf3\x99`\x99\r\xb1\x88݆\a\xf8\x97\xbb9\xbaq\xd5\x03\xa6\xd1M\xa5J8=paβ\x90\xf2\v5'\x02\x06\xb7\x04\xa7+\x879\x82kz\xac1=v3,\x99\x9aI1\xb3\x8b\xc1\xca\x01\xe3}\x8e\t\x85\x1dI\x86L\x14y\xd8\xfc)X]\xc9BU\xf3w\x8f\x8d\xabF\x19\x02\xda\x10<\x1bTK\xdd\xd7\x0f+\xac7\xf1\n\xa0Hu\x1fק\x89\x9e\xfd8\x88\xe1l\xf5\x98\xd1R\x0f\xec\xea\x10;\x96<\xa5\xf4\xc0*\xc8C\x91\x98S\xd4:\x82\x0f\x96^e\xf7\xe9\xf18\x02g\xcc\xf0\xa5?Q\xe7\xc4K\x9d/\xc7Y>jG\xa4<\xa1gkzS\xd4\xd4?\xac\xd5N\x0f\x96\x9c\xd1|ے\xebM\xf4\x85\x90 mP\\\bnVd\xfd\xf4\xbc0@m\xcf^\xd2\xe0\x03\x84\x8ak`0A\xc3ܹVRz\xe7\xb04\xa0`\x93,$8\x19\x93)\xbd\xd9)\xa00Ef\x8a\x80\xa7\xfb͘\xc1\x9d\xf9\x00\v|\x18\x1dV\x1d\b\xc3D\xad\xeb\xf8\x14\n\xa1\xd1D\xec\x0f\xbf\xf9?Ϸ?\xe4\v\x94\x859\x84\xd3>X\x82\xf0nΓy;\xdf\xc0\x17\xd4f\xad\x889\xb6F9%7\xac\xdd\x12\xf1ď\x8f\xfc\x97\xcb*\x06E\x8d\xbe%\xf65\xf9j?\x90\xbf\xe6X\x9d\x8f\xf0\v\f\x18ٰ7W\u05ff\xfd|\xfe\x97\x8b\x9fGp\xc1\x92y\x8b(\x17\xc0\xe8ܒ\x17M\xebW\xe6lI\xed\xa9\n\xc1\x7f/\xb0\xdcX\xbd\xa8\xef\xf3\xb2\xc2\xe0{\xd1\r\xc3\xeb\a\xed\x14\xc9Q\xe8\xe0\x05\xfa\x99k\xfb\xa0WK\x85\\\r\xde\xe7\x92\xca?J.z\xc1\x15\x02\x82\xaf\xe6RS\xdcJk\xa2\f\xccQ!\xcc\xf8\xd2\xd3ɒܸ\x87#\xb3\xb4\x02\x15[\x15\xa6l/E\xb1l\"\v\xbf\xb5!\x9a\x02\riw]ᢇ8\xb7{\xda\x16\x1a\xb5\x1f\xbe|R\xd8fi\xb9\xe2\v\xa6x\xb6j\x0f\x92\xc2\xd7+Y\xe5\xe1V>\xabK\xef6\v\u07fc\xbb\xb8\x86\xabw7\x90+\xdb֓\x02Z㿃\x9c*\xb9\x80\t\xd2\x02\x95\v\x9e\x8e\xe0\\\xac,!g\xcb=\xa3\fJ\xbc\xa1ݩ\xb8T\x82\xcb3\xc1\xc9W#\xfb>\x01\x96\xa6ʷDT\xc3˓\xadC6e\xe6\x82O<ϑک\xb7d \xf2\x8cM\x00\xd4kM\x01\xeb\xc3Ccb\xbd¼|`\xbc\x1f\x97HF*\x91\xb6Kh\x8d!\xe9_\xd6\xd6\xca\xde\xf3$@\xeb\x1b\x8e\x83\xd2uk\xeci\xe2\x93*aU\xcak/\xb8\xe1F\xb9\xad\xba\x1cW\xe2XFԶ\xc2\x1f@\x940\x01\xb4o\xe2i\xa9;eǈ\x01|\x05\xdf\xc2=|\x1b@\x91\xd2]\xdf\xf8-Ul<\x11\x1eQT\xd9\xee\xcbq\xe4:\xff\x95\xcc\x18Q\x82\xcb1\xad\xf2\x84\a\x9dq\xa1\x05\xc6{\x83\x8a2\x1bNb\xfcy\x19\x91\xb1\xa5)|\x92bO\x03\xb3ى:\xf8*7\xfd\x01\x14\xeb$\xec\x1e\xc1\x0f y\x0f\xdfZ\xbc\xcd7v\x88\x84\x94\xber\xe6\x8c\xeb&\\\f9\xf1e*\xe5\x86\x053ɼ9\xacI\xabD[\x88 \xb5\xafM\x9c\x86T\xda\x0e\xa9\x94\xa9\xb4\f\xfd\x9cT7\f>\xbb&\xa9\xdb\x12\x15cJ7\xd2\xfa69\xe9\xe2r\xca\t\x06!\x95\x9d\xd1w\x1b\x06\x9a\xb2\x13٠\x1dÃ\xfb\x06W\xa5\bk\xfe\xd2\x1c\xcc'[\x980A:\xa6p\x8a\x8a\xea\xf5AG\xca&+\x8b\x98\xe4\t\xeag\xb5\x82\xb9\x92F&2\v\x91-\x1b5\x9eQ\x057N0\xc7n\f\xb4\xd3v\xd5\xea\xb7\xc1\x82\xf9\xefo\xc6\x03\x1aҀ:0\\\xbf\xbe\x19\xaf\x01\x1e\x02h\x9eܼ\x1e\x9f<㚄U\xa7\x86M\xf08\xf6\xddb\fk)\xe8=Ce+\f\xe8\xbcV\x02\xa4\x1d\xccp\xc1\xf2\xe1-\xae\xbcb\xdep.\x05\xf1h{\xd0\xe5\xe4\x17,\xefLE!K\xf9'\xd4L\xc1Y\xa9f\\\xbb\xbb*,\xe4ҳ\x9adw{\x15u\x14i.\xb90zW\xab\x05/\xb2\xdb[\xc6c\xab\x85c\xab\x85c\xab\x85c\xab\x85\xd8V\v\xff\xc3\xde\xf76\xc7m#y\xbf\x9fO\x81rm=\x92\x9eh\xc6Nj\xebjWoR\x8a\xffdU\xb1\x15\x95d;\xb7\xe5\xf8R\x18\x123\x83\x13\x87\xe0\x11\xa4\xe4\xb9\xcb}\xf7\xabn4@r\x86\xc3\x19`dٛ NUb\x89l\x02\x8d\xeeF\xa3\xd1\xfd\xeb}ba\x11j!B-D\xa8\x85\b\xb5\x10\xa1\x16\"\xd4B\x84Z\x88P\v\x11j!B-D\xa8\x85\b\xb5\x10\xa1\x16\"\xd4B\x84Z\x88P\v\x11j!B-D\xa8\x85\b\xb5\x10\xa1\x16\"\xd4B\x84Z\x88P\v\x11j!B-D\xa8\x85\b\xb5\x10\xa1\x16\"\xd4B\x84Z\x88P\v\x11j!B-D\xa8\x85\b\xb5\x10\xa1\x16\"\xd4B\x84Z\x88P\v\x11j\xe1\xeb\x80Z(\x85Vu\x99\xf8\x9d\x83\xbbB\xf6\\-\vh\x98vmI9gك$3\xb0=R\xb7\x0e)\x8f܉0Q\xf9L\xce\xc9\xd1{\xba\xe49\x9f\x8b\xb1\xe3\xcf؍K?=\x1a}\xfeHC&\x97\xd2\x0fd\x01\xfe4\x88\x05W\aD8\x02\x0fԇ\x1e\xa7\x0f<L\x17\xbc\x82*\xdc3\xf6\x1fǿ~\xf3\xfb\xf8\xe4\xfb\xe3\xe3\x0f\xcf\xc6\x7f\xff\xf8\xcd\xf1\xaf\x13\xfc\x9f\xff\x7f\xf2\xfd\xc9\xef\xf6/ߜ\x9c\x1c\x1f\x7f\xf8\xe9͏o\xaf^~\x94'\xbf\x7f\xc8\xeb\xe5\xad\xf9\xdb\xef\xc7\x1f\xc4ˏ{\x1299\xf9\xfe/\xa3/|8\xed\xea\xe3k\x94\x1c\xfa\xe1\x94\x1c\xb7%\xff\x04\x06\xd6{\xa4|\xa9\xea\x1c\xe1:\x12Rs\xa7\x11&\r\xcbW)\xbf\x1a\xc5\f6\x996\x1c t\xd4Ϩ\x9f\xfe\xfayM\xb2\xd3\xd5P\xef1.\xc9e\x1a\xd0Po\x9av\xe3ƒx7N\xa9\x99Z\xca\n\x8e\xd3!e\xc6- \x15\xec\xfe\xd9\x0eQ\x1b[\xe5M\x12k\xe98V\xb7\xb4\n4\xecEHzʔ=\xfbz\x93\x86\xa0i\xde\xdcS\xa030N\xc5L\xe6\"5\xee\xe9\x9f\xcf\xde\x05\xbd\x06}\"KY\xad\xa0\xa8R|\xf2\n\xecw\xf5\xe5\xa6K\b\xf2\xb9e\x1e\xa04v@L!e\xdb1\x98\x98IM\x96\xbd(B\xb1|\x9dc<\v5F\x8b\nb-\xc2\x1c\xc35\xe8\xe4\xda\xe0G!\xa1\x17$\t\x9ay\xc73\xc0_j\xa8_\xa9t\xed\x03\x93\xd1\xc3\vf\xc5\xf5m#\x95b\f\xbd.\x1cߞZ\xb6\xa2\x83,>U\x8f\xe2\x1d\xa3\xebqU\xca;\x99\x89\xb9x\xa9\x13\x9e\xa1\xa6\x9e\x1dd\x99ϷP\xf5$\n5\x97yU\xaaLC\x04\x15,\x11\x80>\x98\x98/\x82,\xccy@R\xf6\x12\x92f\n;8\x90^\x9e3p\xf4\n^\x82T\xd8\x18\xa57a\b9\xb1\xa9R\x19ULf\xabf\xfc2\xec\n*W\xbf\xe5\xe2\xfe7\x18\xadf\xb3\x8c\xcf]h\x12j%\x02\xd3D\x1bU\xb5Se\x0f\xb6`\x10\xe6/k\xc1xv\xcfW\xba\t|\xbbo\x06P<cߞ\xa0}\xe0\x9a\xb91\xa6\xec\xbb\x13̰z~~\xf5\xdb\xcd?o~;\x7f\xf1\xe6\xe22̎Ú\t\xcf;\xff\x84\x17|*3\x19\xe2xv\x94\x05\x12\xea\xdb\xc4`7\xe7i\xfa4-\x95\x7f\xc9\x12\xf2\xdbޅ8\x9e\xebâKmD8\x14\xbbYg\xc0\xde$\xe7%\xcf+\x17\xf4n\x86\tk\f\x011_\xcd\v\xb5}t\x8e\xf0\x7fim\x05\xcfS\b\xe1\x1fĒ\x87\xab\x85yn\x87\xb1j\x00邨2v\xf5\xf3\xcdſw\xe6\x85~O\x10\xb5\x83\x0e<\x87%\xe8\x83\"\x1d\xbc\xc6\xd7\x06\xbf\"\xae\xf2\u05f9ʁ\xfe8k\xfc\x80\xc3r\x12\xaf\xeb\xbce\xc7dޢ\xebI\x96\xb1\xa5J\xc5\x04.\x8d\xc0\xcd\x11\xbaK\xad\xf9\x8a\xbf\xf8\xc1\x953\x90̡O]\xb6j{\u0095BL\x06o\x92*ߒ\xbb>\xe3\x99\x16\x93Gۍ\xc1\x91y\x03\xc7\xf7\x83V\xd1Qa\xa9\xc8UE\x11\xbf m\x00\xf4\xbfR%\xcc\xc4\x14Z\xc5\x02\x9d\x1d/\xc8\xc9l6c\xa9-ϯ\xdc\xc8\xf1\x86ɛ*`\xe6\xf6o\xc6\xf6c\xfe\xe2\x06\x19\xaa\x80\t\x84\x982АV\xe3}\xea\x92\xeb[\x91b\xd9T\xa8\x8fM\xd1\x15\xb3<n\xeaoW\x85\b\xbeOE\xdf\xdad\xff\xe2=\xaf\x7f46\xd8\xf6\x01\x8f~γյR\xd5+\acr\x90 \xffB\xa7\xa5\xee=\x90'E\x86\xee5\xa6\x8b\xa6c\\D0\x11\x1d\xa4\x15\x92>o\xc2R?\xb6\x81(\xeb\xfc\\\xffX\xaa\xba8\x88\xb1\xe0\xac\xffx\xf1\x02\xbcb8\x90\x80\xfc\x89\xbc*W\bM\xe5I\x98m\x82\xab\xbb\xf3\xd8;\xcai\nʶq\xe6\xc1^׳7|\xc5x\xa6\x15\x1d\x1c\xbd)ʼ/B\xc2(T\x13R\x19=U\xd5b=\xa6\x83\xe6a\xf3;\xfeȡM\x82\x8d\x8bd\xc2.\xbaFן,\xbf\x15\x1a\xc0\xbb\x13\x91\x8a<\x11\x93\xf0\xbb\xecGL\x83@ɿT9\x98\x97\x83d\xff\xc2\xe6\xff@Ĥ\xeaJ\xee(\b\x84\x93\xce\xf4\x1c\xf3\x95и\xd4\x1a\xae\xab/f\xd8\xc4+l\xe1\x7f\xaa\xa7\"\x13\x95\t\x94 \xc8-\xa4C\xc2o\xe4\x92\xcf\xfd\xb5\x89Wn+\x04\xa4\xad\\ץ\xa0\xa09\xf4u\t8\x06\xe4\xcaM\xfd\xdd\xc5\v\xf6\x8c\x1d\xc3\xdcOP\xfc!\xe12\x04\xf5\x05\x1bm\xaeY\x139\xb3C\x04\x96z\x93D\xdb\x01\x98\x99h\xaaOY\xae\xa0\x1afay\x1a\x12\x1d\xb2\xc1+\xaa\x90\x12i4M_\x87i:pc}\xa7Ey\xf0\xbe\xfa\xee\x11\xf6\xd5\x17\xa1ά\xf1\xe0\xcb\ueaa1AaKQ\xf1\x94Wܛ\xa6I\xa7\xb3\x047T!Dv\x87U\x01Eۛ\xe6\x9fL\x15\xbe\xcc.\xad\xc5k\x99ןLu\x80>X\x97n^\"9FWI!;\n\x94\x8f\x14E\x06\xabR\xa9\xae>\xc1v\xd2\x16ݰ\xb5o\xd4\xd3\uebf8=\xc0\x8d\x14\xa4\x19{\xd3\xe4Ь4Uˍ\xc9\xc3AT\xf0\x80Sqk\xc2=ʹMټ?\xd3R\xce?\x9b\xb2\x1d\x12\xba\xcfĝ\b@)_Ӗ\xd7@\x05\xf2\x1f\xac\xd4 \xd9\x00\xaa\x8ce|*2\xe3\x1a\x1a\xcdqHi\x8d \x8d\x1e9\xa8Z\xaa\xecpȋk\x95aa0wL\x02\xb2\x7f\x18\x1e\xe1ˇ\xf2\xe8\xed\xaaX\xe3Qp\x14\xfdk\xe4Q\x1d\xe0\xe1m\xf0\b\xdc\xc4.\x8f\x80\xec\x1f\x84G\xc1W\x10Z$\x90pvU\xaa\x99\xf4W֮\x10B\xcb5C\xaeI\xce\xf1\xdf\xfak-\xfa\xb2\xc8\xf1H\x85Ľ)\xda\xc1\xf0\xb2U\xf4\xc4+\xb3\xe7Q\x15\x977\xd1\xff\xd7\f\xceX\xedӮ\x00X\x16\x04\x97jّYB\x8f\xba\xbb\xa9\x84g\xd0\xf8'P.6dc\x9d\xe0\x01\xf5\\\xd4؎\xe8\u061c>lɂ?\t\x88\fX\x1f%W\xa9\xa0\f\xb2\xa6\x00\x0f<Z\xfaZ\x10a[\x16\a~\x8aM\xbeJm-7|1l\xb8\x8a\xa0\xb2-(\a\xc7\x1dA\xe4i\x88\x81\xa5\xc4\xde\xc5)+\x05\xe4\xde\xdc\tkР\xf6&\x13\xd5Q\xd8:\xb5&l-\x03\xb1\x12%\x02\xd42\xc4P\x12\x14\t^\vX\x8fx\x86[\f\x18\xf8'\xaf\xad\xb0=yd+L/\x1f\xaa,O\x80J\xa3!\x81\xb7j\xf0\xef\xad\xccS\xaa\x1b\xeb0\x9fBaA4\xe9\\\x86U\x9f\xd2Y'\xc6Kq\xc6~\r\xd3=\xb7`l\xbc\xa9\xdaA\x14\xdb\xe6\xa0G\xb5\x83h\x1aspm\x8e\x8b\x14\xcba\xe3\xae\xd5\x0f\"\xbcv\xd9\xe9\x18\x10\x90\xcbj\xff8\xeb\xf5.G\x1d\x04\x139\x86 *\xd1\x0e\"\xdaXF+\x03O\x1eW\xbflb\xbb\xefv4\x0eI*\tv\xa9\xeee\x9e\xaa{\xfdPє_\f9{tN\xc0\xdcU2\x9f\xebQ\xa0\xe6\x82i\x87&\bNh\xf5ÄT\xac%p}R7C\a\xdet\xc9P\x910_̆\xc2\x15\xdeķ\x847\x9ap\x857š\xf0\x86\x89\rz\x93\xfc2\xe1\x8d\xf9R\xf3\xe7%|\xb7\x92<\xbb)Dr\xf0\xae\xf6㛛\xf3.\xc9\x00\x8a\f6\xf8{\xec\t\r\xab\x044\x19O\x97Rk\x80\xf5\xb8\x17ӅR\xb7At\x8fm\xb5\xf1\\V\x8bz:IԲ\x95E?\xd6r\xae\x9f\x92f\x8f\x81;aMNd\x9e٪\a\xdc4\x04\xf4\x94\xa2\x1b\x03\x98L\x10\xd1\xc4q\x15\x8d\x04\xc2\x0e\xb9\x04\xd7M\xb6_\x86\x82Ta\xc5£\xbbT\x9b\xa2x\x19\b(\xbeC\x1c\x83\xf9B\xe82-\xb4'\xa4\xdeZ\x97 \xb2\xb8\x96\xe6\xea\xe7љNG5\xb8\xb7:\x98\xd3\xffhh\xb1T\x18p\x88\xc0s\x9f\x9cu\x1az7\x0e\x89\xb9\xd1\x0e\xa2\xc9\xd9\x11\x8c\xd0\xe6<\x1e5\xf4\x03q<\x9c\xaa\x80\xad\xe2Y\xb1\xe0c\f\x10`8\x1d6\xb4 \x8a\xf6\xb0\xb3P\xb9\x82\x03\xe4\x14\xea;\x96\x85\xca\x03z~\x93\x80@\xfc\xca䛱\xaaq4Z\xcb\xe5:\xe9\x052\xc1\xa4\xc3a\xe9\bb\x03\x81ۂ\xadn\x0f\x80\xa9\x872-lߴp\xf9vMmJ\x10\xc5Rh\xf0\xbae\xceDY\xaa\x92\xeaFl\xa2A>\x0f\x0e'\\)h\x8e\x9fe`\x148\\\xa4\x1c\xb5\"Za,m\xda\xc7\u008ai\xb08b6\x13\t\x1e\xd9[+\x17D\xdc܇\x1e7\xfd\xc6\xe06\xec\xde\\\xc1-x\x00\x98\x0f\xfc\xcb\xd9R~\x02\x0e\xb4Fw(\x17l_\xac~\x92'p\xeb\x1cv\x10\xb5\x85ݧLv\aL\x95EAD+(\x8biw\xa6\xc6E\xa4\xeb\xbc \x8apg\a\xf1\x99\xb2>`g\bɷ\xe8\xe4\\<\xc86\f'\x1cK\f\x1c{2B\x01dY\x7f\xfe\x86ݑ\x9d|\x04\x91\xde\xc8\xe1\xb0\xf1\xb1\xe0;\x84\x81\\\x0e&\xfd\xafq)g\xeaA\xf39\xb6\xe5t\\\xcc\x0e\xa1\xf8Yo\x9a?\xe3m\xf3C\xdc8\x7f\x99[\x9e\xa0\xd7\b\xd1\xf9\xc06\xbf7-*\xad\x88&\\/\x8e\x02\xb6SL\noP\xb1\xb3\x95E\xe3\x97\xff\xed\x9b3\xdfm?\x0fpn\x98\xb4ނ\xba\xa7\xbe\xa6~n\n\x84\xf22{y\x05\xf0\x03\x95\xe8\x8e\xd8;\x1b\x12i\xb5\xfa\r\x9f:f\xd8\xe0H)\b\xe8\xdfO_\xfe\x13\xb7!\xd7\xd2\xd8\xe2y_\xb9O\x894\xc0\x03\xa6\xf6\xf3\x10\xb0\x01\x1bI\xf7m,\x95\xb3\x99\xb0\x15Ξ\xdb^\xc1K\xbe\x84\x83\x83f\x94\xfa;\x15si\xcaL\x9dk\xe5yC\xe1@\xc2N\x8d\xbb'+\xb6\x94\xf3\x85\x89\xd20\x8eP\x94\xfep\x93\x95b\x00F\xc6 #\x0f\x92W\xefy\xb9\x84\x13\vO\x16\x02֍\xe7\x80A\xea\xab\xf8\xd8In5\x86F\xa3\x10e\x13\x06R¬\rT\xa2CJ\xaf'Kc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9\xd8|:6\x9f\x8eͧc\xf3\xe9?_\xf3i]\xa52?\x1b\x05\nX\x7f\xb7\x00J\xa2\xf6 \xca\x1cv'\x18\xb2\x1a\xaa\r@\xfb\xcc\xe8\xacs\xe4\xe8\x8f\x02\xf0Y\x9a\xad\x9b2b\xb1Q 4(0\x98\x17^4\xfb\x87eAH\xb1}\x99\xa9K\xf5\xa2*s\xf6\xf2\xe7WN\xa3\x82Z\x1d\x84U\a\xe2|~\xce\x13\xf1\x00\x82\xd0f\b\xf1~\x14\x80S\x93dJS\x9d,\f\x8e%\v\x9e\xe7\"#\xa7[\xfaq\x16n4\xa6B\xe4P\x7f\x01`:\xd3\x15\xe3L\xcb|\x9e\tƫ\x8a'\x8b\t\xfbe!\xf2\x10!\xa0\xaeu\xcdH5\xe4\xe4.\x8d0\x94b\xe9\xdbg\x10\x86\xc8xR*\xadٲ\xce*Y\xb8A2-\xb4\xf6G\x93\xbb\x985\v\fB\xd5*@=u\xb3\xf0\x1e\xa3\x81Ak\xd6\x1a㸧@_,\x8bj\xc5`\xe9\xfd\xbc#`\xe1L\x96\xbabI&\xa1\xd8\xc8,\r\xa4B*3\xceS\xe6\x9b\x1b\x8f\xe5\xbbf\x154\xb16O1]\xa1\xa8\xb4\xa9\xf4\t\x1b(\r1\x95\x9a\xa2o\xfa\x14\xea\x9bh\xa3\xf4\x16z+K(\xf6ց3\xa3\xa6\x1f\x05\x0eӭ\x8f\xd4M\xa9Yc\f\xa1\xf8~\x14\xd2\x7f崃\xe5М\x0f1\xc9\x1dͪ\x17Y0\xc1\xc4\x05T\x9c\\\xdcA#!\x91\b\xa8\x8d\xe7\xc62zQ\\\xb7\xa2\x9f݈\xb6|\xd77Bk>\x17W\x9e)6\xdb\x02\xc4@\xa7%\\\x9e\a.\x04R\xabT\xf3v\xb3nG\xdd\x13\xa8\x17٥\x99\xa3;sޗО\x1a\r\"v\xae\x02\xbf;\xafT\xb8\xc4\x1e\xad\x95\xc7\x10S퇼\bK\xe8\x85V\x89\x1c\xba-\x9a\xd4\xc8i)Ō\xcd$\x84\xb4\xa06\xaf\xd6~\x05G\xd8\xcf\x02:\x90\x00t\x89\x86\xab\x04\x95۰\x93卟\xc0\xfeB\x8c\xac\xca:\a\x14s\a\x02\x040\x93p\x86\x99\x97\x82\xfb:\xefX\xb5\xf8\xd7g\x7f\xff76]\x81\x17\x8cy\x90\x95\xaaxf\a\xc92\x91\xcf=\xb1\xfdi{\xea\xe2\x909IȠ\xa1\xb8gX\xa8R\xec\xdb\xefn\xa7\xcdq\x02l\xfe\xd3T\xdc=m\xc9\xe78Ss?\x9e>\xb7\xf5\x95\xaef\xf2h\xf4\x99/3z̀\xcad\xb2\n6\x04\xb6y\x0e[\xa8{\x94\x87\xd6\x17\x824\x96<\xac)Ġ\x8a:\x03Q\x9b\xb0W\x16Yҋd\xad\xc5&\x1a\xd6&\x03\xb8\xa7|U\xca\r\xadk\x13l\xc9\x14Mŋ\xa8\"\xe09\xba\x1a\xc7=\xd6ŉ_\xf1,\x9b\xf2\xe4\xf6\xadz\xad\xe6\xfa\xe7\xfc%\x80\xc9x\x91G\xe9\xb7\xfc\xc88x1\x8b:\xbf\x05\x8e4\xc3ϔ\xdfn\xabꪨ+[\xe4\xddZx\xb7\x98\xdex\x90\xceA\xb3\x91\xe1ft\xe2\x13\xe8-\x86g\xbdHr\x02\xdf1\xa1\xb7L\xcdݸ\xb55\x06\xbe\x15A\xdf=\xfb\xebߌɂ۰\xbf=ÒQ\r\xe5\xde2Y\xa0o\x00\x8e\xec\x92g\x99(\x83\xfc\x02t*A\xe8'=F\xe2\xb3ۈj\xf5\x00'\xad\a<r\xbf}\xfbO<o\xcbJ\x8blvj\xdaU\xd8\b\xa2\x17\xd1#t\xe2\x8eh\x97\x85\xa3ї8\xd0ީ\xac\x06\x98\xd7;\x99\b\x1d\xcc\xea\x0e\x15{\x13\x94I\x00/\xf6C\x81\x98f*\xb9e)\x11j\xd5f\xd0\x0e\xef\x96q2\xfa\xacU([gG\xf3\x9e\xc2\x05\x8f\x17EƖ\xbc(\x1c\x96C\xc9\xef;\x93E[\xe2]\x80\xc2\xc3\x18rHV\x87Y\x1b_\x87\xbd\x87\xab\r!+0\x85\xef\xeeGˋE\x9a\x94\x03\xd0Rt\xdbA/\x80\xa4[\x13\xe3h\xc2ʡ?\xec\xc7\xe4`\xabwHMO\x87ǹ\xcb\x15X\xf2\x8a\xce4\x81\xf93(\xb5\x85(\xb5ԕȫ\xf7\xa8\x13\xcf3.\x97\x14\xde\v\xa0\x19Ґ \x98\xa1ay\t\xe3\x96\xc0{\xbe\xe8\xcd\xe8\xc0d\x86\x90\xda\x16c\xb0\xb1\xa5\xaf\x97\x05\xe8H\x17\x80\xf3\x18B\xe8#\xe0a\x16N\x8f\xfe\xf9TNi\xd7N\xb2\a9\x1c\x87\x9a\xfd\xf7\r\x8f\xe8\x17h\xf5M\xbbi\x7fuF\x0524\xc9ط\x03C\x8fe\xbeq\xf0\x0f`\xbd\x81\x84\x9dF\xc7\xecz\x93e\x9d\x80\r\t\x94\rnO\x85\x8d\x91LL7\x84\x00\xf2\xe0\xb2\xd2\xf0\xd8\xd1ّ\x1f\xa7\x0f29\x96ݥ*8\xdcի\xfc@\xae\xaf\x93;\fh\x16\x8e\xc9H\xd1\xf5\x8cA\xba\"u\xd8\xe6ADuE\xa9\x96\xb4\x0f\xdb\xe3\x13\"\x8f\x05P\xbc\x87\xaep\xa5\xaa\xe1\xf6\x13\xee\x1e\x9aK\xa97k\xec\xb8T\xb9\bq 4偼u\x98\xad\xe0\x92`\x9a\x80\xccٷ\x93o\x9f\xfd\xabm\xfc8\x93\xb5\x8d?\x10\xf8\xb9e\xb7\x1e\x95\v\xb6e\xfb\x81\x9cxC!֦\xc3z\x10\xec$\x9cϠm\fO\xc7\x10V%i\xbe\x97Z\xb0cߨ\xb9\xfdG\x95m,˓nH\xcf\xfb\xfcw\xc8)\xd0Fj\xa7\x9fag0\x06ݛ&\xddt\xf4\xc5\xe2u8͞m\xa5\xcd\xf4'!\x9d>\x8e\xcdh\x8e\f\xea\xd5ɣ*\t-\xd9\xcbOEyಽ\xfcTp\x8c\xfa\x17\xcd\xfa\x8d\x02QI\x91\x1f\x03\xeb\x17@w\xbb[\xf0\x83\x00\xd0\xe6\x90\xfdO˥\xccx\x99ajٍ\xe1$\x9bր\x16~'K\x95\aU_\x00\xea@)\x11m\xbc\x14\x88\x05\t!\x91\xbf\x1c\xbf?\xbf\xc6\f\xed\x10\xe0.؝\x85]\x9f\x1a\xae\xe3\x1f\x80\xa3\xadI\xae+A#\xd2\x01t\x8d\x12X~\x82db\x00\xd9\xf2\x97\a\xa4*\x01 xU\xf3\f\x01ے\xac\xd6\xf2N<\xa2\x9a\x85\x9e\x1c\x9d\xaf\xfd\a:8\x12d\xe0\v\xe9eo:\x96\xc6\xc1\xed\x1f\xe9M\x04B\xbfe\xbd\x98\x19g\xd0\ue867\xfdi5\x9erL\x95A.\xfc\x03\xce!\x05\xd4\t=u*Z=\u07fch\xaf\x1f\x97\f&\xf6\xe3\x87\xd6}e\xdaK*\xbd\xe5\xd1O\x12)\xef\xf3l\xe4-zo͛\xd4s\xcdD\x1d\x97\xfc\x13VGrT\u05fdh2\f6B/\xb3\xf7\"\x13\xa5\xb2\xdb\xd2=\x97\x95\xab7\x05\xc8f\xef\xce\x12xp2xʓу/\xfd\xde\xeb\xb2烻\x97m\x97\x98\r\x8a\xd5\xceQ\f}\x7f\xe0e\x99'Y\x9d\x8a\xe7Y\xad+Q^\v\xad\xea\xb2\xf7\xf6\xa3#;\x17\xfdo9\xe3\x83\r5\xe0\x88\xcb`\x87\xaaD9։*z\xcdCټ\xec\xfc\x19\x1aTj\x01' \xa6\xddTҀ\xa0BR\x92*\xc5\x16d\xed\xbcβ\xb5\xa2\xc6\u07be\t\xf0\x1cx'[j\xbb\x86\xce\x0fv\x88p\x90\xd4\x05ߛe\xad\x17\xe0\\͙\xce\xe0\xc6C\xcdp\xf1\x91\x92\xf9?\x185}d\x830\xa3\xb54I\xa8\xc0\x04s;\vWpYC\xc8\"( \x91\x1e#\xba5(8\xa8H{1\xadO\x0e\xed@<\x85\xacy~\x8daVr\xf6\xe1צش9\xd6\xc8 =\a\x97\xfau\xf1u\xb1\x0f\xbbt߈\f}\x83\x1d\xac{\xdd~ְm)*~\xf7\xed\xa4\xfb\x9bJA\x88\x19\nҶ\\\xdfc-\x97Q6\xf0\xb4\x01\xce\xffN\xa65\xcf:\x12\xd8\xe2Y\xc3Z\xb8\x82\xcfe֗ ų\xe6\xfd\x0e\x8f]\xc1\xe0ėo\xc3Q`\xbc\xf1\x01\xf7\x9bRa\xfb\x9eYc\xe1\xfa+\x86\x8bt\x8fK\xed\xc0\xb5\xe5#\x99v8$mM\xb3}\xbb\x10\x9d\xe7P\xba\xce/_lso\xb6\x8a\xd7\xc6P\xcf\a\x86C:c\x7f3\u0605\x81\x1c1\xaa\xf9\x82\xd4Tv+V\x98>\v\x19k\xc0`n\x89\x98\xae\xc1T\xdfu+V\xa3^\x8aԸ\xc7Л\x8c\xc2\x03\xf8\xb7b0\xf6\xd5aǭX\xb9kw\xe4\v\xfc\xc0^\x806\xac0\xad1\x87\x9d\x91\xe1[\xceA=\xb7\x7f,\xd7\xf6\x1e\xbecs)@^\x8d\xa8\xc0B@P\x05\x98\x0eҸ\x90Ů\xe4\x18Xu\xc89\xa0\xd5l\x9a\xf7\x1a\xf2F\xf3.\xf2Sv\xa9*\xf8\xcf\xcbOR\xef(\xc8\x01Ax\xa1\x84\xbeT\x15>}0s\xcc\xd0\xf6f\x8dy\x1c\x16\x97\xe7\xe6\xac\x06\xf33\xdfpӼ\xd8]\xff\xeeX,5\xbb\xc8\xc1P\x11\x0f\\\xb1\xa2&\xf2\xed\x1aC\xdc0\x86\xa6\x8cg0 Ѧ\x8f\x8c\xd2\xf0\x8d6\xe7ڟ\x1a\xa4\xd8\x1d\x86\x19\x02\x96\xfb\xd1\x001A\xbb\xc8x\"R\xea3\xc18\x9c~x%\xe6r\xb8\xfd\xc0R\x94sL4H\x16C\xb3\x1a\xb4C\x1ek=\xb4\xb7\xd9\x7fv\xbb\xc8\xdbM\xcdر\xfds\xb8д\x87\xe0\xf6\xb9\x85\x1b\xb6\x93\x18ϮvZ\xb4\x9d\x1c\xeb\xc8}\xebӴ\x99\xf3\x02$\xff\x7f\xc0<\xa3\x10\xfd/+\xb8,\xf5\x84\x9dS\x85ʖ\xef\xb6\xdf _\xa7M|\xc9\v\xf8\x00\xac\xc2\x1d\xcf`\xfb\x00\x98Ɯ\x89A\xf8\x155\xdb\xd8`!D\x00\xa58`z\xdd%ғ[\xb1zrJ\x8d\x83\a\x97\n\x1e\xbeȟ\x9c\xbaB\xf4\x8eR\xba}\n\x1b$>\xc1\xdf=\x99ll\xb0[h\xef\xd8v\a\xa5d\xe0\x97\xce\xeb~cR\x9b\xceF\xa1\xf21(\x1b\x1d\xb9\xb8\\\xfbfG8\xda\xceq\xe7X\xd1\xf7I^\xceE\xd5\xf3\xac\xf5\x981\x95a\xc2\xce\xf3\xd5\x06],\x8c\xeb\xa1i\x9d\xbaF\xce\n\x17E\"\xaa&ٿM\x8a\x12\x97t\xffA\x18\x1e\x9c\xf8,J\xa1R\x93epm>\xf8F\xa5\xe2l\x98\xa7W=\xaf\xb4εpOl\xf3<\xe0\x94\x0056}`\xeb\xe0O#\xf3d\x82\x1b\xad\x9dq\xfb\xe8\xd1>\xa0^\xb4#$\x9bs\x14y\xbd\xdc\x1c\xf8\xb8\xfbZ\xcf\xef\xff!\xb2B\x94W=\xb9E\x03R\x06Z,\xca;q\xa9Rq\xa5\xcaJ\xefb\xd9\xfa\xf3=q\x80\x96(\xa9\f\xbaLУ\xa3-w]t\x9a\xf0=\x06\f\x1d\xd9a\tdr\xcd+\xf1\x1aҋwL\xea\xba\xfb\xf4\x1a\xa2\x01\x1d\x10aM\xa1\xba\x04Sb\xa5\xca{\x83\xab$\x05%\xe4\xa5cb3\x85Ũ\xb6J\x95\xe2H#\x86\x87\x91+\xfbC\xed=\xf9aG\x1a\xa0\x02\xa0S\xd5O\xf2\x87\xa2\xf7\x815\x06\xbch?\xcfd7@h\x89\x99Y\xa9\xd9@\xfa\xdaڬN\xc1\x1e\xfc$\x7fx\xaa'\xec\x19[\n\x9e\x83\x011\x19ߓю\xaa\xe8-\x05\xf5\xbbj\x9f\xeb\xc2g\xe2\uf2adӮ\x8b\xf5I\x931\xe8\xa5\xcaH\x8a\xbf\xc0\x9c\al\"-\xc4\xd5\xfb\x1e^t\xf8@\x06\xf0\xea\xfd\x0e}\x86Ӽ5\xf6\x1b\x14\x19\x83\xf7!L\xc5t\xce\v\xbd\x80^H\x16\x11#\xc9T\x9d\x12,Hy\xe2-\xefCʮ\x93\x85H\xebL\xf4w,\xed\xcc\xf3\xa6\xf5\xa8]\xf0:\x97\xffUw\xfb{\xdb\xf06=\xbdA\x93\xb5y\xe2\xe2r\x96s\xa9\xf1e~@\x81\xb0_\xa2}\x80(o\xa9\xa3i\x93D۳\x846\x17\xa5H\xc0=k\x10\x1bI\xd6XB\xbd}\xe8\xf1\xde\x1a];\x87\xc9\xfe\xbbB\xbfg>\xa6\xafn\xa4\xd3l\x91?S\x88s6ں\x16$s7\xf8\x1cKx\x01ݤ\xa9uX]b3\xc1\xa6\xff\x11\xb7kB,\x1a\xedg\f\xe9RA\xaa\x1c\xae@tŗ\xc5\x0e\ty\xbe\xf9\x06T\x99\xaa2\xd5\x0e&\xa9\x1d_$\xf7\xb6\xbf\xd4\xea\x9e7}\"\xd3I\x8b6\xe2c\x80X\x18\xd2\"e\xe2\x0e\xaa\xcfs\xc2Ӵ\xd47W\x8d\xa1\xef\x8b{0d\x84X:pW\x87{\r\xb6\xe4tCףm\xd6\x06.\xdbƽ\xb5\xf7{ib\xaf3\x815>z\a\x83\xb1p\x8aBl\t\\=\xe1\xf2f\x99\xa9\x10\xb2eKT'|/J\xc1\xe6\"\x87\x13D\xafšs0\xf43\xab\x81\xbe\xd5`\xcb?\xe4\x16O\xe0\x16\xdd\xf6\xff\x06\x17\u0379\xa4=$\x8d$\xdb\xfd~2\xf2\xb1\xc6T.v-\xb8V\xf9\x0eF\xbcj?K\x81\x0e\x1c\xa2\x99z\xc2qM\xa9ݱl\xfc\xca\r\xaah\x8d\xe0\xcb\x13\x9f\xc5*\x16\\\xeft\x90\xe1\x19k'\xdbJ\xe9,%)\xf1\xdeN쥸\xef\xf9)\xb0B\xa4\xef\xa9'\xbbʷ>rUZ_\xfd\x1caI7\xe5\x1b\xfc\xe4\xabR\xcd\xcb>0\xea\xb1U\xc1\x1eY\x1a\xb3+^\x02\xfav\xb6z\xd5\xdf\xf4j̶\xfcb\x88\xcbJW\xdd\x11\x1b{'\xf4.\xd6o}\xd1^\xa2\xc3\r\x86\xd1f\x90{>Uu5\xeaOL\xdaЎB\xe9jL\xe2d\x01^\x8b\xac\x9e\xcb\x1c\x82j\xd5\x11\x1d\xb7]\xf5g\x0f]Sq\b.A9H\x8f\x95b\x0e\xa5\x13e\xdf!|k\xbc\xa9\xc3\n3\x7f\xda'ڶ\xb83/ΊR<\x85\x91\xd0F\xd5C\x16s\x0f{ǹ9\xb6]>\xf6\x9e\x9bK\xcf|\x9eo\xbe\u05ff\xc5\x18\x16n!龿-\xba\xb1\x8f\xad\xdf\xcb\xe2\xef\x14r\x1bcĒ۽X@u\xbfִ\x18\xb3W\x8a\xaa.\t}\xa5\x99\xfe\xe9P\xe2*\xe4\x1d\xa0\xb2NBG\xbd\xc5\f\xf6\x8cy\xb714\x12\xb5m(\xfd&q\x0f\xab\xb5\xdbv\xed\xb0O\xfb\xb2\x02ŭߋ\xee\xe3\x87{\xdc2\xa5\xedB7jOt\x83WHw<\x9a\xbd\x86\xd6u\x82\x86\x94\x8b\xdd\xf3m\f\xa7/\x7f\x1d\xea5p\xc8\xdb\xf9\x81\xed\xb1\xee\xa2\x14\xfd{\xcc\xd9h\x90\xbfW\xdb\xde{\x88\xbd\xa9\x14\x0f\xba5\x95\"\xeeLqg\x8a;Sܙ\xe2\xce\xf4\xaf\xb43\x19g\xe8l4\xc8N+\x99\x03\xfb\x0e\xad\xb9\r\xbe;˼A\xb8\xf9\xe8\x04\xf2$\x84\xcd#\x91]\xa2X!\xa7\xab\xb1\x98\xcdTY\x99\x83\xd2x\f\x9b\x8e\x89P\xf5\xd0\x05C\x807l&\xc7\x19l\x83\xbb\xbf\xb76\x1fb7<_A\xa9\x95V9vf_\xf2\x15$\x02Ȝ'I\r\x01\x90\xa7\xba\xe2}\x91\xbc\x1d\\\x1e\xde-p\xa7\xa3\xdd|\xcbe|\x87\xe5\x17\xed\xe7\x9d~9\x18Y$gX\a\xf5)`y\xb0\x82\xa1\x9703\xa0\x8bă\x94i\xa8\xffڌp\uf2ba\xc0\x1f\x84\xac\xbaضk\xaf\xcd\xe1\xad{\xd8N\x00_ߜ\x86j\xdf`n\xd36LѠWa\xcd\x00\x89r\x0e\xe2S\xaaz\xbe\xb0\"\xb8-D\xb5\x85h\n\x90\x91\x8al\x01E\xc3\xcc6Ժ\\\xa6̬\xb4\x19\xee\x10\xd1a\x16\x0e\xe8\xf1.;\xe7m\xe1\xf6\x8d\x9dZ3\xf7\x15\xc7<\xef\\\xd0\xea\xe5>\xd1\xcf&\xc6Վ\x83\xba<W\xb8_i(R\xc4r\x83\"c\xc7rf\x92\xda\x12\xd0\xfb\x93\xfd\xdd\xd7Ac\x1el\xad\xefy\x99\xcb|\xbek\xf2\xbf\xd0c=\xc1_\xa2\xd0\x13\xfe\xdd ɚ\x80\xb05\xa3{\x85\x7f\xed \xb7\x94bY\x83\x96\x1f\x10\x00\xeeա\x8d\x1fb\xf0>m1\x99\xbeD?i.N\f\n)%\x9e\xc3\x0f\x18\xbb\x95yzf\xeb5\x8b\xac.\x01\xfe\x11\xff\x9a\xa8\xdc\xe4\x9c\xe83\xf6\xe1\xe3\xc8N\xe8=@\x97\xa8\\\x9f\xb1\x0f\x1fG\xff7\x00\x88\x0e\xd7&D\xfe\x01\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}{s\x1b9r\xf8\xff\xfc\x14]\xfc\xfd\xaalgEz7\xb9ʃ\xffliem\xa2Z\xaf풴N%>'\x05\xcd4ID3\xc0\x04\xc0P\xe2f\xf3\xddS\x8dǼ8\xc3\xc1\xd0\xf2\xddޕ8\xaa\xb29\x04z\xfa\x85F\xa3\xbb\a\x98-\x16\x8b\x19+\xf8GT\x9aK\xb1\x02Vp|4(\xe8\x9b^\xde\xff\xa3^r\xf9z\xf7\xdd잋t\x05\x17\xa562\xbfF-K\x95\xe0\x1b\\s\xc1\r\x97b\x96\xa3a)3l5\x03`BH\xc3趦\xaf\x00\x89\x14F\xc9,C\xb5ؠXޗwxW\xf2,Ee\x81\x87G\xef\xbe]\xfe\xc3\xf2\xdb\x19@\xa2\xd0v\xbf\xe59j\xc3\xf2b\x05\xa2̲\x19\x80`9\xae@'[L\xcb\f\xf5r\x87\x19*\xb9\xe4r\xa6\vL\xe8i\x1b%\xcbb\x05\xf5\x0f\xae\x93\xc7\xc4Qq\xe3\xfb\xdb[\x19\xd7\xe6\xa7\xd6\xed\xb7\\\x1b\xfbS\x91\x95\x8ae\x8d\xe7ٻ\x9a\x8bM\x991Uߟ\x01\xe8D\x16\xb8\x82w,G]\xb0\x04\xd3\x19\x80'\xcc>z\xe1Q\xdf}\xe7`$[\xcc-\xb3\xe8\x9b,P\x9c\x7f\xb8\xfa\xf8w7\xad\xdb\x00)\xeaD\xf1\x82xQ\xa3\a\\\x03\x83\x8f\x96@P^\x14`\xb6̀\xc2B\xa1Fa\xa8E\xa1p\x110L+\x90\x00RA\x81\x8a˔'\xf0\x03K\xee\xcb\xc2u\xd6[Yf)\xdc!\xa8R,\xab\x0e\x85\x92\x05*\xc3\x03\v\xdd\xd5P\x99\xc6\xdd\x0e\xc6/\x88(\xd7\nR\xd2\x15\xd4`\xb6\x18\x18\x83\xa9\xe7\x03\xc85\x98-\xd75\xfeV\xfc-\xc0@\x8d\x98\x00y\xf7_\x98\x98%ܠ\"0\x01\xebD\x8a\x1d*\xe2@\"7\x82\xffZ\xc1\xd6`\xa4}h\xc6\fz\xb9\xd6\x17\x17\x06\x95`\x19\xecXV\xe2\x190\x91B\xce\xf6\xa0\x90\x9e\x02\xa5h\xc0\xb3M\xf4\x12~\x96\n\x81\x8b\xb5\\\xc1֘B\xaf^\xbf\xdep\x13\x86J\"\xf3\xbc\x14\xdc\xec_[\xad\xe7w\xa5\x91J\xbfNq\x87\xd9k\xcd7\v\xa6\x92-7\x98\x98R\xe1kV\xf0\x85E]\x10\xc1z\x99\xa7\xff/HT\xbfh\xe1j\xf6\xa4_\xda(.6\x8d\x1f\xacB\x1f\x91\x00i\xb6S\x18\xd7\xd5\x11Z3\x9a\x8b\x8d\xe5\xce\xf5\xe5\xcdmS\x99\xb8n\x01\x05\xcf\xf7\xba\xa3\xaeE@\f\xe3b\x8d\xca\tq\xaddna\xa2H\vɅ\xb1_\x92\x8c\xa3\xe8\xb2_\x97w97$\xf7\xff.Q\x1b\x92\xd5\x12.\xac\xfd =,\x8b\x94\x19L\x97p%\xe0\x82\xe5\x98]0\x8d_]\x00\xc4i\xbd \xc6Ɖ\xa0i\xfa\xea\x0fAYy\xae5~\bfj@^a\x8c\xdf\x14\x98\xb4\x86\f\xf5\xe3k\x9e\u0601\x01k\xa9j\x13аB\x00\xc7G\xad7\xc6I\xa9\x14\x8ad\xffAf<\xd9w\x1btP\xba\xe8\xb6\x0f\xb8\xa0\x86\xad|\xb0Ë\xec50\xe8\xb36\xe1R\xa5\x80\x87-\xcf\xd0Y\xa6\x1d\x97\xa5\x0e\xe6\xc7k\f\u05f5\x8d\xdb2-^\x18Hd^dH:\xd0\x03\xf2\r\xaeY\x99Y\xad\x81\xf3,\x93\x0f\x87\x8dP\x94\xf9!}\v\u05fc\xe7\xfe\x8fR\xdd\xf1C\xf4\x17p\x8dEƒ6\x8f\x8f\xe8\x04\xfd\xe5\\kL\xafK\x11\xc5\xe6\x9fۭ\x1bL~ \xe3l$\xa4\x12\x1e\xb8\xd9ZuP\xa5 Sʺ\x03\x8a\xae\aT\xe8\x1f\xee9N=\x82\xb2X\xb3\t\x0f\x8e\xbd\xaa\x14\x82\x8bͲ\xc9\xca\x1e\x88ץx/\x12\x8cg\xef\xcd=/zn{8\xfd\xbf\x9c\xdb\t~:w\xff\x95\x8bT>\xc4r\u05f5&\x8bH\xba\x9bI\xb1\x01\xb6\x91\xc0\x02\xc3HM\x13&`\xcbv\x87\xc2\x06\xb8C\x14\x90\x96hG \xb7bцg\x99\x9f5k\x019\x82\x9aP\v\xab\x05cz\xfc\xb7\x7f\xd8\x1e6!\xe7\x87\xdde\xb8\x02\xa3\xcaI:X\xb0Rc:\u009c\x0f\xb6QK\xe3\xd0l\xad9\xc7jL\x13\xcb\x1c\xb4%\x9c\xfb\xff\x1d\x80\x85\xbay*1\xa8\x18\xdc\xd9a\xae\xdd\xf4Z\xa9/7\x8e=\xdak\xe9 L\xa6\x10\xf4=/\x8a>3\xe0\x88\xbf\x932C&f\xfdȌ\xd0_\x19\x1d;O^()\x00\x1fɑ\xaa\x1d\x17\x9a&\x1f\xb6(HD$L\xb3\xed\xd3\x0egΖS\x04d0/\xc83\x19A\xf1\xd67#)\x10\x03\xd3\xca\xf3&\xb7\x88\xee\x04ON\x06U<\xf0\x9f\xe8\x8fZ\x16J\xeex\x8ai\xff\xc4q|\xf2\xa0+u\xb6\xe2\xa3\xcc\xca\x1c\xf5\xad\xbcFmxgR\xeb%\xe2Mo\xc7\x1e\xbdS\xfe\a\xeb\xda\xf5¥\x91\b\xa4.D\xb0a\xf74\xab8-#~\xb0,\x83B\xa6\xb0sO\x82\xbb}@\xfaP6c:D\x17>&Y\x99bZy\xf7:\x82\xda˃Nv\x1dĸ -\xa3U\a\xa1*\xaa_{!\x92\xc4hjU\b\xe4\x13q\xe1`\x02\xb7*\bw\x03\nG\x7f\xdc`>\x80\xe7Q\x8d\x8c295\f\xa6\x14\xdb\x1f\xe1YX+NaY\xd5\xc7{\xae\x19O\x90\x98U\xf9\xa7\x96kC\xf3_0\x19\x7fa\f\xdbJy\x1fä\x7f\xa1v\xb5\x1f\x0e\x89]\x92\xc3\x1dnَK\xa5\xbb\x8b9|Ĥ4\xbd\x86\x95\xfe\x98\x81\x94\xafרP\x18(\xb6\x8c\xec\xb1\\\x8f2븉\xa0+\bk\xb0A\x87\xaeZ\xe8$<ˍ!R\xc8P\xf4\x8d\xd3\xf0!)\x93\xc5.\v\xe0\"\xe5;\x9e\x96,\x03.\xb4a\x82\x1e@&\xa2¯\x9f\xbeQ\x858\xc0\xdf\x19\xe0@\x05I\xa9\xe5\xc4K\x81\xb4\xf2Υ\xeaW\x8e\xf09\x043(Q\xb8cd\x01\xe5\xd0tT\x7f\x14\x05K<*\xa9\xf5]j\xbbsVK\xcaM\xd0\x19\xbb\xc3\f4f\x98\x18\xa9\x86\xd9\x13\xa3\x04\xd3\xec\xe7\x00g{,i=gШ\x1e5\xa2\xf5e$y\x1b\t9i\xd6\a\x94\xf7v\xfe\xb1\xee\x8a5\xb1\xac(\xb2\xfd1\xa2\xa34#\xd2hL2\x1f\xb1\x86\xe4\x90\xefA\x9bNc{ջ1S\x13\xd7+\xb5yfz\x93\xe9\\t\xb5u\x12ׯ\x0e\xba?\xbd\xb2\x13\xbb9\xea%\\\xad\x01\xf3\xc2\xecπ\x9bp7\x06*9X5\x1e\x7fe\x82;m\xb4\\u{?\xf9hy\x12\xa9Uh\xfc\x95\b\xcdNV7~\xae\x9a$\xb0\xb7͞g\xc0ו\xc0\xd23X\xf3\xccPhslbm9:\xa3\x92{J\x06\xc5νt\xe5\xcc$\xdb\xcbjI\x1bѣë.\x00\xe0\xcd5\x8c\x95A\x04H\xa8\x9c\n\x1b\xf0\xe5\nsJU,\xe1v\x8b\xad;v\xbds\xfe\xeeM\x7f\xd8\xefDM= \xea\xbc\xe3\xe94Q\xb0\x04F\x81l\x10eݴj\x8dg\x03\xed\x14\xf3\x80{\xdc;Ϫwq\xd9w\x91hY\x05R!E\b\xac2\x12,\v\xca'#\xa2\xe0MQ\x15\x9fU\xc0\x9epe\x14S\t?\x1f\xa3pܥ\x1b\x96\x8a\x98\xa1\xd4\xc3T?v(3\x10\xdd}\x82Q\xear\xfcD\xb2+\x81\xd5\xf9\x11'\xf8\x17\x94\xdc\xc8l\xd4^o{\x82\xa2\xc3\x17\x19l\xd0hGXH=}d\x19O+\\\xedJi\x02\xc4+q\x06嵐\x7f.\x1f9\xa5[H\x93\xdeH\xd4櫓w\xbe*\x8b\x1d\x11'2\xd8u\xb6\xc3R\xb8i\x81\xf82\xe9\xf95\x0e\xd6\xf1\xa1\xd1T\x89\x8dk\xca1I\xe5\xf93\x01\"\x81\xf1\xc89\xb4\xf2R\x1bZ\xac\n)\x16v\x9a\x0eO\x9b\x00\xb4\x89\x97\x17\x95T-I\x9dM\x84؋\xa2G\uf5bcC\x87\xfcA\xda\xefإ\\N$\x85\xb4$1\x90\xba\x1a\xc5\fnx\x029\xaa\rBA\xf3F\xbcRM\xb0\xe4'ka\xbck\x11>~Z艢\xf7]\v\x1a\xf5\x91-\x83\x98\xa3\x9a\x0f$\x14\x9f\x82J;\xbd[\x7f(\x8a\xfb,Mm\xd1\a\xcb>L\x9cY&ʫe\x01\x1aHҰ`\x90\xb3\x82l\xc0\xff\xd0\xf4j\xd5\xfb\x7f\xa3p(\x18W\x9ar\x18TǑa\xb3\x7f\x88\x126\x1e\x15\x05\x920\xe1\x1aHOv,\xa3@\x1a\x19o\x01\x98Y\x0f\x87\xb0\xeczPgQ\x80\x1f\xb6R#)\x14\xac9f)\xd1=\xbf\xc7\xfd\xfc\xec\xc0zͯ\xc4<\x0ef\xc8\xc1\xb4,B\xe5\xb5H\x91\xedan\x7f\x9b[\xc7l\xca\x109\xc1y\x9b\xa0\xd5\xd1Mie\xba\x9aMP-Z\xaa\a\xaf\x85:W\xf5(\xb4d^ΞH\xa7\v\xa9\xcd$\xb4>Hm\\\x00\xb0\xe5n\xf7D\bG\xa0Zg\xc2G\r\x81\xad\r*\xd0F\xaaP\xfbAf\xb7\x13 '\xc9\xeb\xf1\xf9\x85\xa9F4\xd2\x01\xa6\xd0\xc0\xbc\xb6\x10.j3w)~\xfa\xff8̄z:5*\x94LP\xebqU\x8a\x9c9Z\xec=\xe4c\x15\xacen\xf1\xb6\x8e2\xcd1\xa1\xe4\xd3\\qbmL\xbb\x0ea\x97\x8f\x8d\xb83\xa3d&&Q\xaa|\n\x8etQ\xc9\r\xeb\xd6!E\xa3{\xe1z\x87\x01\xe8\x81\xd9U\x0eS\x9b\xd2\x1a\x95h\xc8MU\xff\xbd9\x1e9\x17WVOữ\xe6\xac@H2\xe2\xa9K\x99\x8bп\x16HuCLt\x8c)\t\xfb\xb0\xa5z\x94\xa6d\x0f3\x19\xf1\x92\x02r\xa6)d\xdc\b\xd6\xf8'\xbdа\xe6JWKp\x8c\xf3\xab\xbc\x06h\x9bN^ξ\xa2\x06Hq\xa9\xd4\xc9K\xcc\xf7\xaewE8\x05t\x1f|YO4D\xa8\x99O\x15.\x14\xf5\xe2\x06P$\xb2\xa4JH\xbb\xbaBz\xcc\x04\x88N\x88n2\x89\x9c3\xc7*\x88\x86>\v\xab\x9d\\\x8cF\xc7\xeak\x01?2\x9e͎\xb6\xf92\xb1\x1a\x9e\xa3,\xcd*\xb2yG\xacT\xe3,KS\xd9kR\xe6\x9c=\xf2\xbć\xe5$\x96h\xb8`\xfd\x16\x9e\xd7\xc5^N\xd6\x0f\x8c\x1b\x9b\xf4#\xd84\x0fL\x80hdU\x83\aw\xb8\xa6\xd2\xd7D\n\xcdS\xac\xdc\a/\xff\xdez\x93\xa1\x8b\xc1\x9a\xf1\xacT\xb8\xfcz\x92\x99\xban\xf3\xe6)\xaa\xf5\x04\xb7u\n\"\v;u͞\xf0\xe9\xb1\xf3G\xa1\xa6\xb9\xcc\x1f\x14>\xbdkZ(NZ*Ǽ\xd3Q\x98\xd6{m{\xa7^y\x99\xd8\x0f\xb9\xa7\xa3P\xc9KxvO\x9f\xdd\xd3g\xf7\xf4\xd9=}vO\x9f\xdd\xd3g\xf7\xf4\xd9=}vO\xff\x04\xeei\f\x86\xee\x05\xcb\xd9\x17b\x15Y\x821\x86\xf6ȳ|\xa5\xd1EVj\x83*\xb8x\x033|_\x95Q\xb7gO\r}\xe2\x9a,싩CZ\x13<\xc3\xea5\xca;\xacʠ\xec\x8a1\f&\x9b\xc0\x8e\xf1\xc2#\x188Vm\xcf\x0f*\xe0V\xb3S\xca\xe6ڵ\xe3U\xb9\x9aՓ!\x8f\xcd\xc8\xf0x/=\xf7:c\xb3\xe6\xaa]\xfbf\xd7\x01\x01\xe3\xe5l\xb2\xf76j6\xa2\x19:\xa4\x8d\x01\xb9\x13\xd4,\xba\x10\x7fh\x86\xf7\xcf\xee(N\x87\x99\xb5\x12\xfe\xeey\x19Qm6\\c\xe6xHo\x8b\xee\xbe[\xb6\x7f1\xd2W\x9c\xf5\x82\x04\xf7Z\x19\x15\xbd\x03-]ŦY\xd6\x1e\xf4\xd4\xc8^\x1e\x0f@\xa4\x12p\x9e9m\x0e\x10Z\xec\x87\xf7\x96\x06\x96-Oe\xe5\xf8B\xad\x9b\x14\x1dj\xd7\xe1j\xb7[;\x06\xd1.\xea\x1a\x9fU\xbe\xa0\x06\xed\xa86N\xaf7\x8bAڿ\x10t\xbcʬ\xbf~l\x04\xea\x94ڲ\xd85xD\x1dY|\xf5X\x1c{芯\x19\x1b5\x19\xe1\n\x1c\x9dDΓU\x85Eւ5*\xbcFA\x9eX\x01\x16Ͱ\xb8j\xaf\x16\xbb\x8e\xd5xUd_\xadG@\xc2\xd1ʮ\xc3\xd2\a\xaa\xd7\x1a\x05\xd9W\xcf\x15S\xa5\x15\x85ktmVUq5\n\xf6\xcb*\xb2F\xed\xdaD]\x18\x9bV\xc3'\xce\xcf?^_\x15UU\x15\xb5\x16\x18ǹQ'4\x8c\xf2\xd4j\xa9(\xae\xb6\xc6M\x03\x8d\xa1ʨ\xaa\xea\xe9ȃ\xa3\xea\xa1\x0ek\x9d\x8e@\x1c\xaf\x82\x1a\xaep\x9aŏo[\xfb\x14Q\xd7t\x04d\xb3\xe2i\xb2\x1b0\xaaM#\r\xfa7\x10\x89\x9fk\xb3?\x87\x06~)\xd1R\xa5\xa8FW%SP\x1fE\xbb5h\xdew\x9e\xdfXB\xd7n\xb4ò\xb9\xe2\x19\xf2\xa2d\xf5\xfaH\x02\xb4\xe7\x0eYn\x1a8Eӧ\xa1\x1f\xec\xf2\xb3v\xb3\x86+nk\x8f\xb6\xb3\xda\xd2X0*\xb3M\xe9\xbdv\x1b\x15\xd2K\xb8dɶj8\x00\xd1>y\xcb4\xad\xecsf`^-c_\x87\x9etg\xbe\x04\xf8QV\x11\x84\n\xea`͢\xe6y\x91\xed\xa9~\x02\xe6m@\xa7.\x1dFt\xc7\xed\x0fp\xcd\f\xbe\xe597\xabqi_\xb7{\x80ܡR<m\v\x9b\xb2\x8el\x83\x90I\xb7\xcd\u038b!\xd1\xf8\xfd\tH\b\x90\xf1\xbc\n_r\xedA\xbdЍ\xfd\a\xfc=}27\xc6-@*\x1fD&Y\xfa\x13\xff\xa1\x18l\xd4aɛf\x1f\xe0\xed\xd0n\x00\xe8h\x94\xc7|\xae\x06\xa1\xc4\x17\xa9\xe8=b.\xe0'\xfe\xc3k\xbd\x84o!G&\xe85OǪ~.\xd0\xe5\xb4r\x05\\\x98\xbf\xff\xc3`+\xa7\x1a\xb4o\xd7fp\xb9\\\x16S\x99\xf1K1Ȋ\xb2h2\x82\xe4:\b\x12:\x12\xff\xf3\xf2!j\x10\xb9]<\xae\xe9\xfd\xf0\xd5l\x94M\xd7\xdd>6\xfc\x854yZ\x83ą\xb7\x98\x14;D\x96lg\xa3:cWw\xdcn\x15\x83\x8fE\xc6\x13n\xb2\xbd_\xea\xd1\xcb\xed\xaaz\x8b\x99\xcc\xdd\xf0K\x0f\xae\x00̏\xcc\xc6\x0e\x84\xce\x10\xbb\xc20z\rޭ\x9f\xadGC>\xb7Gc\x00h\x8a\tO\x1bAUn^\xb8!\x8e)\x94\x85\v˸G\xdaE\x81\xa0\xcda2\xef/\r\xdbˁ}P\xfcÖ\xb3\xc9.\xf9Q\x19y^6-\x92n\xc49\a@R\xec\xae\xc1|\x1b\x05\r\xdc\r\xf6\f\xce=\xbc\x8a\x9d4nT\x99\x1d\x01j\xeb\xcdBs\x1b|Z\xfb\x1d\x812|\xa1!Qܠ\xe2lht\x8c\x9b\xc2P@<\xfc{\x87_綠\x03x-e\x8b\x1e\xa5\x8f\x02\xb7Hɉ葅WG#f\xa7\xa7\x15\x17!\x02{\xb4\xcd\xe5\xe3X\x9b(O.g\x8f7\xfcף\xe5?L\xec߯\x8f#\x1cc\x97\x9b-G\x90\xea\b\xe9g\x87c\xa5h\x95d\f\xe4R\xd3\x0e\x83\\C\xc6\xd4f$c\xe7ǜ\x95\x13\xa55\x19\xdc\v\xf9 @\xf3_\x11\x04\xee\x82\xf4ᘁ\x8e\x9a\xb4\xbd\xbe2C\x1bL\xae\xe0?^\xfe\xf1\x9b\xdf\x16\xaf\xbe\x7f\xf9\xf2ӷ\x8b\x7f\xfa\xfc\xcd\xcb?.\xed\x7f\xfe\xe6\xd5\xf7\xaf~\v_\xbey\xf5\xea\xe5\xcbO?\xfd\xfcϷ\x1f.?\xf3W\xbf}\x12e~\xef\xbe\xfd\xf6\xf2\x13^~\x8e\x04\xf2\xea\xd5\xf7\xff\xff\bR\x8f\vځU\t4\xa8\x17\\\x98\x85T\v'\x8e\x11zr.~\xff\x9a\xc2Ő\xa6d\xc8&\xa8\nM\x1bV-\xecn1\x05mY\xaa\r\xadc\xbd\xcdK2\xc6\xf3\xf0\x85k\xa0},\xed\xbdA\xb7\xd1\x17\xc0\xb0\x82%\xdc\xf8`\xadi>E\xb8\xa8\xcf\x1b\xae:p\xa9\xc5Q\xa0εzV\xef/R\xefb\x97\x84\x8c\xcc*V\xdd>|\xbc\xa8\xb28A\xe5\x06t\xe5\b\xc8\xe08j\x1f\x0f\xb1\xed\xedR\xad\x9a\x8b\xdc\"\x7f\t\xef\xad#bw'\x05\xb9\x8e\x82\xf95\x04\x1f1\x0fO\xcb\xf0\xf4\xf0\xf6\x89\xf2<\xc1\xdd\xfd\xd2lO\x84#6@\xc8\x13e~N\xcc\xffD\xc0\xfc\x92\x1d\x06bUa\xe2\xce\x02-\x06>Q^hjvh\x82\x13U_\x81\xf7'\x90\xf9d\xf9\xa2\xc9Y\xa3H\x88O\xb1{\xc0DvN\xd95\xa0\xc5̘lR\x14T\x18\xdb-\xe0 \xec\x1c\tvp\xa7\x80֓l\"I\xcf\"\xe0\x01\x1c\xe4\x9f\xc6\xf2K\x91`\xfb\xb3P\x83\xef\xfdGB\x9d\xb0;@\xa4\xd5=I\xc3b2=\xf5'&G\x15\x97\xa9\n\xad\x82\xb0G\x9b\x8e\x04tN\xa1\xa8\x91\xd9\x19#hj\x16a\xb2,Z\xa37>\xa75\x8a\xc2\xf9W\xc8l\x9d\x9a\xdf\x1a\x059\xf2\x86\x7fo\x96k\x14\xe8\xf0\xdb\xfd':A\x91\x9a\x18\xd5\xccG\xdd/2\xa6\xf5q\x8dj)\xc8M\xabۓ\xfbޥ\x0e{v:\x8b\x1cr\x03\x89C\xd3\xfb\xe1G\xc1\x06\x1f\xfd\x04?<\xc2\xdcE\x0e\xach\x8f>\xc6b8Bn\xf7\xc5\x049}\xac\xfb\x1c\xac\xc9\xfd>\xb3\x1b\xbe\xa3\x9d\xb5\xf7\xc5p\xc8\xd4\x13\xc3r\xdag@\x87\xd1K\x11MJ۽\b \xc1%\x9f\xcel>\x06\x1f\x19U\x86\x1f\x858\xaf\xf5\xc5!zAҟ\x9f\xc1<\xac\xc4\xe74\xa7\xce\v%I\x891\x9d\xffŉml\xc2Z\xf8\x80\xe9\xec\xc41\x1c\x81\xeaq$\xb5`\x85\xdeJ\xf3\xb3\xdc\xe1\x9b\xc14x{\xf0w\xba4\x92\xb7!\x88K\xfaAIu?\x80{a\x02\\\xdc\\U\xcf\xd7v#q\x11\xbc\x9aFb\x90kHd\xc1\xfdn\xe3\xd5\xfd\xd9Q\x83V\xa5\x11\xcf@K\x9f\xe60\xa0K\xb5\xe3;\xbf\xd4ʤ\xd6\x1d\x033\x04s\xaf\r\xe6\xcb/\x13\xc0p\xc9u`\x81\x8f\x1fM\x90@\x888\xf5\b\xc0o̞d\xb2Lk&\xf7\x82\x06\xe2\x02\xbd\x9a\xf9\xe1\xa3\xdd0\xcdnG\x9d\xd4\xdbv\xfbEL(T\rE\xaa\xfe\xe7\x01\x90C\xbb\xf1?\x15Ϝ\xc0\xdez)\xc7\xf0\xac\xddï\xfcmd*,\xe8\x83\xe2\xf9]hzaRi\x80\xa3\xad\v\xb0\xdej!d\xb4\xab\xaa~\x9a\xbf\x86\xe6\xf5\x11\x9bdL\x16A\xdc\xed\xed[G\x10\xbd\xe1\xb3|S*\x8bҢ`J#q:\x10\xea:\xdd\xf5?\x8a\xae\xea\\\x8eƩ\x065\x1d\n\x89M\xee턓\xa8)\x85}\xa9\x1e\xd3N\xfa-\x82\xc4_\x06\xba\xf6(\xbfK\x01\xcdF\xdeq\x1b>\xc1`\xc0\x8d\x89:$\xc0#I\xe9R\x1aR\xfe\x8c\x17J\xff\xf92\x11]&[\xef\xe6\fV\x9fй\x14R1ų}\x05\x90\x8b\xeaСE\xce\x04\xdb`\n[\xcc\nT\xfeMJNgh\f\x0eqk\x8d}\x89\x84͟~\xb5\xa1霁`\xa2\xc2\xf0\xd0\x11\"\xfe\xd8߳\x11yl\f\xd4c/\x92\xc8\xf5 ,\xa6\xb5L\xb8͗\xfb\xe3b<S\x868rԭ\x18Q\xf7\xe3\x93\xef\x91ɝ\x86\xf1\xbfKѓ\xeci1\xec\xd67\vṫ\xf3w\xe7\x8d=\xb4\xd0\u0081_ɓ\xa6\x9f;\x87\xab\x1c\xc0\x06\x82Ӭ'8\x03\\n\x960?\xcfQ\xf1\x84\xbd~\x87\x0f\xff\xf9oR\xdd\xcf[g\x16\xc1/\xb7\x17\xcb\xd9\x04֔\x1a\xdf?\bz\xf7\xcaO5\xfaJ8[3B\xef/\a\x1d\x83\x89\xea\x9b\x00\xa9$\xaa\xd3\xfc\x00<\x80\xacNȁ\x84N\xd2\n\xa5\x0e\x8d\U000f05b3\x89\xc3dx\x88\xf4\xfb\x83\x8b\xfe\xd3`\x16\xd5\x015\xb3\b\xbdц\x99\xb2\xa3\xa9-\xee\x05rnlCHXA\xa7\xe0\xf9\xf7\xb8\xed\xa1d\xc6\x02\xf1I\xb7\xf0\x9eh\x1ff\xc3a\xe7\x8ci\x13%˷U\xc3:\xb8L\tB\xd2\xd80\xc5\xd2IY\xf6\f\xa3P\xe1u\x00\x12\xeaC\xcbz\x11m\x96\xf4\xd0qv\v\x1a\x11\xa7\x89\xb3W\x95\tg:o\xab\xc04\x82^\xdf2\x10lx=R\tPEB\xea\x8f9\xeaM\x1f\x10O\xfc\x81Lgp\x87\t\x1d\xdcdeXq\x82Z\xb8\xf3\x9c\xea\x06\x92j?\xb4?\x12\xab\aj}H\xd6\x19\xad\xb9B\xb7\xc3\xe3\xe2\xb6,m\x9d\rg\x83\"\xfd\xf3N\xe3\xb0;\x0f\x9b(w\xa7\xbc-\xff\xa4b\x12\xf8h\xaeKA\xf6rDL\xef\xea\x96ALԹ\xa3\x97\\\xdbsȪ\xf3\x9e\x0e`B]\x948lE\xbe&\xc5\xf60\x99\x11Z?P\x9b@e\xb0\x00\xb6c\xd0ʀ\xfb,\xae\x86f\x01\xef\xb0\xef`\xbfKAD\x1c\xc6\xdf\xdc\xfb\xf7\x98ڼHߩ\xa3GI\xdcU\xbd\xec\xde\\z\x84\xda\xfa!\xaey\xe7\xadJ*\x87\xaa!\xba\x8d\x0e\xfa\x94\xfa%_\xbb-\xe5\x13\xa2\xe9\xd5,\xda_8Bɰ\x9f\xd0k\xeb\x0fn\xdas\x05ӆ\x92\xf8呿S\xcf\f,I\xb00\xfeE\xdd桼\xf3y\xeb\xcc]\xfb5\x91\xc2\x05\x9d\xf5\n>}\xa6cv\xed2Ɵ)\xabW\xf0\xe9\xf3\xec\xff\x06\x00\xb2J\xabK\xc2x\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XMs\xdc6\f\xbd\xebW`҃/\x916I\x0f\xed薺\xe9\xd4\xd34\xe3\xb1\xd3\\29p)습D\xb2 \xb8\xae\xdb\xe9\x7f\uf014\xbc_\xd2z\x9dNW{\x91H\x80\xc0\xc3\xc3#\xa5\xa2,\xcbBy\xf3\t)\x18gkP\xde\xe0\x9f\x8cV\xeeBu\xf7}\xa8\x8c[l^\x17w\xc665\\\xc6\xc0\xae\xbf\xc1\xe0\"i\xfc\x11W\xc6\x1a6\xce\x16=\xb2j\x14\xab\xba\x00P\xd6:V\xf28\xc8-\x80v\x96\xc9u\x1dR\xb9F[\xdd\xc5%.\xa3\xe9\x1a\xa4\xe4|\\z\xf3\xaa\xfa\xaezU\x00h\xc2d\xfe\xd1\xf4\x18X\xf5\xbe\x06\x1b\xbb\xae\x00\xb0\xaa\xc7\x1a\x02\xd2\x06)\xb0\xe2\x18\b\xff\x88\x188T\x1b\xec\x90\\e\\\x11<jYxM.\xfa\x1a\xb6\x03\xd9~\b*'t\x9b\\\xdd&W7\xd9U\x1a\xedL\xe0_\xe6f\xbc7\xc3,\xdfER\xddt@iBh\x1d\xf1\x87\xed\xa2%\x84@y\xc4\xd8u\xec\x14M\x1a\x17\x00A;\x8f5$[\xaf46\x05\x80$=\xa2Z\x0eXl^gw\xba\xc5>\xa1/wΣ}{}\xf5\xe9\xdb۽\xc7\x00\r\x06M\xc6\v\xb8\x93\x99\x81\t\xa0`\x88\x02\u0601\xd2\x1aC\x00\x1d\x89\xd02\xe4(\xc1ؕ\xa3>\xd5\xe8\xd15\x80Z\xba\xc8\xc0-§\x04\xf9\x90Y\xf58œ\xf3HlF4\x06\xb3-\xfbv\x9e\x1e\xc4z!\xe9\xe4\xf4\xa1\x11\xdaaH+\r\x90`3 \x00n\x05ܚ\x00\x84\x9e0\xa0\xe5\xc3(\xe5\xefV\xa0,\xb8\xe5塀\x1ap\b\x10Z\x17\xbbFغAb \xd4nm\xcd_\x8f\xbe\x83\x00\"\x8bv\x8aG\x9el\x7f\xc62\x92U\x1dlT\x17\xf1%(\xdb@\xaf\x1e\x80PV\x81hw\xfc\xa5)\xa1\x82_\x1da\x02\xb3\x86\x96هz\xb1X\x1b\x1e\xbbN\xbb\xbe\x8f\xd6\xf0\xc3\"5\x90YFv\x14\x16\rn\xb0[\x04\xb3.\x15\xe9\xd60j\x8e\x84\v\xe5M\x99B\xb7\x92p\xa8\xfa\xe6\x1b\x1a\xfa4\\\xec\xc5\xca\x0f¬\xc0d\xeczg 5ĉ\nH;d~dӜ\xe8\x16hcש$7\xefn?¸t*ƞS\x18p\xdf\x1a\x86m\t\x040cWH\xc9\x0eV\xe4\xfa\xe4\x13m㝱\x99]\xba3h\x0f\xe1\x0fq\xd9\x1b\x0e#w\xa5V\x15\\&)\x82%B\xf4\x8dbl*\xb8\xb2p\xa9z\xec.U\xc0\xff\xbd\x00\x82t(\x05\xd8\xf3J\xb0\xab\xa2۟x\xa9\a\xd4v\x06F\x99\x9b\xa9\xd7Dw\xdfz\xd4RA\x01Q\xac\xcd\xca\xe8\xd4\x1e\xb0r\x04jʤ:+\x92d\xf1\xccX\x06%\xc9\xd1\x1c\xe8\x8b[\x9d\x13ʹ\x9c\xc8\xe5[\x15\xf0\xf0\xe1AL\xd72\xe7p\xfdάP?\xe8\x0e\xb3\x8b\xac&\xf8t(r\xa1\x8d\xfd\xf1\x9a%|\xc0\xfb\x89\xa7\xd7\xe4DY\x93\xae\x03\x9c\xc1\x8da\xbfY\x9bqW\x9d\xcf,\xcfJ{خT\xef\b\xf4\xe0\b(Z+}{\xa4\x90\xf2?R\xf2\xa39\x86\xb1\x9f\x88f2\x9e+\xbbr\xa2\xad\xacdaŹ\x9fp(\xf6\xb0N\x8ek\xc2\xe1|\xad\xe7\xc4\xeb,@\xf3?\xed\xa4_k<E\xfe\x19 \xf69ߢ\xea\xb8\x1d)\x963\xbf\b\xe031*\xb8\xe2bҥ\xe0\x1e@t- \x83\x996\x87V\xe59KD+e\x96\x03\x87\xc6Y\x8fG\xb5\x96\xa6&Q\xcc\x19\x139\x90\xa9e\x8750\xc59\xbfOUM\xaeN\x05~G\xe4h~\xca\x01\x8c\xefG\x8b\x11IL7\xdc*\x06\xadb\xc0&Aһ\xa3=z\xff\"\xd4\xd2\xf3$gL\xe2\x970\xfa\x18\x8d\x0f&\xc0J\x99n\xa2_w\xaf{\xc3\xed\x1cbg\xb1iD\xe4\xe7D\x8e\xcb\x16\xf5\xdd\xf6\x14\xfc\x1c\x80\xa6\x1c\b^\xf7-\xda=Ɯp\n\x8fl\x92\x90\xc0+\xd1+P#s\xb5Dw*\xdb|H\xacA\xb6ޒM?O\xbf3\xf9t6\x843\x1b\xc0\f^\x93[\xc1t{\x9e\x83\xd6)H\xa67\x89\xed\xaf\x84\xdf\xfc\xc9\xe1\x1b)%\xf1\xa9\xe4\xc5\xcbOOq\xb5\x84[v\xdec\xf3_\xa1\x1e\xba\xe3\xd2E\xcbg#>d\x91\x8cF\xe0m\xec\x97H\tp!\xec\xf3I*\x92\x97\xe5\x0e\a\xf1ʢ\xf7|q\xdb&/\xa7\xfa\xf5\xc4\xcew\xf2<\xb4\xbd\x86ד\xba8\x03\x93\xf1\xf5\xc6\xec\xbd\xd9\xec\x130\xeds\x17!\x85E+uR\xd0\x15\xef\x1a\x9a\xdewأ\xe5\xf0\x12\xb0ZW\xb0y3\x9c\xfa~P\xfa.\xfa+\xc6\xfe\xad\x16\xfdXl\xde\xccz\xcdQT\xc5W\xf1E\x8e\xe6\x86pr\x9f.Sf\x93\x03\xb2;\x17\xcf\xc4\xfe\t5Ɂ*\"\xf5PLr\t\x9b\x13\xaa\xbbW\xb5\xeb#\x83=\x95\x9d89½\n\xc5,\x8b\xb1\x81\xe5Ü\xe9\xe5㇔\xaax\xbe؞\x05\xcad\xf5r\xd7\f\x04}\x02\x90\xdbݹ#\x99\xf7\xbbo\xa0vu~\b\x93\xc5>z\x98\xc2lv\xd2\v\xecH\xadw\x13\x0eq\xf9\xf8V\\\x17{'8\xf8\xfb\x9fb{\x98\x93\x0f\x1f\x9e\xb1\xd9\xf9x#\f\xad\xe1ŋ\xbdO?\xe9V;ۤ\xef`\xa1\x86\xcf_\xe4\xeb\r;\xc2f\x00!\xd4\xf0\xf9K\xf1\xef\x00\xab\x02\x97\xc4j\x13\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccZ_\x8f۸\x11\x7fק\x18\xe4\n\xf8\xa1\x96\x9c\xb4\xbdkOh\xda\v\xf6R\xc0\xb8d\xb3\xb8\xddK\x1f\x82\x1c@Kc\x8b\xb7\x14\xa9r(;\xee\xe1\xbe{1\x14eɶ\xa4\xf5n\x9a\xa2Q\x80D\xe4p8\U000dbfe4\x1c\xc5q\x1c\x89J\xbeGK\xd2\xe8\x14D%\xf1\x93C\xcdo\x94\xdc\xff\x85\x12i\x16\xdb\x17ѽ\xd4y\nW59S\xfe\x88dj\x9b\xe1\xf7\xb8\x96Z:itT\xa2\x13\xb9p\"\x8d\x00\x84\xd6\xc6\t\x1e&~\x05Ȍv\xd6(\x856ޠN\xee\xeb\x15\xaej\xa9r\xb4\x9ey\xbb\xf5\xf6y\xf2\xe7\xe4y\x04\x90Y\xf4\xcb\xefd\x89\xe4DY\xa5\xa0k\xa5\"\x00-JLa\x8b\n\xad\xa9T\xbd\x91\x9a\x92\xe6-\x91&\xa2\n3\xdeqcM]\xb5d<\xd1,\f\xd24\x9a\xbc\xf7\xabn<\x0f?\xac$\xb9\x1fΦ\xdeHr~\xbaR\xb5\x15\xeado?CRoj%\xec\xf1\\\x04@\x99\xa90\x85kQ\"U\"\xc3<\x02\b\xcazQb\x10y\xee\xe1\x13\xea\xc6J\xed\xd0^\x19U\x97-l1\xe4H\x99\x95\x15\x93\xa4\xb0\xd4\xe4\x84R\x1e\x19\xa8\nA\xe8\xb7\a\xf8\x85\x8c\xbe\x11\xaeH!!'\\MI\x7f\x965O\xe1\xa67\xe2\xf6,\x169+\xf5fh\xa3\x7f\x16\xa8\xc1\x15\bAKءEP\x82\x1c\xc8F\x06\xccG\xb7f\xb2 \xe9\xc1|\x81\xb8\x91\xe4\r\xf3Y\x9e\xf0iDʅ\xc3 P\x8fq\xebZə[\x1c\xf1}\xb5\xc1af\xc1g^\xf8\x17\xca\n,\xbd\x97\xf2\x9b\xa9P\xbf\xbaY\xbe\xff\xe3\xed\xd10\x1c\xe3\xd1\xf7\a\xef&\x04ﮖ K\xb1A\x02gZT\x02\xe1\x01\xb6\xb55\xe5\x81#\xc0\xae\x855\x90\x11\xda-Z '\xac\xa39\b\x9dCav\xcco\x8bV\xae\xf7l\x02i\x81\xe4F\vW[\xa4\xe4\xc0\xac\xb2\xa6B\xebd\xeb\xd2\xcd\xd3\v\xe3\xde\xe8\x8923ַ\xa1\x82\x9c\xe3\x97U(\xb0uL\xcc\x03D`\xd6\xe0\nI`\xb1\xb2H\xa8\x9b\x88>b\fL$4\x98\xd5/\x98\xb9\x04n\xbdF\x04T\x98Z\xe5\x1c\xf6[\xb4\x0e,ff\xa3\xe5\xbf\x0f\xbc=h\x8c\x84\x12\x0eC|u\x8f\x0f\x04-\x14l\x85\xaa\xb1\x01\xa6\x14{\xb0Ȼ@\xad{\xfc<\t%\xf0\xd6X\x04\xa9\xd7&\x85¹\x8a\xd2\xc5b#]\x9b\xbe2S\x96\xb5\x96n\xbf\xf0\x99H\xaejg,-rܢZ\x90\xdc\xc4\xc2f\x85t\x981\xcc\vQ\xc9؋\xaeYaJ\xca\xfc+\x1b\x12\x1e͎d=\v\xa4\xe6\xafO0\x13\x16\xe0,\x03\x92@\x84\xa5\x8d\xa2\x1d\xd0<\xc4\xe8\xfc\xf8\xfa\xf6\x0eڭ\xbd1\x8e\x98B\xc0\xbd[H\x9d\t\x180\xa9\xd7h\xfd:\xef\x8a\xdę\xf3\xcaH\xed\xfcK\xa6$\xeaS\xf8\xa9^\x95ұ\xdd\xffU#\xfb\xba3\t\\\xf9\x9c\x0e+\x84\xba\xe2\xc0\xca\x13Xj\xb8\x12%\xaa+A\xf8\xc5\r\xc0HS\xcc\xc0^f\x82~9\xea\xfe0\x974\xa0֛h\xcbƈ\xbd\xfa\xe1\x7f[aƦc\xf4x\x99\\\xcb\xcc\xc7\x05\xac\x8d\x05\x11B\xbb\xa1\xed\xc2u<d\xf9\xf1y\xe4\xa6V\xea\x163\x8b\xeet\xfaD\x9a\xe51u+\f'9\x1f\x8d@\x9e\v\xff\x9f\xd5\x05.\xb6V\xa3C_\xc8s\x93ݣ͌^\xcb\r\xa7س\xbd8\xfc\xfaIJ\xb7\xc5\vv\xd2\x15\\\x99s6\x8bP\xe45fJ/?\xcd\xc0\xe2F\xb2C\xf7\x13U\xfb\x87˷X)L\xc1\xd9\x1a\xa3\xa3\xb9Ip\x0e\t|`\xfc\x04\x99\xd9u\xc0\x80\x85\xb2\xb8F\x8b\xda\rz\xe6\x19&ĉ!\xc3\xca\xd1\xc2l\xd1n%\xee\x16;c\xef\xa5\xdeĬw\xdc\xf8\f-X\x14Z|\xe5\xff\x19\x94\b\xe0\xee\xdd\xf7\xefRx\x95\xe7`\\\x81\x16j\xc2u\xad`-Q\xe5\x94\xf4\x92\xf4\x1c؟\xe7P\xcb\xfc\xef\xb3h\x80ӘsO:\xf3\xc1\xa3\xe8\x12G\"\x10\x16\xbd\xbd\x87+Z\xbf\x94%pW\x9c\x9b\x0e\x00?aV;\xb6.\xb1\xf3\xa0Ȋ\xa66\xce\b\x16-\x83\\Z̜\xb1{\xbfa`\x8f9H\xed\xcc\x00ϳ29\xa3 K\xc7\xe9\xdcˤ\xc3rЃ\x8e\x14o\xa2ӫ\xcf\xd1#tW\xcdGU\x1f\xe09\xed\xb3\xc1\b\xc3SC\x96h\x03\xd9/k\xdd7\xc39\xec\n\x99\x15P־\xfb\xcaT\x9d\x0f٠C\xadE>\x97\x1b$7\aL6IhL\x17\xcd?q\xa3Y\xbc66\x16;\xfa\x8e\n\U00047bffI\xffڬ\xf8\xdb9\xacA_\xe1\xb8.\xa7\xf0\U000c77ff\xfb\xf8\xfbv\xd9\a\x11\xaf\x9f\xc7\xdf~\xfc\xf5\x9b?\xfd\xf6\xbb\x91\xa5\x93~\xcc\x7f}\xc3\x132\xe9E\x90\xbd\xef-h\x91\xab\x8c\x92\xd9ާ%\xcfo\xdf\xd6\xd1\x00\xc9\b_\xe8:\xac\x04\x96k\x90nF\xc0Ŏ\xd0͏ =\xd0\xf1\x8eL᷑\x98\x8fA\xf6@ֻ̏\xf8\xb9\xc7\xfd\xf8\xe4\t4?\xe0\xbeE\xe4\x1e\xf7G5a$\xb5Opn\xcenBj\xa97s\x8e\xee\x9b\xd7o\x19\xe1R\x04l\xaaz\xa5d\xe6w:@\xe5\x9du\x92\xe9\n=\xe8\x987U\x85Mvex\xa4\xd7\xed\xce!\xd4\x17k\x8c\x83\x8c!\xf2\xc5v4\xf1\x9e\xc7\x003c'\xe8-\xf6\xb2q\x1f#\x89j\xcca\xe5\x1df\x92\xe3u8\xcc\xf6d\x1b3\xf8\xa5\xf6\xbc\xc0\xa6gv\xbd\xeb\f\xca:\x06\x93:\x03\x84\x8a\xbb@\x9f\xa7\x00\xde>\x84}\xc0_p\xd7)\xf3\x96\xcf=\x0e\xe4\xd3GF\xf1\xc3\xf5zD\xb5\xff\x93\xca\xfdEj\xf8\x13\xf03\x1e\x16\xa1\x1e\x85!w\xa6|h\xdc\x15\xe8\xdb\x0e\xf6\x91\xd0\"\x1a\v\xdc\xd0s\x8c\x96\x17zGs2̣\x81\xd9\xde\xd3h\xb42F\xa1\xd0\xd1(\x99?JH\x8b'\x87\xa2\xe3'f瞘\x9fhw\xba\xc7ٚ\x1c\xe6Kߣ>\x14\x82\xc7.xw\xba\xf4\xd0\x19Q\xdd4\x7f\xe0\n\xe1\xcfM\x13<Cٟ\x8d%\x1f\xb1\x87Bl\x91s\xceP^\x99O\xb2\x16\\\xd5\xc9\xf1u\x93\xa4\x02s\x7f\xf1A@uV\x80 x\xf6\xe9\xeb\xe7\xdf&A\xda\x14\xae^\xfet;\x87w/_\x7f\x12e\xa5p\x9a\xf5\xd5\xf5K\xcenh\x9f%\xf0\xeaH\xe4B\xf0A5\x00\v\xb2Av\x0f\x92\v%\xef=m\x10~<\a\xa5\xda\xf8nY0F\xc25\x87A\xa4\xb6\xf4\xce\b\xb0\xac\xdc~\x0e\xa2/\xc6\xe4\x06]*7\xbaK#\xa7U\xa3\x03_\xe8}+x2\x15\xb4\xa3\xed\xe4\xa3c\xfa\xa2F\xa0\xe3'\xac\x15\xe3\xb1\xe0i.\xf5\xeb;>\x03\xb6\xed\x00\xdf;\x98u\xe7oO/\xd9S\xb5\x02u]N\xa1\x16\x87Z?I\xd2\x06G\xf4Y\xd8?\x94x\xa6\x92N\xec7\x88\x9e\x94\x8d\xa6\xf6\x8d\x1bģG2-\xa5^z\x7f\x84\x17ѥ^3,F\x10\x80\xa2\v\xf6n.u\xd3hԿ\x8e.G<q\xebmYm\xf9\x00\xce\xf7\x9b<j֟s=r6:}\x98m\xf6D:\xe4\x9c\xfeչY7gT\xb3\x9e<x6:\xcd(\b\x90D\x17\xa7\x87\xb1\xd3\xe61>\x1d.\xa7\x12\x0e\xb0\x04\xa6\x13\xed\x11\xd8K\x94D\x8f\xef={G\xf5a\x82\x13\xe1_\xf7\x8e\xf6-\xb6M\xd1\tb\a\x81z|G\xd8B\xab!\xe6\xdde\xe4\xa8\x1e\x17d\xdf\v\xa2\xff\xa2\xac\xfbP\xc6\xfd\xef\x1c\xe3\x93\xe8\x89Z\x94Ht\xa9\x00o\x1bZ\x0e@\xd1.\x04\xb12\xb5\x83]\xd1;\x9f\xcd\xe8aw\xe3g-\xa4\xc2\xfcɢ\xfb\x8fP\x17\t\xee?N\r\xe5\x8dC5\x1f\x92;\x89\x1e_\x7f\xe2\xb3/O\xa7O\f\xff\x10r|\xfa\x7fs\x95a\x91j\xe5X\xf9\xa7\xded<\t\x9bF\x94\th~\xd2ۇH>\a\xbd/P6\x1f\xc8\x01\xe3\xd1?\xf4\x1d3\x8d&-\xf9f`\t[t\xe4\xc3\xdf\xe0\a\xd5'֢\xe6J\xa6\xf9\xe8\x19;Y\xe2Ӏ\x18\xb4\xccH\x1c?2\x82\xfb\x8a$\xd1e~\x19\xc35\xee\x06F\x97\xa3\xa0\xc5p#,\x7f\x9eP\xfb\x11G\x1c\xf5\xd0Q\x04\x06\xfd\xebl\xd0\x1b5\xef\xa1K\xceX\xb1\xe9\xe3M\xf5\xea\xf0!1\x8d\x8e\x1a,\xf8\xf5\xb7\xa8\xeb\xb5D\xc67\x1a\x98_\x9f\xfeh\xe1ٳ\xa3\xdf\"\xf8\xd7\xcc\xe8\xe6G\x04\x94\u0087\x8f\xfc\xb3\x03g,\xe6ႂR\xf8\xf01\xfa\xcf\x00/Q\f\x7f\xe6!\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x92\xdb6\f\xbd\xeb+0\xe9!\x97JN\xa6\x87vt\xcbl{\xc84\xcd\xec\xc4\xe9^29\xd0$$\xa3K\x91,\x01\xba\xdd~}\x87\x14\xb5\xb2\xbd\xf6v;\xd3Z\xbe\x90\x04\x1e\x80\x87\aJM۶\x8d\nt\x87\x91ɻ\x1eT \xfcS\xd0\xe5\x15w\xf7?pG~sx\xdbܓ3=\xdc$\x16?}B\xf6)j\xfc\x11\ar$\xe4]3\xa1(\xa3D\xf5\r\x80r\u038b\xcaۜ\x97\x00\xda;\x89\xdeZ\x8c툮\xbbO;\xdc%\xb2\x06c\x01_B\x1f\xdet\xdfwo\x1a\x00\x1d\xb1\xb8\x7f\xa6\tY\xd4\x14zp\xc9\xda\x06\xc0\xa9\t{8x\x9b&d\xa7\x02\xef\xbdX\xaf\x8b5w\a\xb4\x18}G\xbe\xe1\x80:\xc7\x1e\xa3O\xa1\x87\xf5`\x86\xa8y\xcd5\xdd\x15\xb4mE\xfbPъ\x81%\x96\x9f\x9f1\xfa@,\xc50\xd8\x14\x95\xbd\x9aY\xb1arc\xb2*^\xb3j\x00X\xfb\x80=|T\x13rP\x1aM\x03P\xe9))\xb7\v\x01ogD\xbdǩP\x9eW>\xa0{w\xfb\xfe\xee\xbb\xed\xc96\x80A֑B\x8eq\xad\x10 \x06\x05K&\xf0\xc7\x1e#\xc2]a\rX|D\xaeI?\x82\x02,\xf9s\xf7\xb8\x19\xa2\x0f\x18\x85\x16\x82\xe7\xe7H^G\xbbgy\xbdΩ\xcfV`\xb2\xae\x90A\xf6\xb8\x94\x8f\xa6V\v~\x00\xd9\x13C\xc4\x10\x91\xd1\xc9ڮ\xf5\xf1\x03(\a~\xf7\x1bj\xe9`\x8b1\xc3\x00\xef}\xb2&\xcb\xf1\x80Q \xa2\xf6\xa3\xa3\xbf\x1e\xb1\x19ė\xa0V\t\xd6ή\x0f9\xc1蔅\x83\xb2\t\xbf\x05\xe5\fL\xea\x01\"\xe6(\x90\xdc\x11^1\xe1\x0e~\xf1\x11\x81\xdc\xe0{؋\x04\xee7\x9b\x91d\x19+\xed\xa7)9\x92\x87M\x99\x10\xda%\xf1\x917\x06\x0fh7Lc\xab\xa2ޓ\xa0\x96\x14q\xa3\x02\xb5%u\x97\v\xe6n2\xdf\xc4:\x88\xfc\xfa$Wy\xc8*b\x89\xe4ƣ\x83\"\xf7g:\x90\x95>\vav\x9d\v]\x89&7\x16v>\xfd\xb4\xfd\fK\xe8Ҍ\x13P\xa8\xbc\xaf\x8e\xbc\xb6 \x13Fn\xc0X\xfc`\x88~*\x98\xe8L\xf0\xe4\xa4,\xb4%t\xe7\xf4s\xdaM$\xb9\xef\xbf'dɽ\xea\xe0\xa6\xdc5\xb0CH\xc1(A\xd3\xc1{\a7jB{\xa3\x18\xff\xf7\x06d\xa6\xb9\xcdľ\xac\x05\xc7\xd7\xe4\xfa\xcb(}e\xed\xe8`\xb9Į\xf4\xeb\xf2$o\x03\xea\x93\x01\xca(4P\x9d\xec\xc1\xc7\x13D\x00\xb5\xcc\xf9e\xbcu\xb8\xaf\x0fx\xbd\xe3\a\x1a\xcfw\x01\x941\xe5\r\xa1\xec\xedU\xdfg\b\xbbP\xf7\x8dw\x03\x8dY\xa8\x83\x8f\x10\xa2?\x90\xc1\xd8.u\xd6LR\xac\x05\x13Z\xc3\xdd\x13\xc8+\x9c\xd7\"\vd\xff|\x1e\xb7\xd5,g\x92U\xbb\xb8\xcd7\x14\xd6\v\xb3\\\x9fjĮyq\xc5Y\xe1\x14\xf1lV\xdb\xc7\x00\xcd\v\xea`Q\x92Έ~\x89z\x8a[\xadsW\x15\xa4S\x8c\xe8\xa4b\x9e@B.\xf6?RP\xd8+\xc6\x7f\xe0\xfcr\x84\xdb카\xc1Ҁ\xfaA[\x9c\x01\xc1\x0fO \xff\xa5\xe8\xf3\x1f]\x9a\x9e\xe6\xd6»\x83\"\xabv\x16/\x9c\xfd\xea\xd4\xd5ӫͿ\xd8\xcf'\x9b\x9c\xdfh\xa6\a\x89i\x8e\\UVw\xd6\xee+\xad1\b\x9a\x8f\xe7_=\xaf^\x9d|\xb8\x94\xa5\xf6n\x1eV\xee\xe1\xcb\xd7\xfc=\x92_\xfd\xa6\xbe\x96\xb9\x87/_\x9b\xbf\a\x00\xbf\xca\xff\xa71\n\x00\x00"),
 }
 
 var CRDs = crds()
@@ -20,4 +20,9 @@ echo "Updating plugin proto"
 
 protoc pkg/plugin/proto/*.proto --go_out=plugins=grpc:pkg/plugin/generated/ -I pkg/plugin/proto/
 
+# versioned plugin kinds live in their own packages, e.g. pkg/plugin/proto/backupitemaction/v2
+for dir in $(find pkg/plugin/proto -mindepth 2 -name '*.proto' -exec dirname {} \; | sort -u); do
+    protoc ${dir}/*.proto --go_out=plugins=grpc,MShared.proto=github.com/vmware-tanzu/velero/pkg/plugin/generated:pkg/plugin/generated/ -I pkg/plugin/proto/
+done
+
 echo "Updating plugin proto - done!"
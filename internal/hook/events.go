@@ -0,0 +1,43 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+// HookFailedEventReason is the reason of the events recorded on pods whose hooks failed.
+const HookFailedEventReason = "HookFailed"
+
+// recordHookFailure records a warning event on the pod whose hook failed, so that the failure
+// shows up alongside the pod's own events. It does nothing if recorder is nil.
+func recordHookFailure(recorder record.EventRecorder, namespace, name string, uid types.UID, messageFmt string, args ...interface{}) {
+	if recorder == nil {
+		return
+	}
+
+	pod := &corev1api.ObjectReference{
+		Kind:       "Pod",
+		APIVersion: "v1",
+		Namespace:  namespace,
+		Name:       name,
+		UID:        uid,
+	}
+	recorder.Eventf(pod, corev1api.EventTypeWarning, HookFailedEventReason, messageFmt, args...)
+}
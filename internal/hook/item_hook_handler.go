@@ -32,9 +32,11 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
@@ -174,6 +176,13 @@ func (i *InitContainerRestoreHookHandler) HandleRestoreHooks(
 // DefaultItemHookHandler is the default itemHookHandler.
 type DefaultItemHookHandler struct {
 	PodCommandExecutor podexec.PodCommandExecutor
+
+	// EventRecorder, if set, records an event on each pod whose hook fails.
+	EventRecorder record.EventRecorder
+	// Metrics, if set, records the outcome of each executed hook.
+	Metrics *metrics.ServerMetrics
+	// BackupName is the name of the backup that the hooks are run for, used in the events.
+	BackupName string
 }
 
 func (h *DefaultItemHookHandler) HandleHooks(
@@ -210,8 +219,11 @@ func (h *DefaultItemHookHandler) HandleHooks(
 				"hookPhase":  phase,
 			},
 		)
-		if err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", hookFromAnnotations); err != nil {
+		err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", hookFromAnnotations)
+		h.registerHookOutcome(phase, err)
+		if err != nil {
 			hookLog.WithError(err).Error("Error executing hook")
+			recordHookFailure(h.EventRecorder, namespace, name, metadata.GetUID(), "Hook %s failed in the %s phase of backup %s: %v", "<from-annotation>", phase, h.BackupName, err)
 			if hookFromAnnotations.OnError == velerov1api.HookErrorModeFail {
 				return err
 			}
@@ -244,8 +256,10 @@ func (h *DefaultItemHookHandler) HandleHooks(
 						},
 					)
 					err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, resourceHook.Name, hook.Exec)
+					h.registerHookOutcome(phase, err)
 					if err != nil {
 						hookLog.WithError(err).Error("Error executing hook")
+						recordHookFailure(h.EventRecorder, namespace, name, metadata.GetUID(), "Hook %s failed in the %s phase of backup %s: %v", resourceHook.Name, phase, h.BackupName, err)
 						if hook.Exec.OnError == velerov1api.HookErrorModeFail {
 							return err
 						}
@@ -258,6 +272,20 @@ func (h *DefaultItemHookHandler) HandleHooks(
 	return nil
 }
 
+// registerHookOutcome records the outcome of a hook executed in the given phase of a backup.
+func (h *DefaultItemHookHandler) registerHookOutcome(phase hookPhase, err error) {
+	if h.Metrics == nil {
+		return
+	}
+
+	hookPhase := string(phase) + "-backup"
+	if err != nil {
+		h.Metrics.RegisterHookFailure(hookPhase)
+	} else {
+		h.Metrics.RegisterHookSuccess(hookPhase)
+	}
+}
+
 func phasedKey(phase hookPhase, key string) string {
 	if phase != "" {
 		return fmt.Sprintf("%v.%v", phase, key)
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -506,6 +507,40 @@ func TestHandleHooks(t *testing.T) {
 	}
 }
 
+func TestHandleHooksRecordsFailureEventOnPod(t *testing.T) {
+	podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+	defer podCommandExecutor.AssertExpectations(t)
+
+	recorder := record.NewFakeRecorder(1)
+	recorder.IncludeObject = true
+	h := &DefaultItemHookHandler{
+		PodCommandExecutor: podCommandExecutor,
+		EventRecorder:      recorder,
+		BackupName:         "backup-1",
+	}
+
+	item := velerotest.UnstructuredOrDie(`
+	{
+		"apiVersion": "v1",
+		"kind": "Pod",
+		"metadata": {
+			"namespace": "ns",
+			"name": "name",
+			"uid": "1234"
+		}
+	}`)
+	hook := velerov1api.BackupResourceHook{
+		Exec: &velerov1api.ExecHook{Container: "c", Command: []string{"/bin/false"}, OnError: velerov1api.HookErrorModeContinue},
+	}
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, item.UnstructuredContent(), "ns", "name", "freeze", hook.Exec).Return(errors.New("exit code 1"))
+
+	err := h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, item, []ResourceHook{{Name: "freeze", Pre: []velerov1api.BackupResourceHook{hook}}}, PhasePre)
+	require.NoError(t, err)
+
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Warning HookFailed Hook freeze failed in the pre phase of backup backup-1: exit code 1 involvedObject{kind=Pod,apiVersion=v1}", <-recorder.Events)
+}
+
 func TestGetPodExecHookFromAnnotations(t *testing.T) {
 	phases := []hookPhase{"", PhasePre, PhasePost}
 	for _, phase := range phases {
@@ -26,8 +26,10 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -55,9 +57,19 @@ func (d *DefaultListWatchFactory) NewListWatch(namespace string, selector fields
 
 var _ ListWatchFactory = &DefaultListWatchFactory{}
 
+// postRestoreHookPhase is the hook phase label of the hook metrics recorded during restores.
+const postRestoreHookPhase = "post-restore"
+
 type DefaultWaitExecHookHandler struct {
 	ListWatchFactory   ListWatchFactory
 	PodCommandExecutor podexec.PodCommandExecutor
+
+	// EventRecorder, if set, records an event on each pod whose hook fails.
+	EventRecorder record.EventRecorder
+	// Metrics, if set, records the outcome of each hook.
+	Metrics *metrics.ServerMetrics
+	// RestoreName is the name of the restore that the hooks are run for, used in the events.
+	RestoreName string
 }
 
 var _ WaitExecHookHandler = &DefaultWaitExecHookHandler{}
@@ -157,6 +169,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				if hook.Hook.WaitTimeout.Duration != 0 && time.Since(waitStart) > hook.Hook.WaitTimeout.Duration {
 					err := fmt.Errorf("Hook %s in container %s expired before executing", hook.HookName, hook.Hook.Container)
 					hookLog.Error(err)
+					e.recordHookFailure(pod, err)
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 						errors = append(errors, err)
 						cancel()
@@ -169,8 +182,13 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					OnError:   hook.Hook.OnError,
 					Timeout:   hook.Hook.ExecTimeout,
 				}
-				if err := e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh); err != nil {
+				err := e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh)
+				if err == nil && e.Metrics != nil {
+					e.Metrics.RegisterHookSuccess(postRestoreHookPhase)
+				}
+				if err != nil {
 					hookLog.WithError(err).Error("Error executing hook")
+					e.recordHookFailure(pod, fmt.Errorf("Hook %s in container %s failed: %v", hook.HookName, hook.Hook.Container, err))
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 						errors = append(errors, err)
 						cancel()
@@ -221,6 +239,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				},
 			)
 			hookLog.Error(err)
+			e.recordHookFailure(pod, err)
 			if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 				errors = append(errors, err)
 			}
@@ -230,6 +249,13 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 	return errors
 }
 
+func (e *DefaultWaitExecHookHandler) recordHookFailure(pod *v1.Pod, err error) {
+	if e.Metrics != nil {
+		e.Metrics.RegisterHookFailure(postRestoreHookPhase)
+	}
+	recordHookFailure(e.EventRecorder, pod.Namespace, pod.Name, pod.UID, "Post-restore hook failed for restore %s: %v", e.RestoreName, err)
+}
+
 func podHasContainer(pod *v1.Pod, containerName string) bool {
 	if pod == nil {
 		return false
@@ -26,15 +26,28 @@ type PluginLister interface {
 	List(kind framework.PluginKind) []framework.PluginIdentifier
 }
 
-// GetInstalledPluginInfo returns a list of installed plugins
-func GetInstalledPluginInfo(pluginLister PluginLister) []velerov1api.PluginInfo {
+type PluginStatusGetter interface {
+	// Status returns the status of the plugin executable command, and false if it
+	// hasn't been run.
+	Status(command string) (velerov1api.PluginStatus, bool)
+}
+
+// GetInstalledPluginInfo returns a list of installed plugins. If statusGetter is not
+// nil, the status of each plugin's process is included.
+func GetInstalledPluginInfo(pluginLister PluginLister, statusGetter PluginStatusGetter) []velerov1api.PluginInfo {
 	var plugins []velerov1api.PluginInfo
 	for _, v := range framework.AllPluginKinds() {
 		list := pluginLister.List(v)
 		for _, plugin := range list {
 			pluginInfo := velerov1api.PluginInfo{
-				Name: plugin.Name,
-				Kind: plugin.Kind.String(),
+				Name:    plugin.Name,
+				Kind:    plugin.Kind.String(),
+				Version: plugin.Kind.Version(),
+			}
+			if statusGetter != nil {
+				if status, ok := statusGetter.Status(plugin.Command); ok {
+					pluginInfo.Status = &status
+				}
 			}
 			plugins = append(plugins, pluginInfo)
 		}
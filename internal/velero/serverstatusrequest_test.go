@@ -0,0 +1,91 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+)
+
+type fakePluginLister struct {
+	plugins []framework.PluginIdentifier
+}
+
+func (l *fakePluginLister) List(kind framework.PluginKind) []framework.PluginIdentifier {
+	var plugins []framework.PluginIdentifier
+	for _, plugin := range l.plugins {
+		if plugin.Kind == kind {
+			plugins = append(plugins, plugin)
+		}
+	}
+	return plugins
+}
+
+type fakePluginStatusGetter map[string]velerov1api.PluginStatus
+
+func (g fakePluginStatusGetter) Status(command string) (velerov1api.PluginStatus, bool) {
+	status, found := g[command]
+	return status, found
+}
+
+func TestGetInstalledPluginInfo(t *testing.T) {
+	lister := &fakePluginLister{
+		plugins: []framework.PluginIdentifier{
+			{Command: "/plugins/aws", Kind: framework.PluginKindObjectStore, Name: "velero.io/aws"},
+			{Command: "/velero", Kind: framework.PluginKindBackupItemActionV2, Name: "velero.io/pod"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		statusGetter PluginStatusGetter
+		want         []velerov1api.PluginInfo
+	}{
+		{
+			name: "without a status getter, plugins have no status",
+			want: []velerov1api.PluginInfo{
+				{Name: "velero.io/pod", Kind: "BackupItemAction/v2", Version: "v2"},
+				{Name: "velero.io/aws", Kind: "ObjectStore", Version: "v1"},
+			},
+		},
+		{
+			name: "plugins whose executables have been run have their status",
+			statusGetter: fakePluginStatusGetter{
+				"/plugins/aws": {Phase: velerov1api.PluginPhaseRestarting, RestartCount: 2, LastError: "plugin process exited"},
+			},
+			want: []velerov1api.PluginInfo{
+				{Name: "velero.io/pod", Kind: "BackupItemAction/v2", Version: "v2"},
+				{
+					Name:    "velero.io/aws",
+					Kind:    "ObjectStore",
+					Version: "v1",
+					Status:  &velerov1api.PluginStatus{Phase: velerov1api.PluginPhaseRestarting, RestartCount: 2, LastError: "plugin process exited"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tc.want, GetInstalledPluginInfo(lister, tc.statusGetter))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ActionStatus records the execution of a pre/post backup or restore
+// action plugin.
+type ActionStatus struct {
+	// PluginName is the name of the registered plugin.
+	// +optional
+	PluginName string `json:"pluginName,omitempty"`
+
+	// StartTimestamp records the time the plugin was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the plugin completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Phase is the current state of the action.
+	// +optional
+	Phase ActionPhase `json:"phase,omitempty"`
+
+	// Message is the error returned by the plugin, if it failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ActionPhase is a string representation of the lifecycle phase
+// of a pre/post backup or restore action plugin.
+// +kubebuilder:validation:Enum=InProgress;Completed;Failed
+type ActionPhase string
+
+const (
+	// ActionPhaseInProgress means the action is being executed.
+	ActionPhaseInProgress ActionPhase = "InProgress"
+
+	// ActionPhaseCompleted means the action finished successfully.
+	ActionPhaseCompleted ActionPhase = "Completed"
+
+	// ActionPhaseFailed means the action returned an error.
+	ActionPhaseFailed ActionPhase = "Failed"
+)
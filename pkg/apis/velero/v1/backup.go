@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -95,6 +96,35 @@ type BackupSpec struct {
 	// + nullable
 	DefaultVolumesToRestic *bool `json:"defaultVolumesToRestic,omitempty"`
 
+	// UnmountedVolumesToRestic specifies whether restic should be used to take a
+	// backup of persistent volume claims that are not mounted by any running pod.
+	// Each such claim is temporarily mounted in a Velero-managed helper pod while
+	// its data is backed up.
+	// +optional
+	// +nullable
+	UnmountedVolumesToRestic *bool `json:"unmountedVolumesToRestic,omitempty"`
+
+	// SnapshotMoveData specifies whether the data of the CSI snapshots taken
+	// during the backup is copied to the backup storage location, so that it
+	// survives the loss of the storage system.
+	// +optional
+	// +nullable
+	SnapshotMoveData *bool `json:"snapshotMoveData,omitempty"`
+
+	// ResticVolumeRules are evaluated in order for each pod volume that isn't
+	// explicitly selected or excluded by the pod's restic annotations. The first
+	// rule that matches a volume decides whether it's backed up with restic. If no
+	// rule matches, DefaultVolumesToRestic decides.
+	// +optional
+	// +nullable
+	ResticVolumeRules []ResticVolumeRule `json:"resticVolumeRules,omitempty"`
+
+	// ResticRateLimit overrides the backup storage location's restic rate
+	// limit for this backup's pod volume backups.
+	// +optional
+	// +nullable
+	ResticRateLimit *ResticRateLimit `json:"resticRateLimit,omitempty"`
+
 	// OrderedResources specifies the backup order of resources of specific Kind.
 	// The map key is the Kind name and value is a list of resource names separated by commas.
 	// Each resource name has format "namespace/resourcename".  For cluster resources, simply use "resourcename".
@@ -103,6 +133,57 @@ type BackupSpec struct {
 	OrderedResources map[string]string `json:"orderedResources,omitempty"`
 }
 
+// ResticVolumeRuleAction is whether the pod volumes matched by a
+// ResticVolumeRule are backed up with restic.
+// +kubebuilder:validation:Enum=Include;Exclude
+type ResticVolumeRuleAction string
+
+const (
+	// ResticVolumeRuleActionInclude means matching volumes are backed up with restic.
+	ResticVolumeRuleActionInclude ResticVolumeRuleAction = "Include"
+
+	// ResticVolumeRuleActionExclude means matching volumes are not backed up with restic.
+	ResticVolumeRuleActionExclude ResticVolumeRuleAction = "Exclude"
+)
+
+// ResticVolumeRule selects pod volumes to include in or exclude from restic
+// backups. A volume matches the rule if it matches all of the rule's criteria.
+type ResticVolumeRule struct {
+	// Action is whether matching volumes are backed up with restic.
+	Action ResticVolumeRuleAction `json:"action"`
+
+	// PVCSelector matches persistent volume claim volumes whose claim has matching
+	// labels. Other kinds of volumes never match it.
+	// +optional
+	// +nullable
+	PVCSelector *metav1.LabelSelector `json:"pvcSelector,omitempty"`
+
+	// StorageClasses matches persistent volume claim volumes whose claim uses one of
+	// the storage classes. Other kinds of volumes never match it.
+	// +optional
+	// +nullable
+	StorageClasses []string `json:"storageClasses,omitempty"`
+
+	// VolumeTypes matches volumes of the given types, named as in the pod spec's
+	// volume source, for example "persistentVolumeClaim", "emptyDir" or "projected".
+	// +optional
+	// +nullable
+	VolumeTypes []string `json:"volumeTypes,omitempty"`
+
+	// MinSize matches volumes at least this large. The size of a persistent volume
+	// claim volume is its claim's capacity, and the size of an emptyDir volume is
+	// its size limit. Volumes without a known size never match it.
+	// +optional
+	// +nullable
+	MinSize *resource.Quantity `json:"minSize,omitempty"`
+
+	// MaxSize matches volumes at most this large. Volumes without a known size
+	// never match it.
+	// +optional
+	// +nullable
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
 type BackupHooks struct {
 	// Resources are hooks that should be executed when backing up individual instances of a resource.
@@ -198,7 +279,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;FailedPreBackupActions;InProgress;Completed;PartiallyFailed;Failed;Deleting
 type BackupPhase string
 
 const (
@@ -210,6 +291,10 @@ const (
 	// the controller's validations and therefore will not run.
 	BackupPhaseFailedValidation BackupPhase = "FailedValidation"
 
+	// BackupPhaseFailedPreBackupActions means one or more of the
+	// pre-backup actions failed and therefore the backup will not run.
+	BackupPhaseFailedPreBackupActions BackupPhase = "FailedPreBackupActions"
+
 	// BackupPhaseInProgress means the backup is currently executing.
 	BackupPhaseInProgress BackupPhase = "InProgress"
 
@@ -310,6 +395,20 @@ type BackupStatus struct {
 	// +optional
 	// +nullable
 	Progress *BackupProgress `json:"progress,omitempty"`
+
+	// PreBackupActionsStatuses contains information about the execution of the
+	// pre-backup action plugins. It's only populated if there are pre-backup
+	// action plugins registered.
+	// +optional
+	// +nullable
+	PreBackupActionsStatuses []ActionStatus `json:"preBackupActionsStatuses,omitempty"`
+
+	// PostBackupActionsStatuses contains information about the execution of the
+	// post-backup action plugins. It's only populated if there are post-backup
+	// action plugins registered.
+	// +optional
+	// +nullable
+	PostBackupActionsStatuses []ActionStatus `json:"postBackupActionsStatuses,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
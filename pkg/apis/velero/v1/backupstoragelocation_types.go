@@ -54,6 +54,26 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// ResticRateLimit limits the bandwidth used by restic to transfer pod volume
+	// data to and from the restic repositories in this location.
+	// +optional
+	// +nullable
+	ResticRateLimit *ResticRateLimit `json:"resticRateLimit,omitempty"`
+}
+
+// ResticRateLimit limits the bandwidth used by restic to transfer data
+// to and from object storage.
+type ResticRateLimit struct {
+	// UploadKiBps is the maximum upload rate of pod volume backups, in KiB/s.
+	// 0 means no limit.
+	// +optional
+	UploadKiBps int64 `json:"uploadKiBps,omitempty"`
+
+	// DownloadKiBps is the maximum download rate of pod volume restores, in KiB/s.
+	// 0 means no limit.
+	// +optional
+	DownloadKiBps int64 `json:"downloadKiBps,omitempty"`
 }
 
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
@@ -80,9 +100,8 @@ type BackupStorageLocationStatus struct {
 
 	// LastSyncedRevision is the value of the `metadata/revision` file in the backup
 	// storage location the last time the BSL's contents were synced into the cluster.
-	//
-	// Deprecated: this field is no longer updated or used for detecting changes to
-	// the location's contents and will be removed entirely in v2.0.
+	// Backup sync skips the location while its revision is unchanged, and otherwise
+	// syncs the changes in the location's change log since the revision.
 	// +optional
 	LastSyncedRevision types.UID `json:"lastSyncedRevision,omitempty"`
 
@@ -177,4 +196,3 @@ const (
 )
 
 // TODO(2.0): remove the AccessMode field from BackupStorageLocationStatus.
-// TODO(2.0): remove the LastSyncedRevision field from BackupStorageLocationStatus.
@@ -0,0 +1,116 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DataDownloadSpec is the specification for a DataDownload.
+type DataDownloadSpec struct {
+	// TargetNamespace is the namespace of the persistent volume claim
+	// the data is downloaded to.
+	TargetNamespace string `json:"targetNamespace"`
+
+	// TargetPVC is the name of the persistent volume claim the data is
+	// downloaded to.
+	TargetPVC string `json:"targetPVC"`
+
+	// SourceNamespace is the namespace the data was uploaded from, which
+	// identifies the restic repository it's stored in.
+	SourceNamespace string `json:"sourceNamespace"`
+
+	// SnapshotID is the identifier of the restic snapshot of the data.
+	SnapshotID string `json:"snapshotID"`
+
+	// BackupStorageLocation is the name of the backup storage location
+	// where the data is stored.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+
+	// Cancel indicates that the download should be stopped.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// DataDownloadPhase represents the lifecycle phase of a DataDownload.
+// +kubebuilder:validation:Enum=New;InProgress;Completed;Failed;Canceled
+type DataDownloadPhase string
+
+const (
+	DataDownloadPhaseNew        DataDownloadPhase = "New"
+	DataDownloadPhaseInProgress DataDownloadPhase = "InProgress"
+	DataDownloadPhaseCompleted  DataDownloadPhase = "Completed"
+	DataDownloadPhaseFailed     DataDownloadPhase = "Failed"
+	DataDownloadPhaseCanceled   DataDownloadPhase = "Canceled"
+)
+
+// DataDownloadStatus is the current status of a DataDownload.
+type DataDownloadStatus struct {
+	// Phase is the current state of the DataDownload.
+	// +optional
+	Phase DataDownloadPhase `json:"phase,omitempty"`
+
+	// Message is a message about the data download's status.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartTimestamp records the time the download was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the download was completed.
+	// Completion time is recorded even on failed or canceled downloads.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Progress holds the total number of bytes of the snapshot and the
+	// current number of downloaded bytes.
+	// +optional
+	Progress PodVolumeOperationProgress `json:"progress,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DataDownload restores the data uploaded by a DataUpload into a new
+// persistent volume claim.
+type DataDownload struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec DataDownloadSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status DataDownloadStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DataDownloadList is a list of DataDownloads.
+type DataDownloadList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DataDownload `json:"items"`
+}
@@ -0,0 +1,124 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DataUploadSpec is the specification for a DataUpload.
+type DataUploadSpec struct {
+	// VolumeSnapshot is the name of the CSI VolumeSnapshot whose data is
+	// uploaded.
+	VolumeSnapshot string `json:"volumeSnapshot"`
+
+	// SourceNamespace is the namespace of the VolumeSnapshot and of the
+	// persistent volume claim it was taken from.
+	SourceNamespace string `json:"sourceNamespace"`
+
+	// SourcePVC is the name of the persistent volume claim the
+	// VolumeSnapshot was taken from.
+	SourcePVC string `json:"sourcePVC"`
+
+	// StorageClass is the name of the storage class used to provision
+	// the temporary persistent volume claim the snapshot is restored to
+	// while its data is uploaded.
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// BackupStorageLocation is the name of the backup storage location
+	// where the data is uploaded to.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+
+	// Cancel indicates that the upload should be stopped.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// DataUploadPhase represents the lifecycle phase of a DataUpload.
+// +kubebuilder:validation:Enum=New;InProgress;Completed;Failed;Canceled
+type DataUploadPhase string
+
+const (
+	DataUploadPhaseNew        DataUploadPhase = "New"
+	DataUploadPhaseInProgress DataUploadPhase = "InProgress"
+	DataUploadPhaseCompleted  DataUploadPhase = "Completed"
+	DataUploadPhaseFailed     DataUploadPhase = "Failed"
+	DataUploadPhaseCanceled   DataUploadPhase = "Canceled"
+)
+
+// DataUploadStatus is the current status of a DataUpload.
+type DataUploadStatus struct {
+	// Phase is the current state of the DataUpload.
+	// +optional
+	Phase DataUploadPhase `json:"phase,omitempty"`
+
+	// SnapshotID is the identifier of the restic snapshot of the
+	// uploaded data.
+	// +optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// Message is a message about the data upload's status.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartTimestamp records the time the upload was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the upload was completed.
+	// Completion time is recorded even on failed or canceled uploads.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Progress holds the total number of bytes of the volume and the current
+	// number of uploaded bytes.
+	// +optional
+	Progress PodVolumeOperationProgress `json:"progress,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DataUpload copies the data of a CSI VolumeSnapshot taken during a backup
+// to the backup storage location.
+type DataUpload struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec DataUploadSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status DataUploadStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DataUploadList is a list of DataUploads.
+type DataUploadList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DataUpload `json:"items"`
+}
@@ -38,6 +38,14 @@ const (
 	// PVCUIDLabel is the label key used to identify a PVC by uid.
 	PVCUIDLabel = "velero.io/pvc-uid"
 
+	// ResticHelperPodLabel is the label key used to identify the pods that Velero
+	// creates to mount persistent volume claims for restic backups, and the pod
+	// volume backups taken through them.
+	ResticHelperPodLabel = "velero.io/restic-helper-pod"
+
+	// ExcludeFromBackupLabel is the label key used to exclude an item from backups.
+	ExcludeFromBackupLabel = "velero.io/exclude-from-backup"
+
 	// PodVolumeOperationTimeoutAnnotation is the annotation key used to apply
 	// a backup/restore-specific timeout value for pod volume operations (i.e.
 	// restic backups/restores).
@@ -0,0 +1,285 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NotificationSinkType is the kind of target a NotificationSink delivers notifications to.
+// +kubebuilder:validation:Enum=Webhook;Slack;SMTP;CloudEvents
+type NotificationSinkType string
+
+const (
+	// NotificationSinkTypeWebhook means notifications are POSTed as JSON documents to
+	// spec.http.
+	NotificationSinkTypeWebhook NotificationSinkType = "Webhook"
+
+	// NotificationSinkTypeSlack means notifications are POSTed to spec.http in the format
+	// of Slack incoming webhooks, which many chat services accept.
+	NotificationSinkTypeSlack NotificationSinkType = "Slack"
+
+	// NotificationSinkTypeSMTP means notifications are emailed through spec.smtp.
+	NotificationSinkTypeSMTP NotificationSinkType = "SMTP"
+
+	// NotificationSinkTypeCloudEvents means notifications are POSTed to spec.http as
+	// CloudEvents in the HTTP binary content mode.
+	NotificationSinkTypeCloudEvents NotificationSinkType = "CloudEvents"
+)
+
+// NotificationEventKind is the kind of resource whose phase transitions are notified.
+// +kubebuilder:validation:Enum=Backup;Restore;DeleteBackupRequest
+type NotificationEventKind string
+
+const (
+	NotificationEventKindBackup              NotificationEventKind = "Backup"
+	NotificationEventKindRestore             NotificationEventKind = "Restore"
+	NotificationEventKindDeleteBackupRequest NotificationEventKind = "DeleteBackupRequest"
+)
+
+// NotificationSinkSpec is the specification for a NotificationSink.
+type NotificationSinkSpec struct {
+	// Type is the kind of target notifications are delivered to.
+	Type NotificationSinkType `json:"type"`
+
+	// HTTP is the target of Webhook, Slack and CloudEvents sinks.
+	// +optional
+	// +nullable
+	HTTP *HTTPNotificationTarget `json:"http,omitempty"`
+
+	// SMTP is the target of SMTP sinks.
+	// +optional
+	// +nullable
+	SMTP *SMTPNotificationTarget `json:"smtp,omitempty"`
+
+	// Filter limits the phase transitions that are notified. If it's empty, every phase
+	// transition of every Backup, Restore and DeleteBackupRequest is notified.
+	// +optional
+	Filter NotificationFilter `json:"filter,omitempty"`
+
+	// Template is a Go text/template that renders the notification's message from the
+	// phase transition. If it's not set, a one-line summary of the transition is used.
+	// +optional
+	Template string `json:"template,omitempty"`
+
+	// MaxRetries is the number of times a failed delivery is retried, with exponential
+	// backoff, before it's recorded as failed. Defaults to 5.
+	// +optional
+	// +nullable
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// Timeout is how long each delivery attempt may take. Defaults to 30 seconds.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// HTTPNotificationTarget is an HTTP endpoint that notifications are POSTed to.
+type HTTPNotificationTarget struct {
+	// URL is the endpoint's URL. Exactly one of URL and URLSecret must be set.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// URLSecret is the key of a secret in the NotificationSink's namespace containing the
+	// endpoint's URL, for endpoints such as Slack incoming webhooks whose URLs are
+	// credentials.
+	// +optional
+	// +nullable
+	URLSecret *corev1api.SecretKeySelector `json:"urlSecret,omitempty"`
+
+	// Headers are additional headers sent with each request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// AuthorizationSecret is the key of a secret in the NotificationSink's namespace
+	// containing the value of the Authorization header sent with each request.
+	// +optional
+	// +nullable
+	AuthorizationSecret *corev1api.SecretKeySelector `json:"authorizationSecret,omitempty"`
+
+	// CACert is a PEM-encoded certificate bundle to trust the endpoint's certificate with.
+	// +optional
+	CACert []byte `json:"caCert,omitempty"`
+
+	// InsecureSkipTLSVerify disables verification of the endpoint's certificate.
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// SMTPNotificationTarget is a mail server that notifications are emailed through.
+type SMTPNotificationTarget struct {
+	// Host is the mail server's host name.
+	Host string `json:"host"`
+
+	// Port is the mail server's port. Defaults to 587.
+	// +optional
+	Port int `json:"port,omitempty"`
+
+	// From is the address notifications are sent from.
+	From string `json:"from"`
+
+	// To are the addresses notifications are sent to.
+	// +kubebuilder:validation:MinItems=1
+	To []string `json:"to"`
+
+	// Username is the user to authenticate to the mail server as. If it's not set, no
+	// authentication is done.
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// PasswordSecret is the key of a secret in the NotificationSink's namespace
+	// containing the password of Username.
+	// +optional
+	// +nullable
+	PasswordSecret *corev1api.SecretKeySelector `json:"passwordSecret,omitempty"`
+}
+
+// NotificationFilter limits the phase transitions that a NotificationSink notifies.
+// A transition is notified if it matches every non-empty field.
+type NotificationFilter struct {
+	// Kinds are the kinds of resources whose phase transitions are notified.
+	// +optional
+	Kinds []NotificationEventKind `json:"kinds,omitempty"`
+
+	// Phases are the phases whose transitions are notified, e.g. Failed or
+	// PartiallyFailed.
+	// +optional
+	Phases []string `json:"phases,omitempty"`
+
+	// Schedules are the names of the schedules whose backups, and restores from whose
+	// backups, are notified.
+	// +optional
+	Schedules []string `json:"schedules,omitempty"`
+
+	// Namespaces limits notifications to backups and restores that include any of these
+	// namespaces. Backups and restores that include all namespaces match any namespace.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// NotificationDeliveryResult is the result of delivering a notification.
+// +kubebuilder:validation:Enum=Delivered;Failed
+type NotificationDeliveryResult string
+
+const (
+	// NotificationDeliveryResultDelivered means the notification was delivered.
+	NotificationDeliveryResultDelivered NotificationDeliveryResult = "Delivered"
+
+	// NotificationDeliveryResultFailed means the notification could not be delivered
+	// after all of its retries.
+	NotificationDeliveryResultFailed NotificationDeliveryResult = "Failed"
+)
+
+// NotificationDelivery is the record of delivering a notification.
+type NotificationDelivery struct {
+	// Kind is the kind of resource whose phase transition was notified.
+	Kind NotificationEventKind `json:"kind"`
+
+	// Name is the name of the resource whose phase transition was notified.
+	Name string `json:"name"`
+
+	// Phase is the phase the resource transitioned to.
+	Phase string `json:"phase"`
+
+	// Result is the result of the delivery.
+	Result NotificationDeliveryResult `json:"result"`
+
+	// Attempts is the number of attempts made to deliver the notification.
+	// +optional
+	Attempts int `json:"attempts,omitempty"`
+
+	// Timestamp is when the delivery succeeded, or when its last attempt failed.
+	// +optional
+	// +nullable
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+
+	// Message is the error the last attempt failed with.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// NotificationSinkStatus is the current status of a NotificationSink.
+type NotificationSinkStatus struct {
+	// DeliveredCount is the number of notifications that were delivered.
+	// +optional
+	DeliveredCount int `json:"deliveredCount,omitempty"`
+
+	// FailedCount is the number of notifications that could not be delivered.
+	// +optional
+	FailedCount int `json:"failedCount,omitempty"`
+
+	// LastDeliveryTimestamp is when a notification was last delivered.
+	// +optional
+	// +nullable
+	LastDeliveryTimestamp *metav1.Time `json:"lastDeliveryTimestamp,omitempty"`
+
+	// LastFailureTimestamp is when a notification last failed to be delivered.
+	// +optional
+	// +nullable
+	LastFailureTimestamp *metav1.Time `json:"lastFailureTimestamp,omitempty"`
+
+	// RecentDeliveries are the records of the most recent deliveries, most recent first.
+	// +optional
+	// +nullable
+	RecentDeliveries []NotificationDelivery `json:"recentDeliveries,omitempty"`
+}
+
+// TODO(2.0) After converting all resources to use the runtime-controller client,
+// the genclient and k8s:deepcopy markers will no longer be needed and should be removed.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Notification target type"
+// +kubebuilder:printcolumn:name="Delivered",type="integer",JSONPath=".status.deliveredCount",description="Notifications delivered"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedCount",description="Notifications that could not be delivered"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:rbac:groups=velero.io,resources=notificationsinks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=notificationsinks/status,verbs=get;update;patch
+
+// NotificationSink is a target that the Velero server notifies of the phase
+// transitions of Backups, Restores and DeleteBackupRequests.
+type NotificationSink struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec NotificationSinkSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status NotificationSinkStatus `json:"status,omitempty"`
+}
+
+// TODO(2.0) After converting all resources to use the runtime-controller client,
+// the k8s:deepcopy marker will no longer be needed and should be removed.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// NotificationSinkList is a list of NotificationSinks.
+type NotificationSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []NotificationSink `json:"items"`
+}
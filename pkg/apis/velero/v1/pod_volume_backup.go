@@ -44,6 +44,12 @@ type PodVolumeBackupSpec struct {
 	// volume backup as tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// RateLimit overrides the backup storage location's restic rate limit
+	// for this pod volume backup.
+	// +optional
+	// +nullable
+	RateLimit *ResticRateLimit `json:"rateLimit,omitempty"`
 }
 
 // PodVolumeBackupPhase represents the lifecycle phase of a PodVolumeBackup.
@@ -38,6 +38,32 @@ type PodVolumeRestoreSpec struct {
 
 	// SnapshotID is the ID of the volume snapshot to be restored.
 	SnapshotID string `json:"snapshotID"`
+
+	// PersistentVolumeClaim is the name of the persistent volume claim being
+	// populated, when the volume is restored through a Velero helper pod
+	// rather than through the restic init container of a restored pod.
+	// +optional
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+
+	// RateLimit overrides the backup storage location's restic rate limit
+	// for this pod volume restore.
+	// +optional
+	// +nullable
+	RateLimit *ResticRateLimit `json:"rateLimit,omitempty"`
+
+	// IncludePaths are the paths of the files and directories within the
+	// volume to restore, such as /var/lib/app/file. If it's set, only these
+	// paths are restored, into the volume of a running pod rather than of
+	// a pod that's waiting in the restic init container.
+	// +optional
+	// +nullable
+	IncludePaths []string `json:"includePaths,omitempty"`
+
+	// TargetPath is the directory within the volume that the files are
+	// restored into. The files are restored into the volume's root if it's
+	// empty.
+	// +optional
+	TargetPath string `json:"targetPath,omitempty"`
 }
 
 // PodVolumeRestorePhase represents the lifecycle phase of a PodVolumeRestore.
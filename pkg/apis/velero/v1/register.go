@@ -56,6 +56,10 @@ func CustomResources() map[string]typeInfo {
 		"BackupStorageLocation":  newTypeInfo("backupstoragelocations", &BackupStorageLocation{}, &BackupStorageLocationList{}),
 		"VolumeSnapshotLocation": newTypeInfo("volumesnapshotlocations", &VolumeSnapshotLocation{}, &VolumeSnapshotLocationList{}),
 		"ServerStatusRequest":    newTypeInfo("serverstatusrequests", &ServerStatusRequest{}, &ServerStatusRequestList{}),
+		"DataUpload":             newTypeInfo("datauploads", &DataUpload{}, &DataUploadList{}),
+		"DataDownload":           newTypeInfo("datadownloads", &DataDownload{}, &DataDownloadList{}),
+		"VeleroPlugin":           newTypeInfo("veleroplugins", &VeleroPlugin{}, &VeleroPluginList{}),
+		"NotificationSink":       newTypeInfo("notificationsinks", &NotificationSink{}, &NotificationSinkList{}),
 	}
 }
 
@@ -92,8 +92,36 @@ type RestoreSpec struct {
 	// Hooks represent custom behaviors that should be executed during or post restore.
 	// +optional
 	Hooks RestoreHooks `json:"hooks,omitempty"`
+
+	// PodVolumeRestoreMode specifies how volumes backed up with restic are restored.
+	// If empty, defaults to InitContainer.
+	// +optional
+	PodVolumeRestoreMode PodVolumeRestoreMode `json:"podVolumeRestoreMode,omitempty"`
+
+	// ResticRateLimit overrides the backup storage location's restic rate
+	// limit for this restore's pod volume restores.
+	// +optional
+	// +nullable
+	ResticRateLimit *ResticRateLimit `json:"resticRateLimit,omitempty"`
 }
 
+// PodVolumeRestoreMode defines how volumes backed up with restic are restored.
+// +kubebuilder:validation:Enum=InitContainer;HelperPod
+type PodVolumeRestoreMode string
+
+const (
+	// PodVolumeRestoreModeInitContainer means that restic's init container is added to
+	// each restored pod with volumes to restore, and the pod's volumes are populated
+	// while the init container is running.
+	PodVolumeRestoreModeInitContainer PodVolumeRestoreMode = "InitContainer"
+
+	// PodVolumeRestoreModeHelperPod means that each restored persistent volume claim
+	// with a restic backup is populated from a Velero-owned helper pod before any
+	// restored pod that uses it is created. Restored pods are not modified, and only
+	// volumes that are persistent volume claims are restored.
+	PodVolumeRestoreModeHelperPod PodVolumeRestoreMode = "HelperPod"
+)
+
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
 type RestoreHooks struct {
 	Resources []RestoreResourceHookSpec `json:"resources,omitempty"`
@@ -186,7 +214,7 @@ type InitRestoreHook struct {
 
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed
+// +kubebuilder:validation:Enum=New;FailedValidation;FailedPreRestoreActions;InProgress;Completed;PartiallyFailed;Failed
 type RestorePhase string
 
 const (
@@ -198,6 +226,10 @@ const (
 	// the controller's validations and therefore will not run.
 	RestorePhaseFailedValidation RestorePhase = "FailedValidation"
 
+	// RestorePhaseFailedPreRestoreActions means one or more of the
+	// pre-restore actions failed and therefore the restore will not run.
+	RestorePhaseFailedPreRestoreActions RestorePhase = "FailedPreRestoreActions"
+
 	// RestorePhaseInProgress means the restore is currently executing.
 	RestorePhaseInProgress RestorePhase = "InProgress"
 
@@ -259,6 +291,20 @@ type RestoreStatus struct {
 	// +optional
 	// +nullable
 	Progress *RestoreProgress `json:"progress,omitempty"`
+
+	// PreRestoreActionsStatuses contains information about the execution of the
+	// pre-restore action plugins. It's only populated if there are pre-restore
+	// action plugins registered.
+	// +optional
+	// +nullable
+	PreRestoreActionsStatuses []ActionStatus `json:"preRestoreActionsStatuses,omitempty"`
+
+	// PostRestoreActionsStatuses contains information about the execution of the
+	// post-restore action plugins. It's only populated if there are post-restore
+	// action plugins registered.
+	// +optional
+	// +nullable
+	PostRestoreActionsStatuses []ActionStatus `json:"postRestoreActionsStatuses,omitempty"`
 }
 
 // RestoreProgress stores information about the restore's execution progress
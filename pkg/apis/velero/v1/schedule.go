@@ -38,8 +38,72 @@ type ScheduleSpec struct {
 	// +optional
 	// +nullable
 	UseOwnerReferencesInBackup *bool `json:"useOwnerReferencesInBackup,omitempty"`
+
+	// Paused specifies whether the schedule is paused. A paused schedule
+	// doesn't run backups, and the runs it misses while paused are skipped.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// TimeZone is the IANA name of the time zone the Cron expression is
+	// evaluated in, e.g. "America/New_York". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// MissedRunPolicy specifies what to do with the runs that were missed
+	// while the Velero server wasn't running. Defaults to RunOnce.
+	// +optional
+	MissedRunPolicy ScheduleMissedRunPolicy `json:"missedRunPolicy,omitempty"`
+
+	// MissedRunWindow is how long ago a missed run can have been due for
+	// it to still be run with the RunAll missed run policy. Defaults to 24h.
+	// +optional
+	// +nullable
+	MissedRunWindow *metav1.Duration `json:"missedRunWindow,omitempty"`
+
+	// ConcurrencyPolicy specifies how to treat a scheduled run while a
+	// previous Backup from this Schedule hasn't completed. Defaults to Allow.
+	// +optional
+	ConcurrencyPolicy ScheduleConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
 }
 
+// ScheduleConcurrencyPolicy specifies how a schedule treats runs that overlap
+// with a previous Backup that hasn't completed.
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+type ScheduleConcurrencyPolicy string
+
+const (
+	// ScheduleConcurrencyPolicyAllow means that a Backup is created for each
+	// scheduled run, even if a previous one hasn't completed.
+	ScheduleConcurrencyPolicyAllow ScheduleConcurrencyPolicy = "Allow"
+
+	// ScheduleConcurrencyPolicyForbid means that a scheduled run is skipped
+	// if a previous Backup is still new or in progress.
+	ScheduleConcurrencyPolicyForbid ScheduleConcurrencyPolicy = "Forbid"
+
+	// ScheduleConcurrencyPolicyReplace means that previous Backups that are
+	// still new or in progress are deleted and replaced by the Backup for the
+	// scheduled run. Backups in progress are cancelled before they're deleted.
+	ScheduleConcurrencyPolicyReplace ScheduleConcurrencyPolicy = "Replace"
+)
+
+// ScheduleMissedRunPolicy specifies what a schedule does with missed runs.
+// +kubebuilder:validation:Enum=Skip;RunOnce;RunAll
+type ScheduleMissedRunPolicy string
+
+const (
+	// ScheduleMissedRunPolicySkip means that missed runs are skipped, and
+	// the next backup is run at the next scheduled time.
+	ScheduleMissedRunPolicySkip ScheduleMissedRunPolicy = "Skip"
+
+	// ScheduleMissedRunPolicyRunOnce means that a single backup is run
+	// as soon as possible for all of the missed runs.
+	ScheduleMissedRunPolicyRunOnce ScheduleMissedRunPolicy = "RunOnce"
+
+	// ScheduleMissedRunPolicyRunAll means that a backup is run for each
+	// of the missed runs within the schedule's missed run window.
+	ScheduleMissedRunPolicyRunAll ScheduleMissedRunPolicy = "RunAll"
+)
+
 // SchedulePhase is a string representation of the lifecycle phase
 // of a Velero schedule
 // +kubebuilder:validation:Enum=New;Enabled;FailedValidation
@@ -71,6 +135,20 @@ type ScheduleStatus struct {
 	// +nullable
 	LastBackup *metav1.Time `json:"lastBackup,omitempty"`
 
+	// NextRunTime is the next time a Backup is due to be run for this
+	// Schedule.
+	// +optional
+	// +nullable
+	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
+
+	// LastSkipped is the time of the last scheduled run that was skipped,
+	// because the Schedule was paused, because of its missed run policy,
+	// or because a previous Backup hadn't completed and its concurrency
+	// policy is Forbid.
+	// +optional
+	// +nullable
+	LastSkipped *metav1.Time `json:"lastSkipped,omitempty"`
+
 	// ValidationErrors is a slice of all validation errors (if
 	// applicable)
 	// +optional
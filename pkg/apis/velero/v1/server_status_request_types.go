@@ -64,6 +64,60 @@ const (
 type PluginInfo struct {
 	Name string `json:"name"`
 	Kind string `json:"kind"`
+
+	// Version is the version of the plugin kind's interface that the plugin implements,
+	// e.g. v2 for a BackupItemAction/v2 plugin.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Status is the health of the plugin's process. It is not set if the plugin's
+	// process has not been run since the Velero server started.
+	// +optional
+	// +nullable
+	Status *PluginStatus `json:"status,omitempty"`
+}
+
+// PluginPhase represents the health of a plugin's process.
+// +kubebuilder:validation:Enum=Up;Restarting;Failed;Stopped
+type PluginPhase string
+
+const (
+	// PluginPhaseUp means the plugin's process is running and passing health checks.
+	PluginPhaseUp PluginPhase = "Up"
+
+	// PluginPhaseRestarting means the plugin's process exited or failed a health check
+	// and is being restarted.
+	PluginPhaseRestarting PluginPhase = "Restarting"
+
+	// PluginPhaseFailed means the plugin's process could not be started or restarted.
+	PluginPhaseFailed PluginPhase = "Failed"
+
+	// PluginPhaseStopped means the plugin's process was stopped because Velero no
+	// longer needed it.
+	PluginPhaseStopped PluginPhase = "Stopped"
+)
+
+// PluginStatus is the health of a plugin's process. All of the plugins in an
+// executable share the same process, and so the same status.
+type PluginStatus struct {
+	// Phase is the current health of the plugin's process.
+	// +optional
+	Phase PluginPhase `json:"phase,omitempty"`
+
+	// RestartCount is the number of times the plugin's process has been restarted
+	// since the Velero server started.
+	// +optional
+	RestartCount int `json:"restartCount,omitempty"`
+
+	// LastError is the error that caused the most recent restart, or that the most
+	// recent restart failed with.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// LastHealthCheckTimestamp is when the plugin's process last passed a health check.
+	// +optional
+	// +nullable
+	LastHealthCheckTimestamp *metav1.Time `json:"lastHealthCheckTimestamp,omitempty"`
 }
 
 // ServerStatusRequestStatus is the current status of a ServerStatusRequest.
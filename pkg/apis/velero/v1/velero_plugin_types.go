@@ -0,0 +1,221 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VeleroPluginSpec is the specification for a VeleroPlugin.
+type VeleroPluginSpec struct {
+	// Images are the OCI images to install plugins from. The executables in each
+	// image's /plugins directory are installed into the Velero server's plugin
+	// directory.
+	// +kubebuilder:validation:MinItems=1
+	Images []PluginImage `json:"images"`
+
+	// ImagePullSecret is the name of a secret of type kubernetes.io/dockerconfigjson
+	// in the Velero namespace with credentials for the images' registries.
+	// +optional
+	// +nullable
+	ImagePullSecret *corev1api.LocalObjectReference `json:"imagePullSecret,omitempty"`
+}
+
+// PluginImage is an OCI image to install plugins from.
+type PluginImage struct {
+	// Image is the image reference, which must include the image's digest, e.g.
+	// velero/velero-plugin-for-aws@sha256:<digest>.
+	// +kubebuilder:validation:Pattern=`^[^@]+@sha256:[a-f0-9]{64}$`
+	Image string `json:"image"`
+
+	// Verification is the policy for verifying the image's signature. If it's not
+	// set, the image's signature is not verified.
+	// +optional
+	// +nullable
+	Verification *SignaturePolicy `json:"verification,omitempty"`
+}
+
+// SignatureType is the kind of signature an image is signed with.
+// +kubebuilder:validation:Enum=Cosign;Notation
+type SignatureType string
+
+const (
+	// SignatureTypeCosign means the image is signed with a cosign key, and its signature
+	// is stored in the image's repository under the tag sha256-<digest>.sig.
+	SignatureTypeCosign SignatureType = "Cosign"
+
+	// SignatureTypeNotation means the image is signed with a Notation certificate, and
+	// its signature is stored in the image's repository as a referrer of the image.
+	SignatureTypeNotation SignatureType = "Notation"
+)
+
+// SignaturePolicy is the policy for verifying an image's signature.
+type SignaturePolicy struct {
+	// Type is the kind of signature the image must be signed with.
+	Type SignatureType `json:"type"`
+
+	// Key is the key of a secret in the Velero namespace containing, in PEM format, the
+	// public key the image must be signed with for Cosign signatures, or the root
+	// certificates the image's signing certificate must be issued by for Notation
+	// signatures.
+	Key corev1api.SecretKeySelector `json:"key"`
+
+	// TrustedIdentities are the subjects that the image's signing certificate may have for
+	// Notation signatures, as distinguished names such as "x509.subject: C=US, O=Example, CN=signer".
+	// A certificate has a trusted identity if its subject has all of the identity's attributes.
+	// If it's empty, a certificate issued by one of the root certificates may have any subject.
+	// +optional
+	// +nullable
+	TrustedIdentities []string `json:"trustedIdentities,omitempty"`
+}
+
+// VeleroPluginPhase is the current state of a VeleroPlugin.
+// +kubebuilder:validation:Enum=New;Installed;PartiallyFailed;Failed
+type VeleroPluginPhase string
+
+const (
+	// VeleroPluginPhaseNew means the VeleroPlugin's images haven't been installed yet.
+	VeleroPluginPhaseNew VeleroPluginPhase = "New"
+
+	// VeleroPluginPhaseInstalled means the plugins in all of the VeleroPlugin's images
+	// were installed.
+	VeleroPluginPhaseInstalled VeleroPluginPhase = "Installed"
+
+	// VeleroPluginPhasePartiallyFailed means the plugins in some of the VeleroPlugin's
+	// images could not be installed.
+	VeleroPluginPhasePartiallyFailed VeleroPluginPhase = "PartiallyFailed"
+
+	// VeleroPluginPhaseFailed means the plugins in none of the VeleroPlugin's images
+	// could be installed.
+	VeleroPluginPhaseFailed VeleroPluginPhase = "Failed"
+)
+
+// PluginImagePhase is the current state of the installation of a plugin image.
+// +kubebuilder:validation:Enum=Installed;Failed
+type PluginImagePhase string
+
+const (
+	// PluginImagePhaseInstalled means the image's plugins were installed.
+	PluginImagePhaseInstalled PluginImagePhase = "Installed"
+
+	// PluginImagePhaseFailed means the image could not be pulled, failed verification,
+	// or its plugins could not be installed.
+	PluginImagePhaseFailed PluginImagePhase = "Failed"
+)
+
+// SignatureVerificationPhase is the result of verifying a plugin image's signature.
+// +kubebuilder:validation:Enum=Verified;Unverified;Failed
+type SignatureVerificationPhase string
+
+const (
+	// SignatureVerificationPhaseVerified means the image's signature was verified.
+	SignatureVerificationPhaseVerified SignatureVerificationPhase = "Verified"
+
+	// SignatureVerificationPhaseUnverified means the image has no verification policy,
+	// so its signature was not verified.
+	SignatureVerificationPhaseUnverified SignatureVerificationPhase = "Unverified"
+
+	// SignatureVerificationPhaseFailed means the image has no valid signature matching
+	// its verification policy.
+	SignatureVerificationPhaseFailed SignatureVerificationPhase = "Failed"
+)
+
+// PluginImageStatus is the status of the installation of a plugin image.
+type PluginImageStatus struct {
+	// Image is the image reference.
+	Image string `json:"image"`
+
+	// Phase is the current state of the image's installation.
+	// +optional
+	Phase PluginImagePhase `json:"phase,omitempty"`
+
+	// Verification is the result of verifying the image's signature.
+	// +optional
+	Verification SignatureVerificationPhase `json:"verification,omitempty"`
+
+	// Executables are the names of the plugin executables installed from the image.
+	// +optional
+	// +nullable
+	Executables []string `json:"executables,omitempty"`
+
+	// Message is a message about why the image's installation failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VeleroPluginStatus is the current status of a VeleroPlugin.
+type VeleroPluginStatus struct {
+	// Phase is the current state of the VeleroPlugin.
+	// +optional
+	Phase VeleroPluginPhase `json:"phase,omitempty"`
+
+	// LastInstallTimestamp is when the Velero server last installed the VeleroPlugin's
+	// images.
+	// +optional
+	// +nullable
+	LastInstallTimestamp *metav1.Time `json:"lastInstallTimestamp,omitempty"`
+
+	// Images are the statuses of the installation of each of the VeleroPlugin's images.
+	// +optional
+	// +nullable
+	Images []PluginImageStatus `json:"images,omitempty"`
+}
+
+// TODO(2.0) After converting all resources to use the runtime-controller client,
+// the genclient and k8s:deepcopy markers will no longer be needed and should be removed.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Installation phase"
+// +kubebuilder:printcolumn:name="Last Installed",type="date",JSONPath=".status.lastInstallTimestamp",description="When the plugins were last installed"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:rbac:groups=velero.io,resources=veleroplugins,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=veleroplugins/status,verbs=get;update;patch
+
+// VeleroPlugin lists OCI images to install Velero plugins from when the Velero
+// server starts, and how to verify their signatures.
+type VeleroPlugin struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec VeleroPluginSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status VeleroPluginStatus `json:"status,omitempty"`
+}
+
+// TODO(2.0) After converting all resources to use the runtime-controller client,
+// the k8s:deepcopy marker will no longer be needed and should be removed.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// VeleroPluginList is a list of VeleroPlugins.
+type VeleroPluginList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VeleroPlugin `json:"items"`
+}
@@ -27,6 +27,30 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionStatus) DeepCopyInto(out *ActionStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionStatus.
+func (in *ActionStatus) DeepCopy() *ActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backup) DeepCopyInto(out *Backup) {
 	*out = *in
@@ -254,6 +278,28 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.UnmountedVolumesToRestic != nil {
+		in, out := &in.UnmountedVolumesToRestic, &out.UnmountedVolumesToRestic
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SnapshotMoveData != nil {
+		in, out := &in.SnapshotMoveData, &out.SnapshotMoveData
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResticVolumeRules != nil {
+		in, out := &in.ResticVolumeRules, &out.ResticVolumeRules
+		*out = make([]ResticVolumeRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResticRateLimit != nil {
+		in, out := &in.ResticRateLimit, &out.ResticRateLimit
+		*out = new(ResticRateLimit)
+		**out = **in
+	}
 	if in.OrderedResources != nil {
 		in, out := &in.OrderedResources, &out.OrderedResources
 		*out = make(map[string]string, len(*in))
@@ -299,6 +345,20 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		*out = new(BackupProgress)
 		**out = **in
 	}
+	if in.PreBackupActionsStatuses != nil {
+		in, out := &in.PreBackupActionsStatuses, &out.PreBackupActionsStatuses
+		*out = make([]ActionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostBackupActionsStatuses != nil {
+		in, out := &in.PostBackupActionsStatuses, &out.PostBackupActionsStatuses
+		*out = make([]ActionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -399,6 +459,11 @@ func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.ResticRateLimit != nil {
+		in, out := &in.ResticRateLimit, &out.ResticRateLimit
+		*out = new(ResticRateLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -436,6 +501,210 @@ func (in *BackupStorageLocationStatus) DeepCopy() *BackupStorageLocationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataDownload) DeepCopyInto(out *DataDownload) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataDownload.
+func (in *DataDownload) DeepCopy() *DataDownload {
+	if in == nil {
+		return nil
+	}
+	out := new(DataDownload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataDownload) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataDownloadList) DeepCopyInto(out *DataDownloadList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataDownload, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataDownloadList.
+func (in *DataDownloadList) DeepCopy() *DataDownloadList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataDownloadList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataDownloadList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataDownloadSpec) DeepCopyInto(out *DataDownloadSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataDownloadSpec.
+func (in *DataDownloadSpec) DeepCopy() *DataDownloadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataDownloadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataDownloadStatus) DeepCopyInto(out *DataDownloadStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	out.Progress = in.Progress
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataDownloadStatus.
+func (in *DataDownloadStatus) DeepCopy() *DataDownloadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataDownloadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataUpload) DeepCopyInto(out *DataUpload) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataUpload.
+func (in *DataUpload) DeepCopy() *DataUpload {
+	if in == nil {
+		return nil
+	}
+	out := new(DataUpload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataUpload) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataUploadList) DeepCopyInto(out *DataUploadList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataUpload, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataUploadList.
+func (in *DataUploadList) DeepCopy() *DataUploadList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataUploadList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataUploadList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataUploadSpec) DeepCopyInto(out *DataUploadSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataUploadSpec.
+func (in *DataUploadSpec) DeepCopy() *DataUploadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataUploadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataUploadStatus) DeepCopyInto(out *DataUploadStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	out.Progress = in.Progress
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataUploadStatus.
+func (in *DataUploadStatus) DeepCopy() *DataUploadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataUploadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeleteBackupRequest) DeepCopyInto(out *DeleteBackupRequest) {
 	*out = *in
@@ -665,98 +934,359 @@ func (in *ExecHook) DeepCopy() *ExecHook {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecHook)
+	out := new(ExecHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecRestoreHook) DeepCopyInto(out *ExecRestoreHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.ExecTimeout = in.ExecTimeout
+	out.WaitTimeout = in.WaitTimeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecRestoreHook.
+func (in *ExecRestoreHook) DeepCopy() *ExecRestoreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecRestoreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPNotificationTarget) DeepCopyInto(out *HTTPNotificationTarget) {
+	*out = *in
+	if in.URLSecret != nil {
+		in, out := &in.URLSecret, &out.URLSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AuthorizationSecret != nil {
+		in, out := &in.AuthorizationSecret, &out.AuthorizationSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPNotificationTarget.
+func (in *HTTPNotificationTarget) DeepCopy() *HTTPNotificationTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPNotificationTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitRestoreHook) DeepCopyInto(out *InitRestoreHook) {
+	*out = *in
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitRestoreHook.
+func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(InitRestoreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Metadata) DeepCopyInto(out *Metadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metadata.
+func (in *Metadata) DeepCopy() *Metadata {
+	if in == nil {
+		return nil
+	}
+	out := new(Metadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDelivery) DeepCopyInto(out *NotificationDelivery) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDelivery.
+func (in *NotificationDelivery) DeepCopy() *NotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationFilter) DeepCopyInto(out *NotificationFilter) {
+	*out = *in
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]NotificationEventKind, len(*in))
+		copy(*out, *in)
+	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationFilter.
+func (in *NotificationFilter) DeepCopy() *NotificationFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSink) DeepCopyInto(out *NotificationSink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSink.
+func (in *NotificationSink) DeepCopy() *NotificationSink {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationSink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkList) DeepCopyInto(out *NotificationSinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkList.
+func (in *NotificationSinkList) DeepCopy() *NotificationSinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationSinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkSpec) DeepCopyInto(out *NotificationSinkSpec) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPNotificationTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SMTP != nil {
+		in, out := &in.SMTP, &out.SMTP
+		*out = new(SMTPNotificationTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Filter.DeepCopyInto(&out.Filter)
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkSpec.
+func (in *NotificationSinkSpec) DeepCopy() *NotificationSinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecRestoreHook) DeepCopyInto(out *ExecRestoreHook) {
+func (in *NotificationSinkStatus) DeepCopyInto(out *NotificationSinkStatus) {
 	*out = *in
-	if in.Command != nil {
-		in, out := &in.Command, &out.Command
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.LastDeliveryTimestamp != nil {
+		in, out := &in.LastDeliveryTimestamp, &out.LastDeliveryTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailureTimestamp != nil {
+		in, out := &in.LastFailureTimestamp, &out.LastFailureTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.RecentDeliveries != nil {
+		in, out := &in.RecentDeliveries, &out.RecentDeliveries
+		*out = make([]NotificationDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out.ExecTimeout = in.ExecTimeout
-	out.WaitTimeout = in.WaitTimeout
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecRestoreHook.
-func (in *ExecRestoreHook) DeepCopy() *ExecRestoreHook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkStatus.
+func (in *NotificationSinkStatus) DeepCopy() *NotificationSinkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecRestoreHook)
+	out := new(NotificationSinkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InitRestoreHook) DeepCopyInto(out *InitRestoreHook) {
+func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 	*out = *in
-	if in.InitContainers != nil {
-		in, out := &in.InitContainers, &out.InitContainers
-		*out = make([]corev1.Container, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
 	}
-	out.Timeout = in.Timeout
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitRestoreHook.
-func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageLocation.
+func (in *ObjectStorageLocation) DeepCopy() *ObjectStorageLocation {
 	if in == nil {
 		return nil
 	}
-	out := new(InitRestoreHook)
+	out := new(ObjectStorageLocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Metadata) DeepCopyInto(out *Metadata) {
+func (in *PluginImage) DeepCopyInto(out *PluginImage) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(SignaturePolicy)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metadata.
-func (in *Metadata) DeepCopy() *Metadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginImage.
+func (in *PluginImage) DeepCopy() *PluginImage {
 	if in == nil {
 		return nil
 	}
-	out := new(Metadata)
+	out := new(PluginImage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
+func (in *PluginImageStatus) DeepCopyInto(out *PluginImageStatus) {
 	*out = *in
-	if in.CACert != nil {
-		in, out := &in.CACert, &out.CACert
-		*out = make([]byte, len(*in))
+	if in.Executables != nil {
+		in, out := &in.Executables, &out.Executables
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageLocation.
-func (in *ObjectStorageLocation) DeepCopy() *ObjectStorageLocation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginImageStatus.
+func (in *PluginImageStatus) DeepCopy() *PluginImageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectStorageLocation)
+	out := new(PluginImageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -764,6 +1294,11 @@ func (in *ObjectStorageLocation) DeepCopy() *ObjectStorageLocation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginInfo) DeepCopyInto(out *PluginInfo) {
 	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(PluginStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -777,6 +1312,26 @@ func (in *PluginInfo) DeepCopy() *PluginInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginStatus) DeepCopyInto(out *PluginStatus) {
+	*out = *in
+	if in.LastHealthCheckTimestamp != nil {
+		in, out := &in.LastHealthCheckTimestamp, &out.LastHealthCheckTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginStatus.
+func (in *PluginStatus) DeepCopy() *PluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodVolumeBackup) DeepCopyInto(out *PodVolumeBackup) {
 	*out = *in
@@ -849,6 +1404,11 @@ func (in *PodVolumeBackupSpec) DeepCopyInto(out *PodVolumeBackupSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(ResticRateLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -908,7 +1468,7 @@ func (in *PodVolumeRestore) DeepCopyInto(out *PodVolumeRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -968,6 +1528,16 @@ func (in *PodVolumeRestoreList) DeepCopyObject() runtime.Object {
 func (in *PodVolumeRestoreSpec) DeepCopyInto(out *PodVolumeRestoreSpec) {
 	*out = *in
 	out.Pod = in.Pod
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(ResticRateLimit)
+		**out = **in
+	}
+	if in.IncludePaths != nil {
+		in, out := &in.IncludePaths, &out.IncludePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1006,6 +1576,22 @@ func (in *PodVolumeRestoreStatus) DeepCopy() *PodVolumeRestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticRateLimit) DeepCopyInto(out *ResticRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRateLimit.
+func (in *ResticRateLimit) DeepCopy() *ResticRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResticRepository) DeepCopyInto(out *ResticRepository) {
 	*out = *in
@@ -1104,6 +1690,47 @@ func (in *ResticRepositoryStatus) DeepCopy() *ResticRepositoryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticVolumeRule) DeepCopyInto(out *ResticVolumeRule) {
+	*out = *in
+	if in.PVCSelector != nil {
+		in, out := &in.PVCSelector, &out.PVCSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticVolumeRule.
+func (in *ResticVolumeRule) DeepCopy() *ResticVolumeRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticVolumeRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Restore) DeepCopyInto(out *Restore) {
 	*out = *in
@@ -1329,6 +1956,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		**out = **in
 	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.ResticRateLimit != nil {
+		in, out := &in.ResticRateLimit, &out.ResticRateLimit
+		*out = new(ResticRateLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -1363,6 +1995,20 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 		*out = new(RestoreProgress)
 		**out = **in
 	}
+	if in.PreRestoreActionsStatuses != nil {
+		in, out := &in.PreRestoreActionsStatuses, &out.PreRestoreActionsStatuses
+		*out = make([]ActionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostRestoreActionsStatuses != nil {
+		in, out := &in.PostRestoreActionsStatuses, &out.PostRestoreActionsStatuses
+		*out = make([]ActionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1376,6 +2022,32 @@ func (in *RestoreStatus) DeepCopy() *RestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SMTPNotificationTarget) DeepCopyInto(out *SMTPNotificationTarget) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPNotificationTarget.
+func (in *SMTPNotificationTarget) DeepCopy() *SMTPNotificationTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(SMTPNotificationTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
@@ -1446,6 +2118,11 @@ func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MissedRunWindow != nil {
+		in, out := &in.MissedRunWindow, &out.MissedRunWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1466,6 +2143,14 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		in, out := &in.LastBackup, &out.LastBackup
 		*out = (*in).DeepCopy()
 	}
+	if in.NextRunTime != nil {
+		in, out := &in.NextRunTime, &out.NextRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSkipped != nil {
+		in, out := &in.LastSkipped, &out.LastSkipped
+		*out = (*in).DeepCopy()
+	}
 	if in.ValidationErrors != nil {
 		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
@@ -1571,7 +2256,9 @@ func (in *ServerStatusRequestStatus) DeepCopyInto(out *ServerStatusRequestStatus
 	if in.Plugins != nil {
 		in, out := &in.Plugins, &out.Plugins
 		*out = make([]PluginInfo, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -1586,6 +2273,28 @@ func (in *ServerStatusRequestStatus) DeepCopy() *ServerStatusRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignaturePolicy) DeepCopyInto(out *SignaturePolicy) {
+	*out = *in
+	in.Key.DeepCopyInto(&out.Key)
+	if in.TrustedIdentities != nil {
+		in, out := &in.TrustedIdentities, &out.TrustedIdentities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignaturePolicy.
+func (in *SignaturePolicy) DeepCopy() *SignaturePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SignaturePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageType) DeepCopyInto(out *StorageType) {
 	*out = *in
@@ -1607,6 +2316,122 @@ func (in *StorageType) DeepCopy() *StorageType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VeleroPlugin) DeepCopyInto(out *VeleroPlugin) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VeleroPlugin.
+func (in *VeleroPlugin) DeepCopy() *VeleroPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(VeleroPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VeleroPlugin) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VeleroPluginList) DeepCopyInto(out *VeleroPluginList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VeleroPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VeleroPluginList.
+func (in *VeleroPluginList) DeepCopy() *VeleroPluginList {
+	if in == nil {
+		return nil
+	}
+	out := new(VeleroPluginList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VeleroPluginList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VeleroPluginSpec) DeepCopyInto(out *VeleroPluginSpec) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]PluginImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImagePullSecret != nil {
+		in, out := &in.ImagePullSecret, &out.ImagePullSecret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VeleroPluginSpec.
+func (in *VeleroPluginSpec) DeepCopy() *VeleroPluginSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VeleroPluginSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VeleroPluginStatus) DeepCopyInto(out *VeleroPluginStatus) {
+	*out = *in
+	if in.LastInstallTimestamp != nil {
+		in, out := &in.LastInstallTimestamp, &out.LastInstallTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]PluginImageStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VeleroPluginStatus.
+func (in *VeleroPluginStatus) DeepCopy() *VeleroPluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VeleroPluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotLocation) DeepCopyInto(out *VolumeSnapshotLocation) {
 	*out = *in
@@ -30,12 +30,14 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -43,10 +45,13 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
@@ -62,8 +67,8 @@ const BackupFormatVersion = "1.1.0"
 type Backupper interface {
 	// Backup takes a backup using the specification in the velerov1api.Backup and writes backup and log data
 	// to the given writers.
-	Backup(logger logrus.FieldLogger, backup *Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error
-	BackupWithResolvers(log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer,
+	Backup(logger logrus.FieldLogger, backup *Request, backupFile io.Writer, actions []biav2.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error
+	BackupWithResolvers(ctx context.Context, log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer,
 		backupItemActionResolver framework.BackupItemActionResolver, itemSnapshotterResolver framework.ItemSnapshotterResolver,
 		volumeSnapshotterGetter VolumeSnapshotterGetter) error
 }
@@ -78,6 +83,8 @@ type kubernetesBackupper struct {
 	resticTimeout          time.Duration
 	defaultVolumesToRestic bool
 	clientPageSize         int
+	eventRecorder          record.EventRecorder
+	metrics                *metrics.ServerMetrics
 }
 
 func (i *itemKey) String() string {
@@ -104,6 +111,8 @@ func NewKubernetesBackupper(
 	resticTimeout time.Duration,
 	defaultVolumesToRestic bool,
 	clientPageSize int,
+	eventRecorder record.EventRecorder,
+	metrics *metrics.ServerMetrics,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
 		backupClient:           backupClient,
@@ -114,6 +123,8 @@ func NewKubernetesBackupper(
 		resticTimeout:          resticTimeout,
 		defaultVolumesToRestic: defaultVolumesToRestic,
 		clientPageSize:         clientPageSize,
+		eventRecorder:          eventRecorder,
+		metrics:                metrics,
 	}, nil
 }
 
@@ -170,19 +181,22 @@ type VolumeSnapshotterGetter interface {
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
 // to the backup log.
 func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer,
-	actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
+	actions []biav2.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
 	backupItemActions := framework.NewBackupItemActionResolver(actions)
 	itemSnapshotters := framework.NewItemSnapshotterResolver(nil)
-	return kb.BackupWithResolvers(log, backupRequest, backupFile, backupItemActions, itemSnapshotters,
+	return kb.BackupWithResolvers(context.Background(), log, backupRequest, backupFile, backupItemActions, itemSnapshotters,
 		volumeSnapshotterGetter)
 }
 
-func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
+func (kb *kubernetesBackupper) BackupWithResolvers(ctx context.Context, log logrus.FieldLogger,
 	backupRequest *Request,
 	backupFile io.Writer,
 	backupItemActionResolver framework.BackupItemActionResolver,
 	itemSnapshotterResolver framework.ItemSnapshotterResolver,
 	volumeSnapshotterGetter VolumeSnapshotterGetter) error {
+	ctx, span := tracing.Tracer().Start(ctx, "BackupWithResolvers", trace.WithAttributes(tracing.BackupNameKey.String(backupRequest.Name)))
+	defer span.End()
+
 	gzippedData := gzip.NewWriter(backupFile)
 	defer gzippedData.Close()
 
@@ -202,6 +216,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	log.Infof("Including resources: %s", backupRequest.ResourceIncludesExcludes.IncludesString())
 	log.Infof("Excluding resources: %s", backupRequest.ResourceIncludesExcludes.ExcludesString())
 	log.Infof("Backing up all pod volumes using Restic: %t", boolptr.IsSetToTrue(backupRequest.Backup.Spec.DefaultVolumesToRestic))
+	log.Infof("Backing up unmounted persistent volume claims using Restic: %t", boolptr.IsSetToTrue(backupRequest.Backup.Spec.UnmountedVolumesToRestic))
 
 	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
@@ -231,7 +246,9 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		}
 	}
 
-	ctx, cancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
+	// derive the pod volume timeout from ctx so that cancelling the backup also stops
+	// waiting for its pod volume backups.
+	ctx, cancelFunc := context.WithTimeout(ctx, podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticBackupper restic.Backupper
@@ -251,6 +268,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	defer os.RemoveAll(tempDir)
 
 	collector := &itemCollector{
+		ctx:                   ctx,
 		log:                   log,
 		backupRequest:         backupRequest,
 		discoveryHelper:       kb.discoveryHelper,
@@ -279,7 +297,11 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
 		itemHookHandler: &hook.DefaultItemHookHandler{
 			PodCommandExecutor: kb.podCommandExecutor,
+			EventRecorder:      kb.eventRecorder,
+			Metrics:            kb.metrics,
+			BackupName:         backupRequest.Name,
 		},
+		metrics: kb.metrics,
 	}
 
 	// helper struct to send current progress between the main
@@ -357,7 +379,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 				return
 			}
 
-			if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
+			if backedUp := kb.backupItem(ctx, log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
 				backedUpGroupResources[item.groupResource] = true
 			}
 		}()
@@ -388,7 +410,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	// we don't want to back it up, and if it's true it will already be included.
 	if backupRequest.Spec.IncludeClusterResources == nil {
 		for gr := range backedUpGroupResources {
-			kb.backupCRD(log, gr, itemBackupper)
+			kb.backupCRD(ctx, log, gr, itemBackupper)
 		}
 	}
 
@@ -407,20 +429,28 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	return nil
 }
 
-func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
-	backedUpItem, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR)
+func (kb *kubernetesBackupper) backupItem(ctx context.Context, log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
+	backedUpItem, err := itemBackupper.backupItem(ctx, log, unstructured, gr, preferredGVR)
+
+	// the item's resource and namespace are logged with its errors so that they're
+	// reported with the item in the backup's results.
+	itemLog := log.WithFields(logrus.Fields{
+		"resource":  gr.String(),
+		"namespace": unstructured.GetNamespace(),
+		"name":      unstructured.GetName(),
+	})
 	if aggregate, ok := err.(kubeerrs.Aggregate); ok {
-		log.WithField("name", unstructured.GetName()).Infof("%d errors encountered backup up item", len(aggregate.Errors()))
+		itemLog.Infof("%d errors encountered backup up item", len(aggregate.Errors()))
 		// log each error separately so we get error location info in the log, and an
 		// accurate count of errors
 		for _, err = range aggregate.Errors() {
-			log.WithError(err).WithField("name", unstructured.GetName()).Error("Error backing up item")
+			itemLog.WithError(err).Error("Error backing up item")
 		}
 
 		return false
 	}
 	if err != nil {
-		log.WithError(err).WithField("name", unstructured.GetName()).Error("Error backing up item")
+		itemLog.WithError(err).Error("Error backing up item")
 		return false
 	}
 	return backedUpItem
@@ -428,7 +458,7 @@ func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.Grou
 
 // backupCRD checks if the resource is a custom resource, and if so, backs up the custom resource definition
 // associated with it.
-func (kb *kubernetesBackupper) backupCRD(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper) {
+func (kb *kubernetesBackupper) backupCRD(ctx context.Context, log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper) {
 	crdGroupResource := kuberesource.CustomResourceDefinitions
 
 	log.Debugf("Getting server preferred API version for %s", crdGroupResource)
@@ -461,7 +491,7 @@ func (kb *kubernetesBackupper) backupCRD(log logrus.FieldLogger, gr schema.Group
 	}
 	log.Infof("Found associated CRD %s to add to backup", gr.String())
 
-	kb.backupItem(log, gvr.GroupResource(), itemBackupper, unstructured, gvr)
+	kb.backupItem(ctx, log, gvr.GroupResource(), itemBackupper, unstructured, gvr)
 }
 
 func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer) error {
@@ -47,6 +47,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/test"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
@@ -1336,7 +1337,7 @@ func TestBackupActionsRunForCorrectItems(t *testing.T) {
 				actions = append(actions, action)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, actions, nil)
+			err := h.backupper.Backup(h.log, req, backupFile, adaptV1Actions(actions), nil)
 			assert.NoError(t, err)
 
 			for action, want := range tc.actions {
@@ -1409,7 +1410,7 @@ func TestBackupWithInvalidActions(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			assert.Error(t, h.backupper.Backup(h.log, req, backupFile, tc.actions, nil))
+			assert.Error(t, h.backupper.Backup(h.log, req, backupFile, adaptV1Actions(tc.actions), nil))
 		})
 	}
 }
@@ -1540,7 +1541,7 @@ func TestBackupActionModifications(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(h.log, req, backupFile, adaptV1Actions(tc.actions), nil)
 			assert.NoError(t, err)
 
 			assertTarballFileContents(t, backupFile, tc.want)
@@ -1548,6 +1549,123 @@ func TestBackupActionModifications(t *testing.T) {
 	}
 }
 
+// skipBackupAction is a version 2 backup item action that asks for the items
+// with the given names to be skipped, and returns additional items for them.
+type skipBackupAction struct {
+	names           []string
+	additionalItems []velero.ResourceIdentifier
+}
+
+func (a *skipBackupAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{}, nil
+}
+
+func (a *skipBackupAction) Execute(input *biav2.ExecuteInput) (*biav2.ExecuteOutput, error) {
+	metadata, err := meta.Accessor(input.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &biav2.ExecuteOutput{UpdatedItem: input.Item}
+	for _, name := range a.names {
+		if metadata.GetName() == name {
+			output.SkipBackup = true
+			output.AdditionalItems = a.additionalItems
+		}
+	}
+	return output, nil
+}
+
+// TestBackupActionSkipBackup runs backups with version 2 backup item actions that ask for
+// items to be skipped, and verifies that the skipped items aren't in the backup tarball
+// but their additional items are.
+func TestBackupActionSkipBackup(t *testing.T) {
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		actions      []biav2.BackupItemAction
+		want         []string
+	}{
+		{
+			name:   "skipped items are not backed up",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-1", "pod-2").Result(),
+				),
+			},
+			actions: []biav2.BackupItemAction{
+				&skipBackupAction{names: []string{"pod-1"}},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-2.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-2.json",
+			},
+		},
+		{
+			name:   "additional items of skipped items are backed up",
+			backup: defaultBackup().IncludedNamespaces("ns-1").Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").Result(),
+				),
+			},
+			actions: []biav2.BackupItemAction{
+				&skipBackupAction{
+					names:           []string{"pod-1"},
+					additionalItems: []velero.ResourceIdentifier{{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"}},
+				},
+			},
+			want: []string{
+				"resources/persistentvolumes/cluster/pv-1.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/pv-1.json",
+			},
+		},
+		{
+			name:   "actions after the one that skips an item aren't run for it",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+				),
+			},
+			actions: []biav2.BackupItemAction{
+				&skipBackupAction{names: []string{"pod-1"}},
+				biav2.NewAdaptedV1BackupItemAction(&pluggableAction{
+					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+						return nil, nil, errors.New("should not be called")
+					},
+				}),
+			},
+			want: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			assert.NoError(t, err)
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+		})
+	}
+}
+
 // TestBackupActionAdditionalItems runs backups with backup item actions that return
 // additional items to be backed up, and verifies that those items are included in the
 // backup tarball as appropriate. Verification is done by looking at the files that exist
@@ -1792,7 +1910,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(h.log, req, backupFile, adaptV1Actions(tc.actions), nil)
 			assert.NoError(t, err)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
@@ -2584,6 +2702,19 @@ func (b *fakeResticBackupper) BackupPodVolumes(backup *velerov1.Backup, pod *cor
 	return res, nil
 }
 
+// BackupUnmountedPVC returns one pod volume backup for the PVC, with namespace "velero"
+// and name "pvb-<pvc-namespace>-<pvc-name>".
+func (b *fakeResticBackupper) BackupUnmountedPVC(backup *velerov1.Backup, pvc *corev1.PersistentVolumeClaim, _ logrus.FieldLogger) ([]*velerov1.PodVolumeBackup, []error) {
+	return []*velerov1.PodVolumeBackup{
+		builder.ForPodVolumeBackup("velero", fmt.Sprintf("pvb-%s-%s", pvc.Namespace, pvc.Name)).Result(),
+	}, nil
+}
+
+// BackupPVC is not used by the item backupper, so it returns nothing.
+func (b *fakeResticBackupper) BackupPVC(_ *velerov1.Backup, _ *corev1.PersistentVolumeClaim, _ logrus.FieldLogger) ([]*velerov1.PodVolumeBackup, []error) {
+	return nil, nil
+}
+
 // TestBackupWithRestic runs backups of pods that are annotated for restic backup,
 // and ensures that the restic backupper is called, that the returned PodVolumeBackups
 // are added to the Request object, and that when PVCs are backed up with restic, the
@@ -2630,6 +2761,32 @@ func TestBackupWithRestic(t *testing.T) {
 				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-foo").Result(),
 			},
 		},
+		{
+			name: "restic volume rules select pod volumes by their claim's storage class",
+			backup: defaultBackup().
+				ResticVolumeRules(velerov1.ResticVolumeRule{
+					Action:         velerov1.ResticVolumeRuleActionInclude,
+					StorageClasses: []string{"standard"},
+				}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						Volumes(
+							builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+							builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+						).
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("standard").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").StorageClass("fast").Result(),
+				),
+			},
+			want: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-1").Result(),
+			},
+		},
 		{
 			name:   "when PVC pod volumes are backed up using restic, their claimed PVs are not also snapshotted",
 			backup: defaultBackup().Result(),
@@ -2666,6 +2823,30 @@ func TestBackupWithRestic(t *testing.T) {
 				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-2").Result(),
 			},
 		},
+		{
+			name:   "when unmounted PVCs are backed up using restic, their claimed PVs are not also snapshotted",
+			backup: defaultBackup().UnmountedVolumesToRestic(true).Result(),
+			apiResources: []*test.APIResource{
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+					builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").Result(),
+				),
+			},
+			vsl: newSnapshotLocation("velero", "default", "default"),
+			snapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).
+					WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+					WithVolume("pv-2", "vol-2", "", "type-1", 100, false),
+			},
+			want: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pvc-1").Result(),
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pvc-2").Result(),
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2711,6 +2892,16 @@ func (a *pluggableAction) AppliesTo() (velero.ResourceSelector, error) {
 	return a.selector, nil
 }
 
+// adaptV1Actions adapts version 1 backup item actions to version 2, like the plugin
+// manager does for plugins that only implement version 1.
+func adaptV1Actions(actions []velero.BackupItemAction) []biav2.BackupItemAction {
+	var adapted []biav2.BackupItemAction
+	for _, action := range actions {
+		adapted = append(adapted, biav2.NewAdaptedV1BackupItemAction(action))
+	}
+	return adapted
+}
+
 type harness struct {
 	*test.APIServer
 	backupper *kubernetesBackupper
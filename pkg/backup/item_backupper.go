@@ -18,6 +18,7 @@ package backup
 
 import (
 	"archive/tar"
+	"context"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -40,8 +42,11 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -58,13 +63,15 @@ type itemBackupper struct {
 
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]velero.VolumeSnapshotter
+	metrics                            *metrics.ServerMetrics
 }
 
 // backupItem backs up an individual item to tarWriter. The item may be excluded based on the
-// namespaces IncludesExcludes list.
+// namespaces IncludesExcludes list. The item is traced in a span that's a child of the one in ctx,
+// and that the calls to the item actions for it are traced in.
 // In addition to the error return, backupItem also returns a bool indicating whether the item
 // was actually backed up.
-func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource) (bool, error) {
+func (ib *itemBackupper) backupItem(ctx context.Context, logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource) (bool, error) {
 	metadata, err := meta.Accessor(obj)
 	if err != nil {
 		return false, err
@@ -77,8 +84,8 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	log = log.WithField("resource", groupResource.String())
 	log = log.WithField("namespace", namespace)
 
-	if metadata.GetLabels()["velero.io/exclude-from-backup"] == "true" {
-		log.Info("Excluding item because it has label velero.io/exclude-from-backup=true")
+	if metadata.GetLabels()[velerov1api.ExcludeFromBackupLabel] == "true" {
+		log.Infof("Excluding item because it has label %s=true", velerov1api.ExcludeFromBackupLabel)
 		return false, nil
 	}
 
@@ -118,6 +125,15 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		return true, nil
 	}
 	ib.backupRequest.BackedUpItems[key] = struct{}{}
+	if ib.metrics != nil {
+		ib.metrics.RegisterBackupItem(ib.backupRequest.Labels[velerov1api.ScheduleNameLabel], groupResource.String())
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "BackupItem", trace.WithAttributes(
+		tracing.ResourceKey.String(groupResource.String()),
+		tracing.NamespaceKey.String(namespace),
+	))
+	defer span.End()
 
 	log.Info("Backing up item")
 
@@ -143,7 +159,12 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			// Get the list of volumes to back up using restic from the pod's annotations. Remove from this list
 			// any volumes that use a PVC that we've already backed up (this would be in a read-write-many scenario,
 			// where it's been backed up from another pod), since we don't need >1 backup per PVC.
-			for _, volume := range restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic)) {
+			podVolumes, err := restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic), ib.backupRequest.Spec.ResticVolumeRules, ib.getPVC)
+			if err != nil {
+				backupErrs = append(backupErrs, err)
+			}
+
+			for _, volume := range podVolumes {
 				if found, pvcName := ib.resticSnapshotTracker.HasPVCForPodVolume(pod, volume); found {
 					log.WithFields(map[string]interface{}{
 						"podVolume": volume,
@@ -162,13 +183,30 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		}
 	}
 
+	if groupResource == kuberesource.PersistentVolumeClaims && boolptr.IsSetToTrue(ib.backupRequest.Spec.UnmountedVolumesToRestic) {
+		pvc := new(corev1api.PersistentVolumeClaim)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+			backupErrs = append(backupErrs, errors.WithStack(err))
+		} else if !ib.resticSnapshotTracker.Has(pvc.Namespace, pvc.Name) {
+			// back up the claim's data before running item actions, so that if it isn't mounted by
+			// any running pod and gets backed up through a helper pod, its PV is not also snapshotted.
+			podVolumeBackups, errs := ib.backupUnmountedPVC(log, pvc)
+			if len(podVolumeBackups) > 0 {
+				ib.resticSnapshotTracker.TrackPVC(pvc.Namespace, pvc.Name)
+			}
+
+			ib.backupRequest.PodVolumeBackups = append(ib.backupRequest.PodVolumeBackups, podVolumeBackups...)
+			backupErrs = append(backupErrs, errs...)
+		}
+	}
+
 	// capture the version of the object before invoking plugin actions as the plugin may update
 	// the group version of the object.
 	// group version of this object
 	// Used on filepath to backup up all groups and versions
 	version := resourceVersion(obj)
 
-	updatedObj, err := ib.executeActions(log, obj, groupResource, name, namespace, metadata)
+	updatedObj, skip, err := ib.executeActions(ctx, log, obj, groupResource, name, namespace, metadata)
 	if err != nil {
 		backupErrs = append(backupErrs, err)
 
@@ -180,6 +218,17 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 
 		return false, kubeerrs.NewAggregate(backupErrs)
 	}
+	if skip {
+		log.Info("Excluding item because a backup item action asked for it to be skipped")
+		delete(ib.backupRequest.BackedUpItems, key)
+
+		log.Debug("Executing post hooks")
+		if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}
 	obj = updatedObj
 	if metadata, err = meta.Accessor(obj); err != nil {
 		return false, errors.WithStack(err)
@@ -297,34 +346,74 @@ func (ib *itemBackupper) backupPodVolumes(log logrus.FieldLogger, pod *corev1api
 	return ib.resticBackupper.BackupPodVolumes(ib.backupRequest.Backup, pod, volumes, log)
 }
 
+// getPVC gets the persistent volume claim with the given namespace and name from the cluster.
+func (ib *itemBackupper) getPVC(namespace, name string) (*corev1api.PersistentVolumeClaim, error) {
+	gvr, resource, err := ib.discoveryHelper.ResourceFor(kuberesource.PersistentVolumeClaims.WithVersion(""))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pvc); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return pvc, nil
+}
+
+// backupUnmountedPVC triggers a restic backup of the specified PVC if it isn't mounted by any
+// running pod, and returns a list of PodVolumeBackups and a slice of any errors that were encountered.
+func (ib *itemBackupper) backupUnmountedPVC(log logrus.FieldLogger, pvc *corev1api.PersistentVolumeClaim) ([]*velerov1api.PodVolumeBackup, []error) {
+	if ib.resticBackupper == nil {
+		log.Warn("No restic backupper, not backing up unmounted persistent volume claim")
+		return nil, nil
+	}
+
+	return ib.resticBackupper.BackupUnmountedPVC(ib.backupRequest.Backup, pvc, log)
+}
+
+// executeActions runs the backup item actions that apply to the item, and returns the updated item
+// and whether an action asked for it to be skipped. The actions after one that skips the item
+// aren't run.
 func (ib *itemBackupper) executeActions(
+	ctx context.Context,
 	log logrus.FieldLogger,
 	obj runtime.Unstructured,
 	groupResource schema.GroupResource,
 	name, namespace string,
 	metadata metav1.Object,
-) (runtime.Unstructured, error) {
+) (runtime.Unstructured, bool, error) {
 	for _, action := range ib.backupRequest.ResolvedActions {
 		if !action.ShouldUse(groupResource, namespace, metadata, log) {
 			continue
 		}
 		log.Info("Executing custom action")
 
-		updatedItem, additionalItemIdentifiers, err := action.Execute(obj, ib.backupRequest.Backup)
+		output, err := action.Execute(&biav2.ExecuteInput{Item: obj, Backup: ib.backupRequest.Backup, Context: ctx})
 		if err != nil {
-			return nil, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
+			return nil, false, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
 		}
-		obj = updatedItem
+		obj = output.UpdatedItem
 
-		for _, additionalItem := range additionalItemIdentifiers {
+		for _, additionalItem := range output.AdditionalItems {
 			gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 
 			client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 
 			item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
@@ -337,16 +426,20 @@ func (ib *itemBackupper) executeActions(
 				continue
 			}
 			if err != nil {
-				return nil, errors.WithStack(err)
+				return nil, false, errors.WithStack(err)
 			}
 
-			if _, err = ib.backupItem(log, item, gvr.GroupResource(), gvr); err != nil {
-				return nil, err
+			if _, err = ib.backupItem(ctx, log, item, gvr.GroupResource(), gvr); err != nil {
+				return nil, false, err
 			}
 		}
+
+		if output.SkipBackup {
+			return obj, true, nil
+		}
 	}
 
-	return obj, nil
+	return obj, false, nil
 }
 
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
@@ -26,6 +26,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -37,12 +38,15 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
 // itemCollector collects items from the Kubernetes API according to
 // the backup spec and writes them to files inside dir.
 type itemCollector struct {
+	// ctx is the context of the backup, whose span the listing of each resource is traced in.
+	ctx                   context.Context
 	log                   logrus.FieldLogger
 	backupRequest         *Request
 	discoveryHelper       discovery.Helper
@@ -175,6 +179,9 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		clusterScoped = !resource.Namespaced
 	)
 
+	ctx, span := tracing.Tracer().Start(r.ctx, "ListResource", trace.WithAttributes(tracing.ResourceKey.String(gr.String())))
+	defer span.End()
+
 	orders := getOrderedResourcesForType(log, r.backupRequest.Backup.Spec.OrderedResources, resource.Name)
 	// Getting the preferred group version of this resource
 	preferredGVR, _, err := r.discoveryHelper.ResourceFor(gr.WithVersion(""))
@@ -309,7 +316,7 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 			// TODO allow configuration of page buffer size
 			listPager.PageSize = int64(r.pageSize)
 			// Add each item to temporary slice
-			list, paginated, err := listPager.List(context.Background(), metav1.ListOptions{LabelSelector: labelSelector})
+			list, paginated, err := listPager.List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
 			if err != nil {
 				log.WithError(errors.WithStack(err)).Error("Error listing resources")
 				continue
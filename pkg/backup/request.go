@@ -47,6 +47,7 @@ type Request struct {
 	ResolvedItemSnapshotters  []framework.ItemSnapshotterResolvedAction
 	VolumeSnapshots           []*volume.Snapshot
 	PodVolumeBackups          []*velerov1api.PodVolumeBackup
+	DataUploads               []*velerov1api.DataUpload
 	BackedUpItems             map[itemKey]struct{}
 }
 
@@ -168,12 +168,36 @@ func (b *BackupBuilder) SnapshotVolumes(val bool) *BackupBuilder {
 	return b
 }
 
+// SnapshotMoveData sets the Backup's "snapshot move data" flag.
+func (b *BackupBuilder) SnapshotMoveData(val bool) *BackupBuilder {
+	b.object.Spec.SnapshotMoveData = &val
+	return b
+}
+
 // DefaultVolumesToRestic sets the Backup's "DefaultVolumesToRestic" flag.
 func (b *BackupBuilder) DefaultVolumesToRestic(val bool) *BackupBuilder {
 	b.object.Spec.DefaultVolumesToRestic = &val
 	return b
 }
 
+// UnmountedVolumesToRestic sets the Backup's "UnmountedVolumesToRestic" flag.
+func (b *BackupBuilder) UnmountedVolumesToRestic(val bool) *BackupBuilder {
+	b.object.Spec.UnmountedVolumesToRestic = &val
+	return b
+}
+
+// ResticVolumeRules appends to the Backup's restic volume rules.
+func (b *BackupBuilder) ResticVolumeRules(rules ...velerov1api.ResticVolumeRule) *BackupBuilder {
+	b.object.Spec.ResticVolumeRules = append(b.object.Spec.ResticVolumeRules, rules...)
+	return b
+}
+
+// ResticRateLimit sets the Backup's restic rate limit.
+func (b *BackupBuilder) ResticRateLimit(limit velerov1api.ResticRateLimit) *BackupBuilder {
+	b.object.Spec.ResticRateLimit = &limit
+	return b
+}
+
 // Phase sets the Backup's phase.
 func (b *BackupBuilder) Phase(phase velerov1api.BackupPhase) *BackupBuilder {
 	b.object.Status.Phase = phase
@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// NotificationSinkBuilder builds NotificationSink objects.
+type NotificationSinkBuilder struct {
+	object *velerov1api.NotificationSink
+}
+
+// ForNotificationSink is the constructor for a NotificationSinkBuilder.
+func ForNotificationSink(ns, name string) *NotificationSinkBuilder {
+	return &NotificationSinkBuilder{
+		object: &velerov1api.NotificationSink{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "NotificationSink",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built NotificationSink.
+func (b *NotificationSinkBuilder) Result() *velerov1api.NotificationSink {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the NotificationSink's ObjectMeta.
+func (b *NotificationSinkBuilder) ObjectMeta(opts ...ObjectMetaOpt) *NotificationSinkBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// Type sets the NotificationSink's type.
+func (b *NotificationSinkBuilder) Type(sinkType velerov1api.NotificationSinkType) *NotificationSinkBuilder {
+	b.object.Spec.Type = sinkType
+	return b
+}
+
+// HTTP sets the NotificationSink's HTTP target.
+func (b *NotificationSinkBuilder) HTTP(target *velerov1api.HTTPNotificationTarget) *NotificationSinkBuilder {
+	b.object.Spec.HTTP = target
+	return b
+}
+
+// URL sets the URL of the NotificationSink's HTTP target.
+func (b *NotificationSinkBuilder) URL(url string) *NotificationSinkBuilder {
+	if b.object.Spec.HTTP == nil {
+		b.object.Spec.HTTP = new(velerov1api.HTTPNotificationTarget)
+	}
+	b.object.Spec.HTTP.URL = url
+	return b
+}
+
+// SMTP sets the NotificationSink's SMTP target.
+func (b *NotificationSinkBuilder) SMTP(target *velerov1api.SMTPNotificationTarget) *NotificationSinkBuilder {
+	b.object.Spec.SMTP = target
+	return b
+}
+
+// Kinds sets the kinds of resources the NotificationSink notifies.
+func (b *NotificationSinkBuilder) Kinds(kinds ...velerov1api.NotificationEventKind) *NotificationSinkBuilder {
+	b.object.Spec.Filter.Kinds = append(b.object.Spec.Filter.Kinds, kinds...)
+	return b
+}
+
+// Phases sets the phases the NotificationSink notifies.
+func (b *NotificationSinkBuilder) Phases(phases ...string) *NotificationSinkBuilder {
+	b.object.Spec.Filter.Phases = append(b.object.Spec.Filter.Phases, phases...)
+	return b
+}
+
+// Schedules sets the schedules the NotificationSink notifies.
+func (b *NotificationSinkBuilder) Schedules(schedules ...string) *NotificationSinkBuilder {
+	b.object.Spec.Filter.Schedules = append(b.object.Spec.Filter.Schedules, schedules...)
+	return b
+}
+
+// Namespaces sets the namespaces the NotificationSink notifies.
+func (b *NotificationSinkBuilder) Namespaces(namespaces ...string) *NotificationSinkBuilder {
+	b.object.Spec.Filter.Namespaces = append(b.object.Spec.Filter.Namespaces, namespaces...)
+	return b
+}
+
+// Template sets the NotificationSink's message template.
+func (b *NotificationSinkBuilder) Template(template string) *NotificationSinkBuilder {
+	b.object.Spec.Template = template
+	return b
+}
+
+// MaxRetries sets the NotificationSink's maximum number of retries.
+func (b *NotificationSinkBuilder) MaxRetries(maxRetries int) *NotificationSinkBuilder {
+	b.object.Spec.MaxRetries = &maxRetries
+	return b
+}
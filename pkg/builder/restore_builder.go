@@ -142,6 +142,12 @@ func (b *RestoreBuilder) PreserveNodePorts(val bool) *RestoreBuilder {
 	return b
 }
 
+// PodVolumeRestoreMode sets the Restore's pod volume restore mode.
+func (b *RestoreBuilder) PodVolumeRestoreMode(mode velerov1api.PodVolumeRestoreMode) *RestoreBuilder {
+	b.object.Spec.PodVolumeRestoreMode = mode
+	return b
+}
+
 // StartTimestamp sets the Restore's start timestamp.
 func (b *RestoreBuilder) StartTimestamp(val time.Time) *RestoreBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
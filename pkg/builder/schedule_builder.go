@@ -89,3 +89,33 @@ func (b *ScheduleBuilder) Template(spec velerov1api.BackupSpec) *ScheduleBuilder
 	b.object.Spec.Template = spec
 	return b
 }
+
+// Paused sets the Schedule's paused flag.
+func (b *ScheduleBuilder) Paused(val bool) *ScheduleBuilder {
+	b.object.Spec.Paused = val
+	return b
+}
+
+// TimeZone sets the Schedule's time zone.
+func (b *ScheduleBuilder) TimeZone(val string) *ScheduleBuilder {
+	b.object.Spec.TimeZone = val
+	return b
+}
+
+// MissedRunPolicy sets the Schedule's missed run policy.
+func (b *ScheduleBuilder) MissedRunPolicy(policy velerov1api.ScheduleMissedRunPolicy) *ScheduleBuilder {
+	b.object.Spec.MissedRunPolicy = policy
+	return b
+}
+
+// MissedRunWindow sets the Schedule's missed run window.
+func (b *ScheduleBuilder) MissedRunWindow(window time.Duration) *ScheduleBuilder {
+	b.object.Spec.MissedRunWindow = &metav1.Duration{Duration: window}
+	return b
+}
+
+// ConcurrencyPolicy sets the Schedule's concurrency policy.
+func (b *ScheduleBuilder) ConcurrencyPolicy(policy velerov1api.ScheduleConcurrencyPolicy) *ScheduleBuilder {
+	b.object.Spec.ConcurrencyPolicy = policy
+	return b
+}
@@ -0,0 +1,80 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// VeleroPluginBuilder builds VeleroPlugin objects.
+type VeleroPluginBuilder struct {
+	object *velerov1api.VeleroPlugin
+}
+
+// ForVeleroPlugin is the constructor for a VeleroPluginBuilder.
+func ForVeleroPlugin(ns, name string) *VeleroPluginBuilder {
+	return &VeleroPluginBuilder{
+		object: &velerov1api.VeleroPlugin{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "VeleroPlugin",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built VeleroPlugin.
+func (b *VeleroPluginBuilder) Result() *velerov1api.VeleroPlugin {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the VeleroPlugin's ObjectMeta.
+func (b *VeleroPluginBuilder) ObjectMeta(opts ...ObjectMetaOpt) *VeleroPluginBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// Image appends an image to the VeleroPlugin's images, with an optional verification policy.
+func (b *VeleroPluginBuilder) Image(image string, verification *velerov1api.SignaturePolicy) *VeleroPluginBuilder {
+	b.object.Spec.Images = append(b.object.Spec.Images, velerov1api.PluginImage{
+		Image:        image,
+		Verification: verification,
+	})
+	return b
+}
+
+// ImagePullSecret sets the VeleroPlugin's image pull secret.
+func (b *VeleroPluginBuilder) ImagePullSecret(name string) *VeleroPluginBuilder {
+	b.object.Spec.ImagePullSecret = &corev1api.LocalObjectReference{Name: name}
+	return b
+}
+
+// Phase sets the VeleroPlugin's phase.
+func (b *VeleroPluginBuilder) Phase(phase velerov1api.VeleroPluginPhase) *VeleroPluginBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
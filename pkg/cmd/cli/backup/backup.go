@@ -35,6 +35,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewLogsCommand(f),
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
+		NewInspectCommand(f),
+		NewDiffCommand(f),
 		NewDeleteCommand(f, "delete"),
 	)
 
@@ -84,22 +84,25 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name                    string
-	TTL                     time.Duration
-	SnapshotVolumes         flag.OptionalBool
-	DefaultVolumesToRestic  flag.OptionalBool
-	IncludeNamespaces       flag.StringArray
-	ExcludeNamespaces       flag.StringArray
-	IncludeResources        flag.StringArray
-	ExcludeResources        flag.StringArray
-	Labels                  flag.Map
-	Selector                flag.LabelSelector
-	IncludeClusterResources flag.OptionalBool
-	Wait                    bool
-	StorageLocation         string
-	SnapshotLocations       []string
-	FromSchedule            string
-	OrderedResources        string
+	Name                     string
+	TTL                      time.Duration
+	SnapshotVolumes          flag.OptionalBool
+	DefaultVolumesToRestic   flag.OptionalBool
+	UnmountedVolumesToRestic flag.OptionalBool
+	SnapshotMoveData         flag.OptionalBool
+	IncludeNamespaces        flag.StringArray
+	ExcludeNamespaces        flag.StringArray
+	IncludeResources         flag.StringArray
+	ExcludeResources         flag.StringArray
+	Labels                   flag.Map
+	Selector                 flag.LabelSelector
+	IncludeClusterResources  flag.OptionalBool
+	Wait                     bool
+	StorageLocation          string
+	SnapshotLocations        []string
+	FromSchedule             string
+	OrderedResources         string
+	ResticUploadLimit        int64
 
 	client veleroclient.Interface
 }
@@ -135,6 +138,23 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 
 	f = flags.VarPF(&o.DefaultVolumesToRestic, "default-volumes-to-restic", "", "Use restic by default to backup all pod volumes")
 	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.UnmountedVolumesToRestic, "unmounted-volumes-to-restic", "", "Use restic to backup persistent volume claims that aren't mounted by any running pod")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.SnapshotMoveData, "snapshot-move-data", "", "Copy the data of CSI snapshots to the backup storage location")
+	f.NoOptDefVal = "true"
+
+	flags.Int64Var(&o.ResticUploadLimit, "restic-upload-limit", o.ResticUploadLimit, "Maximum upload rate of restic pod volume backups, in KiB/s. Overrides the backup storage location's limit. Optional.")
+}
+
+// ResticRateLimit returns the restic rate limit set by the flags, or nil if there isn't one.
+func (o *CreateOptions) ResticRateLimit() *velerov1api.ResticRateLimit {
+	if o.ResticUploadLimit <= 0 {
+		return nil
+	}
+
+	return &velerov1api.ResticRateLimit{UploadKiBps: o.ResticUploadLimit}
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -353,6 +373,15 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.DefaultVolumesToRestic.Value != nil {
 			backupBuilder.DefaultVolumesToRestic(*o.DefaultVolumesToRestic.Value)
 		}
+		if o.UnmountedVolumesToRestic.Value != nil {
+			backupBuilder.UnmountedVolumesToRestic(*o.UnmountedVolumesToRestic.Value)
+		}
+		if o.SnapshotMoveData.Value != nil {
+			backupBuilder.SnapshotMoveData(*o.SnapshotMoveData.Value)
+		}
+		if rateLimit := o.ResticRateLimit(); rateLimit != nil {
+			backupBuilder.ResticRateLimit(*rateLimit)
+		}
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
@@ -41,6 +41,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		listOptions           metav1.ListOptions
 		details               bool
 		insecureSkipTLSVerify bool
+		outputFormat          string
 	)
 
 	config, err := client.LoadConfig()
@@ -53,6 +54,8 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		Use:   use + " [NAME1] [NAME2] [NAME...]",
 		Short: "Describe backups",
 		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(output.ValidateDescribeFormat(outputFormat))
+
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
@@ -73,7 +76,9 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 			}
 
 			first := true
-			for _, backup := range backups.Items {
+			var descriptions []*output.BackupDescription
+			for i := range backups.Items {
+				backup := backups.Items[i]
 				deleteRequestListOptions := pkgbackup.NewDeleteBackupRequestListOptions(backup.Name, string(backup.UID))
 				deleteRequestList, err := veleroClient.VeleroV1().DeleteBackupRequests(f.Namespace()).List(context.TODO(), deleteRequestListOptions)
 				if err != nil {
@@ -102,6 +107,11 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 					}
 				}
 
+				if outputFormat != "" {
+					descriptions = append(descriptions, output.NewBackupDescription(context.Background(), kbClient, &backup, deleteRequestList.Items, podVolumeBackupList.Items, vscList.Items, insecureSkipTLSVerify, caCertFile))
+					continue
+				}
+
 				s := output.DescribeBackup(context.Background(), kbClient, &backup, deleteRequestList.Items, podVolumeBackupList.Items, vscList.Items, details, veleroClient, insecureSkipTLSVerify, caCertFile)
 				if first {
 					first = false
@@ -110,6 +120,9 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 					fmt.Printf("\n\n%s", s)
 				}
 			}
+			if outputFormat != "" {
+				cmd.CheckError(output.PrintDescriptions(os.Stdout, outputFormat, descriptions))
+			}
 			cmd.CheckError(err)
 		},
 	}
@@ -118,5 +131,6 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	c.Flags().BoolVar(&details, "details", details, "Display additional detail in the command output.")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	output.BindDescribeFlags(c.Flags(), &outputFormat)
 	return c
 }
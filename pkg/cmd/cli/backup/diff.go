@@ -0,0 +1,161 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/backupcontents"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+// backupDiffIgnoredFields are the fields that change whenever an item is updated, which are
+// ignored when the items of two backups are compared.
+var backupDiffIgnoredFields = [][]string{
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+}
+
+// BackupDiff is the difference between two backups.
+type BackupDiff struct {
+	Old        string                           `json:"old"`
+	New        string                           `json:"new"`
+	Items      []backupcontents.ItemChange      `json:"items"`
+	PodVolumes []backupcontents.PodVolumeChange `json:"podVolumes"`
+}
+
+func NewDiffCommand(f client.Factory) *cobra.Command {
+	var (
+		o             = NewInspectOptions()
+		resources     = flag.NewStringArray()
+		namespaces    = flag.NewStringArray()
+		includeStatus bool
+		outputFormat  = flag.NewEnum("text", "text", "json")
+	)
+
+	c := &cobra.Command{
+		Use:   "diff OLD NEW",
+		Short: "Compare the contents of two backups",
+		Long: `Compare the contents of two backups. The items that were added, removed or modified from the old backup to the new one are listed, along with the changes to the fields of the modified items, and the pod volumes whose restic snapshot or size changed.
+
+Items are compared in the API version that was preferred when they were backed up. Fields that change whenever an item is updated, such as metadata.resourceVersion, are ignored.`,
+		Example: `  # compare two backups
+  velero backup diff backup-1 backup-2
+
+  # compare only the deployments in the nginx namespace, as JSON
+  velero backup diff backup-1 backup-2 --resources deployments.apps --namespaces nginx -o json`,
+		Args: cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			diff := &BackupDiff{Old: args[0], New: args[1]}
+
+			ignored := append([][]string{}, backupDiffIgnoredFields...)
+			if !includeStatus {
+				ignored = append(ignored, []string{"status"})
+			}
+
+			cmd.CheckError(o.WithContents(f, diff.Old, func(oldContents *backupcontents.Contents) error {
+				return o.WithContents(f, diff.New, func(newContents *backupcontents.Contents) error {
+					var err error
+					diff.Items, err = backupcontents.CompareItems(oldContents, newContents, resources, namespaces, ignored)
+					return err
+				})
+			}))
+
+			veleroClient, err := f.Client()
+			cmd.CheckError(err)
+			oldPVBs, err := veleroClient.VeleroV1().PodVolumeBackups(f.Namespace()).List(context.TODO(), label.NewListOptionsForBackup(diff.Old))
+			cmd.CheckError(errors.Wrapf(err, "error getting PodVolumeBackups for backup %s", diff.Old))
+			newPVBs, err := veleroClient.VeleroV1().PodVolumeBackups(f.Namespace()).List(context.TODO(), label.NewListOptionsForBackup(diff.New))
+			cmd.CheckError(errors.Wrapf(err, "error getting PodVolumeBackups for backup %s", diff.New))
+			diff.PodVolumes = backupcontents.ComparePodVolumeBackups(oldPVBs.Items, newPVBs.Items)
+
+			if outputFormat.String() == "json" {
+				if diff.Items == nil {
+					diff.Items = []backupcontents.ItemChange{}
+				}
+				if diff.PodVolumes == nil {
+					diff.PodVolumes = []backupcontents.PodVolumeChange{}
+				}
+				encoded, err := json.MarshalIndent(diff, "", "  ")
+				cmd.CheckError(err)
+				fmt.Println(string(encoded))
+				return
+			}
+
+			printBackupDiff(os.Stdout, diff)
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	c.Flags().Var(&resources, "resources", "Only compare the items of these resources. A resource may be given as resource.group, such as deployments.apps, or as just the resource if that's unambiguous.")
+	c.Flags().Var(&namespaces, "namespaces", "Only compare the items in these namespaces. Cluster-scoped items are excluded if this is set.")
+	c.Flags().BoolVar(&includeStatus, "include-status", includeStatus, "Compare the items' status too.")
+	c.Flags().VarP(outputFormat, "output", "o", "Output display format. Valid formats are 'text' and 'json'.")
+
+	return c
+}
+
+// printBackupDiff writes the human-readable form of the diff to w.
+func printBackupDiff(w io.Writer, diff *BackupDiff) {
+	fmt.Fprintf(w, "Comparing backup %s to backup %s.\n\n", diff.Old, diff.New)
+
+	if len(diff.Items) == 0 {
+		fmt.Fprintln(w, "Items: no changes")
+	} else {
+		fmt.Fprintln(w, "Items:")
+		for _, change := range diff.Items {
+			switch change.Type {
+			case backupcontents.ChangeAdded:
+				fmt.Fprintf(w, "  + %s\n", change.Item)
+			case backupcontents.ChangeRemoved:
+				fmt.Fprintf(w, "  - %s\n", change.Item)
+			default:
+				fmt.Fprintf(w, "  ~ %s\n", change.Item)
+				backupcontents.PrintChanges(w, "      ", change.Changes)
+			}
+		}
+	}
+
+	fmt.Fprintln(w)
+
+	if len(diff.PodVolumes) == 0 {
+		fmt.Fprintln(w, "Pod volume backups: no changes")
+		return
+	}
+	fmt.Fprintln(w, "Pod volume backups:")
+	for _, change := range diff.PodVolumes {
+		switch change.Type {
+		case backupcontents.ChangeAdded:
+			fmt.Fprintf(w, "  + %s/%s volume %s: snapshot %s, %d bytes\n", change.Namespace, change.Pod, change.Volume, change.NewSnapshotID, change.NewSize)
+		case backupcontents.ChangeRemoved:
+			fmt.Fprintf(w, "  - %s/%s volume %s: snapshot %s, %d bytes\n", change.Namespace, change.Pod, change.Volume, change.OldSnapshotID, change.OldSize)
+		default:
+			fmt.Fprintf(w, "  ~ %s/%s volume %s: snapshot %s -> %s, %d -> %d bytes\n", change.Namespace, change.Pod, change.Volume, change.OldSnapshotID, change.NewSnapshotID, change.OldSize, change.NewSize)
+		}
+	}
+}
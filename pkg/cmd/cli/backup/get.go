@@ -26,10 +26,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 )
 
 func NewGetCommand(f client.Factory, use string) *cobra.Command {
 	var listOptions metav1.ListOptions
+	var watch bool
 
 	c := &cobra.Command{
 		Use:   use,
@@ -41,6 +43,15 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
+			if watch {
+				informerFactory := informers.NewFilteredSharedInformerFactory(veleroClient, 0, f.Namespace(), func(opts *metav1.ListOptions) {
+					opts.LabelSelector = listOptions.LabelSelector
+				})
+				err := output.Watch(context.Background(), c, informerFactory.Velero().V1().Backups().Informer(), output.IncludeNames(args))
+				cmd.CheckError(err)
+				return
+			}
+
 			var backups *api.BackupList
 			if len(args) > 0 {
 				backups = new(api.BackupList)
@@ -62,6 +73,7 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector")
 
 	output.BindFlags(c.Flags())
+	output.BindWatchFlag(c.Flags(), &watch)
 
 	return c
 }
@@ -0,0 +1,356 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/backupcontents"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+)
+
+func NewInspectCommand(f client.Factory) *cobra.Command {
+	o := NewInspectOptions()
+
+	c := &cobra.Command{
+		Use:   "inspect",
+		Short: "Inspect the Kubernetes manifests stored in a backup",
+		Long: `Inspect the Kubernetes manifests stored in a backup without downloading and extracting it by hand.
+
+The backup's tarball is downloaded from object storage and, once the backup is done, kept in a local cache so that it's downloaded only once.`,
+	}
+
+	o.BindFlags(c.PersistentFlags())
+
+	c.AddCommand(
+		newInspectListCommand(f, o),
+		newInspectCatCommand(f, o),
+		newInspectDiffCommand(f, o),
+	)
+
+	return c
+}
+
+// InspectOptions are the options for downloading the backup that's inspected.
+type InspectOptions struct {
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	CacheDir              string
+	NoCache               bool
+	caCertFile            string
+}
+
+func NewInspectOptions() *InspectOptions {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+
+	return &InspectOptions{
+		Timeout:    time.Minute,
+		CacheDir:   backupcontents.DefaultCacheDir(),
+		caCertFile: config.CACertFile(),
+	}
+}
+
+func (o *InspectOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process download request.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.caCertFile, "cacert", o.caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	flags.StringVar(&o.CacheDir, "cache-dir", o.CacheDir, "Directory to cache the downloaded backups in.")
+	flags.BoolVar(&o.NoCache, "no-cache", o.NoCache, "Download the backup even if it's cached, and don't cache it.")
+}
+
+// WithContents downloads and extracts the backup with the given name, or reads it from the
+// cache, and calls fn with its contents, which are removed when fn returns.
+func (o *InspectOptions) WithContents(f client.Factory, name string, fn func(*backupcontents.Contents) error) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	downloader := &backupcontents.Downloader{
+		KBClient:              kbClient,
+		CacheDir:              o.CacheDir,
+		Timeout:               o.Timeout,
+		InsecureSkipTLSVerify: o.InsecureSkipTLSVerify,
+		CACertFile:            o.caCertFile,
+	}
+	if o.NoCache {
+		downloader.CacheDir = ""
+	}
+
+	contents, err := downloader.Open(context.Background(), backup)
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+
+	return fn(contents)
+}
+
+func newInspectListCommand(f client.Factory, o *InspectOptions) *cobra.Command {
+	var (
+		resources    = flag.NewStringArray()
+		namespaces   = flag.NewStringArray()
+		outputFormat = flag.NewEnum("table", "table", "json")
+	)
+
+	c := &cobra.Command{
+		Use:   "ls NAME",
+		Short: "List the items stored in a backup",
+		Example: `  # list all the items in a backup
+  velero backup inspect ls backup-1
+
+  # list the deployments and services in the nginx namespace
+  velero backup inspect ls backup-1 --resources deployments.apps,services --namespaces nginx`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.WithContents(f, args[0], func(contents *backupcontents.Contents) error {
+				items := contents.Items(resources, namespaces)
+
+				if outputFormat.String() == "json" {
+					if items == nil {
+						items = []backupcontents.Item{}
+					}
+					encoded, err := json.MarshalIndent(items, "", "  ")
+					if err != nil {
+						return errors.WithStack(err)
+					}
+					fmt.Println(string(encoded))
+					return nil
+				}
+
+				if len(items) == 0 {
+					fmt.Println("No items found in backup.")
+					return nil
+				}
+
+				w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+				fmt.Fprintln(w, "RESOURCE\tNAMESPACE\tNAME")
+				for _, item := range items {
+					namespace := item.Namespace
+					if namespace == "" {
+						namespace = "<cluster>"
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\n", item.GroupResource, namespace, item.Name)
+				}
+				return w.Flush()
+			}))
+		},
+	}
+
+	c.Flags().Var(&resources, "resources", "Only list the items of these resources. A resource may be given as resource.group, such as deployments.apps, or as just the resource if that's unambiguous.")
+	c.Flags().Var(&namespaces, "namespaces", "Only list the items in these namespaces. Cluster-scoped items are excluded if this is set.")
+	c.Flags().VarP(outputFormat, "output", "o", "Output display format. Valid formats are 'table' and 'json'.")
+
+	return c
+}
+
+func newInspectCatCommand(f client.Factory, o *InspectOptions) *cobra.Command {
+	var (
+		apiVersion   string
+		outputFormat = flag.NewEnum("json", "json", "yaml")
+	)
+
+	c := &cobra.Command{
+		Use:   "cat NAME ITEM",
+		Short: "Print an item stored in a backup",
+		Long: `Print an item stored in a backup. The item is given as <resource>/<namespace>/<name>, or <resource>/<name> for cluster-scoped items.
+
+The item is printed in the API version that was preferred by the cluster when it was backed up, unless another version that it was backed up in is selected.`,
+		Example: `  # print the nginx deployment stored in a backup
+  velero backup inspect cat backup-1 deployments.apps/nginx/nginx
+
+  # print a cluster-scoped item in another API version, as YAML
+  velero backup inspect cat backup-1 customresourcedefinitions.apiextensions.k8s.io/widgets.example.com --api-version v1beta1 -o yaml`,
+		Args: cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			item, err := backupcontents.ParseItem(args[1])
+			cmd.CheckError(err)
+
+			cmd.CheckError(o.WithContents(f, args[0], func(contents *backupcontents.Contents) error {
+				item, data, err := contents.Read(item, apiVersion)
+				if err != nil {
+					return err
+				}
+
+				if outputFormat.String() == "yaml" {
+					data, err = yaml.JSONToYAML(data)
+					if err != nil {
+						return errors.Wrapf(err, "error converting item %s to YAML", item)
+					}
+					fmt.Print(string(data))
+					return nil
+				}
+
+				buf := new(bytes.Buffer)
+				if err := json.Indent(buf, data, "", "  "); err != nil {
+					return errors.Wrapf(err, "error formatting item %s", item)
+				}
+				fmt.Println(buf.String())
+				return nil
+			}))
+		},
+	}
+
+	c.Flags().StringVar(&apiVersion, "api-version", apiVersion, "API version of the item to print, such as v1beta1. Defaults to the version that was preferred when the item was backed up.")
+	c.Flags().VarP(outputFormat, "output", "o", "Output display format. Valid formats are 'json' and 'yaml'.")
+
+	return c
+}
+
+// liveDiffIgnoredFields are the fields that are set by the API server, which are ignored when
+// an item in a backup is compared with the item in the cluster.
+var liveDiffIgnoredFields = [][]string{
+	{"metadata", "uid"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "selfLink"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+}
+
+func newInspectDiffCommand(f client.Factory, o *InspectOptions) *cobra.Command {
+	var (
+		includeStatus bool
+		outputFormat  = flag.NewEnum("text", "text", "json")
+	)
+
+	c := &cobra.Command{
+		Use:   "diff NAME ITEM",
+		Short: "Compare an item stored in a backup with the item in the cluster",
+		Long: `Compare an item stored in a backup with the item in the cluster. The item is given as <resource>/<namespace>/<name>, or <resource>/<name> for cluster-scoped items.
+
+Fields that are set by the API server, such as metadata.uid and metadata.resourceVersion, are ignored.`,
+		Example: `  # show what changed in the nginx deployment since it was backed up
+  velero backup inspect diff backup-1 deployments.apps/nginx/nginx`,
+		Args: cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			item, err := backupcontents.ParseItem(args[1])
+			cmd.CheckError(err)
+
+			var data []byte
+			cmd.CheckError(o.WithContents(f, args[0], func(contents *backupcontents.Contents) error {
+				item, data, err = contents.Read(item, "")
+				return err
+			}))
+
+			backedUp := new(unstructured.Unstructured)
+			cmd.CheckError(errors.Wrapf(json.Unmarshal(data, &backedUp.Object), "error decoding item %s", item))
+
+			live, err := getLiveItem(f, item, backedUp.GetAPIVersion())
+			cmd.CheckError(err)
+
+			ignored := append([][]string{}, liveDiffIgnoredFields...)
+			if !includeStatus {
+				ignored = append(ignored, []string{"status"})
+			}
+			var changes []backupcontents.FieldChange
+			if live != nil {
+				backedUpObj, err := backupcontents.DecodeItem(data, ignored)
+				cmd.CheckError(err)
+				// the live item is encoded and decoded again so that its numbers have the same type
+				// as the backed up item's
+				liveData, err := json.Marshal(live.Object)
+				cmd.CheckError(err)
+				liveObj, err := backupcontents.DecodeItem(liveData, ignored)
+				cmd.CheckError(err)
+				changes = backupcontents.DiffJSON(backedUpObj, liveObj)
+			}
+
+			if outputFormat.String() == "json" {
+				encoded, err := json.MarshalIndent(struct {
+					Item            backupcontents.Item          `json:"item"`
+					ExistsInCluster bool                         `json:"existsInCluster"`
+					Changes         []backupcontents.FieldChange `json:"changes"`
+				}{item, live != nil, changes}, "", "  ")
+				cmd.CheckError(err)
+				fmt.Println(string(encoded))
+				return
+			}
+
+			switch {
+			case live == nil:
+				fmt.Printf("%s doesn't exist in the cluster.\n", item)
+			case len(changes) == 0:
+				fmt.Printf("%s is unchanged since it was backed up.\n", item)
+			default:
+				fmt.Printf("Changes to %s since it was backed up:\n", item)
+				backupcontents.PrintChanges(os.Stdout, "  ", changes)
+			}
+		},
+	}
+
+	c.Flags().BoolVar(&includeStatus, "include-status", includeStatus, "Compare the items' status too.")
+	c.Flags().VarP(outputFormat, "output", "o", "Output display format. Valid formats are 'text' and 'json'.")
+
+	return c
+}
+
+// getLiveItem returns the item from the cluster in the given API version, or nil if it doesn't
+// exist.
+func getLiveItem(f client.Factory, item backupcontents.Item, apiVersion string) (*unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing API version of item %s", item)
+	}
+	gr := schema.ParseGroupResource(item.GroupResource)
+	if gr.Group != gv.Group {
+		return nil, errors.Errorf("API version %q of item %s doesn't match its resource", apiVersion, item)
+	}
+
+	dynamicClient, err := f.DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := dynamicClient.Resource(gv.WithResource(gr.Resource)).Namespace(item.Namespace).Get(context.TODO(), item.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting %s from the cluster", item)
+	}
+	return live, nil
+}
@@ -20,8 +20,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,6 +32,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/logs"
+	"github.com/vmware-tanzu/velero/pkg/logstream"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
 func NewLogsCommand(f client.Factory) *cobra.Command {
@@ -41,6 +46,9 @@ func NewLogsCommand(f client.Factory) *cobra.Command {
 	timeout := time.Minute
 	insecureSkipTLSVerify := false
 	caCertFile := config.CACertFile()
+	follow := false
+	level := logging.LogLevelFlag(logrus.TraceLevel)
+	serverPort := logs.DefaultServerPort
 
 	c := &cobra.Command{
 		Use:   "logs BACKUP",
@@ -62,20 +70,38 @@ func NewLogsCommand(f client.Factory) *cobra.Command {
 				cmd.Exit("Error checking for backup %q: %v", backupName, err)
 			}
 
+			w := logs.NewLevelFilter(os.Stdout, level.Parse())
+
 			switch backup.Status.Phase {
 			case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
-				// terminal phases, do nothing.
+				// terminal phases, the log is in object storage.
+			case velerov1api.BackupPhaseInProgress:
+				// the log is streamed from the Velero server while the backup is in progress.
+				kubeClient, err := f.KubeClient()
+				cmd.CheckError(err)
+
+				err = logs.Stream(context.Background(), kubeClient, f.Namespace(), logstream.KindBackup, backupName, serverPort, follow, w)
+				if err != logs.ErrNotFound {
+					cmd.CheckError(err)
+					cmd.CheckError(w.Flush())
+					return
+				}
+				// the backup finished after its phase was checked, so its log has been uploaded.
 			default:
-				cmd.Exit("Logs for backup %q are not available until it's finished processing. Please wait "+
-					"until the backup has a phase of Completed or Failed and try again.", backupName)
+				cmd.Exit("Logs for backup %q are not available until it's in progress. Please wait "+
+					"until the backup has a phase of InProgress, Completed or Failed and try again.", backupName)
 			}
 
-			err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), backupName, velerov1api.DownloadTargetKindBackupLog, os.Stdout, timeout, insecureSkipTLSVerify, caCertFile)
+			err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), backupName, velerov1api.DownloadTargetKindBackupLog, w, timeout, insecureSkipTLSVerify, caCertFile)
 			cmd.CheckError(err)
+			cmd.CheckError(w.Flush())
 		},
 	}
 
 	c.Flags().DurationVar(&timeout, "timeout", timeout, "How long to wait to receive logs.")
+	c.Flags().BoolVarP(&follow, "follow", "f", follow, "If the backup is in progress, keep printing its logs until it's done.")
+	c.Flags().Var(level, "level", fmt.Sprintf("Only print the log lines at this level or a more severe one. Valid values are %s.", strings.Join(level.AllowedValues(), ", ")))
+	c.Flags().IntVar(&serverPort, "server-port", serverPort, "The port of the Velero server's log stream address, which the logs of in-progress backups are served on.")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
 	return c
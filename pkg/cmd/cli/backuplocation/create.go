@@ -72,6 +72,8 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	ResticUploadLimit                     int64
+	ResticDownloadLimit                   int64
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -103,6 +105,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 		"access-mode",
 		fmt.Sprintf("Access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.Int64Var(&o.ResticUploadLimit, "restic-upload-limit", o.ResticUploadLimit, "Maximum upload rate of restic pod volume backups to this location, in KiB/s. Optional.")
+	flags.Int64Var(&o.ResticDownloadLimit, "restic-download-limit", o.ResticDownloadLimit, "Maximum download rate of restic pod volume restores from this location, in KiB/s. Optional.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -126,6 +130,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.ResticUploadLimit < 0 || o.ResticDownloadLimit < 0 {
+		return errors.New("--restic-upload-limit and --restic-download-limit must be non-negative")
+	}
+
 	return nil
 }
 
@@ -176,6 +184,13 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 		backupStorageLocation.Spec.ValidationFrequency = &metav1.Duration{Duration: o.ValidationFrequency}
 	}
 
+	if o.ResticUploadLimit > 0 || o.ResticDownloadLimit > 0 {
+		backupStorageLocation.Spec.ResticRateLimit = &velerov1api.ResticRateLimit{
+			UploadKiBps:   o.ResticUploadLimit,
+			DownloadKiBps: o.ResticDownloadLimit,
+		}
+	}
+
 	for secretName, secretKey := range o.Credential.Data() {
 		backupStorageLocation.Spec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
 		break
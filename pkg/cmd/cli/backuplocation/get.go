@@ -28,10 +28,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 )
 
 func NewGetCommand(f client.Factory, use string) *cobra.Command {
 	var listOptions metav1.ListOptions
+	var watch bool
 	var showDefaultOnly bool
 
 	c := &cobra.Command{
@@ -41,6 +43,18 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 			err := output.ValidateFlags(c)
 			cmd.CheckError(err)
 
+			if watch {
+				veleroClient, err := f.Client()
+				cmd.CheckError(err)
+
+				informerFactory := informers.NewFilteredSharedInformerFactory(veleroClient, 0, f.Namespace(), func(opts *metav1.ListOptions) {
+					opts.LabelSelector = listOptions.LabelSelector
+				})
+				err = output.Watch(context.Background(), c, informerFactory.Velero().V1().BackupStorageLocations().Informer(), includeLocation(output.IncludeNames(args), showDefaultOnly))
+				cmd.CheckError(err)
+				return
+			}
+
 			kbClient, err := f.KubebuilderClient()
 			cmd.CheckError(err)
 
@@ -93,6 +107,19 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector.")
 
 	output.BindFlags(c.Flags())
+	output.BindWatchFlag(c.Flags(), &watch)
 
 	return c
 }
+
+// includeLocation returns a filter for output.Watch that includes the backup storage locations
+// that include returns true for and, if defaultOnly is true, that are the default location.
+func includeLocation(include func(metav1.Object) bool, defaultOnly bool) func(metav1.Object) bool {
+	return func(obj metav1.Object) bool {
+		if include != nil && !include(obj) {
+			return false
+		}
+		location, ok := obj.(*velerov1api.BackupStorageLocation)
+		return ok && (!defaultOnly || location.Spec.Default)
+	}
+}
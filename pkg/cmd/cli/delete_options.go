@@ -70,7 +70,7 @@ func (o *DeleteOptions) Validate(c *cobra.Command, f client.Factory, args []stri
 		hasAll      = o.all
 		hasSelector = o.Selector.LabelSelector != nil
 	)
-	if !xor(hasNames, hasAll, hasSelector) {
+	if !Xor(hasNames, hasAll, hasSelector) {
 		return errors.New("you must specify exactly one of: specific " + o.singularTypeName + " name(s), the --all flag, or the --selector flag")
 	}
 
@@ -112,7 +112,7 @@ func GetConfirmation() bool {
 
 // Xor returns true if exactly one of the provided values is true,
 // or false otherwise.
-func xor(val bool, vals ...bool) bool {
+func Xor(val bool, vals ...bool) bool {
 	res := val
 
 	for _, v := range vals {
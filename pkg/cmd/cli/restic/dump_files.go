@@ -0,0 +1,128 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/restorefiles"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/exec"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// NewDumpFilesCommand returns the command that `velero restore files` runs in a restic
+// daemonset pod to download files from a pod volume backup. It restores the files to a
+// temporary directory and writes them to stdout as a tar archive.
+func NewDumpFilesCommand(f veleroclient.Factory) *cobra.Command {
+	var (
+		repoIdentifier string
+		snapshotID     string
+		backupLocation string
+		includePaths   = flag.NewStringArray()
+	)
+
+	c := &cobra.Command{
+		Use:    "dump-files",
+		Short:  "Write files from a restic snapshot to stdout as a tar archive",
+		Long:   "Write files from a restic snapshot to stdout as a tar archive",
+		Hidden: true,
+		Args:   cobra.ExactArgs(0),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(dumpFiles(f, repoIdentifier, snapshotID, backupLocation, includePaths))
+		},
+	}
+
+	c.Flags().StringVar(&repoIdentifier, "repo-identifier", repoIdentifier, "The restic repository's identifier.")
+	c.Flags().StringVar(&snapshotID, "snapshot-id", snapshotID, "The ID of the restic snapshot.")
+	c.Flags().StringVar(&backupLocation, "backup-storage-location", backupLocation, "The backup storage location of the restic repository.")
+	c.Flags().Var(&includePaths, "include", "Paths within the snapshot to restore. Can be specified more than once.")
+
+	return c
+}
+
+func dumpFiles(f veleroclient.Factory, repoIdentifier, snapshotID, backupLocationName string, includePaths []string) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	fs := filesystem.NewFileSystem()
+	credentialsFileStore, err := credentials.NewNamespacedFileStore(kbClient, f.Namespace(), defaultCredentialsDirectory, fs)
+	if err != nil {
+		return errors.Wrap(err, "error creating credentials file store")
+	}
+
+	credsFile, err := credentialsFileStore.Path(restic.RepoKeySelector())
+	if err != nil {
+		return errors.Wrap(err, "error creating temp restic credentials file")
+	}
+	// ignore error since there's nothing we can do and it's a temp file.
+	defer os.Remove(credsFile)
+
+	backupLocation := &velerov1api.BackupStorageLocation{}
+	if err := kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: f.Namespace(),
+		Name:      backupLocationName,
+	}, backupLocation); err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	// restore into the scratch directory, which unlike the rest of the container's
+	// filesystem is writable
+	dir, err := ioutil.TempDir(os.Getenv("VELERO_SCRATCH_DIR"), "dump-files-")
+	if err != nil {
+		return errors.Wrap(err, "error creating temp directory")
+	}
+	// ignore error since there's nothing we can do and it's a temp directory.
+	defer os.RemoveAll(dir)
+
+	resticCmd := restic.RestoreFilesCommand(repoIdentifier, credsFile, snapshotID, dir, includePaths)
+
+	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
+	if backupLocation.Spec.ObjectStorage != nil && backupLocation.Spec.ObjectStorage.CACert != nil {
+		caCertFile, err := restic.TempCACertFile(backupLocation.Spec.ObjectStorage.CACert, backupLocationName, fs)
+		if err != nil {
+			return errors.Wrap(err, "error creating temp cacert file")
+		}
+		// ignore error since there's nothing we can do and it's a temp file.
+		defer os.Remove(caCertFile)
+		resticCmd.CACertFile = caCertFile
+	}
+
+	if resticCmd.Env, err = restic.CmdEnv(backupLocation, credentialsFileStore); err != nil {
+		return errors.Wrap(err, "error setting restic cmd env")
+	}
+	resticCmd.ExtraFlags = append(resticCmd.ExtraFlags, restic.DownloadLimitFlags(nil, backupLocation)...)
+
+	if stdout, stderr, err := exec.RunCommand(resticCmd.Cmd()); err != nil {
+		return errors.Wrapf(err, "error running restic restore, cmd=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
+	}
+
+	return restorefiles.WriteTar(os.Stdout, dir)
+}
@@ -33,6 +33,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 	c.AddCommand(
 		repo.NewRepositoryCommand(f),
 		NewServerCommand(f),
+		NewDumpFilesCommand(f),
 	)
 
 	return c
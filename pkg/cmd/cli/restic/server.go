@@ -51,6 +51,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/controller"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
@@ -74,6 +75,7 @@ const (
 func NewServerCommand(f client.Factory) *cobra.Command {
 	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
 	formatFlag := logging.NewFormatFlag()
+	maxConcurrentOperations := 0
 
 	command := &cobra.Command{
 		Use:    "server",
@@ -88,7 +90,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 			logger.Infof("Starting Velero restic server %s (%s)", buildinfo.Version, buildinfo.FormattedGitSHA())
 
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
-			s, err := newResticServer(logger, f, defaultMetricsAddress)
+			s, err := newResticServer(logger, f, defaultMetricsAddress, maxConcurrentOperations)
 			cmd.CheckError(err)
 
 			s.run()
@@ -97,6 +99,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+	command.Flags().IntVar(&maxConcurrentOperations, "max-concurrent-operations", maxConcurrentOperations, "The maximum number of pod volume backups and restores to run at the same time on this node. 0 means one backup and one restore at a time.")
 
 	return command
 }
@@ -115,9 +118,12 @@ type resticServer struct {
 	metrics               *metrics.ServerMetrics
 	metricsAddress        string
 	namespace             string
+	// maxConcurrentOperations is the maximum number of pod volume
+	// backups and restores that run at the same time, if positive.
+	maxConcurrentOperations int
 }
 
-func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress string) (*resticServer, error) {
+func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress string, maxConcurrentOperations int) (*resticServer, error) {
 
 	kubeClient, err := factory.KubeClient()
 	if err != nil {
@@ -173,6 +179,8 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAd
 		mgr:                   mgr,
 		metricsAddress:        metricAddress,
 		namespace:             factory.Namespace(),
+
+		maxConcurrentOperations: maxConcurrentOperations,
 	}
 
 	if err := s.validatePodVolumesHostPath(); err != nil {
@@ -209,6 +217,14 @@ func (s *resticServer) run() {
 		s.logger.Fatalf("Failed to create credentials file store: %v", err)
 	}
 
+	// the limiter is shared by both controllers so that it caps the
+	// total number of restic operations running on the node.
+	operationLimiter := restic.NewOperationLimiter(s.maxConcurrentOperations)
+	workers := 1
+	if s.maxConcurrentOperations > 0 {
+		workers = s.maxConcurrentOperations
+	}
+
 	backupController := controller.NewPodVolumeBackupController(
 		s.logger,
 		s.veleroInformerFactory.Velero().V1().PodVolumeBackups(),
@@ -220,6 +236,7 @@ func (s *resticServer) run() {
 		s.mgr.GetClient(),
 		os.Getenv("NODE_NAME"),
 		credentialFileStore,
+		operationLimiter,
 	)
 
 	restoreController := controller.NewPodVolumeRestoreController(
@@ -232,6 +249,7 @@ func (s *resticServer) run() {
 		s.mgr.GetClient(),
 		os.Getenv("NODE_NAME"),
 		credentialFileStore,
+		operationLimiter,
 	)
 
 	go s.veleroInformerFactory.Start(s.ctx.Done())
@@ -245,8 +263,8 @@ func (s *resticServer) run() {
 
 	// Adding the controllers to the manager will register them as a (runtime-controller) runnable,
 	// so the manager will ensure the cache is started and ready before all controller are started
-	s.mgr.Add(managercontroller.Runnable(backupController, 1))
-	s.mgr.Add(managercontroller.Runnable(restoreController, 1))
+	s.mgr.Add(managercontroller.Runnable(backupController, workers))
+	s.mgr.Add(managercontroller.Runnable(restoreController, workers))
 
 	s.logger.Info("Controllers starting...")
 
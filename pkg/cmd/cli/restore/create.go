@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -89,6 +90,8 @@ type CreateOptions struct {
 	IncludeClusterResources flag.OptionalBool
 	Wait                    bool
 	AllowPartiallyFailed    flag.OptionalBool
+	PodVolumeRestoreMode    *flag.Enum
+	ResticDownloadLimit     int64
 
 	client veleroclient.Interface
 }
@@ -101,6 +104,11 @@ func NewCreateOptions() *CreateOptions {
 		RestoreVolumes:          flag.NewOptionalBool(nil),
 		PreserveNodePorts:       flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		PodVolumeRestoreMode: flag.NewEnum(
+			"",
+			string(api.PodVolumeRestoreModeInitContainer),
+			string(api.PodVolumeRestoreModeHelperPod),
+		),
 	}
 }
 
@@ -130,6 +138,10 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.AllowPartiallyFailed, "allow-partially-failed", "", "If using --from-schedule, whether to consider PartiallyFailed backups when looking for the most recent one. This flag has no effect if not using --from-schedule.")
 	f.NoOptDefVal = "true"
 
+	flags.Var(o.PodVolumeRestoreMode, "pod-volume-restore-mode", fmt.Sprintf("How to restore volumes backed up with restic. Valid values are %s. If empty, defaults to %s. Optional.", strings.Join(o.PodVolumeRestoreMode.AllowedValues(), ", "), api.PodVolumeRestoreModeInitContainer))
+
+	flags.Int64Var(&o.ResticDownloadLimit, "restic-download-limit", o.ResticDownloadLimit, "Maximum download rate of restic pod volume restores, in KiB/s. Overrides the backup storage location's limit. Optional.")
+
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete.")
 }
 
@@ -269,9 +281,14 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			RestorePVs:              o.RestoreVolumes.Value,
 			PreserveNodePorts:       o.PreserveNodePorts.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
+			PodVolumeRestoreMode:    api.PodVolumeRestoreMode(o.PodVolumeRestoreMode.String()),
 		},
 	}
 
+	if o.ResticDownloadLimit > 0 {
+		restore.Spec.ResticRateLimit = &api.ResticRateLimit{DownloadKiBps: o.ResticDownloadLimit}
+	}
+
 	if printed, err := output.PrintWithFormat(c, restore); printed || err != nil {
 		return err
 	}
@@ -36,6 +36,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		listOptions           metav1.ListOptions
 		details               bool
 		insecureSkipTLSVerify bool
+		outputFormat          string
 	)
 
 	config, err := client.LoadConfig()
@@ -48,6 +49,8 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		Use:   use + " [NAME1] [NAME2] [NAME...]",
 		Short: "Describe restores",
 		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(output.ValidateDescribeFormat(outputFormat))
+
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
@@ -68,13 +71,20 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 			}
 
 			first := true
-			for _, restore := range restores.Items {
+			var descriptions []*output.RestoreDescription
+			for i := range restores.Items {
+				restore := restores.Items[i]
 				opts := restic.NewPodVolumeRestoreListOptions(restore.Name)
 				podvolumeRestoreList, err := veleroClient.VeleroV1().PodVolumeRestores(f.Namespace()).List(context.TODO(), opts)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "error getting PodVolumeRestores for restore %s: %v\n", restore.Name, err)
 				}
 
+				if outputFormat != "" {
+					descriptions = append(descriptions, output.NewRestoreDescription(context.Background(), kbClient, &restore, podvolumeRestoreList.Items, insecureSkipTLSVerify, caCertFile))
+					continue
+				}
+
 				s := output.DescribeRestore(context.Background(), kbClient, &restore, podvolumeRestoreList.Items, details, veleroClient, insecureSkipTLSVerify, caCertFile)
 				if first {
 					first = false
@@ -83,6 +93,9 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 					fmt.Printf("\n\n%s", s)
 				}
 			}
+			if outputFormat != "" {
+				cmd.CheckError(output.PrintDescriptions(os.Stdout, outputFormat, descriptions))
+			}
 			cmd.CheckError(err)
 		},
 	}
@@ -91,6 +104,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	c.Flags().BoolVar(&details, "details", details, "Display additional detail in the command output.")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	output.BindDescribeFlags(c.Flags(), &outputFormat)
 
 	return c
 }
@@ -0,0 +1,488 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/restorefiles"
+	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+const (
+	// resticDaemonSetSelector selects the pods of the restic daemonset.
+	resticDaemonSetSelector = "name=restic"
+	resticContainer         = "restic"
+)
+
+func NewFilesCommand(f client.Factory) *cobra.Command {
+	o := NewFilesOptions()
+
+	c := &cobra.Command{
+		Use:   "files --backup BACKUP --pod NAMESPACE/POD --volume VOLUME --path PATH [--target-pod NAMESPACE/POD | --target-pvc PVC | --output-dir DIR]",
+		Short: "Restore individual files from a pod volume backup",
+		Long: `Restore individual files from a pod volume backup, rather than the whole volume.
+
+The files are restored from the restic snapshot of a pod's volume taken by a backup, and can be restored into:
+  - a directory in a volume of a running pod, with --target-pod
+  - a new persistent volume claim, with --target-pvc
+  - a local directory, with --output-dir, in which case they're streamed through the CLI
+
+Paths are relative to the root of the volume. A path that's a directory is restored with all of its contents.`,
+		Example: `  # restore a file into the "restored" directory of the same volume of the pod it was backed up from
+  velero restore files --backup backup-1 --pod nginx/nginx-0 --volume data --path /var/lib/x/file --target-pod nginx/nginx-0 --target-path restored
+
+  # restore a directory into a new 1Gi persistent volume claim in the nginx namespace
+  velero restore files --backup backup-1 --pod nginx/nginx-0 --volume data --path /var/lib/x --target-pvc nginx-files --size 1Gi
+
+  # download two files to a local directory
+  velero restore files --backup backup-1 --pod nginx/nginx-0 --volume data --path /a --path /b --output-dir ./files`,
+		Args: cobra.ExactArgs(0),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type FilesOptions struct {
+	BackupName      string
+	Pod             string
+	Volume          string
+	Paths           flag.StringArray
+	TargetPod       string
+	TargetVolume    string
+	TargetPath      string
+	TargetPVC       string
+	TargetNamespace string
+	StorageClass    string
+	Size            string
+	OutputDir       string
+	Timeout         time.Duration
+
+	namespace  string
+	client     veleroclient.Interface
+	kubeClient kubeclient.Interface
+}
+
+func NewFilesOptions() *FilesOptions {
+	return &FilesOptions{
+		Paths:   flag.NewStringArray(),
+		Timeout: time.Hour,
+	}
+}
+
+func (o *FilesOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.BackupName, "backup", o.BackupName, "Backup to restore the files from.")
+	flags.StringVar(&o.Pod, "pod", o.Pod, "Pod whose volume was backed up, as namespace/name.")
+	flags.StringVar(&o.Volume, "volume", o.Volume, "Name of the backed up volume in the pod.")
+	flags.Var(&o.Paths, "path", "Path within the volume of a file or directory to restore. Can be specified more than once.")
+	flags.StringVar(&o.TargetPod, "target-pod", o.TargetPod, "Running pod to restore the files into a volume of, as namespace/name.")
+	flags.StringVar(&o.TargetVolume, "target-volume", o.TargetVolume, "Volume of the target pod to restore the files into. Defaults to --volume.")
+	flags.StringVar(&o.TargetPath, "target-path", o.TargetPath, "Directory within the target volume to restore the files into. Defaults to velero-restore-<backup>.")
+	flags.StringVar(&o.TargetPVC, "target-pvc", o.TargetPVC, "Name of a persistent volume claim to create and restore the files into.")
+	flags.StringVar(&o.TargetNamespace, "target-namespace", o.TargetNamespace, "Namespace to create the target persistent volume claim in. Defaults to the pod's namespace.")
+	flags.StringVar(&o.StorageClass, "storage-class", o.StorageClass, "Storage class of the target persistent volume claim. Defaults to the cluster's default storage class.")
+	flags.StringVar(&o.Size, "size", o.Size, "Size of the target persistent volume claim, such as 1Gi.")
+	flags.StringVar(&o.OutputDir, "output-dir", o.OutputDir, "Local directory to download the files to.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait for the files to be restored.")
+}
+
+func (o *FilesOptions) Complete(f client.Factory) error {
+	var err error
+	if o.client, err = f.Client(); err != nil {
+		return err
+	}
+	if o.kubeClient, err = f.KubeClient(); err != nil {
+		return err
+	}
+	o.namespace = f.Namespace()
+
+	if o.TargetPod != "" {
+		if o.TargetVolume == "" {
+			o.TargetVolume = o.Volume
+		}
+		if o.TargetPath == "" {
+			o.TargetPath = "velero-restore-" + o.BackupName
+		}
+	}
+	if o.TargetPVC != "" && o.TargetNamespace == "" {
+		if podNamespace, _, err := parsePodName(o.Pod); err == nil {
+			o.TargetNamespace = podNamespace
+		}
+	}
+
+	return nil
+}
+
+func (o *FilesOptions) Validate() error {
+	if o.BackupName == "" {
+		return errors.New("--backup is required")
+	}
+	if _, _, err := parsePodName(o.Pod); err != nil {
+		return errors.Wrap(err, "invalid --pod")
+	}
+	if o.Volume == "" {
+		return errors.New("--volume is required")
+	}
+	if len(o.Paths) == 0 {
+		return errors.New("at least one --path is required")
+	}
+
+	targets := 0
+	for _, target := range []string{o.TargetPod, o.TargetPVC, o.OutputDir} {
+		if target != "" {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return errors.New("exactly one of --target-pod, --target-pvc and --output-dir is required")
+	}
+
+	if o.TargetPod == "" && (o.TargetVolume != "" || o.TargetPath != "") {
+		return errors.New("--target-volume and --target-path can only be used with --target-pod")
+	}
+	if o.TargetPod != "" {
+		if _, _, err := parsePodName(o.TargetPod); err != nil {
+			return errors.Wrap(err, "invalid --target-pod")
+		}
+	}
+
+	if o.TargetPVC == "" && (o.TargetNamespace != "" || o.StorageClass != "" || o.Size != "") {
+		return errors.New("--target-namespace, --storage-class and --size can only be used with --target-pvc")
+	}
+	if o.TargetPVC != "" {
+		if o.Size == "" {
+			return errors.New("--size is required with --target-pvc")
+		}
+		if _, err := resource.ParseQuantity(o.Size); err != nil {
+			return errors.Wrap(err, "invalid --size")
+		}
+	}
+
+	return nil
+}
+
+func (o *FilesOptions) Run(f client.Factory) error {
+	pvb, err := o.getPodVolumeBackup()
+	if err != nil {
+		return err
+	}
+
+	includePaths := make([]string, 0, len(o.Paths))
+	for _, path := range o.Paths {
+		includePaths = append(includePaths, "/"+strings.TrimPrefix(path, "/"))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), o.Timeout)
+	defer cancel()
+
+	switch {
+	case o.OutputDir != "":
+		return o.download(ctx, f, pvb, includePaths)
+	case o.TargetPVC != "":
+		return o.restoreToNewPVC(ctx, pvb, includePaths)
+	}
+
+	namespace, name, _ := parsePodName(o.TargetPod)
+	pod, err := o.kubeClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error getting pod %s", o.TargetPod)
+	}
+	if pod.Status.Phase != corev1api.PodRunning {
+		return errors.Errorf("pod %s is not running", o.TargetPod)
+	}
+
+	if err := o.restoreToPod(ctx, pvb, pod, o.TargetVolume, o.TargetPath, includePaths); err != nil {
+		return err
+	}
+	fmt.Printf("Files restored to directory %s of volume %s of pod %s.\n", o.TargetPath, o.TargetVolume, o.TargetPod)
+	return nil
+}
+
+// getPodVolumeBackup returns the backup's pod volume backup of the pod's volume, which has the
+// restic repository and snapshot that the files are restored from.
+func (o *FilesOptions) getPodVolumeBackup() (*velerov1api.PodVolumeBackup, error) {
+	if _, err := o.client.VeleroV1().Backups(o.namespace).Get(context.TODO(), o.BackupName, metav1.GetOptions{}); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup %s", o.BackupName)
+	}
+
+	list, err := o.client.VeleroV1().PodVolumeBackups(o.namespace).List(context.TODO(), label.NewListOptionsForBackup(o.BackupName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting PodVolumeBackups for backup %s", o.BackupName)
+	}
+	pvbs := make([]*velerov1api.PodVolumeBackup, 0, len(list.Items))
+	for i := range list.Items {
+		pvbs = append(pvbs, &list.Items[i])
+	}
+
+	podNamespace, podName, _ := parsePodName(o.Pod)
+	pod := &corev1api.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: podNamespace, Name: podName}}
+	snapshotID, ok := restic.GetVolumeBackupsForPod(pvbs, pod, podNamespace)[o.Volume]
+	if !ok {
+		return nil, errors.Errorf("backup %s has no restic snapshot of volume %s of pod %s", o.BackupName, o.Volume, o.Pod)
+	}
+
+	for _, pvb := range pvbs {
+		if pvb.Spec.Pod.Namespace == podNamespace && pvb.Spec.Pod.Name == podName && pvb.Spec.Volume == o.Volume && pvb.Status.SnapshotID == snapshotID {
+			return pvb, nil
+		}
+	}
+	return nil, errors.Errorf("backup %s has no restic snapshot of volume %s of pod %s", o.BackupName, o.Volume, o.Pod)
+}
+
+// restoreToPod restores the files into the directory of the volume of the running pod, by
+// creating a PodVolumeRestore that the restic daemonset pod on the pod's node processes.
+func (o *FilesOptions) restoreToPod(ctx context.Context, pvb *velerov1api.PodVolumeBackup, pod *corev1api.Pod, volume, targetPath string, includePaths []string) error {
+	pvr := &velerov1api.PodVolumeRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    o.namespace,
+			GenerateName: label.GetValidName(o.BackupName+"-files") + "-",
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel: label.GetValidName(o.BackupName),
+				velerov1api.PodUIDLabel:     string(pod.UID),
+			},
+		},
+		Spec: velerov1api.PodVolumeRestoreSpec{
+			Pod: corev1api.ObjectReference{
+				Kind:      "Pod",
+				Namespace: pod.Namespace,
+				Name:      pod.Name,
+				UID:       pod.UID,
+			},
+			Volume:                volume,
+			BackupStorageLocation: pvb.Spec.BackupStorageLocation,
+			RepoIdentifier:        pvb.Spec.RepoIdentifier,
+			SnapshotID:            pvb.Status.SnapshotID,
+			IncludePaths:          includePaths,
+			TargetPath:            targetPath,
+		},
+	}
+
+	pvrClient := o.client.VeleroV1().PodVolumeRestores(o.namespace)
+	created, err := pvrClient.Create(ctx, pvr, metav1.CreateOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error creating PodVolumeRestore")
+	}
+	name := created.Name
+	defer func() {
+		if err := pvrClient.Delete(context.Background(), name, metav1.DeleteOptions{}); err != nil {
+			fmt.Printf("Error deleting PodVolumeRestore %s: %v\n", name, err)
+		}
+	}()
+
+	fmt.Printf("Waiting for PodVolumeRestore %s to complete.\n", name)
+
+	err = wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		updated, err := pvrClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "error getting PodVolumeRestore %s", name)
+		}
+		switch updated.Status.Phase {
+		case velerov1api.PodVolumeRestorePhaseCompleted:
+			return true, nil
+		case velerov1api.PodVolumeRestorePhaseFailed:
+			return false, errors.Errorf("PodVolumeRestore %s failed: %s", name, updated.Status.Message)
+		}
+		return false, nil
+	}, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		return errors.Errorf("timed out waiting for PodVolumeRestore %s to complete", name)
+	}
+	return err
+}
+
+// restoreToNewPVC creates the target persistent volume claim and a helper pod that mounts it,
+// and restores the files into the claim's volume through the helper pod, which is deleted
+// afterwards.
+func (o *FilesOptions) restoreToNewPVC(ctx context.Context, pvb *velerov1api.PodVolumeBackup, includePaths []string) error {
+	pvc := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.TargetNamespace,
+			Name:      o.TargetPVC,
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel: label.GetValidName(o.BackupName),
+			},
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			AccessModes: []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteOnce},
+			Resources: corev1api.ResourceRequirements{
+				Requests: corev1api.ResourceList{
+					corev1api.ResourceStorage: resource.MustParse(o.Size),
+				},
+			},
+		},
+	}
+	if o.StorageClass != "" {
+		pvc.Spec.StorageClassName = &o.StorageClass
+	}
+
+	pvc, err := o.kubeClient.CoreV1().PersistentVolumeClaims(o.TargetNamespace).Create(ctx, pvc, metav1.CreateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error creating persistent volume claim %s/%s", o.TargetNamespace, o.TargetPVC)
+	}
+	fmt.Printf("Persistent volume claim %s/%s created.\n", pvc.Namespace, pvc.Name)
+
+	config, err := restic.GetRestoreHelperConfig(o.kubeClient.CoreV1().ConfigMaps(o.namespace))
+	if err != nil {
+		return errors.Wrap(err, "error getting restore helper config")
+	}
+	helper := restic.RestoreHelperContainer(logrus.StandardLogger(), config)
+
+	pod, err := o.kubeClient.CoreV1().Pods(o.TargetNamespace).Create(ctx, restic.NewFileRestoreHelperPod(pvc, helper), metav1.CreateOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error creating helper pod")
+	}
+	defer func() {
+		if err := o.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); err != nil {
+			fmt.Printf("Error deleting helper pod %s/%s: %v\n", pod.Namespace, pod.Name, err)
+		}
+	}()
+
+	fmt.Printf("Waiting for helper pod %s/%s to be running.\n", pod.Namespace, pod.Name)
+	if pod, err = restic.WaitForHelperPod(ctx, o.kubeClient.CoreV1(), pod); err != nil {
+		return err
+	}
+
+	if err := o.restoreToPod(ctx, pvb, pod, restic.HelperPodVolume, "", includePaths); err != nil {
+		return err
+	}
+	fmt.Printf("Files restored to persistent volume claim %s/%s.\n", pvc.Namespace, pvc.Name)
+	return nil
+}
+
+// download restores the files in a restic daemonset pod, which streams them as a tar archive
+// that's extracted to the output directory.
+func (o *FilesOptions) download(ctx context.Context, f client.Factory, pvb *velerov1api.PodVolumeBackup, includePaths []string) error {
+	pods, err := o.kubeClient.CoreV1().Pods(o.namespace).List(ctx, metav1.ListOptions{LabelSelector: resticDaemonSetSelector})
+	if err != nil {
+		return errors.Wrap(err, "error listing restic pods")
+	}
+	var resticPod *corev1api.Pod
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1api.PodRunning {
+			resticPod = &pods.Items[i]
+			break
+		}
+	}
+	if resticPod == nil {
+		return errors.Errorf("no running restic pod found in namespace %s", o.namespace)
+	}
+
+	command := []string{
+		"/velero", "restic", "dump-files",
+		"--repo-identifier=" + pvb.Spec.RepoIdentifier,
+		"--snapshot-id=" + pvb.Status.SnapshotID,
+		"--backup-storage-location=" + pvb.Spec.BackupStorageLocation,
+	}
+	for _, path := range includePaths {
+		command = append(command, "--include="+path)
+	}
+
+	clientConfig, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	req := o.kubeClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(resticPod.Namespace).
+		Name(resticPod.Name).
+		SubResource("exec")
+	req.VersionedParams(&corev1api.PodExecOptions{
+		Container: resticContainer,
+		Command:   command,
+		Stdout:    true,
+		Stderr:    true,
+	}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(clientConfig, "POST", req.URL())
+	if err != nil {
+		return errors.Wrap(err, "error creating executor")
+	}
+
+	reader, writer := io.Pipe()
+	stderr := new(bytes.Buffer)
+	streamErr := make(chan error, 1)
+	go func() {
+		err := executor.Stream(remotecommand.StreamOptions{Stdout: writer, Stderr: stderr})
+		writer.CloseWithError(err)
+		streamErr <- err
+	}()
+
+	// stop the extraction, and with it the stream, on timeout
+	go func() {
+		<-ctx.Done()
+		reader.CloseWithError(errors.New("timed out waiting for the files to be restored"))
+	}()
+
+	files, extractErr := restorefiles.ExtractTar(reader, o.OutputDir)
+	// unblock the stream if extraction stopped before the end of the archive
+	reader.Close()
+
+	select {
+	case err := <-streamErr:
+		// report the stream's error unless it was caused by the extraction stopping
+		if err != nil && (extractErr == nil || errors.Cause(extractErr) == err) {
+			return errors.Wrapf(err, "error restoring files in restic pod %s, stderr=%s", resticPod.Name, stderr.String())
+		}
+	case <-ctx.Done():
+		return errors.New("timed out waiting for the files to be restored")
+	}
+	if extractErr != nil {
+		return extractErr
+	}
+
+	fmt.Printf("%d files downloaded to %s.\n", files, o.OutputDir)
+	return nil
+}
+
+// parsePodName parses a pod given as namespace/name.
+func parsePodName(s string) (string, string, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("invalid pod %q, expected <namespace>/<name>", s)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(*FilesOptions)
+		expectedErr string
+	}{
+		{
+			name:   "restore into a pod is valid",
+			mutate: func(o *FilesOptions) { o.TargetPod = "ns-1/pod-2" },
+		},
+		{
+			name: "restore into a new PVC is valid",
+			mutate: func(o *FilesOptions) {
+				o.TargetPVC = "pvc-1"
+				o.Size = "1Gi"
+			},
+		},
+		{
+			name:   "download is valid",
+			mutate: func(o *FilesOptions) { o.OutputDir = "out" },
+		},
+		{
+			name: "invalid pod",
+			mutate: func(o *FilesOptions) {
+				o.Pod = "pod-1"
+				o.OutputDir = "out"
+			},
+			expectedErr: `invalid --pod: invalid pod "pod-1", expected <namespace>/<name>`,
+		},
+		{
+			name: "missing path",
+			mutate: func(o *FilesOptions) {
+				o.Paths = nil
+				o.OutputDir = "out"
+			},
+			expectedErr: "at least one --path is required",
+		},
+		{
+			name:        "no target",
+			mutate:      func(o *FilesOptions) {},
+			expectedErr: "exactly one of --target-pod, --target-pvc and --output-dir is required",
+		},
+		{
+			name: "more than one target",
+			mutate: func(o *FilesOptions) {
+				o.TargetPod = "ns-1/pod-2"
+				o.OutputDir = "out"
+			},
+			expectedErr: "exactly one of --target-pod, --target-pvc and --output-dir is required",
+		},
+		{
+			name: "target path without target pod",
+			mutate: func(o *FilesOptions) {
+				o.OutputDir = "out"
+				o.TargetPath = "restored"
+			},
+			expectedErr: "--target-volume and --target-path can only be used with --target-pod",
+		},
+		{
+			name: "size without target PVC",
+			mutate: func(o *FilesOptions) {
+				o.OutputDir = "out"
+				o.Size = "1Gi"
+			},
+			expectedErr: "--target-namespace, --storage-class and --size can only be used with --target-pvc",
+		},
+		{
+			name:        "target PVC without size",
+			mutate:      func(o *FilesOptions) { o.TargetPVC = "pvc-1" },
+			expectedErr: "--size is required with --target-pvc",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			o := NewFilesOptions()
+			o.BackupName = "backup-1"
+			o.Pod = "ns-1/pod-1"
+			o.Volume = "data"
+			o.Paths = []string{"/var/lib/x/file"}
+			tc.mutate(o)
+
+			err := o.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tc.expectedErr, err.Error())
+		})
+	}
+}
@@ -19,6 +19,8 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -29,6 +31,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 )
 
@@ -38,7 +41,7 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 	c := &cobra.Command{
 		Use:   use + " NAME --schedule",
 		Short: "Create a schedule",
-		Long: `The --schedule flag is required, in cron notation, using UTC time:
+		Long: `The --schedule flag is required, in cron notation, using UTC time unless --timezone is set:
 
 | Character Position | Character Period | Acceptable Values |
 | -------------------|:----------------:| -----------------:|
@@ -50,7 +53,17 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 
 The schedule can also be expressed using "@every <duration>" syntax. The duration
 can be specified using a combination of seconds (s), minutes (m), and hours (h), for
-example: "@every 2h30m".`,
+example: "@every 2h30m".
+
+If the Velero server is not running when a backup is due, the --missed-run-policy flag
+controls what happens once it comes back: RunOnce (the default) creates a single
+catch-up backup, Skip drops missed runs, and RunAll creates a backup for each run
+missed within --missed-run-window.
+
+The --concurrency-policy flag controls what happens when a backup is due while a
+previous backup from the schedule hasn't completed: Allow (the default) creates the
+backup anyway, Forbid skips the run, and Replace cancels and deletes previous
+backups that haven't completed and creates the new one.`,
 
 		Example: `  # Create a backup every 6 hours.
   velero create schedule NAME --schedule="0 */6 * * *"
@@ -82,6 +95,11 @@ type CreateOptions struct {
 	BackupOptions              *backup.CreateOptions
 	Schedule                   string
 	UseOwnerReferencesInBackup bool
+	Paused                     bool
+	TimeZone                   string
+	MissedRunPolicy            *flag.Enum
+	MissedRunWindow            time.Duration
+	ConcurrencyPolicy          *flag.Enum
 
 	labelSelector *metav1.LabelSelector
 }
@@ -89,6 +107,18 @@ type CreateOptions struct {
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
 		BackupOptions: backup.NewCreateOptions(),
+		MissedRunPolicy: flag.NewEnum(
+			string(api.ScheduleMissedRunPolicyRunOnce),
+			string(api.ScheduleMissedRunPolicySkip),
+			string(api.ScheduleMissedRunPolicyRunOnce),
+			string(api.ScheduleMissedRunPolicyRunAll),
+		),
+		ConcurrencyPolicy: flag.NewEnum(
+			string(api.ScheduleConcurrencyPolicyAllow),
+			string(api.ScheduleConcurrencyPolicyAllow),
+			string(api.ScheduleConcurrencyPolicyForbid),
+			string(api.ScheduleConcurrencyPolicyReplace),
+		),
 	}
 }
 
@@ -96,6 +126,11 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	o.BackupOptions.BindFlags(flags)
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "A cron expression specifying a recurring schedule for this backup to run")
 	flags.BoolVar(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", o.UseOwnerReferencesInBackup, "Specifies whether to use OwnerReferences on backups created by this Schedule. Notice: if set to true, when schedule is deleted, backups will be deleted too.")
+	flags.BoolVar(&o.Paused, "paused", o.Paused, "Create the schedule in a paused state. Paused schedules do not create backups until resumed with 'velero schedule resume'.")
+	flags.StringVar(&o.TimeZone, "timezone", o.TimeZone, "IANA time zone name (e.g. America/New_York) used to evaluate the cron expression. Defaults to UTC.")
+	flags.Var(o.MissedRunPolicy, "missed-run-policy", fmt.Sprintf("What to do with runs missed while the schedule was not evaluated, e.g. during server downtime. Valid values are %s.", strings.Join(o.MissedRunPolicy.AllowedValues(), ", ")))
+	flags.DurationVar(&o.MissedRunWindow, "missed-run-window", o.MissedRunWindow, "How far back missed runs are considered when --missed-run-policy is RunAll. Defaults to 24h.")
+	flags.Var(o.ConcurrencyPolicy, "concurrency-policy", fmt.Sprintf("What to do when a backup is due while a previous backup from this schedule hasn't completed. Valid values are %s.", strings.Join(o.ConcurrencyPolicy.AllowedValues(), ", ")))
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -103,6 +138,16 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	if o.TimeZone != "" {
+		if _, err := time.LoadLocation(o.TimeZone); err != nil {
+			return errors.Wrapf(err, "invalid value for --timezone")
+		}
+	}
+
+	if o.MissedRunWindow < 0 {
+		return errors.New("--missed-run-window must be non-negative")
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -133,24 +178,35 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 		Spec: api.ScheduleSpec{
 			Template: api.BackupSpec{
-				IncludedNamespaces:      o.BackupOptions.IncludeNamespaces,
-				ExcludedNamespaces:      o.BackupOptions.ExcludeNamespaces,
-				IncludedResources:       o.BackupOptions.IncludeResources,
-				ExcludedResources:       o.BackupOptions.ExcludeResources,
-				IncludeClusterResources: o.BackupOptions.IncludeClusterResources.Value,
-				LabelSelector:           o.BackupOptions.Selector.LabelSelector,
-				SnapshotVolumes:         o.BackupOptions.SnapshotVolumes.Value,
-				TTL:                     metav1.Duration{Duration: o.BackupOptions.TTL},
-				StorageLocation:         o.BackupOptions.StorageLocation,
-				VolumeSnapshotLocations: o.BackupOptions.SnapshotLocations,
-				DefaultVolumesToRestic:  o.BackupOptions.DefaultVolumesToRestic.Value,
-				OrderedResources:        orders,
+				IncludedNamespaces:       o.BackupOptions.IncludeNamespaces,
+				ExcludedNamespaces:       o.BackupOptions.ExcludeNamespaces,
+				IncludedResources:        o.BackupOptions.IncludeResources,
+				ExcludedResources:        o.BackupOptions.ExcludeResources,
+				IncludeClusterResources:  o.BackupOptions.IncludeClusterResources.Value,
+				LabelSelector:            o.BackupOptions.Selector.LabelSelector,
+				SnapshotVolumes:          o.BackupOptions.SnapshotVolumes.Value,
+				TTL:                      metav1.Duration{Duration: o.BackupOptions.TTL},
+				StorageLocation:          o.BackupOptions.StorageLocation,
+				VolumeSnapshotLocations:  o.BackupOptions.SnapshotLocations,
+				DefaultVolumesToRestic:   o.BackupOptions.DefaultVolumesToRestic.Value,
+				UnmountedVolumesToRestic: o.BackupOptions.UnmountedVolumesToRestic.Value,
+				SnapshotMoveData:         o.BackupOptions.SnapshotMoveData.Value,
+				OrderedResources:         orders,
+				ResticRateLimit:          o.BackupOptions.ResticRateLimit(),
 			},
 			Schedule:                   o.Schedule,
 			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
+			Paused:                     o.Paused,
+			TimeZone:                   o.TimeZone,
+			MissedRunPolicy:            api.ScheduleMissedRunPolicy(o.MissedRunPolicy.String()),
+			ConcurrencyPolicy:          api.ScheduleConcurrencyPolicy(o.ConcurrencyPolicy.String()),
 		},
 	}
 
+	if o.MissedRunWindow > 0 {
+		schedule.Spec.MissedRunWindow = &metav1.Duration{Duration: o.MissedRunWindow}
+	}
+
 	if printed, err := output.PrintWithFormat(c, schedule); printed || err != nil {
 		return err
 	}
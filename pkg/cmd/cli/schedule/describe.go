@@ -19,6 +19,7 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,11 +32,14 @@ import (
 
 func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	var listOptions metav1.ListOptions
+	var outputFormat string
 
 	c := &cobra.Command{
 		Use:   use + " [NAME1] [NAME2] [NAME...]",
 		Short: "Describe schedules",
 		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(output.ValidateDescribeFormat(outputFormat))
+
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
@@ -52,6 +56,15 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				cmd.CheckError(err)
 			}
 
+			if outputFormat != "" {
+				var descriptions []*output.ScheduleDescription
+				for i := range schedules.Items {
+					descriptions = append(descriptions, &output.ScheduleDescription{Schedule: &schedules.Items[i]})
+				}
+				cmd.CheckError(output.PrintDescriptions(os.Stdout, outputFormat, descriptions))
+				return
+			}
+
 			first := true
 			for _, schedule := range schedules.Items {
 				s := output.DescribeSchedule(&schedule)
@@ -67,6 +80,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	}
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector.")
+	output.BindDescribeFlags(c.Flags(), &outputFormat)
 
 	return c
 }
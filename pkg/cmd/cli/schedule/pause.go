@@ -0,0 +1,196 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+)
+
+// NewPauseCommand creates and returns a new cobra command for pausing schedules.
+func NewPauseCommand(f client.Factory, use string) *cobra.Command {
+	o := NewPauseOptions(true)
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Pause schedules",
+		Example: `  # Pause a schedule named "schedule-1".
+  velero schedule pause schedule-1
+
+  # Pause schedules named "schedule-1" and "schedule-2".
+  velero schedule pause schedule-1 schedule-2
+
+  # Pause all schedules labelled with "foo=bar".
+  velero schedule pause --selector foo=bar
+
+  # Pause all schedules.
+  velero schedule pause --all`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run())
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	return c
+}
+
+// NewResumeCommand creates and returns a new cobra command for resuming schedules.
+func NewResumeCommand(f client.Factory, use string) *cobra.Command {
+	o := NewPauseOptions(false)
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Resume paused schedules",
+		Example: `  # Resume a schedule named "schedule-1".
+  velero schedule resume schedule-1
+
+  # Resume all schedules labelled with "foo=bar".
+  velero schedule resume --selector foo=bar
+
+  # Resume all schedules.
+  velero schedule resume --all`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run())
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	return c
+}
+
+// PauseOptions contains the options for pausing or resuming schedules.
+type PauseOptions struct {
+	Names    []string
+	All      bool
+	Selector flag.LabelSelector
+	Paused   bool
+
+	client    clientset.Interface
+	namespace string
+}
+
+// NewPauseOptions returns PauseOptions that set the schedules' paused flag to paused.
+func NewPauseOptions(paused bool) *PauseOptions {
+	return &PauseOptions{Paused: paused}
+}
+
+// BindFlags binds the options to the flag set.
+func (o *PauseOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.VarP(&o.Selector, "selector", "l", "Only affect schedules matching this label selector.")
+	flags.BoolVar(&o.All, "all", o.All, "Affect all schedules.")
+}
+
+// Complete fills out the options from the factory and arguments.
+func (o *PauseOptions) Complete(f client.Factory, args []string) error {
+	o.Names = args
+	o.namespace = f.Namespace()
+
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	o.client = client
+	return nil
+}
+
+// Validate ensures exactly one of names, --all or --selector was specified.
+func (o *PauseOptions) Validate() error {
+	hasNames := len(o.Names) > 0
+	hasAll := o.All
+	hasSelector := o.Selector.LabelSelector != nil
+
+	if !cli.Xor(hasNames, hasAll, hasSelector) {
+		return errors.New("you must specify exactly one of: specific schedule name(s), the --all flag, or the --selector flag")
+	}
+	return nil
+}
+
+// Run sets the paused flag on each of the selected schedules.
+func (o *PauseOptions) Run() error {
+	var (
+		schedules []*velerov1api.Schedule
+		errs      []error
+	)
+	switch {
+	case len(o.Names) > 0:
+		for _, name := range o.Names {
+			schedule, err := o.client.VeleroV1().Schedules(o.namespace).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, errors.WithStack(err))
+				continue
+			}
+			schedules = append(schedules, schedule)
+		}
+	default:
+		selector := labels.Everything().String()
+		if o.Selector.LabelSelector != nil {
+			selector = o.Selector.String()
+		}
+		res, err := o.client.VeleroV1().Schedules(o.namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		if err != nil {
+			errs = append(errs, errors.WithStack(err))
+		} else {
+			for i := range res.Items {
+				schedules = append(schedules, &res.Items[i])
+			}
+		}
+	}
+	if len(schedules) == 0 && len(errs) == 0 {
+		fmt.Println("No schedules found")
+		return nil
+	}
+
+	action := "resumed"
+	if o.Paused {
+		action = "paused"
+	}
+	patch := []byte(fmt.Sprintf(`{"spec":{"paused":%t}}`, o.Paused))
+
+	for _, s := range schedules {
+		if s.Spec.Paused == o.Paused {
+			fmt.Printf("Schedule %s is already %s\n", s.Name, action)
+			continue
+		}
+		if _, err := o.client.VeleroV1().Schedules(s.Namespace).Patch(context.TODO(), s.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+		fmt.Printf("Schedule %s %s\n", s.Name, action)
+	}
+	return kubeerrs.NewAggregate(errs)
+}
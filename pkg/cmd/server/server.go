@@ -63,17 +63,23 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/logstream"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/notification"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/oci"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
@@ -86,6 +92,7 @@ const (
 	defaultMetricsAddress = ":8085"
 
 	defaultBackupSyncPeriod           = time.Minute
+	defaultBackupSyncConcurrency      = 10
 	defaultStoreValidationFrequency   = time.Minute
 	defaultPodVolumeOperationTimeout  = 240 * time.Minute
 	defaultResourceTerminatingTimeout = 10 * time.Minute
@@ -104,6 +111,10 @@ const (
 	// defaultCredentialsDirectory is the path on disk where credential
 	// files will be written to
 	defaultCredentialsDirectory = "/tmp/credentials"
+
+	// pluginInstallTimeout is how long installing the plugins in the images of
+	// VeleroPlugins may take before the ones that haven't been installed are skipped.
+	pluginInstallTimeout = 10 * time.Minute
 )
 
 type serverConfig struct {
@@ -118,10 +129,16 @@ type serverConfig struct {
 	clientQPS                                                               float32
 	clientBurst                                                             int
 	clientPageSize                                                          int
+	backupSyncConcurrency                                                   int
+	pluginHealthCheckInterval                                               time.Duration
+	pluginTimeouts                                                          clientmgmt.Timeouts
 	profilerAddress                                                         string
 	formatFlag                                                              *logging.FormatFlag
 	defaultResticMaintenanceFrequency                                       time.Duration
 	defaultVolumesToRestic                                                  bool
+	tracing                                                                 tracing.Config
+	logStreamMaxLines                                                       int
+	logStreamAddress                                                        string
 }
 
 type controllerRunInfo struct {
@@ -132,6 +149,7 @@ type controllerRunInfo struct {
 func NewCommand(f client.Factory) *cobra.Command {
 	var (
 		volumeSnapshotLocations = flag.NewMap().WithKeyValueDelimiter(":")
+		pluginTimeouts          = flag.NewMap()
 		logLevelFlag            = logging.LogLevelFlag(logrus.InfoLevel)
 		config                  = serverConfig{
 			pluginDir:                         "/plugins",
@@ -139,6 +157,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 			defaultBackupLocation:             "default",
 			defaultVolumeSnapshotLocations:    make(map[string]string),
 			backupSyncPeriod:                  defaultBackupSyncPeriod,
+			backupSyncConcurrency:             defaultBackupSyncConcurrency,
+			pluginHealthCheckInterval:         clientmgmt.DefaultHealthCheckInterval,
 			defaultBackupTTL:                  defaultBackupTTL,
 			storeValidationFrequency:          defaultStoreValidationFrequency,
 			podVolumeOperationTimeout:         defaultPodVolumeOperationTimeout,
@@ -151,6 +171,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 			formatFlag:                        logging.NewFormatFlag(),
 			defaultResticMaintenanceFrequency: restic.DefaultMaintenanceFrequency,
 			defaultVolumesToRestic:            restic.DefaultVolumesToRestic,
+			tracing:                           tracing.Config{SampleRatio: 1},
+			logStreamMaxLines:                 logstream.DefaultMaxLines,
 		}
 	)
 
@@ -186,6 +208,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 				config.defaultVolumeSnapshotLocations = volumeSnapshotLocations.Data()
 			}
 
+			timeouts, err := parsePluginTimeouts(pluginTimeouts.Data())
+			cmd.CheckError(err)
+			config.pluginTimeouts = timeouts
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 
 			s, err := newServer(f, config, logger)
@@ -198,8 +224,11 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(config.formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(config.formatFlag.AllowedValues(), ", ")))
 	command.Flags().StringVar(&config.pluginDir, "plugin-dir", config.pluginDir, "Directory containing Velero plugins")
+	command.Flags().DurationVar(&config.pluginHealthCheckInterval, "plugin-health-check-interval", config.pluginHealthCheckInterval, "How often to check that running plugin processes are responding, restarting them if they aren't. Set this to `0s` to disable health checks.")
+	command.Flags().Var(&pluginTimeouts, "plugin-timeouts", "How long each call to a plugin of a kind may take before it's cancelled, e.g. BackupItemAction=10m,VolumeSnapshotter=1h. A timeout for a plugin kind applies to all of its versions. Calls to plugins of kinds without a timeout don't time out.")
 	command.Flags().StringVar(&config.metricsAddress, "metrics-address", config.metricsAddress, "The address to expose prometheus metrics")
 	command.Flags().DurationVar(&config.backupSyncPeriod, "backup-sync-period", config.backupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. This is the default sync period if none is explicitly specified for a backup storage location.")
+	command.Flags().IntVar(&config.backupSyncConcurrency, "backup-sync-concurrency", config.backupSyncConcurrency, "How many backups to sync from object storage into the cluster at once.")
 	command.Flags().DurationVar(&config.podVolumeOperationTimeout, "restic-timeout", config.podVolumeOperationTimeout, "How long backups/restores of pod volumes should be allowed to run before timing out.")
 	command.Flags().BoolVar(&config.restoreOnly, "restore-only", config.restoreOnly, "Run in a mode where only restores are allowed; backups, schedules, and garbage-collection are all disabled. DEPRECATED: this flag will be removed in v2.0. Use read-only backup storage locations instead.")
 	command.Flags().StringSliceVar(&config.disabledControllers, "disable-controllers", config.disabledControllers, fmt.Sprintf("List of controllers to disable on startup. Valid values are %s", strings.Join(controller.DisableableControllers, ",")))
@@ -215,6 +244,11 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
 	command.Flags().DurationVar(&config.defaultResticMaintenanceFrequency, "default-restic-prune-frequency", config.defaultResticMaintenanceFrequency, "How often 'restic prune' is run for restic repositories by default.")
 	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "Backup all volumes with restic by default.")
+	command.Flags().StringVar(&config.tracing.Endpoint, "tracing-otlp-endpoint", config.tracing.Endpoint, "The host:port of the OpenTelemetry (OTLP gRPC) receiver to export traces of backups, restores and plugin calls to. Tracing is disabled if this is empty.")
+	command.Flags().BoolVar(&config.tracing.Insecure, "tracing-otlp-insecure", config.tracing.Insecure, "Connect to the OTLP receiver without TLS.")
+	command.Flags().StringVar(&config.logStreamAddress, "log-stream-address", config.logStreamAddress, "The address to serve the logs of in-progress backups and restores on, e.g. :8086, to be followed with 'velero backup logs --follow' and 'velero restore logs --follow' through the API server's proxy to the Velero pod. The logs are served to anyone who can reach the address, without authentication. Disabled if empty.")
+	command.Flags().IntVar(&config.logStreamMaxLines, "log-stream-max-lines", config.logStreamMaxLines, "The number of log lines of each in-progress backup and restore that are kept in memory to be followed with 'velero backup logs --follow' and 'velero restore logs --follow'.")
+	command.Flags().Float64Var(&config.tracing.SampleRatio, "tracing-sample-ratio", config.tracing.SampleRatio, "The fraction of backups and restores that are traced, between 0 and 1.")
 
 	return command
 }
@@ -236,13 +270,47 @@ type server struct {
 	logger                              logrus.FieldLogger
 	logLevel                            logrus.Level
 	pluginRegistry                      clientmgmt.Registry
+	pluginInstaller                     *oci.Installer
 	resticManager                       restic.RepositoryManager
 	metrics                             *metrics.ServerMetrics
+	logStreams                          *logstream.Broker
 	config                              serverConfig
 	mgr                                 manager.Manager
 	credentialFileStore                 credentials.FileStore
 }
 
+// parsePluginTimeouts converts the plugin kinds and durations given to the --plugin-timeouts flag
+// into clientmgmt.Timeouts.
+func parsePluginTimeouts(data map[string]string) (clientmgmt.Timeouts, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	validKinds := sets.NewString()
+	for _, kind := range framework.AllPluginKinds() {
+		validKinds.Insert(kind.String())
+	}
+
+	timeouts := make(clientmgmt.Timeouts, len(data))
+	for kind, value := range data {
+		if !validKinds.Has(kind) {
+			return nil, errors.Errorf("invalid plugin kind %q in --plugin-timeouts, valid kinds are %s", kind, strings.Join(validKinds.List(), ", "))
+		}
+
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timeout for plugin kind %s in --plugin-timeouts", kind)
+		}
+		if timeout < 0 {
+			return nil, errors.Errorf("timeout for plugin kind %s in --plugin-timeouts must not be negative", kind)
+		}
+
+		timeouts[framework.PluginKind(kind)] = timeout
+	}
+
+	return timeouts, nil
+}
+
 func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*server, error) {
 	if config.clientQPS < 0.0 {
 		return nil, errors.New("client-qps must be positive")
@@ -273,6 +341,11 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return nil, err
+	}
+
 	pluginRegistry := clientmgmt.NewRegistry(config.pluginDir, logger, logger.Level)
 	if err := pluginRegistry.DiscoverPlugins(); err != nil {
 		return nil, err
@@ -341,6 +414,7 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		logger:                              logger,
 		logLevel:                            logger.Level,
 		pluginRegistry:                      pluginRegistry,
+		pluginInstaller:                     oci.NewInstaller(kbClient, f.Namespace(), config.pluginDir, http.DefaultClient, clock.RealClock{}, logger),
 		config:                              config,
 		mgr:                                 mgr,
 		credentialFileStore:                 credentialFileStore,
@@ -349,6 +423,23 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 	return s, nil
 }
 
+// installPluginImages installs the plugins in the images of VeleroPlugins into the plugin
+// directory, and registers them. It runs in the background, so that pulling images doesn't
+// hold up the server's startup, and the plugins can be used once they're registered.
+func (s *server) installPluginImages() {
+	ctx, cancel := context.WithTimeout(s.ctx, pluginInstallTimeout)
+	defer cancel()
+
+	if err := s.pluginInstaller.Install(ctx); err != nil {
+		s.logger.WithError(err).Error("Error installing plugins from VeleroPlugin images")
+		return
+	}
+
+	if err := s.pluginRegistry.DiscoverPlugins(); err != nil {
+		s.logger.WithError(err).Error("Error registering plugins installed from VeleroPlugin images")
+	}
+}
+
 func (s *server) run() error {
 	signals.CancelOnShutdown(s.cancelFunc, s.logger)
 
@@ -356,6 +447,16 @@ func (s *server) run() error {
 		go s.runProfiler()
 	}
 
+	shutdownTracing, err := tracing.Setup(s.ctx, "velero", s.config.tracing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			s.logger.WithError(err).Error("Error flushing traces")
+		}
+	}()
+
 	// Since s.namespace, which specifies where backups/restores/schedules/etc. should live,
 	// *could* be different from the namespace where the Velero server pod runs, check to make
 	// sure it exists, and fail fast if it doesn't.
@@ -375,6 +476,8 @@ func (s *server) run() error {
 		return err
 	}
 
+	go s.installPluginImages()
+
 	if err := s.runControllers(s.config.defaultVolumeSnapshotLocations); err != nil {
 		return err
 	}
@@ -524,6 +627,8 @@ func (s *server) initRestic() error {
 		s.mgr.GetClient(),
 		s.kubeClient.CoreV1(),
 		s.kubeClient.CoreV1(),
+		s.kubeClient.CoreV1(),
+		s.kubeClient.CoreV1(),
 		s.credentialFileStore,
 		s.logger,
 	)
@@ -568,6 +673,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 
 	ctx := s.ctx
 
+	s.logStreams = logstream.NewBroker(s.config.logStreamMaxLines)
+
 	go func() {
 		metricsMux := http.NewServeMux()
 		metricsMux.Handle("/metrics", promhttp.Handler())
@@ -576,13 +683,28 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger.Fatalf("Failed to start metric server at [%s]: %v", s.metricsAddress, err)
 		}
 	}()
+	if s.config.logStreamAddress != "" {
+		// The logs of in-progress backups and restores are followed by the CLI through the API
+		// server's proxy to the Velero pod. The API server doesn't pass the caller's credentials
+		// on, so the logs can't be authenticated here, which is why they're only served if
+		// they're asked for.
+		go func() {
+			logStreamMux := http.NewServeMux()
+			logStreamMux.Handle(logstream.PathPrefix, logstream.Handler(s.logStreams))
+			s.logger.Infof("Starting log stream server at address [%s]", s.config.logStreamAddress)
+			if err := http.ListenAndServe(s.config.logStreamAddress, logStreamMux); err != nil {
+				s.logger.Fatalf("Failed to start log stream server at [%s]: %v", s.config.logStreamAddress, err)
+			}
+		}()
+	}
 	s.metrics = metrics.NewServerMetrics()
 	s.metrics.RegisterAllMetrics()
 	// Initialize manual backup metrics
 	s.metrics.InitSchedule("")
 
-	newPluginManager := func(logger logrus.FieldLogger) clientmgmt.Manager {
-		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
+	pluginHealthMonitor := clientmgmt.NewHealthMonitor(s.config.pluginHealthCheckInterval, clock.RealClock{}, s.metrics)
+	newPluginManager := func(ctx context.Context, logger logrus.FieldLogger) clientmgmt.Manager {
+		return clientmgmt.NewManager(ctx, logger, s.logLevel, s.pluginRegistry, pluginHealthMonitor, s.config.pluginTimeouts, s.metrics)
 	}
 
 	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore)
@@ -591,10 +713,13 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 
 	backupSyncControllerRunInfo := func() controllerRunInfo {
 		backupSyncContoller := controller.NewBackupSyncController(
+			ctx,
 			s.veleroClient.VeleroV1(),
 			s.mgr.GetClient(),
 			s.veleroClient.VeleroV1(),
+			s.veleroClient.VeleroV1(),
 			s.sharedInformerFactory.Velero().V1().Backups().Lister(),
+			s.sharedInformerFactory.Velero().V1().Restores().Lister(),
 			s.config.backupSyncPeriod,
 			s.namespace,
 			s.csiSnapshotClient,
@@ -602,6 +727,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.defaultBackupLocation,
 			newPluginManager,
 			backupStoreGetter,
+			s.config.backupSyncConcurrency,
+			s.metrics,
 			s.logger,
 		)
 
@@ -612,6 +739,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	backupTracker := controller.NewBackupTracker()
+	eventRecorder := s.mgr.GetEventRecorderFor(controller.EventComponent)
 
 	backupControllerRunInfo := func() controllerRunInfo {
 		backupper, err := backup.NewKubernetesBackupper(
@@ -623,10 +751,13 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.podVolumeOperationTimeout,
 			s.config.defaultVolumesToRestic,
 			s.config.clientPageSize,
+			eventRecorder,
+			s.metrics,
 		)
 		cmd.CheckError(err)
 
 		backupController := controller.NewBackupController(
+			ctx,
 			s.sharedInformerFactory.Velero().V1().Backups(),
 			s.veleroClient.VeleroV1(),
 			s.discoveryHelper,
@@ -646,6 +777,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			csiVSLister,
 			csiVSCLister,
 			backupStoreGetter,
+			eventRecorder,
+			s.logStreams,
 		)
 
 		return controllerRunInfo{
@@ -660,8 +793,11 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.veleroClient.VeleroV1(),
 			s.veleroClient.VeleroV1(),
 			s.sharedInformerFactory.Velero().V1().Schedules(),
+			s.sharedInformerFactory.Velero().V1().Backups().Lister(),
+			backupTracker,
 			s.logger,
 			s.metrics,
+			eventRecorder,
 		)
 
 		return controllerRunInfo{
@@ -677,6 +813,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests().Lister(),
 			s.veleroClient.VeleroV1(),
 			s.mgr.GetClient(),
+			eventRecorder,
 		)
 
 		return controllerRunInfo{
@@ -687,6 +824,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 
 	deletionControllerRunInfo := func() controllerRunInfo {
 		deletionController := controller.NewBackupDeletionController(
+			ctx,
 			s.logger,
 			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests(),
 			s.veleroClient.VeleroV1(), // deleteBackupRequestClient
@@ -705,6 +843,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.metrics,
 			s.discoveryHelper,
+			eventRecorder,
 		)
 
 		return controllerRunInfo{
@@ -726,10 +865,14 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger,
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.kubeClient.CoreV1().RESTClient(),
+			s.veleroClient.VeleroV1(),
+			eventRecorder,
+			s.metrics,
 		)
 		cmd.CheckError(err)
 
 		restoreController := controller.NewRestoreController(
+			ctx,
 			s.namespace,
 			s.sharedInformerFactory.Velero().V1().Restores(),
 			s.veleroClient.VeleroV1(),
@@ -744,6 +887,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.metrics,
 			s.config.formatFlag.Parse(),
+			eventRecorder,
+			s.logStreams,
 		)
 
 		return controllerRunInfo{
@@ -768,6 +913,24 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	notificationControllerRunInfo := func() controllerRunInfo {
+		notificationController := controller.NewNotificationController(
+			ctx,
+			s.namespace,
+			s.logger,
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.sharedInformerFactory.Velero().V1().Restores(),
+			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests(),
+			s.mgr.GetClient(),
+			notification.NewDeliverer(s.mgr.GetClient()),
+		)
+
+		return controllerRunInfo{
+			controller: notificationController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	enabledControllers := map[string]func() controllerRunInfo{
 		controller.BackupSync:        backupSyncControllerRunInfo,
 		controller.Backup:            backupControllerRunInfo,
@@ -776,11 +939,14 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		controller.BackupDeletion:    deletionControllerRunInfo,
 		controller.Restore:           restoreControllerRunInfo,
 		controller.ResticRepo:        resticRepoControllerRunInfo,
+		controller.Notification:      notificationControllerRunInfo,
 	}
 	// Note: all runtime type controllers that can be disabled are grouped separately, below:
 	enabledRuntimeControllers := make(map[string]struct{})
 	enabledRuntimeControllers[controller.ServerStatusRequest] = struct{}{}
 	enabledRuntimeControllers[controller.DownloadRequest] = struct{}{}
+	enabledRuntimeControllers[controller.DataUpload] = struct{}{}
+	enabledRuntimeControllers[controller.DataDownload] = struct{}{}
 
 	if s.config.restoreOnly {
 		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, or GC controllers")
@@ -837,6 +1003,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		},
 		NewPluginManager:  newPluginManager,
 		BackupStoreGetter: backupStoreGetter,
+		EventRecorder:     eventRecorder,
+		Metrics:           s.metrics,
 		Log:               s.logger,
 	}
 	if err := bslr.SetupWithManager(s.mgr); err != nil {
@@ -849,6 +1017,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			Client:         s.mgr.GetClient(),
 			Ctx:            s.ctx,
 			PluginRegistry: s.pluginRegistry,
+			PluginHealth:   pluginHealthMonitor,
 			Clock:          clock.RealClock{},
 			Log:            s.logger,
 		}
@@ -871,6 +1040,47 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	// The manager's cache isn't started until the manager is, so use a client that reads
+	// from the API server to clean up after the data movers of the previous Velero server.
+	dataMoverClient, err := kbclient.New(s.mgr.GetConfig(), kbclient.Options{Scheme: s.mgr.GetScheme()})
+	if err != nil {
+		return errors.Wrap(err, "error creating data mover client")
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.DataUpload]; ok {
+		controller.MarkInProgressDataUploads(s.ctx, dataMoverClient, s.namespace, clock.RealClock{}, s.logger)
+
+		r := controller.DataUploadReconciler{
+			Scheme:        s.mgr.GetScheme(),
+			Client:        s.mgr.GetClient(),
+			PVCClient:     s.kubeClient.CoreV1(),
+			ResticManager: s.resticManager,
+			Clock:         clock.RealClock{},
+			Timeout:       s.config.podVolumeOperationTimeout,
+			Log:           s.logger,
+		}
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.DataUpload)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.DataDownload]; ok {
+		controller.MarkInProgressDataDownloads(s.ctx, dataMoverClient, s.namespace, clock.RealClock{}, s.logger)
+
+		r := controller.DataDownloadReconciler{
+			Scheme:        s.mgr.GetScheme(),
+			Client:        s.mgr.GetClient(),
+			PVCClient:     s.kubeClient.CoreV1(),
+			ResticManager: s.resticManager,
+			Clock:         clock.RealClock{},
+			Timeout:       s.config.podVolumeOperationTimeout,
+			Log:           s.logger,
+		}
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.DataDownload)
+		}
+	}
+
 	// TODO(2.0): presuming all controllers and resources are converted to runtime-controller
 	// by v2.0, the block from this line and including the `s.mgr.Start() will be
 	// deprecated, since the manager auto-starts all the caches. Until then, we need to start the
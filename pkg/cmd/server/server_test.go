@@ -18,12 +18,16 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/controller"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -92,8 +96,11 @@ func TestRemoveControllers(t *testing.T) {
 				controller.Backup,
 				controller.BackupDeletion,
 				controller.BackupSync,
+				controller.DataDownload,
+				controller.DataUpload,
 				controller.DownloadRequest,
 				controller.GarbageCollection,
+				controller.Notification,
 				controller.ResticRepo,
 				controller.Restore,
 				controller.Schedule,
@@ -128,10 +135,13 @@ func TestRemoveControllers(t *testing.T) {
 				controller.Restore:           func() controllerRunInfo { return controllerRunInfo{} },
 				controller.ResticRepo:        func() controllerRunInfo { return controllerRunInfo{} },
 				controller.DownloadRequest:   func() controllerRunInfo { return controllerRunInfo{} },
+				controller.Notification:      func() controllerRunInfo { return controllerRunInfo{} },
 			}
 
 			enabledRuntimeControllers := map[string]struct{}{
 				controller.ServerStatusRequest: {},
+				controller.DataUpload:          {},
+				controller.DataDownload:        {},
 			}
 
 			totalNumOriginalControllers := len(enabledControllers) + len(enabledRuntimeControllers)
@@ -154,3 +164,59 @@ func TestRemoveControllers(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePluginTimeouts(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    clientmgmt.Timeouts
+		wantErr string
+	}{
+		{
+			name: "no timeouts",
+		},
+		{
+			name: "valid kinds and durations",
+			data: map[string]string{"BackupItemAction": "10m", "VolumeSnapshotter": "1h"},
+			want: clientmgmt.Timeouts{
+				framework.PluginKindBackupItemAction:  10 * time.Minute,
+				framework.PluginKindVolumeSnapshotter: time.Hour,
+			},
+		},
+		{
+			name: "versioned kind",
+			data: map[string]string{"BackupItemAction/v2": "5m"},
+			want: clientmgmt.Timeouts{
+				framework.PluginKindBackupItemActionV2: 5 * time.Minute,
+			},
+		},
+		{
+			name:    "invalid kind",
+			data:    map[string]string{"Foo": "10m"},
+			wantErr: `invalid plugin kind "Foo" in --plugin-timeouts`,
+		},
+		{
+			name:    "invalid duration",
+			data:    map[string]string{"ObjectStore": "ten minutes"},
+			wantErr: "invalid timeout for plugin kind ObjectStore in --plugin-timeouts",
+		},
+		{
+			name:    "negative duration",
+			data:    map[string]string{"ObjectStore": "-1m"},
+			wantErr: "timeout for plugin kind ObjectStore in --plugin-timeouts must not be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			timeouts, err := parsePluginTimeouts(tc.data)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, timeouts)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcontents
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ItemChange is a change to an item between two backups.
+type ItemChange struct {
+	Item Item       `json:"item"`
+	Type ChangeType `json:"type"`
+	// Changes are the changes to the fields of a modified item.
+	Changes []FieldChange `json:"changes,omitempty"`
+}
+
+// CompareItems returns the items that were added, removed or modified from old to new, sorted
+// by resource, namespace and name. Only the items of the given resources and namespaces are
+// compared, as for Contents.Items. The items are compared in their preferred API versions,
+// without the ignored fields.
+func CompareItems(old, new *Contents, resources, namespaces []string, ignoredFields [][]string) ([]ItemChange, error) {
+	oldItems := old.Items(resources, namespaces)
+	newItems := new.Items(resources, namespaces)
+
+	inNew := make(map[Item]bool, len(newItems))
+	for _, item := range newItems {
+		inNew[item] = true
+	}
+
+	var changes []ItemChange
+	inOld := make(map[Item]bool, len(oldItems))
+	for _, item := range oldItems {
+		inOld[item] = true
+
+		if !inNew[item] {
+			changes = append(changes, ItemChange{Item: item, Type: ChangeRemoved})
+			continue
+		}
+
+		fieldChanges, err := compareItem(old, new, item, ignoredFields)
+		if err != nil {
+			return nil, err
+		}
+		if len(fieldChanges) > 0 {
+			changes = append(changes, ItemChange{Item: item, Type: ChangeModified, Changes: fieldChanges})
+		}
+	}
+	for _, item := range newItems {
+		if !inOld[item] {
+			changes = append(changes, ItemChange{Item: item, Type: ChangeAdded})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return lessItem(changes[i].Item, changes[j].Item)
+	})
+
+	return changes, nil
+}
+
+func compareItem(old, new *Contents, item Item, ignoredFields [][]string) ([]FieldChange, error) {
+	_, oldData, err := old.Read(item, "")
+	if err != nil {
+		return nil, err
+	}
+	_, newData, err := new.Read(item, "")
+	if err != nil {
+		return nil, err
+	}
+
+	oldObj, err := DecodeItem(oldData, ignoredFields)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding item %s", item)
+	}
+	newObj, err := DecodeItem(newData, ignoredFields)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding item %s", item)
+	}
+
+	return DiffJSON(oldObj, newObj), nil
+}
+
+// PodVolumeChange is a change to the backup of a pod volume between two backups.
+type PodVolumeChange struct {
+	Namespace     string     `json:"namespace"`
+	Pod           string     `json:"pod"`
+	Volume        string     `json:"volume"`
+	Type          ChangeType `json:"type"`
+	OldSnapshotID string     `json:"oldSnapshotID,omitempty"`
+	NewSnapshotID string     `json:"newSnapshotID,omitempty"`
+	OldSize       int64      `json:"oldSize,omitempty"`
+	NewSize       int64      `json:"newSize,omitempty"`
+}
+
+type podVolumeKey struct {
+	namespace, pod, volume string
+}
+
+// ComparePodVolumeBackups returns the pod volumes whose backups were added, removed, or whose
+// snapshot ID or size changed, from the pod volume backups of one backup to those of another.
+// The changes are sorted by namespace, pod and volume.
+func ComparePodVolumeBackups(old, new []velerov1api.PodVolumeBackup) []PodVolumeChange {
+	byKey := func(pvbs []velerov1api.PodVolumeBackup) map[podVolumeKey]velerov1api.PodVolumeBackup {
+		m := make(map[podVolumeKey]velerov1api.PodVolumeBackup, len(pvbs))
+		for _, pvb := range pvbs {
+			m[podVolumeKey{pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name, pvb.Spec.Volume}] = pvb
+		}
+		return m
+	}
+	oldByKey, newByKey := byKey(old), byKey(new)
+
+	var changes []PodVolumeChange
+	for key, oldPVB := range oldByKey {
+		change := PodVolumeChange{
+			Namespace:     key.namespace,
+			Pod:           key.pod,
+			Volume:        key.volume,
+			OldSnapshotID: oldPVB.Status.SnapshotID,
+			OldSize:       oldPVB.Status.Progress.TotalBytes,
+		}
+
+		newPVB, ok := newByKey[key]
+		switch {
+		case !ok:
+			change.Type = ChangeRemoved
+		case newPVB.Status.SnapshotID != oldPVB.Status.SnapshotID || newPVB.Status.Progress.TotalBytes != oldPVB.Status.Progress.TotalBytes:
+			change.Type = ChangeModified
+			change.NewSnapshotID = newPVB.Status.SnapshotID
+			change.NewSize = newPVB.Status.Progress.TotalBytes
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	for key, newPVB := range newByKey {
+		if _, ok := oldByKey[key]; !ok {
+			changes = append(changes, PodVolumeChange{
+				Namespace:     key.namespace,
+				Pod:           key.pod,
+				Volume:        key.volume,
+				Type:          ChangeAdded,
+				NewSnapshotID: newPVB.Status.SnapshotID,
+				NewSize:       newPVB.Status.Progress.TotalBytes,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Namespace != changes[j].Namespace {
+			return changes[i].Namespace < changes[j].Namespace
+		}
+		if changes[i].Pod != changes[j].Pod {
+			return changes[i].Pod < changes[j].Pod
+		}
+		return changes[i].Volume < changes[j].Volume
+	})
+
+	return changes
+}
@@ -0,0 +1,107 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcontents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCompareItems(t *testing.T) {
+	old, err := Extract(test.NewLogger(), test.NewFakeFileSystem(), test.NewTarWriter(t).
+		Add("resources/pods/namespaces/ns-1/unchanged.json", []byte(`{"metadata":{"name":"unchanged","resourceVersion":"1"}}`)).
+		Add("resources/pods/namespaces/ns-1/removed.json", []byte(`{"metadata":{"name":"removed"}}`)).
+		Add("resources/deployments.apps/namespaces/ns-1/web.json", []byte(`{"metadata":{"name":"web"},"spec":{"replicas":1},"status":{"readyReplicas":1}}`)).
+		Add("resources/deployments.apps/namespaces/ns-2/api.json", []byte(`{"metadata":{"name":"api"},"spec":{"replicas":1}}`)).
+		Done())
+	require.NoError(t, err)
+	defer old.Close()
+
+	new, err := Extract(test.NewLogger(), test.NewFakeFileSystem(), test.NewTarWriter(t).
+		Add("resources/pods/namespaces/ns-1/unchanged.json", []byte(`{"metadata":{"name":"unchanged","resourceVersion":"2"}}`)).
+		Add("resources/pods/namespaces/ns-1/added.json", []byte(`{"metadata":{"name":"added"}}`)).
+		Add("resources/deployments.apps/namespaces/ns-1/web.json", []byte(`{"metadata":{"name":"web"},"spec":{"replicas":3},"status":{"readyReplicas":3}}`)).
+		Add("resources/deployments.apps/namespaces/ns-2/api.json", []byte(`{"metadata":{"name":"api"},"spec":{"replicas":2}}`)).
+		Done())
+	require.NoError(t, err)
+	defer new.Close()
+
+	ignored := [][]string{{"metadata", "resourceVersion"}, {"status"}}
+
+	changes, err := CompareItems(old, new, nil, nil, ignored)
+	require.NoError(t, err)
+	assert.Equal(t, []ItemChange{
+		{
+			Item:    Item{GroupResource: "deployments.apps", Namespace: "ns-1", Name: "web"},
+			Type:    ChangeModified,
+			Changes: []FieldChange{{Path: "spec.replicas", Type: ChangeModified, Old: float64(1), New: float64(3)}},
+		},
+		{
+			Item:    Item{GroupResource: "deployments.apps", Namespace: "ns-2", Name: "api"},
+			Type:    ChangeModified,
+			Changes: []FieldChange{{Path: "spec.replicas", Type: ChangeModified, Old: float64(1), New: float64(2)}},
+		},
+		{Item: Item{GroupResource: "pods", Namespace: "ns-1", Name: "added"}, Type: ChangeAdded},
+		{Item: Item{GroupResource: "pods", Namespace: "ns-1", Name: "removed"}, Type: ChangeRemoved},
+	}, changes)
+
+	changes, err = CompareItems(old, new, []string{"deployments"}, []string{"ns-2"}, ignored)
+	require.NoError(t, err)
+	assert.Equal(t, []ItemChange{
+		{
+			Item:    Item{GroupResource: "deployments.apps", Namespace: "ns-2", Name: "api"},
+			Type:    ChangeModified,
+			Changes: []FieldChange{{Path: "spec.replicas", Type: ChangeModified, Old: float64(1), New: float64(2)}},
+		},
+	}, changes)
+}
+
+func newPodVolumeBackup(pod, volume, snapshotID string, size int64) velerov1api.PodVolumeBackup {
+	pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, pod+"-"+volume).
+		PodNamespace("ns-1").
+		PodName(pod).
+		Volume(volume).
+		SnapshotID(snapshotID).
+		Result()
+	pvb.Status.Progress.TotalBytes = size
+	return *pvb
+}
+
+func TestComparePodVolumeBackups(t *testing.T) {
+	old := []velerov1api.PodVolumeBackup{
+		newPodVolumeBackup("pod-1", "data", "snapshot-1", 100),
+		newPodVolumeBackup("pod-1", "logs", "snapshot-2", 100),
+		newPodVolumeBackup("pod-2", "data", "snapshot-3", 100),
+	}
+	new := []velerov1api.PodVolumeBackup{
+		newPodVolumeBackup("pod-1", "data", "snapshot-4", 200),
+		newPodVolumeBackup("pod-2", "data", "snapshot-3", 100),
+		newPodVolumeBackup("pod-3", "data", "snapshot-5", 300),
+	}
+
+	assert.Equal(t, []PodVolumeChange{
+		{Namespace: "ns-1", Pod: "pod-1", Volume: "data", Type: ChangeModified, OldSnapshotID: "snapshot-1", NewSnapshotID: "snapshot-4", OldSize: 100, NewSize: 200},
+		{Namespace: "ns-1", Pod: "pod-1", Volume: "logs", Type: ChangeRemoved, OldSnapshotID: "snapshot-2", OldSize: 100},
+		{Namespace: "ns-1", Pod: "pod-3", Volume: "data", Type: ChangeAdded, NewSnapshotID: "snapshot-5", NewSize: 300},
+	}, ComparePodVolumeBackups(old, new))
+}
@@ -0,0 +1,274 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backupcontents lets the CLI read the items stored in a backup's tarball, which it
+// downloads from object storage and extracts to a local directory.
+package backupcontents
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// Item identifies an item stored in a backup.
+type Item struct {
+	// GroupResource is the item's resource, formatted as "resource.group", or just "resource"
+	// for the core API group.
+	GroupResource string `json:"groupResource"`
+	// Namespace is the item's namespace, which is empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// String returns the item as <resource>/<namespace>/<name>, or <resource>/<name> for
+// cluster-scoped items.
+func (i Item) String() string {
+	if i.Namespace == "" {
+		return fmt.Sprintf("%s/%s", i.GroupResource, i.Name)
+	}
+	return fmt.Sprintf("%s/%s/%s", i.GroupResource, i.Namespace, i.Name)
+}
+
+// ParseItem parses an item from <resource>/<namespace>/<name>, or <resource>/<name> for
+// cluster-scoped items.
+func ParseItem(s string) (Item, error) {
+	parts := strings.Split(s, "/")
+	for _, part := range parts {
+		if part == "" {
+			return Item{}, errors.Errorf("invalid item %q, expected <resource>/<namespace>/<name> or <resource>/<name>", s)
+		}
+	}
+
+	switch len(parts) {
+	case 2:
+		return Item{GroupResource: parts[0], Name: parts[1]}, nil
+	case 3:
+		return Item{GroupResource: parts[0], Namespace: parts[1], Name: parts[2]}, nil
+	}
+	return Item{}, errors.Errorf("invalid item %q, expected <resource>/<namespace>/<name> or <resource>/<name>", s)
+}
+
+// Contents is the catalog of the items in an extracted backup tarball.
+type Contents struct {
+	dir           string
+	fs            filesystem.Interface
+	resources     map[string]*archive.ResourceItems
+	groupVersions map[string]metav1.APIGroup
+}
+
+// Extract extracts the backup tarball read from src to a temporary directory, and parses it.
+// The directory is removed by Close.
+func Extract(log logrus.FieldLogger, fs filesystem.Interface, src io.Reader) (*Contents, error) {
+	dir, err := archive.NewExtractor(log, fs).UnzipAndExtractBackup(src)
+	if err != nil {
+		return nil, errors.Wrap(err, "error extracting backup")
+	}
+
+	contents := &Contents{dir: dir, fs: fs}
+
+	// a backup without any items doesn't have a resources directory
+	exists, err := fs.DirExists(filepath.Join(dir, velerov1api.ResourcesDir))
+	if err != nil {
+		contents.Close()
+		return nil, errors.Wrap(err, "error checking for existence of the backup's resources directory")
+	}
+	if !exists {
+		return contents, nil
+	}
+
+	parser := archive.NewParser(log, fs)
+	if contents.resources, err = parser.Parse(dir); err != nil {
+		contents.Close()
+		return nil, errors.Wrap(err, "error parsing backup")
+	}
+	if contents.groupVersions, err = parser.ParseGroupVersions(dir); err != nil {
+		contents.Close()
+		return nil, errors.Wrap(err, "error parsing backup's API group versions")
+	}
+
+	return contents, nil
+}
+
+// Close removes the directory that the backup was extracted to.
+func (c *Contents) Close() error {
+	return c.fs.RemoveAll(c.dir)
+}
+
+// Items returns the items in the backup, sorted by resource, namespace and name. If resources
+// isn't empty, only the items of those resources are returned. If namespaces isn't empty, only
+// the items in those namespaces are returned, which excludes cluster-scoped items.
+func (c *Contents) Items(resources, namespaces []string) []Item {
+	includedNamespaces := sets.NewString(namespaces...)
+
+	var items []Item
+	for groupResource, resourceItems := range c.resources {
+		if len(resources) > 0 && !matchesAnyResource(groupResource, resources) {
+			continue
+		}
+
+		for namespace, names := range resourceItems.ItemsByNamespace {
+			if includedNamespaces.Len() > 0 && !includedNamespaces.Has(namespace) {
+				continue
+			}
+			for _, name := range names {
+				items = append(items, Item{GroupResource: groupResource, Namespace: namespace, Name: name})
+			}
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return lessItem(items[i], items[j])
+	})
+
+	return items
+}
+
+// lessItem returns whether a is sorted before b, by resource, namespace and name.
+func lessItem(a, b Item) bool {
+	if a.GroupResource != b.GroupResource {
+		return a.GroupResource < b.GroupResource
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+// Versions returns the API versions that the resource's items are stored in, and the preferred
+// one among them. Backups taken before the items were stored in each version have none.
+func (c *Contents) Versions(resource string) ([]string, string, error) {
+	groupResource, err := c.resolve(resource)
+	if err != nil {
+		return nil, "", err
+	}
+
+	group := c.groupVersions[groupResource]
+	var versions []string
+	for _, version := range group.Versions {
+		versions = append(versions, version.Version)
+	}
+	sort.Strings(versions)
+
+	return versions, group.PreferredVersion.Version, nil
+}
+
+// Read returns the stored JSON of the item in the given API version, or in the resource's
+// preferred version if version is empty. The item's resource may be given without its group
+// if that's unambiguous. The returned item has the resolved resource.
+func (c *Contents) Read(item Item, version string) (Item, []byte, error) {
+	groupResource, err := c.resolve(item.GroupResource)
+	if err != nil {
+		return item, nil, err
+	}
+	item.GroupResource = groupResource
+
+	versionDir := ""
+	if version != "" {
+		versionDir, err = c.versionDir(groupResource, version)
+		if err != nil {
+			return item, nil, err
+		}
+	}
+
+	path := filepath.Join(c.dir, velerov1api.ResourcesDir, groupResource, versionDir, velerov1api.ClusterScopedDir, item.Name+".json")
+	if item.Namespace != "" {
+		path = filepath.Join(c.dir, velerov1api.ResourcesDir, groupResource, versionDir, velerov1api.NamespaceScopedDir, item.Namespace, item.Name+".json")
+	}
+
+	data, err := c.fs.ReadFile(path)
+	if os.IsNotExist(err) {
+		return item, nil, errors.Errorf("item %s not found in backup", item)
+	}
+	if err != nil {
+		return item, nil, errors.Wrapf(err, "error reading item %s", item)
+	}
+
+	return item, data, nil
+}
+
+// resolve returns the group resource in the backup that resource refers to.
+func (c *Contents) resolve(resource string) (string, error) {
+	if _, ok := c.resources[resource]; ok {
+		return resource, nil
+	}
+
+	var matches []string
+	for groupResource := range c.resources {
+		if matchesResource(groupResource, resource) {
+			matches = append(matches, groupResource)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", errors.Errorf("resource %q not found in backup", resource)
+	case 1:
+		return matches[0], nil
+	}
+	sort.Strings(matches)
+	return "", errors.Errorf("resource %q is ambiguous, it matches %s", resource, strings.Join(matches, ", "))
+}
+
+// versionDir returns the name of the directory that the items of the group resource are stored
+// in for the given version, which may be given as <group>/<version>.
+func (c *Contents) versionDir(groupResource, version string) (string, error) {
+	version = version[strings.LastIndex(version, "/")+1:]
+
+	group := c.groupVersions[groupResource]
+	var versions []string
+	for _, v := range group.Versions {
+		if v.Version == version {
+			if group.PreferredVersion.Version == version {
+				return version + velerov1api.PreferredVersionDir, nil
+			}
+			return version, nil
+		}
+		versions = append(versions, v.Version)
+	}
+
+	if len(versions) == 0 {
+		return "", errors.Errorf("backup doesn't store the items of %s in more than one API version", groupResource)
+	}
+	sort.Strings(versions)
+	return "", errors.Errorf("API version %q of %s not found in backup, available versions are %s", version, groupResource, strings.Join(versions, ", "))
+}
+
+// matchesResource returns whether resource, which may omit the group, refers to groupResource.
+func matchesResource(groupResource, resource string) bool {
+	return groupResource == resource || strings.HasPrefix(groupResource, resource+".")
+}
+
+func matchesAnyResource(groupResource string, resources []string) bool {
+	for _, resource := range resources {
+		if matchesResource(groupResource, resource) {
+			return true
+		}
+	}
+	return false
+}
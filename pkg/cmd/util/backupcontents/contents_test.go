@@ -0,0 +1,207 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcontents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestParseItem(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    Item
+		expectedErr bool
+	}{
+		{input: "pods/ns-1/pod-1", expected: Item{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1"}},
+		{input: "persistentvolumes/pv-1", expected: Item{GroupResource: "persistentvolumes", Name: "pv-1"}},
+		{input: "pods", expectedErr: true},
+		{input: "pods//pod-1", expectedErr: true},
+		{input: "pods/ns-1/pod-1/extra", expectedErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			item, err := ParseItem(test.input)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, item)
+			assert.Equal(t, test.input, item.String())
+		})
+	}
+}
+
+func newTestContents(t *testing.T) *Contents {
+	t.Helper()
+
+	tarball := test.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		Add("resources/pods/namespaces/ns-1/pod-1.json", []byte(`{"apiVersion":"v1","kind":"Pod"}`)).
+		Add("resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json", []byte(`{"apiVersion":"v1","kind":"Pod"}`)).
+		Add("resources/pods/namespaces/ns-2/pod-2.json", []byte(`{"apiVersion":"v1","kind":"Pod"}`)).
+		Add("resources/pods/v1-preferredversion/namespaces/ns-2/pod-2.json", []byte(`{"apiVersion":"v1","kind":"Pod"}`)).
+		Add("resources/persistentvolumes/cluster/pv-1.json", []byte(`{"apiVersion":"v1","kind":"PersistentVolume"}`)).
+		Add("resources/persistentvolumes/v1-preferredversion/cluster/pv-1.json", []byte(`{"apiVersion":"v1","kind":"PersistentVolume"}`)).
+		Add("resources/horizontalpodautoscalers.autoscaling/namespaces/ns-1/hpa-1.json", []byte(`{"apiVersion":"autoscaling/v1"}`)).
+		Add("resources/horizontalpodautoscalers.autoscaling/v1-preferredversion/namespaces/ns-1/hpa-1.json", []byte(`{"apiVersion":"autoscaling/v1"}`)).
+		Add("resources/horizontalpodautoscalers.autoscaling/v2beta2/namespaces/ns-1/hpa-1.json", []byte(`{"apiVersion":"autoscaling/v2beta2"}`)).
+		Add("resources/widgets.example.com/namespaces/ns-1/widget-1.json", []byte(`{}`)).
+		Add("resources/widgets.example.org/namespaces/ns-1/widget-1.json", []byte(`{}`)).
+		Done()
+
+	contents, err := Extract(test.NewLogger(), test.NewFakeFileSystem(), tarball)
+	require.NoError(t, err)
+	return contents
+}
+
+func TestContentsItems(t *testing.T) {
+	contents := newTestContents(t)
+	defer contents.Close()
+
+	tests := []struct {
+		name       string
+		resources  []string
+		namespaces []string
+		expected   []Item
+	}{
+		{
+			name: "all items are returned sorted",
+			expected: []Item{
+				{GroupResource: "horizontalpodautoscalers.autoscaling", Namespace: "ns-1", Name: "hpa-1"},
+				{GroupResource: "persistentvolumes", Name: "pv-1"},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1"},
+				{GroupResource: "pods", Namespace: "ns-2", Name: "pod-2"},
+				{GroupResource: "widgets.example.com", Namespace: "ns-1", Name: "widget-1"},
+				{GroupResource: "widgets.example.org", Namespace: "ns-1", Name: "widget-1"},
+			},
+		},
+		{
+			name:      "resources may be given without their group",
+			resources: []string{"horizontalpodautoscalers", "widgets.example.com"},
+			expected: []Item{
+				{GroupResource: "horizontalpodautoscalers.autoscaling", Namespace: "ns-1", Name: "hpa-1"},
+				{GroupResource: "widgets.example.com", Namespace: "ns-1", Name: "widget-1"},
+			},
+		},
+		{
+			name:       "namespaces filter excludes cluster-scoped items",
+			resources:  []string{"pods", "persistentvolumes"},
+			namespaces: []string{"ns-2"},
+			expected: []Item{
+				{GroupResource: "pods", Namespace: "ns-2", Name: "pod-2"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, contents.Items(test.resources, test.namespaces))
+		})
+	}
+}
+
+func TestContentsRead(t *testing.T) {
+	contents := newTestContents(t)
+	defer contents.Close()
+
+	tests := []struct {
+		name         string
+		item         Item
+		version      string
+		expectedItem Item
+		expectedData string
+		expectedErr  string
+	}{
+		{
+			name:         "namespaced item in preferred version",
+			item:         Item{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1"},
+			expectedItem: Item{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1"},
+			expectedData: `{"apiVersion":"v1","kind":"Pod"}`,
+		},
+		{
+			name:         "cluster-scoped item",
+			item:         Item{GroupResource: "persistentvolumes", Name: "pv-1"},
+			expectedItem: Item{GroupResource: "persistentvolumes", Name: "pv-1"},
+			expectedData: `{"apiVersion":"v1","kind":"PersistentVolume"}`,
+		},
+		{
+			name:         "resource without group is resolved and item is read in another version",
+			item:         Item{GroupResource: "horizontalpodautoscalers", Namespace: "ns-1", Name: "hpa-1"},
+			version:      "autoscaling/v2beta2",
+			expectedItem: Item{GroupResource: "horizontalpodautoscalers.autoscaling", Namespace: "ns-1", Name: "hpa-1"},
+			expectedData: `{"apiVersion":"autoscaling/v2beta2"}`,
+		},
+		{
+			name:         "preferred version may be selected explicitly",
+			item:         Item{GroupResource: "horizontalpodautoscalers.autoscaling", Namespace: "ns-1", Name: "hpa-1"},
+			version:      "v1",
+			expectedItem: Item{GroupResource: "horizontalpodautoscalers.autoscaling", Namespace: "ns-1", Name: "hpa-1"},
+			expectedData: `{"apiVersion":"autoscaling/v1"}`,
+		},
+		{
+			name:        "unknown version",
+			item:        Item{GroupResource: "horizontalpodautoscalers.autoscaling", Namespace: "ns-1", Name: "hpa-1"},
+			version:     "v3",
+			expectedErr: `API version "v3" of horizontalpodautoscalers.autoscaling not found in backup, available versions are v1, v2beta2`,
+		},
+		{
+			name:        "ambiguous resource",
+			item:        Item{GroupResource: "widgets", Namespace: "ns-1", Name: "widget-1"},
+			expectedErr: `resource "widgets" is ambiguous, it matches widgets.example.com, widgets.example.org`,
+		},
+		{
+			name:        "unknown resource",
+			item:        Item{GroupResource: "services", Namespace: "ns-1", Name: "svc-1"},
+			expectedErr: `resource "services" not found in backup`,
+		},
+		{
+			name:        "unknown item",
+			item:        Item{GroupResource: "pods", Namespace: "ns-2", Name: "pod-1"},
+			expectedErr: "item pods/ns-2/pod-1 not found in backup",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			item, data, err := contents.Read(test.item, test.version)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedItem, item)
+			assert.Equal(t, test.expectedData, string(data))
+		})
+	}
+}
+
+func TestContentsVersions(t *testing.T) {
+	contents := newTestContents(t)
+	defer contents.Close()
+
+	versions, preferred, err := contents.Versions("horizontalpodautoscalers")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1", "v2beta2"}, versions)
+	assert.Equal(t, "v1", preferred)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcontents
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ChangeType is the kind of a change to a field.
+type ChangeType string
+
+const (
+	ChangeAdded    ChangeType = "added"
+	ChangeRemoved  ChangeType = "removed"
+	ChangeModified ChangeType = "modified"
+)
+
+// FieldChange is a change to a field between two JSON documents.
+type FieldChange struct {
+	// Path is the path of the field, such as spec.containers[0].image, or an empty string for
+	// the whole document.
+	Path string      `json:"path"`
+	Type ChangeType  `json:"type"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// DecodeItem decodes the JSON of an item for DiffJSON, without the ignored fields, each of which
+// is given as the path of keys to it.
+func DecodeItem(data []byte, ignoredFields [][]string) (interface{}, error) {
+	obj := make(map[string]interface{})
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, field := range ignoredFields {
+		unstructured.RemoveNestedField(obj, field...)
+	}
+	return obj, nil
+}
+
+// DiffJSON returns the changes between old and new, which are values decoded from JSON. A field
+// that's added or removed is reported once, rather than once for each of its nested fields. The
+// changes are ordered by the fields' keys and array indexes.
+func DiffJSON(old, new interface{}) []FieldChange {
+	var changes []FieldChange
+	diffJSON("", old, new, &changes)
+	return changes
+}
+
+func diffJSON(path string, old, new interface{}, changes *[]FieldChange) {
+	switch oldVal := old.(type) {
+	case map[string]interface{}:
+		newVal, ok := new.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(oldVal)+len(newVal))
+		for key := range oldVal {
+			keys = append(keys, key)
+		}
+		for key := range newVal {
+			if _, ok := oldVal[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			oldField, inOld := oldVal[key]
+			newField, inNew := newVal[key]
+			fieldPath := joinKey(path, key)
+
+			switch {
+			case !inOld:
+				*changes = append(*changes, FieldChange{Path: fieldPath, Type: ChangeAdded, New: newField})
+			case !inNew:
+				*changes = append(*changes, FieldChange{Path: fieldPath, Type: ChangeRemoved, Old: oldField})
+			default:
+				diffJSON(fieldPath, oldField, newField, changes)
+			}
+		}
+		return
+
+	case []interface{}:
+		newVal, ok := new.([]interface{})
+		if !ok {
+			break
+		}
+
+		for i := 0; i < len(oldVal) || i < len(newVal); i++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+
+			switch {
+			case i >= len(oldVal):
+				*changes = append(*changes, FieldChange{Path: elemPath, Type: ChangeAdded, New: newVal[i]})
+			case i >= len(newVal):
+				*changes = append(*changes, FieldChange{Path: elemPath, Type: ChangeRemoved, Old: oldVal[i]})
+			default:
+				diffJSON(elemPath, oldVal[i], newVal[i], changes)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(old, new) {
+		*changes = append(*changes, FieldChange{Path: path, Type: ChangeModified, Old: old, New: new})
+	}
+}
+
+var plainKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// joinKey appends key to path, quoting it if it isn't a plain identifier, such as the keys of
+// labels and annotations.
+func joinKey(path, key string) string {
+	if !plainKey.MatchString(key) {
+		return fmt.Sprintf("%s[%s]", path, strconv.Quote(key))
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// PrintChanges writes the changes to w, one per line, each prefixed with indent and marked with
+// '+' if the field was added, '-' if it was removed, or '~' if it was modified.
+func PrintChanges(w io.Writer, indent string, changes []FieldChange) {
+	for _, change := range changes {
+		path := change.Path
+		if path == "" {
+			path = "(document)"
+		}
+
+		switch change.Type {
+		case ChangeAdded:
+			fmt.Fprintf(w, "%s+ %s: %s\n", indent, path, formatValue(change.New))
+		case ChangeRemoved:
+			fmt.Fprintf(w, "%s- %s: %s\n", indent, path, formatValue(change.Old))
+		default:
+			fmt.Fprintf(w, "%s~ %s: %s -> %s\n", indent, path, formatValue(change.Old), formatValue(change.New))
+		}
+	}
+}
+
+func formatValue(val interface{}) string {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprintf("%v", val)
+	}
+	return string(data)
+}
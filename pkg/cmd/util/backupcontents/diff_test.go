@@ -0,0 +1,99 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcontents
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeJSON(t *testing.T, s string) interface{} {
+	t.Helper()
+
+	var val interface{}
+	require.NoError(t, json.Unmarshal([]byte(s), &val))
+	return val
+}
+
+func TestDiffJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		expected []FieldChange
+	}{
+		{
+			name: "equal documents have no changes",
+			old:  `{"a":1,"b":[1,2],"c":{"d":"e"}}`,
+			new:  `{"c":{"d":"e"},"b":[1,2],"a":1}`,
+		},
+		{
+			name: "changed, added and removed fields are reported in key order",
+			old:  `{"metadata":{"labels":{"app.kubernetes.io/name":"a","tier":"web"}},"spec":{"replicas":1,"paused":true}}`,
+			new:  `{"metadata":{"labels":{"app.kubernetes.io/name":"b"}},"spec":{"replicas":3,"selector":{"app":"a"}}}`,
+			expected: []FieldChange{
+				{Path: `metadata.labels["app.kubernetes.io/name"]`, Type: ChangeModified, Old: "a", New: "b"},
+				{Path: "metadata.labels.tier", Type: ChangeRemoved, Old: "web"},
+				{Path: "spec.paused", Type: ChangeRemoved, Old: true},
+				{Path: "spec.replicas", Type: ChangeModified, Old: float64(1), New: float64(3)},
+				{Path: "spec.selector", Type: ChangeAdded, New: map[string]interface{}{"app": "a"}},
+			},
+		},
+		{
+			name: "array elements are compared by index",
+			old:  `{"containers":[{"image":"nginx:1"},{"image":"sidecar"}]}`,
+			new:  `{"containers":[{"image":"nginx:2"}]}`,
+			expected: []FieldChange{
+				{Path: "containers[0].image", Type: ChangeModified, Old: "nginx:1", New: "nginx:2"},
+				{Path: "containers[1]", Type: ChangeRemoved, Old: map[string]interface{}{"image": "sidecar"}},
+			},
+		},
+		{
+			name: "field with a different type is modified",
+			old:  `{"a":{"b":1}}`,
+			new:  `{"a":[1]}`,
+			expected: []FieldChange{
+				{Path: "a", Type: ChangeModified, Old: map[string]interface{}{"b": float64(1)}, New: []interface{}{float64(1)}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, DiffJSON(decodeJSON(t, test.old), decodeJSON(t, test.new)))
+		})
+	}
+}
+
+func TestPrintChanges(t *testing.T) {
+	changes := []FieldChange{
+		{Path: "spec.replicas", Type: ChangeModified, Old: float64(1), New: float64(3)},
+		{Path: "spec.paused", Type: ChangeRemoved, Old: true},
+		{Path: "spec.selector", Type: ChangeAdded, New: map[string]interface{}{"app": "a"}},
+	}
+
+	buf := new(bytes.Buffer)
+	PrintChanges(buf, "  ", changes)
+	assert.Equal(t, `  ~ spec.replicas: 1 -> 3
+  - spec.paused: true
+  + spec.selector: {"app":"a"}
+`, buf.String())
+}
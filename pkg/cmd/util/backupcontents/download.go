@@ -0,0 +1,161 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcontents
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// DefaultCacheDir returns the directory that the downloaded backup tarballs are cached in by
+// default, or an empty string if the user's cache directory can't be determined.
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "velero", "backups")
+}
+
+// Downloader downloads and extracts the tarballs of backups. The tarballs of the backups that
+// are done are kept in CacheDir, so that they're downloaded only once.
+type Downloader struct {
+	KBClient kbclient.Client
+	// CacheDir is the directory that the tarballs are cached in. Caching is disabled if it's
+	// empty.
+	CacheDir              string
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	CACertFile            string
+	Log                   logrus.FieldLogger
+}
+
+// Open returns the contents of the backup, from the cache if they're in it. The returned
+// contents must be closed.
+func (d *Downloader) Open(ctx context.Context, backup *velerov1api.Backup) (*Contents, error) {
+	path, cleanup, err := d.download(ctx, backup)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening backup tarball")
+	}
+	defer file.Close()
+
+	log := d.Log
+	if log == nil {
+		log = logrus.New()
+	}
+	return Extract(log, filesystem.NewFileSystem(), file)
+}
+
+// download returns the path of the backup's tarball, downloading it if it isn't cached, and a
+// function that removes the tarball if it isn't kept in the cache.
+func (d *Downloader) download(ctx context.Context, backup *velerov1api.Backup) (string, func(), error) {
+	noop := func() {}
+
+	if d.CacheDir == "" || !isCacheable(backup) {
+		file, err := ioutil.TempFile("", backup.Name+"-*.tar.gz")
+		if err != nil {
+			return "", noop, errors.Wrap(err, "error creating temp file for backup tarball")
+		}
+		cleanup := func() { os.Remove(file.Name()) }
+
+		err = downloadrequest.Stream(ctx, d.KBClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupContents, file, d.Timeout, d.InsecureSkipTLSVerify, d.CACertFile)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			cleanup()
+			return "", noop, errors.Wrapf(err, "error downloading backup %s", backup.Name)
+		}
+		return file.Name(), cleanup, nil
+	}
+
+	// the tarballs of a backup are cached under its UID, so that a backup that's deleted and
+	// then created again with the same name isn't served from the cache
+	dir := filepath.Join(d.CacheDir, backup.Namespace, backup.Name)
+	path := filepath.Join(dir, string(backup.UID)+".tar.gz")
+	if _, err := os.Stat(path); err == nil {
+		return path, noop, nil
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", noop, errors.Wrap(err, "error creating cache directory")
+	}
+	file, err := ioutil.TempFile(dir, ".download-*")
+	if err != nil {
+		return "", noop, errors.Wrap(err, "error creating cache file")
+	}
+
+	err = downloadrequest.Stream(ctx, d.KBClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupContents, file, d.Timeout, d.InsecureSkipTLSVerify, d.CACertFile)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", noop, errors.Wrapf(err, "error downloading backup %s", backup.Name)
+	}
+
+	// the tarball is renamed only once it's complete, so that an interrupted download isn't
+	// taken for a cached one
+	if err := os.Rename(file.Name(), path); err != nil {
+		os.Remove(file.Name())
+		return "", noop, errors.Wrap(err, "error caching backup tarball")
+	}
+	removeStale(dir, path)
+
+	return path, noop, nil
+}
+
+// removeStale removes the tarballs in dir other than keep, which are the tarballs of deleted
+// backups that had the same name.
+func removeStale(dir, keep string) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		if path := filepath.Join(dir, file.Name()); path != keep && strings.HasSuffix(path, ".tar.gz") {
+			os.Remove(path)
+		}
+	}
+}
+
+// isCacheable returns whether the backup is done, so that its tarball won't change anymore.
+func isCacheable(backup *velerov1api.Backup) bool {
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+		return backup.UID != ""
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+)
+
+// levelPattern matches the level of a log line in the text or JSON format of Velero's logs.
+var levelPattern = regexp.MustCompile(`(?:^|\s)level=(\w+)|"level":"(\w+)"`)
+
+// LevelFilter is an io.Writer that writes only the log lines at a given level or a more severe
+// one to the underlying writer. Lines whose level can't be determined are always written.
+type LevelFilter struct {
+	w     io.Writer
+	level logrus.Level
+	// partial holds the start of a line that hasn't been terminated yet.
+	partial []byte
+}
+
+// NewLevelFilter returns a LevelFilter that writes the lines at level or a more severe one to w.
+func NewLevelFilter(w io.Writer, level logrus.Level) *LevelFilter {
+	return &LevelFilter{w: w, level: level}
+}
+
+// Write writes the complete lines in p that pass the filter, and keeps any incomplete line at its
+// end until it's terminated by a later write or by Flush.
+func (f *LevelFilter) Write(p []byte) (int, error) {
+	data := append(f.partial, p...)
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		if err := f.writeLine(data[:i+1]); err != nil {
+			return 0, err
+		}
+		data = data[i+1:]
+	}
+	f.partial = append([]byte(nil), data...)
+
+	return len(p), nil
+}
+
+// Flush writes the incomplete line at the end of the log, if any, if it passes the filter.
+func (f *LevelFilter) Flush() error {
+	if len(f.partial) == 0 {
+		return nil
+	}
+	line := f.partial
+	f.partial = nil
+	return f.writeLine(line)
+}
+
+func (f *LevelFilter) writeLine(line []byte) error {
+	if match := levelPattern.FindSubmatch(line); match != nil {
+		name := match[1]
+		if len(name) == 0 {
+			name = match[2]
+		}
+		if level, err := logrus.ParseLevel(string(name)); err == nil && level > f.level {
+			return nil
+		}
+	}
+
+	_, err := f.w.Write(line)
+	return err
+}
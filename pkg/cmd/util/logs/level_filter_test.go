@@ -0,0 +1,83 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevelFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    logrus.Level
+		writes   []string
+		expected string
+	}{
+		{
+			name:  "text lines below the level are dropped",
+			level: logrus.WarnLevel,
+			writes: []string{
+				"time=\"2021-10-01T00:00:00Z\" level=info msg=one\n",
+				"time=\"2021-10-01T00:00:00Z\" level=warning msg=two\n",
+				"time=\"2021-10-01T00:00:00Z\" level=error msg=three\n",
+			},
+			expected: "time=\"2021-10-01T00:00:00Z\" level=warning msg=two\n" +
+				"time=\"2021-10-01T00:00:00Z\" level=error msg=three\n",
+		},
+		{
+			name:  "json lines below the level are dropped",
+			level: logrus.InfoLevel,
+			writes: []string{
+				`{"level":"debug","msg":"one"}` + "\n",
+				`{"level":"info","msg":"two"}` + "\n",
+			},
+			expected: `{"level":"info","msg":"two"}` + "\n",
+		},
+		{
+			name:     "lines split across writes are filtered as a whole",
+			level:    logrus.InfoLevel,
+			writes:   []string{"level=debug ms", "g=one\nlevel=info", " msg=two\nlevel=error msg=three"},
+			expected: "level=info msg=two\nlevel=error msg=three",
+		},
+		{
+			name:     "lines without a level are kept",
+			level:    logrus.ErrorLevel,
+			writes:   []string{"no level here\n", "msg=\"level=info\" level=error\n"},
+			expected: "no level here\nmsg=\"level=info\" level=error\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			f := NewLevelFilter(buf, test.level)
+			for _, w := range test.writes {
+				n, err := f.Write([]byte(w))
+				require.NoError(t, err)
+				assert.Equal(t, len(w), n)
+			}
+			require.NoError(t, f.Flush())
+
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/vmware-tanzu/velero/pkg/logstream"
+)
+
+// DefaultServerPort is the port of the Velero server's log stream address, which the logs of
+// in-progress backups and restores are served on if the server is run with --log-stream-address.
+const DefaultServerPort = 8086
+
+// serverPodSelector selects the pods of the Velero server deployment.
+const serverPodSelector = "deploy=velero"
+
+// ErrNotFound is returned by Stream if the Velero server doesn't have the log of the operation,
+// because the operation hasn't started yet or is already done.
+var ErrNotFound = errors.New("log stream not found")
+
+// Stream copies the log of the in-progress backup or restore of the given kind and name from the
+// Velero server in namespace to w, through the API server's proxy to the server's pod. If follow
+// is true, it keeps copying the log until the operation is done or ctx is done.
+func Stream(ctx context.Context, kubeClient kubernetes.Interface, namespace string, kind logstream.Kind, name string, port int, follow bool, w io.Writer) error {
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: serverPodSelector})
+	if err != nil {
+		return errors.Wrap(err, "error listing Velero server pods")
+	}
+
+	var serverPod string
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1api.PodRunning {
+			serverPod = pod.Name
+			break
+		}
+	}
+	if serverPod == "" {
+		return errors.Errorf("no running Velero server pod found in namespace %s", namespace)
+	}
+
+	params := map[string]string{logstream.FollowParam: strconv.FormatBool(follow)}
+	body, err := kubeClient.CoreV1().Pods(namespace).ProxyGet("http", serverPod, strconv.Itoa(port), logstream.Path(kind, name), params).Stream(ctx)
+	if apierrors.IsNotFound(err) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting log stream from the Velero server, which serves the logs of in-progress operations only if it's run with --log-stream-address")
+	}
+	defer body.Close()
+
+	if _, err := io.Copy(w, body); err != nil && ctx.Err() == nil {
+		return errors.Wrap(err, "error reading log stream")
+	}
+	return nil
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -61,7 +62,7 @@ func DescribeBackup(
 		}
 		phaseString := string(phase)
 		switch phase {
-		case velerov1api.BackupPhaseFailedValidation, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
+		case velerov1api.BackupPhaseFailedValidation, velerov1api.BackupPhaseFailedPreBackupActions, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
 			phaseString = color.RedString(phaseString)
 		case velerov1api.BackupPhaseCompleted:
 			phaseString = color.GreenString(phaseString)
@@ -86,10 +87,15 @@ func DescribeBackup(
 			}
 		}
 
+		describeActionStatuses(d, "Pre-backup actions", status.PreBackupActionsStatuses)
+		describeActionStatuses(d, "Post-backup actions", status.PostBackupActionsStatuses)
+
 		d.Println()
 		d.Printf("Errors:\t%d\n", status.Errors)
 		d.Printf("Warnings:\t%d\n", status.Warnings)
 
+		describeBackupResults(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertFile)
+
 		d.Println()
 		DescribeBackupSpec(d, backup.Spec)
 
@@ -114,6 +120,37 @@ func DescribeBackup(
 	})
 }
 
+// describeBackupResults describes the warnings and errors of a backup, by namespace and item.
+func describeBackupResults(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+	if backup.Status.Warnings == 0 && backup.Status.Errors == 0 {
+		return
+	}
+
+	var buf bytes.Buffer
+	var resultMap map[string]results.Result
+
+	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		d.Println()
+		d.Printf("Warnings:\t<error getting warnings: %v>\n\nErrors:\t<error getting errors: %v>\n", err, err)
+		return
+	}
+
+	if err := json.NewDecoder(&buf).Decode(&resultMap); err != nil {
+		d.Println()
+		d.Printf("Warnings:\t<error decoding warnings: %v>\n\nErrors:\t<error decoding errors: %v>\n", err, err)
+		return
+	}
+
+	if backup.Status.Warnings > 0 {
+		d.Println()
+		describeResult(d, "Warnings", resultMap["warnings"])
+	}
+	if backup.Status.Errors > 0 {
+		d.Println()
+		describeResult(d, "Errors", resultMap["errors"])
+	}
+}
+
 // DescribeBackupSpec describes a backup spec in human-readable format.
 func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	// TODO make a helper for this and use it in all the describers.
@@ -161,6 +198,7 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 
 	d.Println()
 	d.Printf("Velero-Native Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
+	d.Printf("Move CSI Snapshot Data:\t%s\n", BoolPointerString(spec.SnapshotMoveData, "false", "true", "false"))
 
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
@@ -365,6 +403,31 @@ func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ str
 }
 
 // DescribeDeleteBackupRequests describes delete backup requests in human-readable format.
+// describeActionStatuses describes the statuses of pre/post backup or restore actions.
+func describeActionStatuses(d *Describer, title string, statuses []velerov1api.ActionStatus) {
+	if len(statuses) == 0 {
+		return
+	}
+
+	d.Println()
+	d.Printf("%s:\n", title)
+	for _, status := range statuses {
+		phase := string(status.Phase)
+		switch status.Phase {
+		case velerov1api.ActionPhaseCompleted:
+			phase = color.GreenString(phase)
+		case velerov1api.ActionPhaseFailed:
+			phase = color.RedString(phase)
+		}
+
+		if status.Message != "" {
+			d.Printf("\t%s:\t%s (%s)\n", status.PluginName, phase, status.Message)
+		} else {
+			d.Printf("\t%s:\t%s\n", status.PluginName, phase)
+		}
+	}
+}
+
 func DescribeDeleteBackupRequests(d *Describer, requests []velerov1api.DeleteBackupRequest) {
 	d.Printf("Deletion Attempts")
 	if count := failedDeletionCount(requests); count > 0 {
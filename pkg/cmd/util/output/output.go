@@ -144,6 +144,27 @@ func printEncoded(obj runtime.Object, format string) (bool, error) {
 
 func printTable(cmd *cobra.Command, obj runtime.Object) (bool, error) {
 	// 1. generate table
+	table, err := tableFor(obj)
+	if err != nil {
+		return false, err
+	}
+
+	// 2. print table
+	tablePrinter, err := NewPrinter(cmd)
+	if err != nil {
+		return false, err
+	}
+
+	err = tablePrinter.PrintObj(table, os.Stdout)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// tableFor returns the human-readable table of obj.
+func tableFor(obj runtime.Object) (*metav1.Table, error) {
 	var table *metav1.Table
 
 	switch obj.(type) {
@@ -213,25 +234,14 @@ func printTable(cmd *cobra.Command, obj runtime.Object) (bool, error) {
 			Rows:              printPluginList(obj.(*velerov1api.ServerStatusRequest)),
 		}
 	default:
-		return false, errors.Errorf("type %T is not supported", obj)
+		return nil, errors.Errorf("type %T is not supported", obj)
 	}
 
 	if table == nil {
-		return false, errors.Errorf("error generating table for type %T", obj)
+		return nil, errors.Errorf("error generating table for type %T", obj)
 	}
 
-	// 2. print table
-	tablePrinter, err := NewPrinter(cmd)
-	if err != nil {
-		return false, err
-	}
-
-	err = tablePrinter.PrintObj(table, os.Stdout)
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return table, nil
 }
 
 // NewPrinter returns a printer for doing human-readable table printing of
@@ -30,6 +30,9 @@ var (
 		// https://github.com/kubernetes/kubernetes/blob/v1.15.3/pkg/printers/tableprinter.go#L204
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Kind"},
+		{Name: "Status"},
+		{Name: "Restarts"},
+		{Name: "Last Error"},
 	}
 )
 
@@ -57,7 +60,14 @@ func sortByKindAndName(plugins []velerov1api.PluginInfo) {
 func printPlugin(plugin velerov1api.PluginInfo) []metav1.TableRow {
 	row := metav1.TableRow{}
 
-	row.Cells = append(row.Cells, plugin.Name, plugin.Kind)
+	status, restarts, lastError := "<not run>", 0, ""
+	if plugin.Status != nil {
+		status = string(plugin.Status.Phase)
+		restarts = plugin.Status.RestartCount
+		lastError = plugin.Status.LastError
+	}
+
+	row.Cells = append(row.Cells, plugin.Name, plugin.Kind, status, restarts, lastError)
 
 	return []metav1.TableRow{row}
 }
@@ -32,7 +32,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
-	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
 func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, podVolumeRestores []velerov1api.PodVolumeRestore, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertFile string) string {
@@ -48,7 +48,7 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		switch phase {
 		case velerov1api.RestorePhaseCompleted:
 			phaseString = color.GreenString(phaseString)
-		case velerov1api.RestorePhaseFailedValidation, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
+		case velerov1api.RestorePhaseFailedValidation, velerov1api.RestorePhaseFailedPreRestoreActions, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
 			phaseString = color.RedString(phaseString)
 		}
 
@@ -90,6 +90,9 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			}
 		}
 
+		describeActionStatuses(d, "Pre-restore actions", restore.Status.PreRestoreActionsStatuses)
+		describeActionStatuses(d, "Post-restore actions", restore.Status.PostRestoreActionsStatuses)
+
 		describeRestoreResults(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
 
 		d.Println()
@@ -160,7 +163,7 @@ func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *De
 	}
 
 	var buf bytes.Buffer
-	var resultMap map[string]pkgrestore.Result
+	var resultMap map[string]results.Result
 
 	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
 		d.Printf("Warnings:\t<error getting warnings: %v>\n\nErrors:\t<error getting errors: %v>\n", err, err)
@@ -174,15 +177,16 @@ func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *De
 
 	if restore.Status.Warnings > 0 {
 		d.Println()
-		describeRestoreResult(d, "Warnings", resultMap["warnings"])
+		describeResult(d, "Warnings", resultMap["warnings"])
 	}
 	if restore.Status.Errors > 0 {
 		d.Println()
-		describeRestoreResult(d, "Errors", resultMap["errors"])
+		describeResult(d, "Errors", resultMap["errors"])
 	}
 }
 
-func describeRestoreResult(d *Describer, name string, result pkgrestore.Result) {
+// describeResult describes the warnings or errors of a backup or restore.
+func describeResult(d *Describer, name string, result results.Result) {
 	d.Printf("%s:\n", name)
 	d.DescribeSlice(1, "Velero", result.Velero)
 	d.DescribeSlice(1, "Cluster", result.Cluster)
@@ -190,8 +194,13 @@ func describeRestoreResult(d *Describer, name string, result pkgrestore.Result)
 		d.Printf("\tNamespaces: <none>\n")
 	} else {
 		d.Printf("\tNamespaces:\n")
-		for ns, warnings := range result.Namespaces {
-			d.DescribeSlice(2, ns, warnings)
+		namespaces := make([]string, 0, len(result.Namespaces))
+		for ns := range result.Namespaces {
+			namespaces = append(namespaces, ns)
+		}
+		sort.Strings(namespaces)
+		for _, ns := range namespaces {
+			d.DescribeSlice(2, ns, result.Namespaces[ns])
 		}
 	}
 }
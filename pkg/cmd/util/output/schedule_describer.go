@@ -63,6 +63,28 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
 
+	timeZone := spec.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	d.Printf("Time Zone:\t%s\n", timeZone)
+	d.Printf("Paused:\t%t\n", spec.Paused)
+
+	missedRunPolicy := spec.MissedRunPolicy
+	if missedRunPolicy == "" {
+		missedRunPolicy = v1.ScheduleMissedRunPolicyRunOnce
+	}
+	d.Printf("Missed Run Policy:\t%s\n", missedRunPolicy)
+	if spec.MissedRunWindow != nil {
+		d.Printf("Missed Run Window:\t%s\n", spec.MissedRunWindow.Duration)
+	}
+
+	concurrencyPolicy := spec.ConcurrencyPolicy
+	if concurrencyPolicy == "" {
+		concurrencyPolicy = v1.ScheduleConcurrencyPolicyAllow
+	}
+	d.Printf("Concurrency Policy:\t%s\n", concurrencyPolicy)
+
 	d.Println()
 	d.Println("Backup Template:")
 	d.Prefix = "\t"
@@ -76,4 +98,14 @@ func DescribeScheduleStatus(d *Describer, status v1.ScheduleStatus) {
 		lastBackup = fmt.Sprintf("%v", status.LastBackup.Time)
 	}
 	d.Printf("Last Backup:\t%s\n", lastBackup)
+
+	if status.LastSkipped != nil && !status.LastSkipped.Time.IsZero() {
+		d.Printf("Last Skipped:\t%v\n", status.LastSkipped.Time)
+	}
+
+	nextRun := "<n/a>"
+	if status.NextRunTime != nil && !status.NextRunTime.Time.IsZero() {
+		nextRun = fmt.Sprintf("%v", status.NextRunTime.Time)
+	}
+	d.Printf("Next Run:\t%s\n", nextRun)
 }
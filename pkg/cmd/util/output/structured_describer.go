@@ -0,0 +1,207 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// BindDescribeFlags defines the flag that selects the machine-readable format of a describe
+// command's output.
+func BindDescribeFlags(flags *pflag.FlagSet, format *string) {
+	flags.StringVarP(format, "output", "o", *format, "Output display format. Valid formats are 'json' and 'yaml'. If it's not set, a human-readable description is printed.")
+}
+
+// ValidateDescribeFormat returns an error if format isn't a valid value of the describe commands'
+// output flag.
+func ValidateDescribeFormat(format string) error {
+	switch format {
+	case "", "json", "yaml":
+		return nil
+	}
+	return errors.Errorf("invalid output format %q - valid values are 'json' and 'yaml'", format)
+}
+
+// BackupDescription is the machine-readable description of a backup. Along with the backup
+// itself, it holds the data about the backup that's read from other objects or downloaded from
+// object storage, which is also shown by the human-readable description.
+type BackupDescription struct {
+	Backup *velerov1api.Backup `json:"backup"`
+	// Results are the warnings and errors of the backup, keyed by "warnings" and "errors".
+	Results                   map[string]results.Result                  `json:"results,omitempty"`
+	ResourceList              map[string][]string                        `json:"resourceList,omitempty"`
+	VolumeSnapshots           []*volume.Snapshot                         `json:"volumeSnapshots,omitempty"`
+	PodVolumeBackups          []velerov1api.PodVolumeBackup              `json:"podVolumeBackups,omitempty"`
+	CSIVolumeSnapshotContents []snapshotv1beta1api.VolumeSnapshotContent `json:"csiVolumeSnapshotContents,omitempty"`
+	DeleteBackupRequests      []velerov1api.DeleteBackupRequest          `json:"deleteBackupRequests,omitempty"`
+	// Errors are the errors that occurred getting the data of the description.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// NewBackupDescription returns the machine-readable description of a backup.
+func NewBackupDescription(
+	ctx context.Context,
+	kbClient kbclient.Client,
+	backup *velerov1api.Backup,
+	deleteRequests []velerov1api.DeleteBackupRequest,
+	podVolumeBackups []velerov1api.PodVolumeBackup,
+	volumeSnapshotContents []snapshotv1beta1api.VolumeSnapshotContent,
+	insecureSkipTLSVerify bool,
+	caCertFile string,
+) *BackupDescription {
+	desc := &BackupDescription{
+		Backup:                    backup,
+		PodVolumeBackups:          podVolumeBackups,
+		CSIVolumeSnapshotContents: volumeSnapshotContents,
+		DeleteBackupRequests:      deleteRequests,
+	}
+
+	download := func(kind velerov1api.DownloadTargetKind, into interface{}) {
+		if err := downloadJSON(ctx, kbClient, backup.Namespace, backup.Name, kind, into, insecureSkipTLSVerify, caCertFile); err != nil {
+			desc.Errors = append(desc.Errors, err.Error())
+		}
+	}
+
+	if backup.Status.Warnings > 0 || backup.Status.Errors > 0 {
+		download(velerov1api.DownloadTargetKindBackupResults, &desc.Results)
+	}
+	if isBackupDone(backup) {
+		download(velerov1api.DownloadTargetKindBackupResourceList, &desc.ResourceList)
+	}
+	if backup.Status.VolumeSnapshotsAttempted > 0 {
+		download(velerov1api.DownloadTargetKindBackupVolumeSnapshots, &desc.VolumeSnapshots)
+	}
+
+	return desc
+}
+
+// RestoreDescription is the machine-readable description of a restore. Along with the restore
+// itself, it holds the data about the restore that's read from other objects or downloaded from
+// object storage, which is also shown by the human-readable description.
+type RestoreDescription struct {
+	Restore *velerov1api.Restore `json:"restore"`
+	// Results are the warnings and errors of the restore, keyed by "warnings" and "errors".
+	Results           map[string]results.Result      `json:"results,omitempty"`
+	PodVolumeRestores []velerov1api.PodVolumeRestore `json:"podVolumeRestores,omitempty"`
+	// Errors are the errors that occurred getting the data of the description.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// NewRestoreDescription returns the machine-readable description of a restore.
+func NewRestoreDescription(
+	ctx context.Context,
+	kbClient kbclient.Client,
+	restore *velerov1api.Restore,
+	podVolumeRestores []velerov1api.PodVolumeRestore,
+	insecureSkipTLSVerify bool,
+	caCertFile string,
+) *RestoreDescription {
+	desc := &RestoreDescription{
+		Restore:           restore,
+		PodVolumeRestores: podVolumeRestores,
+	}
+
+	if restore.Status.Warnings > 0 || restore.Status.Errors > 0 {
+		if err := downloadJSON(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreResults, &desc.Results, insecureSkipTLSVerify, caCertFile); err != nil {
+			desc.Errors = append(desc.Errors, err.Error())
+		}
+	}
+
+	return desc
+}
+
+// ScheduleDescription is the machine-readable description of a schedule.
+type ScheduleDescription struct {
+	Schedule *velerov1api.Schedule `json:"schedule"`
+}
+
+// PrintDescriptions writes descriptions, a slice of descriptions, to w in format, which is "json"
+// or "yaml". A slice with a single description is written as that description alone.
+func PrintDescriptions(w io.Writer, format string, descriptions interface{}) error {
+	var toPrint interface{} = descriptions
+	switch d := descriptions.(type) {
+	case []*BackupDescription:
+		if len(d) == 1 {
+			toPrint = d[0]
+		}
+	case []*RestoreDescription:
+		if len(d) == 1 {
+			toPrint = d[0]
+		}
+	case []*ScheduleDescription:
+		if len(d) == 1 {
+			toPrint = d[0]
+		}
+	}
+
+	var (
+		encoded []byte
+		err     error
+	)
+	switch format {
+	case "json":
+		encoded, err = json.MarshalIndent(toPrint, "", "  ")
+	case "yaml":
+		encoded, err = yaml.Marshal(toPrint)
+	default:
+		return errors.Errorf("unsupported output format %q; valid values are 'json' and 'yaml'", format)
+	}
+	if err != nil {
+		return errors.Wrap(err, "error encoding description")
+	}
+
+	_, err = fmt.Fprintln(w, string(bytes.TrimRight(encoded, "\n")))
+	return err
+}
+
+// downloadJSON downloads the target of the given kind for the backup or restore with name, and
+// decodes it from JSON into into.
+func downloadJSON(ctx context.Context, kbClient kbclient.Client, namespace, name string, kind velerov1api.DownloadTargetKind, into interface{}, insecureSkipTLSVerify bool, caCertFile string) error {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, namespace, name, kind, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertFile); err != nil {
+		return errors.Wrapf(err, "error downloading %s", kind)
+	}
+	if err := json.NewDecoder(buf).Decode(into); err != nil {
+		return errors.Wrapf(err, "error decoding %s", kind)
+	}
+	return nil
+}
+
+// isBackupDone returns whether the backup's phase is a terminal phase, in which its files are
+// in object storage.
+func isBackupDone(backup *velerov1api.Backup) bool {
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func TestPrintDescriptions(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result()
+	description := &BackupDescription{
+		Backup:       backup,
+		Results:      map[string]results.Result{"warnings": {Velero: []string{"warning-1"}}},
+		ResourceList: map[string][]string{"v1/Pod": {"ns-1/pod-1"}},
+	}
+
+	tests := []struct {
+		name         string
+		format       string
+		descriptions interface{}
+		expected     string
+		expectedErr  bool
+	}{
+		{
+			name:         "a single description is printed on its own",
+			format:       "json",
+			descriptions: []*BackupDescription{description},
+			expected: `{
+  "backup": {
+    "kind": "Backup",
+    "apiVersion": "velero.io/v1",
+    "metadata": {
+      "name": "backup-1",
+      "namespace": "velero",
+      "creationTimestamp": null
+    },
+    "spec": {
+      "metadata": {},
+      "ttl": "0s",
+      "hooks": {}
+    },
+    "status": {
+      "phase": "Completed"
+    }
+  },
+  "results": {
+    "warnings": {
+      "velero": [
+        "warning-1"
+      ]
+    }
+  },
+  "resourceList": {
+    "v1/Pod": [
+      "ns-1/pod-1"
+    ]
+  }
+}
+`,
+		},
+		{
+			name:   "several descriptions are printed as a list",
+			format: "yaml",
+			descriptions: []*ScheduleDescription{
+				{Schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Result()},
+				{Schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-2").Result()},
+			},
+			expected: `- schedule:
+    apiVersion: velero.io/v1
+    kind: Schedule
+    metadata:
+      creationTimestamp: null
+      name: schedule-1
+      namespace: velero
+    spec:
+      schedule: ""
+      template:
+        hooks: {}
+        metadata: {}
+        ttl: 0s
+    status: {}
+- schedule:
+    apiVersion: velero.io/v1
+    kind: Schedule
+    metadata:
+      creationTimestamp: null
+      name: schedule-2
+      namespace: velero
+    spec:
+      schedule: ""
+      template:
+        hooks: {}
+        metadata: {}
+        ttl: 0s
+    status: {}
+`,
+		},
+		{
+			name:         "unsupported format is an error",
+			format:       "table",
+			descriptions: []*BackupDescription{description},
+			expectedErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			err := PrintDescriptions(buf, test.format, test.descriptions)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestValidateDescribeFormat(t *testing.T) {
+	assert.NoError(t, ValidateDescribeFormat(""))
+	assert.NoError(t, ValidateDescribeFormat("json"))
+	assert.NoError(t, ValidateDescribeFormat("yaml"))
+	assert.Error(t, ValidateDescribeFormat("table"))
+}
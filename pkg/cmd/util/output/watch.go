@@ -0,0 +1,178 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+// BindWatchFlag defines the flag that makes a get command watch for changes to the objects it
+// prints.
+func BindWatchFlag(flags *pflag.FlagSet, watch *bool) {
+	flags.BoolVarP(watch, "watch", "w", *watch, "After printing the requested objects, keep printing them as they're created, changed or deleted.")
+}
+
+// IncludeNames returns a filter for Watch that includes only the objects with the given names.
+// It includes all objects if names is empty.
+func IncludeNames(names []string) func(metav1.Object) bool {
+	if len(names) == 0 {
+		return nil
+	}
+
+	included := sets.NewString(names...)
+	return func(obj metav1.Object) bool {
+		return included.Has(obj.GetName())
+	}
+}
+
+// Watch prints the objects in informer's cache, sorted by name, in the format specified by the
+// command's flags, and then prints each object again whenever the informer reports that it was
+// created, changed or deleted, until ctx is done. Only the objects that include returns true for
+// are printed; a nil include prints all of them. In the table format the column headers are
+// printed only once.
+func Watch(ctx context.Context, c *cobra.Command, informer cache.SharedIndexInformer, include func(metav1.Object) bool) error {
+	printer, err := newWatchPrinter(c, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	go informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return errors.New("timed out waiting for the informer cache to sync")
+	}
+
+	var (
+		// lock guards printed and serializes the printing of the initial objects and the changes
+		lock sync.Mutex
+		// printed holds the resource version of each object as it was last printed, so that the
+		// informer's replay of the initial objects and its resyncs aren't printed again.
+		printed = make(map[types.UID]string)
+	)
+
+	print := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		object, ok := obj.(runtime.Object)
+		if !ok {
+			return
+		}
+		accessor, err := meta.Accessor(object)
+		if err != nil || (include != nil && !include(accessor)) {
+			return
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		if printed[accessor.GetUID()] == accessor.GetResourceVersion() {
+			return
+		}
+		printed[accessor.GetUID()] = accessor.GetResourceVersion()
+
+		if err := printer(object); err != nil {
+			fmt.Fprintf(os.Stderr, "error printing %s: %v\n", accessor.GetName(), err)
+		}
+	}
+
+	initial := informer.GetStore().List()
+	sort.Slice(initial, func(i, j int) bool {
+		return objectName(initial[i]) < objectName(initial[j])
+	})
+	for _, obj := range initial {
+		print(obj)
+	}
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    print,
+		UpdateFunc: func(_, obj interface{}) { print(obj) },
+		// a deleted object is printed as it was last seen, so that its deletion is reported
+		DeleteFunc: func(obj interface{}) {
+			lock.Lock()
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if accessor, err := meta.Accessor(obj); err == nil {
+				delete(printed, accessor.GetUID())
+			}
+			lock.Unlock()
+
+			print(obj)
+		},
+	})
+
+	<-ctx.Done()
+	return nil
+}
+
+// newWatchPrinter returns a function that prints a single object to w in the format specified by
+// the command's flags.
+func newWatchPrinter(c *cobra.Command, w io.Writer) (func(runtime.Object) error, error) {
+	format := GetOutputFlagValue(c)
+	switch format {
+	case "", "table":
+		// the same table printer is used for all objects so that it prints the headers only once
+		tablePrinter, err := NewPrinter(c)
+		if err != nil {
+			return nil, err
+		}
+		return func(obj runtime.Object) error {
+			table, err := tableFor(obj)
+			if err != nil {
+				return err
+			}
+			return tablePrinter.PrintObj(table, w)
+		}, nil
+	case "json", "yaml":
+		return func(obj runtime.Object) error {
+			if format == "yaml" {
+				// separate the YAML documents of the objects
+				if _, err := fmt.Fprintln(w, "---"); err != nil {
+					return err
+				}
+			}
+			return encode.EncodeTo(obj.DeepCopyObject(), format, w)
+		}, nil
+	}
+
+	return nil, errors.Errorf("unsupported output format %q; valid values are 'table', 'json', and 'yaml'", format)
+}
+
+func objectName(obj interface{}) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetName()
+}
@@ -0,0 +1,65 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestIncludeNames(t *testing.T) {
+	assert.Nil(t, IncludeNames(nil))
+
+	include := IncludeNames([]string{"backup-1", "backup-2"})
+	assert.True(t, include(builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()))
+	assert.False(t, include(builder.ForBackup(velerov1api.DefaultNamespace, "backup-3").Result()))
+}
+
+func TestWatchPrinterPrintsTableHeadersOnce(t *testing.T) {
+	c := &cobra.Command{}
+	BindFlags(c.Flags())
+
+	buf := new(bytes.Buffer)
+	printer, err := newWatchPrinter(c, buf)
+	require.NoError(t, err)
+
+	require.NoError(t, printer(builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result()))
+	require.NoError(t, printer(builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result()))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "NAME"))
+	assert.Contains(t, lines[1], "InProgress")
+	assert.Contains(t, lines[2], "Completed")
+}
+
+func TestWatchPrinterInvalidFormat(t *testing.T) {
+	c := &cobra.Command{}
+	BindFlags(c.Flags())
+	require.NoError(t, c.Flags().Set("output", "wide"))
+
+	_, err := newWatchPrinter(c, new(bytes.Buffer))
+	assert.Error(t, err)
+}
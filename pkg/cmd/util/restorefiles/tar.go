@@ -0,0 +1,186 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package restorefiles streams the files restored from a pod volume backup from the restic
+// daemonset to the CLI, as a tar archive.
+package restorefiles
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// WriteTar writes the contents of dir to w as a tar archive, with paths relative to dir.
+// Symlinks are archived as links rather than followed.
+func WriteTar(w io.Writer, dir string) error {
+	tw := tar.NewWriter(w)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		header.Name = filepath.ToSlash(rel)
+
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.WithStack(err)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tw, file)
+		return errors.WithStack(err)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error archiving directory %s", dir)
+	}
+
+	return errors.WithStack(tw.Close())
+}
+
+// ExtractTar extracts the tar archive read from r into dir, and returns the number of files
+// extracted. Entries that would be written outside of dir, either directly or through a
+// symlink, are rejected.
+func ExtractTar(r io.Reader, dir string) (int, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return 0, errors.WithStack(err)
+	}
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	tr := tar.NewReader(r)
+	files := 0
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return files, errors.Wrap(err, "error reading tar archive")
+		}
+
+		target, err := targetPath(root, header.Name)
+		if err != nil {
+			return files, err
+		}
+
+		mode := os.FileMode(header.Mode).Perm()
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, mode|0700); err != nil {
+				return files, errors.WithStack(err)
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return files, errors.WithStack(err)
+			}
+		case tar.TypeReg:
+			if err := writeFile(target, mode, tr); err != nil {
+				return files, err
+			}
+			if err := os.Chtimes(target, header.ModTime, header.ModTime); err != nil {
+				return files, errors.WithStack(err)
+			}
+			files++
+		}
+	}
+}
+
+// targetPath returns the path within root that the tar entry name is extracted to, checking
+// that no symlink in its parent directories leads outside of root, and creates the parent
+// directories that don't exist.
+func targetPath(root, name string) (string, error) {
+	target := filepath.Join(root, filepath.Clean("/"+name))
+	if target == root {
+		return "", errors.Errorf("invalid path %q in tar archive", name)
+	}
+
+	existing := filepath.Dir(target)
+	for {
+		_, err := os.Lstat(existing)
+		if err == nil {
+			break
+		}
+		if !os.IsNotExist(err) {
+			return "", errors.WithStack(err)
+		}
+		existing = filepath.Dir(existing)
+	}
+
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if resolved != root && !strings.HasPrefix(resolved, root+string(filepath.Separator)) {
+		return "", errors.Errorf("path %q in tar archive is outside of the target directory", name)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	// don't write through a symlink that an earlier entry created at the same path
+	if info, err := os.Lstat(target); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if err := os.Remove(target); err != nil {
+			return "", errors.WithStack(err)
+		}
+	}
+
+	return target, nil
+}
+
+func writeFile(path string, mode os.FileMode, r io.Reader) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return errors.Wrapf(err, "error writing file %s", path)
+	}
+	return errors.WithStack(file.Close())
+}
@@ -0,0 +1,148 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorefiles
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteAndExtractTar(t *testing.T) {
+	src, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(src)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "var", "lib", "empty"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "var", "lib", "file"), []byte("contents"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "top"), []byte("top"), 0644))
+	require.NoError(t, os.Symlink("var/lib/file", filepath.Join(src, "link")))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, WriteTar(buf, src))
+
+	dest, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dest)
+
+	files, err := ExtractTar(buf, filepath.Join(dest, "out"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, files)
+
+	data, err := ioutil.ReadFile(filepath.Join(dest, "out", "var", "lib", "file"))
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+
+	info, err := os.Stat(filepath.Join(dest, "out", "var", "lib", "file"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	data, err = ioutil.ReadFile(filepath.Join(dest, "out", "top"))
+	require.NoError(t, err)
+	assert.Equal(t, "top", string(data))
+
+	link, err := os.Readlink(filepath.Join(dest, "out", "link"))
+	require.NoError(t, err)
+	assert.Equal(t, "var/lib/file", link)
+
+	info, err = os.Stat(filepath.Join(dest, "out", "var", "lib", "empty"))
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+}
+
+func TestExtractTarOutsideOfDir(t *testing.T) {
+	type entry struct {
+		header   *tar.Header
+		contents string
+	}
+
+	tests := []struct {
+		name        string
+		entries     []entry
+		expectedErr string
+		// expectedFile is the path, relative to the extraction directory, of the file that the
+		// last entry is expected to have been written to
+		expectedFile string
+	}{
+		{
+			name: "parent directory references are kept within the directory",
+			entries: []entry{
+				{header: &tar.Header{Name: "../../escaped", Typeflag: tar.TypeReg, Mode: 0644}, contents: "x"},
+			},
+			expectedFile: "escaped",
+		},
+		{
+			name: "absolute paths are kept within the directory",
+			entries: []entry{
+				{header: &tar.Header{Name: "/abs/file", Typeflag: tar.TypeReg, Mode: 0644}, contents: "x"},
+			},
+			expectedFile: "abs/file",
+		},
+		{
+			name: "file under a symlink to outside of the directory is rejected",
+			entries: []entry{
+				{header: &tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/", Mode: 0777}},
+				{header: &tar.Header{Name: "link/sub/file", Typeflag: tar.TypeReg, Mode: 0644}, contents: "x"},
+			},
+			expectedErr: `path "link/sub/file" in tar archive is outside of the target directory`,
+		},
+		{
+			name: "file replacing a symlink to outside of the directory doesn't write through it",
+			entries: []entry{
+				{header: &tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc/hostname", Mode: 0777}},
+				{header: &tar.Header{Name: "link", Typeflag: tar.TypeReg, Mode: 0644}, contents: "x"},
+			},
+			expectedFile: "link",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			tw := tar.NewWriter(buf)
+			for _, e := range tc.entries {
+				e.header.Size = int64(len(e.contents))
+				require.NoError(t, tw.WriteHeader(e.header))
+				_, err := tw.Write([]byte(e.contents))
+				require.NoError(t, err)
+			}
+			require.NoError(t, tw.Close())
+
+			parent, err := ioutil.TempDir("", "")
+			require.NoError(t, err)
+			defer os.RemoveAll(parent)
+			dest := filepath.Join(parent, "a", "b")
+
+			_, err = ExtractTar(buf, dest)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			info, err := os.Lstat(filepath.Join(dest, tc.expectedFile))
+			require.NoError(t, err)
+			assert.True(t, info.Mode().IsRegular())
+		})
+	}
+}
@@ -30,13 +30,16 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
 	snapshotv1beta1listers "github.com/kubernetes-csi/external-snapshotter/client/v4/listers/volumesnapshot/v1beta1"
@@ -50,22 +53,36 @@ import (
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/logstream"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// dataUploadPollInterval is how often a backup checks whether the
+	// data uploads of its CSI snapshots are done.
+	dataUploadPollInterval = 5 * time.Second
+
+	// dataUploadTimeout is how long a backup waits for the data uploads
+	// of all of its CSI snapshots to be done.
+	dataUploadTimeout = 4 * time.Hour
+)
+
 type backupController struct {
 	*genericController
+	ctx                         context.Context
 	discoveryHelper             discovery.Helper
 	backupper                   pkgbackup.Backupper
 	lister                      velerov1listers.BackupLister
@@ -73,7 +90,7 @@ type backupController struct {
 	kbClient                    kbclient.Client
 	clock                       clock.Clock
 	backupLogLevel              logrus.Level
-	newPluginManager            func(logrus.FieldLogger) clientmgmt.Manager
+	newPluginManager            func(context.Context, logrus.FieldLogger) clientmgmt.Manager
 	backupTracker               BackupTracker
 	defaultBackupLocation       string
 	defaultVolumesToRestic      bool
@@ -85,16 +102,19 @@ type backupController struct {
 	formatFlag                  logging.Format
 	volumeSnapshotLister        snapshotv1beta1listers.VolumeSnapshotLister
 	volumeSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister
+	eventRecorder               record.EventRecorder
+	logStreams                  *logstream.Broker
 }
 
 func NewBackupController(
+	ctx context.Context,
 	backupInformer velerov1informers.BackupInformer,
 	client velerov1client.BackupsGetter,
 	discoveryHelper discovery.Helper,
 	backupper pkgbackup.Backupper,
 	logger logrus.FieldLogger,
 	backupLogLevel logrus.Level,
-	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	newPluginManager func(context.Context, logrus.FieldLogger) clientmgmt.Manager,
 	backupTracker BackupTracker,
 	kbClient kbclient.Client,
 	defaultBackupLocation string,
@@ -107,9 +127,12 @@ func NewBackupController(
 	volumeSnapshotLister snapshotv1beta1listers.VolumeSnapshotLister,
 	volumeSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	eventRecorder record.EventRecorder,
+	logStreams *logstream.Broker,
 ) Interface {
 	c := &backupController{
 		genericController:           newGenericController(Backup, logger),
+		ctx:                         ctx,
 		discoveryHelper:             discoveryHelper,
 		backupper:                   backupper,
 		lister:                      backupInformer.Lister(),
@@ -129,6 +152,8 @@ func NewBackupController(
 		volumeSnapshotLister:        volumeSnapshotLister,
 		volumeSnapshotContentLister: volumeSnapshotContentLister,
 		backupStoreGetter:           backupStoreGetter,
+		eventRecorder:               eventRecorder,
+		logStreams:                  logStreams,
 	}
 
 	c.syncHandler = c.processBackup
@@ -158,6 +183,24 @@ func NewBackupController(
 				}
 				c.queue.Add(key)
 			},
+			DeleteFunc: func(obj interface{}) {
+				key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+				if err != nil {
+					c.logger.WithError(err).Error("Error creating queue key for deleted backup")
+					return
+				}
+				ns, name, err := cache.SplitMetaNamespaceKey(key)
+				if err != nil {
+					c.logger.WithError(err).WithField(Backup, key).Error("Error splitting queue key for deleted backup")
+					return
+				}
+
+				// cancel the backup's context if it's in progress, which cancels any calls
+				// to plugins and waits for pod volume backups that are being made for it.
+				if c.backupTracker.Cancel(ns, name) {
+					c.logger.WithField(Backup, key).Info("Backup was deleted while in progress, cancelling it")
+				}
+			},
 		},
 	)
 
@@ -258,12 +301,17 @@ func (c *backupController) processBackup(key string) error {
 	// store ref to just-updated item for creating patch
 	original = updatedBackup
 	request.Backup = updatedBackup.DeepCopy()
+	recordBackupPhaseEvent(c.eventRecorder, request.Backup, nil)
 
 	if request.Status.Phase == velerov1api.BackupPhaseFailedValidation {
 		return nil
 	}
 
-	c.backupTracker.Add(request.Namespace, request.Name)
+	// The backup's context is cancelled through the backup tracker if the backup is
+	// deleted while it's in progress.
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+	c.backupTracker.Add(request.Namespace, request.Name, cancel)
 	defer c.backupTracker.Delete(request.Namespace, request.Name)
 
 	log.Debug("Running backup")
@@ -272,14 +320,15 @@ func (c *backupController) processBackup(key string) error {
 	c.metrics.RegisterBackupAttempt(backupScheduleName)
 
 	// execution & upload of backup
-	if err := c.runBackup(request); err != nil {
+	backupErr := c.runBackup(ctx, request)
+	if backupErr != nil {
 		// even though runBackup sets the backup's phase prior
 		// to uploading artifacts to object storage, we have to
 		// check for an error again here and update the phase if
 		// one is found, because there could've been an error
 		// while uploading artifacts to object storage, which would
 		// result in the backup being Failed.
-		log.WithError(err).Error("backup failed")
+		log.WithError(backupErr).Error("backup failed")
 		request.Status.Phase = velerov1api.BackupPhaseFailed
 	}
 
@@ -288,7 +337,7 @@ func (c *backupController) processBackup(key string) error {
 		c.metrics.RegisterBackupSuccess(backupScheduleName)
 	case velerov1api.BackupPhasePartiallyFailed:
 		c.metrics.RegisterBackupPartialFailure(backupScheduleName)
-	case velerov1api.BackupPhaseFailed:
+	case velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedPreBackupActions:
 		c.metrics.RegisterBackupFailed(backupScheduleName)
 	case velerov1api.BackupPhaseFailedValidation:
 		c.metrics.RegisterBackupValidationFailure(backupScheduleName)
@@ -298,6 +347,7 @@ func (c *backupController) processBackup(key string) error {
 	if _, err := patchBackup(original, request.Backup, c.client); err != nil {
 		log.WithError(err).Error("error updating backup's final status")
 	}
+	recordBackupPhaseEvent(c.eventRecorder, request.Backup, backupErr)
 
 	return nil
 }
@@ -530,8 +580,12 @@ func (c *backupController) validateAndGetSnapshotLocations(backup *velerov1api.B
 
 // runBackup runs and uploads a validated backup. Any error returned from this function
 // causes the backup to be Failed; if no error is returned, the backup's status's Errors
-// field is checked to see if the backup was a partial failure.
-func (c *backupController) runBackup(backup *pkgbackup.Request) error {
+// field is checked to see if the backup was a partial failure. Calls to plugins are
+// cancelled when ctx is done, in which case the backup isn't persisted to object storage.
+func (c *backupController) runBackup(ctx context.Context, backup *pkgbackup.Request) error {
+	ctx, span := tracing.Tracer().Start(ctx, "Backup", trace.WithAttributes(tracing.BackupNameKey.String(backup.Name)))
+	defer span.End()
+
 	c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Setting up backup log")
 
 	logFile, err := ioutil.TempFile("", "")
@@ -552,6 +606,13 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	logCounter := logging.NewLogCounterHook()
 	logger.Hooks.Add(logCounter)
 
+	// Keep the backup log in memory while the backup is in progress so that it can be followed.
+	if c.logStreams != nil {
+		logStream := c.logStreams.Open(logstream.KindBackup, backup.Name, logger.Formatter)
+		logger.Hooks.Add(logStream)
+		defer logStream.Close()
+	}
+
 	backupLog := logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
 
 	backupLog.Info("Setting up backup temp file")
@@ -562,7 +623,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	defer closeAndRemoveFile(backupFile, backupLog)
 
 	backupLog.Info("Setting up plugin manager")
-	pluginManager := c.newPluginManager(backupLog)
+	pluginManager := c.newPluginManager(ctx, backupLog)
 	defer pluginManager.CleanupClients()
 
 	backupLog.Info("Getting backup item actions")
@@ -575,6 +636,16 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 
+	backupLog.Info("Getting pre-backup and post-backup actions")
+	preBackupActions, err := pluginManager.GetPreBackupActions()
+	if err != nil {
+		return err
+	}
+	postBackupActions, err := pluginManager.GetPostBackupActions()
+	if err != nil {
+		return err
+	}
+
 	backupLog.Info("Setting up backup store to check for backup existence")
 	backupStore, err := c.backupStoreGetter.Get(backup.StorageLocation, pluginManager, backupLog)
 	if err != nil {
@@ -591,13 +662,42 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return errors.Errorf("backup already exists in object storage")
 	}
 
-	backupItemActionsResolver := framework.NewBackupItemActionResolver(actions)
-	itemSnapshottersResolver := framework.NewItemSnapshotterResolver(itemSnapshotters)
+	// if a pre-backup action fails, nothing is backed up, but the backup's log and
+	// metadata are still persisted so that the failure can be looked into.
+	var preBackupActionsFailed bool
+	if len(preBackupActions) > 0 {
+		actions := make(map[string]func() error, len(preBackupActions))
+		for name, action := range preBackupActions {
+			action := action
+			actions[name] = func() error { return action.Execute(backup.Backup) }
+		}
+
+		backupLog.Info("Running pre-backup actions")
+		statuses, failed := runPluginActions(actions, true, c.clock, backupLog)
+		backup.Status.PreBackupActionsStatuses = statuses
+		if failed {
+			backupLog.Error("Not running backup because a pre-backup action failed")
+			preBackupActionsFailed = true
+		}
+	}
 
 	var fatalErrs []error
-	if err := c.backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver,
-		itemSnapshottersResolver, pluginManager); err != nil {
-		fatalErrs = append(fatalErrs, err)
+	if !preBackupActionsFailed {
+		backupItemActionsResolver := framework.NewBackupItemActionResolver(actions)
+		itemSnapshottersResolver := framework.NewItemSnapshotterResolver(itemSnapshotters)
+
+		if err := c.backupper.BackupWithResolvers(ctx, backupLog, backup, backupFile, backupItemActionsResolver,
+			itemSnapshottersResolver, pluginManager); err != nil {
+			fatalErrs = append(fatalErrs, err)
+		}
+	}
+
+	// If the backup was deleted or the server is shutting down, don't persist what was
+	// backed up before the backup was cancelled.
+	if err := ctx.Err(); err != nil {
+		backup.Status.Phase = velerov1api.BackupPhaseFailed
+		backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		return errors.Wrap(err, "backup was cancelled because it was deleted or the server is shutting down")
 	}
 
 	// Empty slices here so that they can be passed in to the persistBackup call later, regardless of whether or not CSI's enabled.
@@ -623,6 +723,10 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 				backupLog.Error(err)
 			}
 		}
+
+		if boolptr.IsSetToTrue(backup.Spec.SnapshotMoveData) {
+			backup.DataUploads = c.moveSnapshotData(ctx, backup, volumeSnapshots, backupLog)
+		}
 	}
 
 	// Mark completion timestamp before serializing and uploading.
@@ -639,6 +743,11 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	backup.Status.Warnings = logCounter.GetCount(logrus.WarnLevel)
 	backup.Status.Errors = logCounter.GetCount(logrus.ErrorLevel)
 
+	backupResults := map[string]results.Result{
+		"warnings": logCounter.GetEntries(logrus.WarnLevel),
+		"errors":   logCounter.GetEntries(logrus.ErrorLevel),
+	}
+
 	recordBackupMetrics(backupLog, backup.Backup, backupFile, c.metrics)
 
 	if err := gzippedLogFile.Close(); err != nil {
@@ -650,6 +759,8 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	// artifacts to object storage so that the JSON representation of the
 	// backup in object storage has the terminal phase set.
 	switch {
+	case preBackupActionsFailed:
+		backup.Status.Phase = velerov1api.BackupPhaseFailedPreBackupActions
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
 	case logCounter.GetCount(logrus.ErrorLevel) > 0:
@@ -666,17 +777,163 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 
-	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents); len(errs) > 0 {
+	_, persistSpan := tracing.Tracer().Start(ctx, "PutBackup")
+	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents, backupResults); len(errs) > 0 {
 		fatalErrs = append(fatalErrs, errs...)
+		tracing.EndSpan(persistSpan, kerrors.NewAggregate(errs))
+	} else {
+		persistSpan.End()
 	}
 
 	c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup completed")
 
+	// post-backup actions are run once the backup has been persisted. The backup log has already
+	// been uploaded by now, so their output only goes to the server log, but the backup's metadata
+	// is uploaded again so that it includes their statuses.
+	if len(fatalErrs) == 0 && !preBackupActionsFailed && len(postBackupActions) > 0 {
+		log := c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
+
+		actions := make(map[string]func() error, len(postBackupActions))
+		for name, action := range postBackupActions {
+			action := action
+			actions[name] = func() error { return action.Execute(backup.Backup) }
+		}
+
+		statuses, failed := runPluginActions(actions, false, c.clock, log)
+		backup.Status.PostBackupActionsStatuses = statuses
+		if failed && backup.Status.Phase == velerov1api.BackupPhaseCompleted {
+			backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+		}
+
+		backupJSON := new(bytes.Buffer)
+		if err := encode.EncodeTo(backup.Backup, "json", backupJSON); err != nil {
+			fatalErrs = append(fatalErrs, errors.Wrap(err, "error encoding backup"))
+		} else if err := backupStore.PutBackupMetadata(backup.Name, backupJSON); err != nil {
+			fatalErrs = append(fatalErrs, errors.Wrap(err, "error uploading backup metadata"))
+		}
+	}
+
 	// if we return a non-nil error, the calling function will update
 	// the backup's phase to Failed.
 	return kerrors.NewAggregate(fatalErrs)
 }
 
+// moveSnapshotData creates a DataUpload for each of the backup's CSI volume snapshots,
+// and waits for their data to be uploaded to the backup storage location, or for ctx
+// to be done.
+func (c *backupController) moveSnapshotData(ctx context.Context, backup *pkgbackup.Request, volumeSnapshots []*snapshotv1beta1api.VolumeSnapshot, log logrus.FieldLogger) []*velerov1api.DataUpload {
+	var dataUploads []*velerov1api.DataUpload
+	for _, vs := range volumeSnapshots {
+		if vs.Spec.Source.PersistentVolumeClaimName == nil {
+			log.Warnf("Volume snapshot %s/%s wasn't taken from a persistent volume claim, not moving its data", vs.Namespace, vs.Name)
+			continue
+		}
+
+		dataUpload := newDataUpload(backup.Backup, vs)
+		if err := c.kbClient.Create(ctx, dataUpload); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("Error creating data upload for volume snapshot %s/%s", vs.Namespace, vs.Name)
+			continue
+		}
+		log.Infof("Created data upload %s for volume snapshot %s/%s", dataUpload.Name, vs.Namespace, vs.Name)
+
+		dataUploads = append(dataUploads, dataUpload)
+	}
+
+	// wait for all of the uploads together, so that the backup's uploads share
+	// one deadline no matter how many there are.
+	waitCtx, cancel := context.WithTimeout(ctx, dataUploadTimeout)
+	defer cancel()
+
+	done := make([]bool, len(dataUploads))
+	err := wait.PollImmediateUntil(dataUploadPollInterval, func() (bool, error) {
+		allDone := true
+		for i, dataUpload := range dataUploads {
+			if done[i] {
+				continue
+			}
+
+			updated := &velerov1api.DataUpload{}
+			if err := c.kbClient.Get(waitCtx, kbclient.ObjectKeyFromObject(dataUpload), updated); err != nil {
+				if apierrors.IsNotFound(err) {
+					log.Errorf("Data upload %s was deleted before it completed", dataUpload.Name)
+					done[i] = true
+					continue
+				}
+				log.WithError(errors.WithStack(err)).Warnf("Error getting data upload %s", dataUpload.Name)
+				allDone = false
+				continue
+			}
+			dataUploads[i] = updated
+
+			switch updated.Status.Phase {
+			case velerov1api.DataUploadPhaseCompleted, velerov1api.DataUploadPhaseFailed, velerov1api.DataUploadPhaseCanceled:
+				done[i] = true
+			default:
+				allDone = false
+			}
+		}
+		return allDone, nil
+	}, waitCtx.Done())
+	if err != nil {
+		log.WithError(err).Error("Error waiting for data uploads to complete, canceling the ones that are still running")
+	}
+
+	for i, dataUpload := range dataUploads {
+		if !done[i] {
+			// ctx may be done, so don't use it to cancel the upload.
+			c.cancelDataUpload(dataUpload, log)
+			continue
+		}
+
+		if dataUpload.Status.Phase != velerov1api.DataUploadPhaseCompleted {
+			log.Errorf("Data upload %s of volume snapshot %s/%s didn't complete: phase %s, %s", dataUpload.Name,
+				dataUpload.Spec.SourceNamespace, dataUpload.Spec.VolumeSnapshot, dataUpload.Status.Phase, dataUpload.Status.Message)
+		}
+	}
+
+	return dataUploads
+}
+
+// cancelDataUpload sets the data upload's spec.cancel, so that the data upload
+// controller stops it.
+func (c *backupController) cancelDataUpload(dataUpload *velerov1api.DataUpload, log logrus.FieldLogger) {
+	original := dataUpload.DeepCopy()
+	dataUpload.Spec.Cancel = true
+	if err := c.kbClient.Patch(context.Background(), dataUpload, kbclient.MergeFrom(original)); err != nil && !apierrors.IsNotFound(err) {
+		log.WithError(errors.WithStack(err)).Errorf("Error canceling data upload %s", dataUpload.Name)
+		return
+	}
+	log.Infof("Canceled data upload %s", dataUpload.Name)
+}
+
+func newDataUpload(backup *velerov1api.Backup, vs *snapshotv1beta1api.VolumeSnapshot) *velerov1api.DataUpload {
+	return &velerov1api.DataUpload{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    backup.Namespace,
+			GenerateName: backup.Name + "-",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: velerov1api.SchemeGroupVersion.String(),
+					Kind:       "Backup",
+					Name:       backup.Name,
+					UID:        backup.UID,
+					Controller: boolptr.True(),
+				},
+			},
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+				velerov1api.BackupUIDLabel:  string(backup.UID),
+			},
+		},
+		Spec: velerov1api.DataUploadSpec{
+			VolumeSnapshot:        vs.Name,
+			SourceNamespace:       vs.Namespace,
+			SourcePVC:             *vs.Spec.Source.PersistentVolumeClaimName,
+			BackupStorageLocation: backup.Spec.StorageLocation,
+		},
+	}
+}
+
 func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
@@ -706,6 +963,7 @@ func persistBackup(backup *pkgbackup.Request,
 	log logrus.FieldLogger,
 	csiVolumeSnapshots []*snapshotv1beta1api.VolumeSnapshot,
 	csiVolumeSnapshotContents []*snapshotv1beta1api.VolumeSnapshotContent,
+	backupResults map[string]results.Result,
 ) []error {
 	persistErrs := []error{}
 	backupJSON := new(bytes.Buffer)
@@ -735,11 +993,21 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	dataUploads, errs := encodeToJSONGzip(backup.DataUploads, "data uploads list")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
 	backupResourceList, errs := encodeToJSONGzip(backup.BackupResourceList(), "backup resources list")
 	if errs != nil {
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	resultsJSON, errs := encodeToJSONGzip(backupResults, "backup results")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
@@ -748,6 +1016,8 @@ func persistBackup(backup *pkgbackup.Request,
 		backupResourceList = nil
 		csiSnapshotJSON = nil
 		csiSnapshotContentsJSON = nil
+		dataUploads = nil
+		resultsJSON = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
@@ -755,11 +1025,13 @@ func persistBackup(backup *pkgbackup.Request,
 		Metadata:                  backupJSON,
 		Contents:                  backupContents,
 		Log:                       backupLog,
+		Results:                   resultsJSON,
 		PodVolumeBackups:          podVolumeBackups,
 		VolumeSnapshots:           nativeVolumeSnapshots,
 		BackupResourceList:        backupResourceList,
 		CSIVolumeSnapshots:        csiSnapshotJSON,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
+		DataUploads:               dataUploads,
 	}
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
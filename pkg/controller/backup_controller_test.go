@@ -35,6 +35,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -50,6 +51,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
+	veleromocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -59,12 +62,12 @@ type fakeBackupper struct {
 	mock.Mock
 }
 
-func (b *fakeBackupper) Backup(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
+func (b *fakeBackupper) Backup(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []biav2.BackupItemAction, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
 	args := b.Called(logger, backup, backupFile, actions, volumeSnapshotterGetter)
 	return args.Error(0)
 }
 
-func (b *fakeBackupper) BackupWithResolvers(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer,
+func (b *fakeBackupper) BackupWithResolvers(ctx context.Context, logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer,
 	backupItemActionResolver framework.BackupItemActionResolver, itemSnapshotterResolver framework.ItemSnapshotterResolver,
 	volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
 	args := b.Called(logger, backup, backupFile, backupItemActionResolver, itemSnapshotterResolver, volumeSnapshotterGetter)
@@ -120,6 +123,7 @@ func TestProcessBackupNonProcessedItems(t *testing.T) {
 			)
 
 			c := &backupController{
+				eventRecorder:     &record.FakeRecorder{},
 				genericController: newGenericController("backup-test", logger),
 				lister:            sharedInformers.Velero().V1().Backups().Lister(),
 				formatFlag:        formatFlag,
@@ -195,6 +199,7 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			}
 
 			c := &backupController{
+				eventRecorder:          &record.FakeRecorder{},
 				genericController:      newGenericController("backup-test", logger),
 				discoveryHelper:        discoveryHelper,
 				client:                 clientset.VeleroV1(),
@@ -262,6 +267,7 @@ func TestBackupLocationLabel(t *testing.T) {
 			require.NoError(t, err)
 
 			c := &backupController{
+				eventRecorder:          &record.FakeRecorder{},
 				genericController:      newGenericController("backup-test", logger),
 				discoveryHelper:        discoveryHelper,
 				client:                 clientset.VeleroV1(),
@@ -326,6 +332,7 @@ func TestDefaultBackupTTL(t *testing.T) {
 			require.NoError(t, err)
 
 			c := &backupController{
+				eventRecorder:          &record.FakeRecorder{},
 				genericController:      newGenericController("backup-test", logger),
 				discoveryHelper:        discoveryHelper,
 				kbClient:               fakeClient,
@@ -359,6 +366,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 		expectedResult         *velerov1api.Backup
 		backupExists           bool
 		existenceCheckError    error
+		preBackupActions       map[string]error
+		postBackupActions      map[string]error
 	}{
 		// Completed
 		{
@@ -774,6 +783,135 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                   "failed pre-backup action stops the backup but persists it",
+			backup:                 defaultBackup().Result(),
+			backupLocation:         defaultBackupLocation,
+			defaultVolumesToRestic: true,
+			preBackupActions:       map[string]error{"velero.io/a": nil, "velero.io/b": errors.New("pre-backup error"), "velero.io/c": nil},
+			postBackupActions:      map[string]error{"velero.io/d": nil},
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Annotations: map[string]string{
+						"velero.io/source-cluster-k8s-major-version": "1",
+						"velero.io/source-cluster-k8s-minor-version": "16",
+						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+					},
+					Labels: map[string]string{
+						"velero.io/storage-location": "loc-1",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.True(),
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:               velerov1api.BackupPhaseFailedPreBackupActions,
+					Version:             1,
+					FormatVersion:       "1.1.0",
+					StartTimestamp:      &timestamp,
+					CompletionTimestamp: &timestamp,
+					Expiration:          &timestamp,
+					Errors:              2,
+					PreBackupActionsStatuses: []velerov1api.ActionStatus{
+						{PluginName: "velero.io/a", StartTimestamp: &timestamp, CompletionTimestamp: &timestamp, Phase: velerov1api.ActionPhaseCompleted},
+						{PluginName: "velero.io/b", StartTimestamp: &timestamp, CompletionTimestamp: &timestamp, Phase: velerov1api.ActionPhaseFailed, Message: "pre-backup error"},
+					},
+				},
+			},
+		},
+		{
+			name:                   "successful pre-backup and post-backup actions are recorded",
+			backup:                 defaultBackup().Result(),
+			backupLocation:         defaultBackupLocation,
+			defaultVolumesToRestic: true,
+			preBackupActions:       map[string]error{"velero.io/a": nil},
+			postBackupActions:      map[string]error{"velero.io/b": nil},
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Annotations: map[string]string{
+						"velero.io/source-cluster-k8s-major-version": "1",
+						"velero.io/source-cluster-k8s-minor-version": "16",
+						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+					},
+					Labels: map[string]string{
+						"velero.io/storage-location": "loc-1",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.True(),
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:               velerov1api.BackupPhaseCompleted,
+					Version:             1,
+					FormatVersion:       "1.1.0",
+					StartTimestamp:      &timestamp,
+					CompletionTimestamp: &timestamp,
+					Expiration:          &timestamp,
+					PreBackupActionsStatuses: []velerov1api.ActionStatus{
+						{PluginName: "velero.io/a", StartTimestamp: &timestamp, CompletionTimestamp: &timestamp, Phase: velerov1api.ActionPhaseCompleted},
+					},
+					PostBackupActionsStatuses: []velerov1api.ActionStatus{
+						{PluginName: "velero.io/b", StartTimestamp: &timestamp, CompletionTimestamp: &timestamp, Phase: velerov1api.ActionPhaseCompleted},
+					},
+				},
+			},
+		},
+		{
+			name:                   "failed post-backup action makes the backup partially failed",
+			backup:                 defaultBackup().Result(),
+			backupLocation:         defaultBackupLocation,
+			defaultVolumesToRestic: true,
+
+			postBackupActions: map[string]error{"velero.io/a": errors.New("post-backup error"), "velero.io/b": nil},
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Annotations: map[string]string{
+						"velero.io/source-cluster-k8s-major-version": "1",
+						"velero.io/source-cluster-k8s-minor-version": "16",
+						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+					},
+					Labels: map[string]string{
+						"velero.io/storage-location": "loc-1",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.True(),
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:               velerov1api.BackupPhasePartiallyFailed,
+					Version:             1,
+					FormatVersion:       "1.1.0",
+					StartTimestamp:      &timestamp,
+					CompletionTimestamp: &timestamp,
+					Expiration:          &timestamp,
+					PostBackupActionsStatuses: []velerov1api.ActionStatus{
+						{PluginName: "velero.io/a", StartTimestamp: &timestamp, CompletionTimestamp: &timestamp, Phase: velerov1api.ActionPhaseFailed, Message: "post-backup error"},
+						{PluginName: "velero.io/b", StartTimestamp: &timestamp, CompletionTimestamp: &timestamp, Phase: velerov1api.ActionPhaseCompleted},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -814,7 +952,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 			require.NoError(t, err)
 
 			c := &backupController{
+				eventRecorder:          &record.FakeRecorder{},
 				genericController:      newGenericController("backup-test", logger),
+				ctx:                    context.Background(),
 				discoveryHelper:        discoveryHelper,
 				client:                 clientset.VeleroV1(),
 				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
@@ -825,28 +965,47 @@ func TestProcessBackupCompletions(t *testing.T) {
 				backupTracker:          NewBackupTracker(),
 				metrics:                metrics.NewServerMetrics(),
 				clock:                  clock.NewFakeClock(now),
-				newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				newPluginManager:       func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
 				backupper:              backupper,
 				formatFlag:             formatFlag,
+				backupLogLevel:         logrus.InfoLevel,
 			}
 
 			pluginManager.On("GetBackupItemActions").Return(nil, nil)
 			pluginManager.On("CleanupClients").Return(nil)
 			pluginManager.On("GetItemSnapshotters").Return(nil, nil)
-			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemAction(nil), pluginManager).Return(nil)
+
+			preBackupActions := make(map[string]velero.PreBackupAction)
+			for name, err := range test.preBackupActions {
+				action := new(veleromocks.PreBackupAction)
+				action.On("Execute", mock.Anything).Return(err)
+				preBackupActions[name] = action
+			}
+			pluginManager.On("GetPreBackupActions").Return(preBackupActions, nil)
+
+			postBackupActions := make(map[string]velero.PostBackupAction)
+			for name, err := range test.postBackupActions {
+				action := new(veleromocks.PostBackupAction)
+				action.On("Execute", mock.Anything).Return(err)
+				postBackupActions[name] = action
+			}
+			pluginManager.On("GetPostBackupActions").Return(postBackupActions, nil)
+			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []biav2.BackupItemAction(nil), pluginManager).Return(nil)
 			backupper.On("BackupWithResolvers", mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolver{}, framework.ItemSnapshotterResolver{}, pluginManager).Return(nil)
 			backupStore.On("BackupExists", test.backupLocation.Spec.StorageType.ObjectStorage.Bucket, test.backup.Name).Return(test.backupExists, test.existenceCheckError)
 
-			// Ensure we have a CompletionTimestamp when uploading and that the backup name matches the backup in the object store.
+			// Ensure we have a CompletionTimestamp and results when uploading and that the backup name matches the backup in the object store.
 			// Failures will display the bytes in buf.
 			hasNameAndCompletionTimestamp := func(info persistence.BackupInfo) bool {
 				buf := new(bytes.Buffer)
 				buf.ReadFrom(info.Metadata)
 				return info.Name == test.backup.Name &&
-					strings.Contains(buf.String(), `"completionTimestamp": "2006-01-02T22:04:05Z"`)
+					strings.Contains(buf.String(), `"completionTimestamp": "2006-01-02T22:04:05Z"`) &&
+					info.Results != nil
 			}
 			backupStore.On("PutBackup", mock.MatchedBy(hasNameAndCompletionTimestamp)).Return(nil)
+			backupStore.On("PutBackupMetadata", test.backup.Name, mock.Anything).Return(nil)
 
 			// add the test's backup to the informer/lister store
 			require.NotNil(t, test.backup)
@@ -1004,6 +1163,7 @@ func TestValidateAndGetSnapshotLocations(t *testing.T) {
 			)
 
 			c := &backupController{
+				eventRecorder:            &record.FakeRecorder{},
 				snapshotLocationLister:   sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				defaultSnapshotLocations: test.defaultLocations,
 			}
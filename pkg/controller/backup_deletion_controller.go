@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -27,13 +28,16 @@ import (
 	snapshotv1beta1listers "github.com/kubernetes-csi/external-snapshotter/client/v4/listers/volumesnapshot/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/vmware-tanzu/velero/internal/delete"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -54,10 +58,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const resticTimeout = time.Minute
+const (
+	resticTimeout = time.Minute
+
+	// backupCancelTimeout is how long to wait for an in-progress backup
+	// to stop once it's been cancelled.
+	backupCancelTimeout = time.Minute
+)
 
 type backupDeletionController struct {
 	*genericController
+	ctx context.Context
 
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter
 	deleteBackupRequestLister velerov1listers.DeleteBackupRequestLister
@@ -73,15 +84,18 @@ type backupDeletionController struct {
 	csiSnapshotContentLister  snapshotv1beta1listers.VolumeSnapshotContentLister
 	csiSnapshotClient         *snapshotterClientSet.Clientset
 	processRequestFunc        func(*velerov1api.DeleteBackupRequest) error
+	backupCancelTimeout       time.Duration
 	clock                     clock.Clock
-	newPluginManager          func(logrus.FieldLogger) clientmgmt.Manager
+	newPluginManager          func(context.Context, logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter         persistence.ObjectBackupStoreGetter
 	metrics                   *metrics.ServerMetrics
 	helper                    discovery.Helper
+	eventRecorder             record.EventRecorder
 }
 
 // NewBackupDeletionController creates a new backup deletion controller.
 func NewBackupDeletionController(
+	ctx context.Context,
 	logger logrus.FieldLogger,
 	deleteBackupRequestInformer velerov1informers.DeleteBackupRequestInformer,
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter,
@@ -96,13 +110,15 @@ func NewBackupDeletionController(
 	csiSnapshotLister snapshotv1beta1listers.VolumeSnapshotLister,
 	csiSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister,
 	csiSnapshotClient *snapshotterClientSet.Clientset,
-	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	newPluginManager func(context.Context, logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	helper discovery.Helper,
+	eventRecorder record.EventRecorder,
 ) Interface {
 	c := &backupDeletionController{
 		genericController:         newGenericController(BackupDeletion, logger),
+		ctx:                       ctx,
 		deleteBackupRequestClient: deleteBackupRequestClient,
 		deleteBackupRequestLister: deleteBackupRequestInformer.Lister(),
 		backupClient:              backupClient,
@@ -118,12 +134,14 @@ func NewBackupDeletionController(
 		csiSnapshotClient:         csiSnapshotClient,
 		metrics:                   metrics,
 		helper:                    helper,
+		eventRecorder:             eventRecorder,
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager:  newPluginManager,
 		backupStoreGetter: backupStoreGetter,
 
-		clock: &clock.RealClock{},
+		backupCancelTimeout: backupCancelTimeout,
+		clock:               &clock.RealClock{},
 	}
 
 	c.syncHandler = c.processQueueItem
@@ -195,14 +213,19 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		return kubeerrs.NewAggregate(errs)
 	}
 
-	// Don't allow deleting an in-progress backup
+	// Cancel an in-progress backup and wait for it to stop before deleting it. If
+	// it doesn't stop in time, the request is processed again later.
 	if c.backupTracker.Contains(req.Namespace, req.Spec.BackupName) {
-		_, err = c.patchDeleteBackupRequest(req, func(r *velerov1api.DeleteBackupRequest) {
-			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
-			r.Status.Errors = []string{"backup is still in progress"}
-		})
+		if c.backupTracker.Cancel(req.Namespace, req.Spec.BackupName) {
+			log.Info("Backup is in progress, cancelling it")
+		}
 
-		return err
+		err := wait.PollImmediate(time.Second, c.backupCancelTimeout, func() (bool, error) {
+			return !c.backupTracker.Contains(req.Namespace, req.Spec.BackupName), nil
+		})
+		if err != nil {
+			return errors.Wrap(err, "error waiting for in-progress backup to be cancelled")
+		}
 	}
 
 	// Get the backup we're trying to delete
@@ -280,13 +303,14 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		log.WithError(errors.WithStack(err)).Error("Error setting backup phase to deleting")
 		return err
 	}
+	c.eventRecorder.Eventf(backup, corev1api.EventTypeNormal, eventReasonDeleting, "Deleting backup as requested by %s", req.Name)
 
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	c.metrics.RegisterBackupDeletionAttempt(backupScheduleName)
 
 	var errs []string
 
-	pluginManager := c.newPluginManager(log)
+	pluginManager := c.newPluginManager(c.ctx, log)
 	defer pluginManager.CleanupClients()
 
 	backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
@@ -536,6 +560,8 @@ func (c *backupDeletionController) patchDeleteBackupRequest(req *velerov1api.Del
 		return nil, errors.Wrap(err, "error marshalling original DeleteBackupRequest")
 	}
 
+	oldPhase := req.Status.Phase
+
 	// Mutate
 	mutate(req)
 
@@ -555,6 +581,14 @@ func (c *backupDeletionController) patchDeleteBackupRequest(req *velerov1api.Del
 		return nil, errors.Wrap(err, "error patching DeleteBackupRequest")
 	}
 
+	if oldPhase != velerov1api.DeleteBackupRequestPhaseProcessed && req.Status.Phase == velerov1api.DeleteBackupRequestPhaseProcessed {
+		if len(req.Status.Errors) > 0 {
+			c.eventRecorder.Eventf(req, corev1api.EventTypeWarning, eventReasonDeletionFailed, "Error deleting backup %s: %s", req.Spec.BackupName, strings.Join(req.Status.Errors, "; "))
+		} else {
+			c.eventRecorder.Eventf(req, corev1api.EventTypeNormal, eventReasonDeleted, "Deleted backup %s", req.Spec.BackupName)
+		}
+	}
+
 	return req, nil
 }
 
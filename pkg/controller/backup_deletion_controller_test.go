@@ -35,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
 	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -58,6 +59,7 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 	sharedInformers := informers.NewSharedInformerFactory(client, 0)
 
 	controller := NewBackupDeletionController(
+		context.Background(),
 		velerotest.NewLogger(),
 		sharedInformers.Velero().V1().DeleteBackupRequests(),
 		client.VeleroV1(), // deleteBackupRequestClient
@@ -76,6 +78,7 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 		nil, // backupStoreGetter
 		metrics.NewServerMetrics(),
 		nil, // discovery helper
+		&record.FakeRecorder{},
 	).(*backupDeletionController)
 
 	// Error splitting key
@@ -156,6 +159,7 @@ func setupBackupDeletionControllerTest(t *testing.T, objects ...runtime.Object)
 		volumeSnapshotter: volumeSnapshotter,
 		backupStore:       backupStore,
 		controller: NewBackupDeletionController(
+			context.Background(),
 			velerotest.NewLogger(),
 			sharedInformers.Velero().V1().DeleteBackupRequests(),
 			client.VeleroV1(), // deleteBackupRequestClient
@@ -170,10 +174,11 @@ func setupBackupDeletionControllerTest(t *testing.T, objects ...runtime.Object)
 			nil, // csiSnapshotLister
 			nil, // csiSnapshotContentLister
 			nil, // csiSnapshotClient
-			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			NewFakeSingleObjectBackupStoreGetter(backupStore),
 			metrics.NewServerMetrics(),
 			nil, // discovery helper
+			&record.FakeRecorder{},
 		).(*backupDeletionController),
 
 		req: req,
@@ -231,9 +236,10 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 	t.Run("existing deletion requests for the backup are deleted", func(t *testing.T) {
 		td := setupBackupDeletionControllerTest(t)
 
-		// add the backup to the tracker so the execution of processRequest doesn't progress
-		// past checking for an in-progress backup. this makes validation easier.
-		td.controller.backupTracker.Add(td.req.Namespace, td.req.Spec.BackupName)
+		// add a backup that can't be cancelled to the tracker so the execution of processRequest
+		// doesn't progress past waiting for the in-progress backup. this makes validation easier.
+		td.controller.backupTracker.Add(td.req.Namespace, td.req.Spec.BackupName, nil)
+		td.controller.backupCancelTimeout = time.Millisecond
 
 		require.NoError(t, td.sharedInformers.Velero().V1().DeleteBackupRequests().Informer().GetStore().Add(td.req))
 
@@ -268,7 +274,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 			},
 		))
 
-		assert.NoError(t, td.controller.processRequest(td.req))
+		assert.Error(t, td.controller.processRequest(td.req))
 
 		expectedDeleteAction := core.NewDeleteAction(
 			velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
@@ -278,32 +284,55 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 
 		// first action is the Create of an existing DBR for the backup as part of test data setup
 		// second action is the Delete of the existing DBR, which we're validating
-		// third action is the Patch of the DBR to set it to processed with an error
-		require.Len(t, td.client.Actions(), 3)
+		require.Len(t, td.client.Actions(), 2)
 		assert.Equal(t, expectedDeleteAction, td.client.Actions()[1])
 	})
 
-	t.Run("deleting an in progress backup isn't allowed", func(t *testing.T) {
+	t.Run("deleting an in progress backup cancels it before deleting it", func(t *testing.T) {
 		td := setupBackupDeletionControllerTest(t)
 
-		td.controller.backupTracker.Add(td.req.Namespace, td.req.Spec.BackupName)
+		// the backup controller stops tracking the backup once it's been cancelled
+		var cancelled bool
+		td.controller.backupTracker.Add(td.req.Namespace, td.req.Spec.BackupName, func() {
+			cancelled = true
+			td.controller.backupTracker.Delete(td.req.Namespace, td.req.Spec.BackupName)
+		})
 
 		err := td.controller.processRequest(td.req)
 		require.NoError(t, err)
+		assert.True(t, cancelled)
 
+		// the backup isn't found since it's not in the clientset, so the request is processed
+		// once the backup has stopped.
 		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
 			core.NewPatchAction(
 				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
 				td.req.Namespace,
 				td.req.Name,
 				types.MergePatchType,
-				[]byte(`{"status":{"errors":["backup is still in progress"],"phase":"Processed"}}`),
+				[]byte(`{"status":{"errors":["backup not found"],"phase":"Processed"}}`),
 			),
 		}
 
 		assert.Equal(t, expectedActions, td.client.Actions())
 	})
 
+	t.Run("deleting an in progress backup that doesn't stop in time is retried", func(t *testing.T) {
+		td := setupBackupDeletionControllerTest(t)
+
+		td.controller.backupTracker.Add(td.req.Namespace, td.req.Spec.BackupName, func() {})
+		td.controller.backupCancelTimeout = time.Millisecond
+
+		err := td.controller.processRequest(td.req)
+		require.Error(t, err)
+		assert.Empty(t, td.client.Actions())
+	})
+
 	t.Run("patching to InProgress fails", func(t *testing.T) {
 		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
 		location := builder.ForBackupStorageLocation("velero", "default").Result()
@@ -527,7 +556,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(td.volumeSnapshotter, nil)
 		pluginManager.On("GetDeleteItemActions").Return(nil, nil)
 		pluginManager.On("CleanupClients")
-		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+		td.controller.newPluginManager = func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
 		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
@@ -688,7 +717,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(td.volumeSnapshotter, nil)
 		pluginManager.On("GetDeleteItemActions").Return(nil, nil)
 		pluginManager.On("CleanupClients")
-		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+		td.controller.newPluginManager = func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
 		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
@@ -829,7 +858,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(td.volumeSnapshotter, nil)
 		pluginManager.On("GetDeleteItemActions").Return([]velero.DeleteItemAction{}, nil)
 		pluginManager.On("CleanupClients")
-		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+		td.controller.newPluginManager = func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
@@ -959,7 +988,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(td.volumeSnapshotter, nil)
 		pluginManager.On("GetDeleteItemActions").Return([]velero.DeleteItemAction{new(mocks.DeleteItemAction)}, nil)
 		pluginManager.On("CleanupClients")
-		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+		td.controller.newPluginManager = func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
 		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(nil, fmt.Errorf("error downloading tarball"))
@@ -1137,6 +1166,7 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 			sharedInformers := informers.NewSharedInformerFactory(client, 0)
 
 			controller := NewBackupDeletionController(
+				context.Background(),
 				velerotest.NewLogger(),
 				sharedInformers.Velero().V1().DeleteBackupRequests(),
 				client.VeleroV1(), // deleteBackupRequestClient
@@ -1155,6 +1185,7 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 				nil, // backupStoreGetter
 				metrics.NewServerMetrics(),
 				nil, // discovery helper,
+				&record.FakeRecorder{},
 			).(*backupDeletionController)
 
 			fakeClock := &clock.FakeClock{}
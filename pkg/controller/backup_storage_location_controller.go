@@ -23,15 +23,19 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/cluster-api/util/patch"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 )
@@ -44,8 +48,10 @@ type BackupStorageLocationReconciler struct {
 	DefaultBackupLocationInfo storage.DefaultBackupLocationInfo
 	// use variables to refer to these functions so they can be
 	// replaced with fakes for testing.
-	NewPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	NewPluginManager  func(context.Context, logrus.FieldLogger) clientmgmt.Manager
 	BackupStoreGetter persistence.ObjectBackupStoreGetter
+	EventRecorder     record.EventRecorder
+	Metrics           *metrics.ServerMetrics
 
 	Log logrus.FieldLogger
 }
@@ -55,6 +61,13 @@ type BackupStorageLocationReconciler struct {
 func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.WithField("controller", BackupStorageLocation)
 
+	if r.Metrics != nil && req.Name != "" {
+		// the location this request is for was deleted, so stop reporting its metrics
+		if err := r.Client.Get(r.Ctx, req.NamespacedName, &velerov1api.BackupStorageLocation{}); apierrors.IsNotFound(err) {
+			r.Metrics.RemoveBackupStorageLocation(req.Name)
+		}
+	}
+
 	log.Debug("Validating availability of backup storage locations.")
 
 	locationList, err := storage.ListBackupStorageLocations(r.Ctx, r.Client, req.Namespace)
@@ -63,7 +76,7 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 		return ctrl.Result{}, err
 	}
 
-	pluginManager := r.NewPluginManager(log)
+	pluginManager := r.NewPluginManager(r.Ctx, log)
 	defer pluginManager.CleanupClients()
 
 	var defaultFound bool
@@ -104,6 +117,7 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 				log.WithError(err).Error("Error getting a patch helper to update this resource")
 				return
 			}
+			previousPhase := location.Status.Phase
 			defer func() {
 				location.Status.LastValidationTime = &metav1.Time{Time: time.Now().UTC()}
 				if err != nil {
@@ -119,6 +133,16 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 				}
 				if err := patchHelper.Patch(r.Ctx, location); err != nil {
 					log.WithError(err).Error("Error updating backup storage location phase")
+				} else if r.Metrics != nil {
+					r.Metrics.SetBackupStorageLocationAvailable(location.Name, location.Status.Phase == velerov1api.BackupStorageLocationPhaseAvailable)
+					r.Metrics.SetBackupStorageLocationLastValidationTimestamp(location.Name, location.Status.LastValidationTime.Time)
+				}
+				if location.Status.Phase != previousPhase {
+					if location.Status.Phase == velerov1api.BackupStorageLocationPhaseAvailable {
+						r.EventRecorder.Event(location, corev1api.EventTypeNormal, eventReasonAvailable, "Backup storage location is available")
+					} else {
+						r.EventRecorder.Event(location, corev1api.EventTypeWarning, eventReasonUnavailable, location.Status.Message)
+					}
 				}
 			}()
 
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -29,6 +30,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -79,6 +81,7 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 
 		// Setup reconciler
 		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		recorder := record.NewFakeRecorder(len(tests))
 		r := BackupStorageLocationReconciler{
 			Ctx:    ctx,
 			Client: fake.NewFakeClientWithScheme(scheme.Scheme, locations),
@@ -86,8 +89,9 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 				StorageLocation:           "location-1",
 				ServerValidationFrequency: 0,
 			},
-			NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			NewPluginManager:  func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			BackupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
+			EventRecorder:     recorder,
 			Log:               velerotest.NewLogger(),
 		}
 
@@ -107,6 +111,11 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			Expect(instance.Spec.Default).To(BeIdenticalTo(tests[i].expectedIsDefault))
 			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
 		}
+
+		// An event is recorded for each location whose phase changed
+		Expect(recorder.Events).To(HaveLen(len(tests)))
+		Expect(<-recorder.Events).To(Equal("Normal Available Backup storage location is available"))
+		Expect(<-recorder.Events).To(Equal(`Warning Unavailable Backup storage location "location-2" is unavailable: an error`))
 	})
 
 	It("Should successfully patch a backup storage location object spec default if the BSL is the default one", func() {
@@ -152,8 +161,9 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 				StorageLocation:           "default",
 				ServerValidationFrequency: 0,
 			},
-			NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			NewPluginManager:  func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			BackupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
+			EventRecorder:     &record.FakeRecorder{},
 			Log:               velerotest.NewLogger(),
 		}
 
@@ -222,8 +232,9 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 				StorageLocation:           "default",
 				ServerValidationFrequency: 0,
 			},
-			NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			NewPluginManager:  func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			BackupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
+			EventRecorder:     &record.FakeRecorder{},
 			Log:               velerotest.NewLogger(),
 		}
 
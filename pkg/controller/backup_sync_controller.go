@@ -18,6 +18,8 @@ package controller
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	snapshotterClientSet "github.com/kubernetes-csi/external-snapshotter/client/v4/clientset/versioned"
@@ -26,6 +28,7 @@ import (
 	kuberrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 
@@ -35,6 +38,7 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 
@@ -43,32 +47,67 @@ import (
 
 type backupSyncController struct {
 	*genericController
+	ctx context.Context
 
 	backupClient            velerov1client.BackupsGetter
 	kbClient                client.Client
 	podVolumeBackupClient   velerov1client.PodVolumeBackupsGetter
+	restoreClient           velerov1client.RestoresGetter
 	backupLister            velerov1listers.BackupLister
+	restoreLister           velerov1listers.RestoreLister
 	csiSnapshotClient       *snapshotterClientSet.Clientset
 	kubeClient              kubernetes.Interface
 	namespace               string
 	defaultBackupLocation   string
 	defaultBackupSyncPeriod time.Duration
-	newPluginManager        func(logrus.FieldLogger) clientmgmt.Manager
+	newPluginManager        func(context.Context, logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter       persistence.ObjectBackupStoreGetter
+	syncConcurrency         int
+	metrics                 *metrics.ServerMetrics
+
+	// lastFullSync is the last time each location's backups were listed
+	// and synced, rather than skipped because its revision was unchanged.
+	lastFullSync map[string]time.Time
+
+	// failedRestores are the restores of each location whose sync into the cluster
+	// failed, with the last-synced time they were checked against, so that they're
+	// retried by the next sync even though it's checked against a later time.
+	failedRestores map[string]map[string]*metav1.Time
 }
 
+const (
+	// backupSyncFullResyncPeriod is how often a location's backups are listed
+	// and synced even if its revision hasn't changed, to pick up backups that
+	// were written by Velero versions that don't update the revision.
+	backupSyncFullResyncPeriod = time.Hour
+
+	// defaultBackupSyncConcurrency is the number of backups that are synced
+	// into the cluster at once if no concurrency is specified.
+	defaultBackupSyncConcurrency = 10
+
+	// restoreSyncGracePeriod is how long before a location's last sync a restore
+	// can have completed for it to still be synced, to allow for the time between
+	// a restore completing and its metadata being uploaded.
+	restoreSyncGracePeriod = time.Minute
+)
+
 func NewBackupSyncController(
+	ctx context.Context,
 	backupClient velerov1client.BackupsGetter,
 	kbClient client.Client,
 	podVolumeBackupClient velerov1client.PodVolumeBackupsGetter,
+	restoreClient velerov1client.RestoresGetter,
 	backupLister velerov1listers.BackupLister,
+	restoreLister velerov1listers.RestoreLister,
 	syncPeriod time.Duration,
 	namespace string,
 	csiSnapshotClient *snapshotterClientSet.Clientset,
 	kubeClient kubernetes.Interface,
 	defaultBackupLocation string,
-	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	newPluginManager func(context.Context, logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	syncConcurrency int,
+	metrics *metrics.ServerMetrics,
 	logger logrus.FieldLogger,
 ) Interface {
 	if syncPeriod <= 0 {
@@ -76,11 +115,18 @@ func NewBackupSyncController(
 	}
 	logger.Infof("Backup sync period is %v", syncPeriod)
 
+	if syncConcurrency <= 0 {
+		syncConcurrency = defaultBackupSyncConcurrency
+	}
+
 	c := &backupSyncController{
 		genericController:       newGenericController(BackupSync, logger),
+		ctx:                     ctx,
 		backupClient:            backupClient,
 		kbClient:                kbClient,
 		podVolumeBackupClient:   podVolumeBackupClient,
+		restoreClient:           restoreClient,
+		restoreLister:           restoreLister,
 		namespace:               namespace,
 		defaultBackupLocation:   defaultBackupLocation,
 		defaultBackupSyncPeriod: syncPeriod,
@@ -92,6 +138,10 @@ func NewBackupSyncController(
 		// replaced with fakes for testing.
 		newPluginManager:  newPluginManager,
 		backupStoreGetter: backupStoreGetter,
+		syncConcurrency:   syncConcurrency,
+		metrics:           metrics,
+		lastFullSync:      make(map[string]time.Time),
+		failedRestores:    make(map[string]map[string]*metav1.Time),
 	}
 
 	c.resyncFunc = c.run
@@ -139,10 +189,11 @@ func (c *backupSyncController) run() {
 	}
 	locations := orderedBackupLocations(&locationList, c.defaultBackupLocation)
 
-	pluginManager := c.newPluginManager(c.logger)
+	pluginManager := c.newPluginManager(c.ctx, c.logger)
 	defer pluginManager.CleanupClients()
 
-	for _, location := range locations {
+	for i := range locations {
+		location := &locations[i]
 		log := c.logger.WithField("backupLocation", location.Name)
 
 		syncPeriod := c.defaultBackupSyncPeriod
@@ -170,155 +221,435 @@ func (c *backupSyncController) run() {
 
 		log.Debug("Checking backup location for backups to sync into cluster")
 
-		backupStore, err := c.backupStoreGetter.Get(&location, pluginManager, log)
+		backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
 		if err != nil {
 			log.WithError(err).Error("Error getting backup store for this location")
 			continue
 		}
 
+		c.syncLocation(location, backupStore, log)
+	}
+}
+
+// syncLocation syncs the backups and restores in a backup storage location that don't
+// exist in the cluster into it, and deletes the backups that no longer exist in the
+// location. The sync is skipped if the location's revision hasn't changed since it was
+// last synced. Otherwise only the backups and restores in the location's change log since
+// the last synced revision are synced, unless a full sync is due or the change log doesn't
+// have all of the changes, in which case all of the location's backups and restores are
+// listed.
+func (c *backupSyncController) syncLocation(location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	start := time.Now()
+
+	// get the revision before listing the backups, so that backups that are added or
+	// deleted while syncing change the revision the next sync compares against.
+	revision, err := backupStore.GetRevision()
+	if err != nil {
+		log.WithError(err).Error("Error getting backup store's revision, syncing all backups")
+		revision = ""
+	}
+
+	fullSyncDue := c.fullSyncDue(location.Name, start)
+	if revision != "" && revision == string(location.Status.LastSyncedRevision) && !fullSyncDue {
+		log.WithField("revision", revision).Debug("Backup location's revision hasn't changed since the last sync, skipping sync")
+		c.metrics.RegisterBackupSyncSkipped(location.Name)
+		c.patchLastSynced(location, start, location.Status.LastSyncedRevision, log)
+		return
+	}
+
+	// get a list of all the backups that exist as custom resources in the cluster
+	clusterBackups, clusterBackupsErr := c.backupLister.Backups(c.namespace).List(labels.Everything())
+	if clusterBackupsErr != nil {
+		log.WithError(errors.WithStack(clusterBackupsErr)).Error("Error getting backups from cluster, proceeding with sync into cluster")
+	} else {
+		log.WithField("backupCount", len(clusterBackups)).Debug("Got backups from cluster")
+	}
+
+	// the changes can only be synced if the cluster's backups are known, since
+	// they stand in for the backups in the backup store that didn't change.
+	var (
+		changes     []persistence.BackupStoreChange
+		incremental bool
+	)
+	if revision != "" && !fullSyncDue && clusterBackupsErr == nil {
+		changes, incremental, err = backupStore.ListChanges(string(location.Status.LastSyncedRevision))
+		if err != nil {
+			log.WithError(err).Error("Error listing changes in backup store, syncing all backups")
+			incremental = false
+		}
+	}
+
+	clusterBackupsSet := sets.NewString()
+	for _, b := range clusterBackups {
+		clusterBackupsSet.Insert(b.Name)
+	}
+
+	var (
+		backupStoreBackups sets.String
+		restoreNames       []string
+		synced             = true
+	)
+	if incremental {
+		log.WithField("changeCount", len(changes)).Debug("Got changes from backup store")
+
+		var putBackups, deletedBackups sets.String
+		putBackups, deletedBackups, restoreNames = latestChanges(changes)
+
+		// only the backups that were deleted from the backup store are deleted
+		// from the cluster.
+		backupStoreBackups = clusterBackupsSet.Union(putBackups)
+		for _, backupName := range deletedBackups.List() {
+			exists, err := backupStore.BackupExists(location.Spec.StorageType.ObjectStorage.Bucket, backupName)
+			if err != nil {
+				log.WithField("backup", backupName).WithError(err).Error("Error checking if backup exists in backup store")
+				synced = false
+				continue
+			}
+			if !exists {
+				backupStoreBackups.Delete(backupName)
+			}
+		}
+	} else {
 		// get a list of all the backups that are stored in the backup storage location
 		res, err := backupStore.ListBackups()
 		if err != nil {
 			log.WithError(err).Error("Error listing backups in backup store")
-			continue
+			return
 		}
-		backupStoreBackups := sets.NewString(res...)
+		backupStoreBackups = sets.NewString(res...)
 		log.WithField("backupCount", len(backupStoreBackups)).Debug("Got backups from backup store")
 
-		// get a list of all the backups that exist as custom resources in the cluster
-		clusterBackups, err := c.backupLister.Backups(c.namespace).List(labels.Everything())
+		restoreNames, err = backupStore.ListRestores()
 		if err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error getting backups from cluster, proceeding with sync into cluster")
-		} else {
-			log.WithField("backupCount", len(clusterBackups)).Debug("Got backups from cluster")
+			log.WithError(err).Error("Error listing restores in backup store")
+			synced = false
 		}
+	}
 
-		// get a list of backups that *are* in the backup storage location and *aren't* in the cluster
-		clusterBackupsSet := sets.NewString()
-		for _, b := range clusterBackups {
-			clusterBackupsSet.Insert(b.Name)
-		}
-		backupsToSync := backupStoreBackups.Difference(clusterBackupsSet)
+	// get a list of backups that *are* in the backup storage location and *aren't* in the cluster
+	backupsToSync := backupStoreBackups.Difference(clusterBackupsSet)
 
-		if count := backupsToSync.Len(); count > 0 {
-			log.Infof("Found %v backups in the backup location that do not exist in the cluster and need to be synced", count)
-		} else {
-			log.Debug("No backups found in the backup location that need to be synced into the cluster")
+	if count := backupsToSync.Len(); count > 0 {
+		log.Infof("Found %v backups in the backup location that do not exist in the cluster and need to be synced", count)
+	} else {
+		log.Debug("No backups found in the backup location that need to be synced into the cluster")
+	}
+
+	syncedBackups, ok := c.syncBackups(location.Name, backupStore, backupsToSync.List(), log)
+	if !ok {
+		synced = false
+	}
+
+	c.deleteOrphanedBackups(location.Name, backupStoreBackups, log)
+
+	if !c.syncRestores(location.Name, backupStore, restoreNames, syncedBackups, location.Status.LastSyncedTime, log) {
+		synced = false
+	}
+
+	if !incremental {
+		c.lastFullSync[location.Name] = start
+	}
+	c.metrics.RegisterBackupSyncDuration(location.Name, time.Since(start).Seconds())
+
+	// only record the revision if all of the backups were synced, so that the
+	// ones that failed are retried on the next sync.
+	lastSyncedRevision := location.Status.LastSyncedRevision
+	if synced {
+		lastSyncedRevision = types.UID(revision)
+	}
+	c.patchLastSynced(location, start, lastSyncedRevision, log)
+}
+
+// latestChanges returns the names of the backups that were put in a backup store and
+// of those that were deleted from it, and the names of the restores that were put in
+// it, going by the last of the given changes to each backup and restore.
+func latestChanges(changes []persistence.BackupStoreChange) (sets.String, sets.String, []string) {
+	var (
+		putBackups     = sets.NewString()
+		deletedBackups = sets.NewString()
+		putRestores    = sets.NewString()
+	)
+
+	for _, change := range changes {
+		switch change.Kind {
+		case persistence.BackupStoreChangeKindBackup:
+			if change.Deleted {
+				putBackups.Delete(change.Name)
+				deletedBackups.Insert(change.Name)
+			} else {
+				deletedBackups.Delete(change.Name)
+				putBackups.Insert(change.Name)
+			}
+		case persistence.BackupStoreChangeKindRestore:
+			if change.Deleted {
+				putRestores.Delete(change.Name)
+			} else {
+				putRestores.Insert(change.Name)
+			}
 		}
+	}
 
-		// sync each backup
-		for backupName := range backupsToSync {
-			log = log.WithField("backup", backupName)
-			log.Info("Attempting to sync backup into cluster")
+	return putBackups, deletedBackups, putRestores.List()
+}
 
-			backup, err := backupStore.GetBackupMetadata(backupName)
-			if err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error getting backup metadata from backup store")
-				continue
+// fullSyncDue returns true if the location hasn't been fully synced by this
+// controller within the full resync period.
+func (c *backupSyncController) fullSyncDue(locationName string, now time.Time) bool {
+	lastFullSync, ok := c.lastFullSync[locationName]
+	return !ok || now.Sub(lastFullSync) >= backupSyncFullResyncPeriod
+}
+
+// patchLastSynced updates the location's last-synced time and revision. The time is
+// when the sync started, so that restores that complete during the sync are synced
+// by the next one.
+func (c *backupSyncController) patchLastSynced(location *velerov1api.BackupStorageLocation, syncTime time.Time, revision types.UID, log logrus.FieldLogger) {
+	statusPatch := client.MergeFrom(location.DeepCopy())
+	location.Status.LastSyncedTime = &metav1.Time{Time: syncTime.UTC()}
+	location.Status.LastSyncedRevision = revision
+	if err := c.kbClient.Status().Patch(context.Background(), location, statusPatch); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error patching backup location's last-synced time")
+	}
+}
+
+// syncBackups syncs the named backups from the backup store into the cluster, using up
+// to syncConcurrency workers. It returns the names of the backups that were created in
+// the cluster, and true if all of the backups were synced.
+func (c *backupSyncController) syncBackups(locationName string, backupStore persistence.BackupStore, backupNames []string, log logrus.FieldLogger) (sets.String, bool) {
+	var (
+		wg      sync.WaitGroup
+		lock    sync.Mutex
+		failed  int32
+		names   = make(chan string)
+		created = sets.NewString()
+	)
+
+	for i := 0; i < c.syncConcurrency && i < len(backupNames); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for backupName := range names {
+				log := log.WithField("backup", backupName)
+				ok, err := c.syncBackup(locationName, backupStore, backupName, log)
+				if err != nil {
+					log.WithError(err).Error("Error syncing backup into cluster")
+					c.metrics.RegisterBackupSyncFailure(locationName)
+					atomic.AddInt32(&failed, 1)
+					continue
+				}
+				if ok {
+					lock.Lock()
+					created.Insert(backupName)
+					lock.Unlock()
+				}
+				c.metrics.RegisterBackupSynced(locationName)
 			}
+		}()
+	}
 
-			backup.Namespace = c.namespace
-			backup.ResourceVersion = ""
+	for _, backupName := range backupNames {
+		names <- backupName
+	}
+	close(names)
+	wg.Wait()
+
+	return created, failed == 0
+}
+
+// syncBackup creates a backup from the backup store in the cluster, along with its pod
+// volume backups and, if the CSI feature is enabled, its volume snapshot contents. It
+// returns true if the backup was created.
+func (c *backupSyncController) syncBackup(locationName string, backupStore persistence.BackupStore, backupName string, log logrus.FieldLogger) (bool, error) {
+	log.Info("Attempting to sync backup into cluster")
+
+	backup, err := backupStore.GetBackupMetadata(backupName)
+	if err != nil {
+		return false, errors.Wrap(err, "error getting backup metadata from backup store")
+	}
+
+	backup.Namespace = c.namespace
+	backup.ResourceVersion = ""
 
-			// update the StorageLocation field and label since the name of the location
-			// may be different in this cluster than in the cluster that created the
-			// backup.
-			backup.Spec.StorageLocation = location.Name
-			if backup.Labels == nil {
-				backup.Labels = make(map[string]string)
+	// update the StorageLocation field and label since the name of the location
+	// may be different in this cluster than in the cluster that created the
+	// backup.
+	backup.Spec.StorageLocation = locationName
+	if backup.Labels == nil {
+		backup.Labels = make(map[string]string)
+	}
+	backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(backup.Spec.StorageLocation)
+
+	// attempt to create backup custom resource via API
+	backup, err = c.backupClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+	switch {
+	case err != nil && kuberrs.IsAlreadyExists(err):
+		log.Debug("Backup already exists in cluster")
+		return false, nil
+	case err != nil && !kuberrs.IsAlreadyExists(err):
+		return false, errors.WithStack(err)
+	default:
+		log.Info("Successfully synced backup into cluster")
+	}
+
+	// process the pod volume backups from object store, if any
+	podVolumeBackups, err := backupStore.GetPodVolumeBackups(backupName)
+	if err != nil {
+		return true, errors.Wrap(err, "error getting pod volume backups for this backup from backup store")
+	}
+
+	for _, podVolumeBackup := range podVolumeBackups {
+		log := log.WithField("podVolumeBackup", podVolumeBackup.Name)
+		log.Debug("Checking this pod volume backup to see if it needs to be synced into the cluster")
+
+		for i, ownerRef := range podVolumeBackup.OwnerReferences {
+			if ownerRef.APIVersion == velerov1api.SchemeGroupVersion.String() && ownerRef.Kind == "Backup" && ownerRef.Name == backup.Name {
+				log.WithField("uid", backup.UID).Debugf("Updating pod volume backup's owner reference UID")
+				podVolumeBackup.OwnerReferences[i].UID = backup.UID
 			}
-			backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(backup.Spec.StorageLocation)
+		}
 
-			// attempt to create backup custom resource via API
-			backup, err = c.backupClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+		if _, ok := podVolumeBackup.Labels[velerov1api.BackupUIDLabel]; ok {
+			podVolumeBackup.Labels[velerov1api.BackupUIDLabel] = string(backup.UID)
+		}
+
+		podVolumeBackup.Namespace = backup.Namespace
+		podVolumeBackup.ResourceVersion = ""
+
+		_, err = c.podVolumeBackupClient.PodVolumeBackups(backup.Namespace).Create(context.TODO(), podVolumeBackup, metav1.CreateOptions{})
+		switch {
+		case err != nil && kuberrs.IsAlreadyExists(err):
+			log.Debug("Pod volume backup already exists in cluster")
+			continue
+		case err != nil && !kuberrs.IsAlreadyExists(err):
+			log.WithError(errors.WithStack(err)).Error("Error syncing pod volume backup into cluster")
+			continue
+		default:
+			log.Debug("Synced pod volume backup into cluster")
+		}
+	}
+
+	if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		// we are syncing these objects only to ensure that the storage snapshots are cleaned up
+		// on backup deletion or expiry.
+		log.Info("Syncing CSI volumesnapshotcontents in backup")
+		snapConts, err := backupStore.GetCSIVolumeSnapshotContents(backupName)
+		if err != nil {
+			return true, errors.Wrap(err, "error getting CSI volumesnapshotcontents for this backup from backup store")
+		}
+
+		log.Infof("Syncing %d CSI volumesnapshotcontents in backup", len(snapConts))
+		for _, snapCont := range snapConts {
+			// TODO: Reset ResourceVersion prior to persisting VolumeSnapshotContents
+			snapCont.ResourceVersion = ""
+			created, err := c.csiSnapshotClient.SnapshotV1beta1().VolumeSnapshotContents().Create(context.TODO(), snapCont, metav1.CreateOptions{})
 			switch {
 			case err != nil && kuberrs.IsAlreadyExists(err):
-				log.Debug("Backup already exists in cluster")
+				log.Debugf("volumesnapshotcontent %s already exists in cluster", snapCont.Name)
 				continue
 			case err != nil && !kuberrs.IsAlreadyExists(err):
-				log.WithError(errors.WithStack(err)).Error("Error syncing backup into cluster")
+				log.WithError(errors.WithStack(err)).Errorf("Error syncing volumesnapshotcontent %s into cluster", snapCont.Name)
 				continue
 			default:
-				log.Info("Successfully synced backup into cluster")
-			}
-
-			// process the pod volume backups from object store, if any
-			podVolumeBackups, err := backupStore.GetPodVolumeBackups(backupName)
-			if err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error getting pod volume backups for this backup from backup store")
-				continue
+				log.Infof("Created CSI volumesnapshotcontent %s", created.Name)
 			}
+		}
+	}
 
-			for _, podVolumeBackup := range podVolumeBackups {
-				log := log.WithField("podVolumeBackup", podVolumeBackup.Name)
-				log.Debug("Checking this pod volume backup to see if it needs to be synced into the cluster")
+	return true, nil
+}
 
-				for i, ownerRef := range podVolumeBackup.OwnerReferences {
-					if ownerRef.APIVersion == velerov1api.SchemeGroupVersion.String() && ownerRef.Kind == "Backup" && ownerRef.Name == backup.Name {
-						log.WithField("uid", backup.UID).Debugf("Updating pod volume backup's owner reference UID")
-						podVolumeBackup.OwnerReferences[i].UID = backup.UID
-					}
-				}
+// syncRestores creates the named restores in the backup store that don't exist in the cluster
+// in it, so that their details, logs and results can be viewed in any cluster that uses the
+// location. Only restores of backups that were just synced into the cluster, and restores
+// that completed since the location was last synced, are synced, so that restores that are
+// deleted from the cluster aren't synced back into it. Restores whose sync failed are
+// retried by the next sync, against the last-synced time they were first checked against.
+// It returns true if all of the restores were synced.
+func (c *backupSyncController) syncRestores(locationName string, backupStore persistence.BackupStore, restoreNames []string, syncedBackups sets.String, lastSynced *metav1.Time, log logrus.FieldLogger) bool {
+	clusterRestores, err := c.restoreLister.Restores(c.namespace).List(labels.Everything())
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error getting restores from cluster")
+		return false
+	}
 
-				if _, ok := podVolumeBackup.Labels[velerov1api.BackupUIDLabel]; ok {
-					podVolumeBackup.Labels[velerov1api.BackupUIDLabel] = string(backup.UID)
-				}
+	clusterRestoresSet := sets.NewString()
+	for _, r := range clusterRestores {
+		clusterRestoresSet.Insert(r.Name)
+	}
+	previouslyFailed := c.failedRestores[locationName]
+	restoresToSync := sets.NewString(restoreNames...)
+	for restoreName := range previouslyFailed {
+		restoresToSync.Insert(restoreName)
+	}
+	restoresToSync = restoresToSync.Difference(clusterRestoresSet)
 
-				podVolumeBackup.Namespace = backup.Namespace
-				podVolumeBackup.ResourceVersion = ""
+	// restores that were retried and are now in the cluster or no longer in the
+	// backup store are dropped from the failed restores.
+	failed := make(map[string]*metav1.Time)
+	defer func() { c.failedRestores[locationName] = failed }()
 
-				_, err = c.podVolumeBackupClient.PodVolumeBackups(backup.Namespace).Create(context.TODO(), podVolumeBackup, metav1.CreateOptions{})
-				switch {
-				case err != nil && kuberrs.IsAlreadyExists(err):
-					log.Debug("Pod volume backup already exists in cluster")
-					continue
-				case err != nil && !kuberrs.IsAlreadyExists(err):
-					log.WithError(errors.WithStack(err)).Error("Error syncing pod volume backup into cluster")
-					continue
-				default:
-					log.Debug("Synced pod volume backup into cluster")
-				}
-			}
+	for _, restoreName := range restoresToSync.List() {
+		log := log.WithField("restore", restoreName)
 
-			if features.IsEnabled(velerov1api.CSIFeatureFlag) {
-				// we are syncing these objects only to ensure that the storage snapshots are cleaned up
-				// on backup deletion or expiry.
-				log.Info("Syncing CSI volumesnapshotcontents in backup")
-				snapConts, err := backupStore.GetCSIVolumeSnapshotContents(backupName)
-				if err != nil {
-					log.WithError(errors.WithStack(err)).Error("Error getting CSI volumesnapshotcontents for this backup from backup store")
-					continue
-				}
+		since := lastSynced
+		if t, ok := previouslyFailed[restoreName]; ok {
+			since = t
+		}
 
-				log.Infof("Syncing %d CSI volumesnapshotcontents in backup", len(snapConts))
-				for _, snapCont := range snapConts {
-					// TODO: Reset ResourceVersion prior to persisting VolumeSnapshotContents
-					snapCont.ResourceVersion = ""
-					created, err := c.csiSnapshotClient.SnapshotV1beta1().VolumeSnapshotContents().Create(context.TODO(), snapCont, metav1.CreateOptions{})
-					switch {
-					case err != nil && kuberrs.IsAlreadyExists(err):
-						log.Debugf("volumesnapshotcontent %s already exists in cluster", snapCont.Name)
-						continue
-					case err != nil && !kuberrs.IsAlreadyExists(err):
-						log.WithError(errors.WithStack(err)).Errorf("Error syncing volumesnapshotcontent %s into cluster", snapCont.Name)
-						continue
-					default:
-						log.Infof("Created CSI volumesnapshotcontent %s", created.Name)
-					}
-				}
-			}
+		restore, err := backupStore.GetRestoreMetadata(restoreName)
+		if err != nil {
+			log.WithError(err).Error("Error getting restore metadata from backup store")
+			failed[restoreName] = since
+			continue
+		}
+		if restore == nil {
+			log.Debug("Restore doesn't have a metadata file in the backup store, skipping")
+			continue
 		}
 
-		c.deleteOrphanedBackups(location.Name, backupStoreBackups, log)
+		// only sync finished restores, so that they're never run by the restore controller.
+		switch restore.Status.Phase {
+		case "", velerov1api.RestorePhaseNew, velerov1api.RestorePhaseInProgress:
+			log.Debug("Restore hasn't finished, skipping")
+			continue
+		}
 
-		// update the location's last-synced time field
-		statusPatch := client.MergeFrom(location.DeepCopy())
-		location.Status.LastSyncedTime = &metav1.Time{Time: time.Now().UTC()}
-		if err := c.kbClient.Status().Patch(context.Background(), &location, statusPatch); err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error patching backup location's last-synced time")
+		if syncedBackups.Has(restore.Spec.BackupName) {
+			// the restore's backup won't be synced again, so retry the restore
+			// whenever it completed if it fails.
+			since = nil
+		} else if !completedSince(restore, since) {
 			continue
 		}
+
+		restore.Namespace = c.namespace
+		restore.ResourceVersion = ""
+
+		_, err = c.restoreClient.Restores(restore.Namespace).Create(context.TODO(), restore, metav1.CreateOptions{})
+		switch {
+		case err != nil && kuberrs.IsAlreadyExists(err):
+			log.Debug("Restore already exists in cluster")
+		case err != nil:
+			log.WithError(errors.WithStack(err)).Error("Error syncing restore into cluster")
+			failed[restoreName] = since
+		default:
+			log.Info("Successfully synced restore into cluster")
+		}
+	}
+
+	return len(failed) == 0
+}
+
+// completedSince returns true if the restore completed after the given time, less the
+// restore sync grace period, or if the time is nil.
+func completedSince(restore *velerov1api.Restore, t *metav1.Time) bool {
+	if t == nil {
+		return true
+	}
+	if restore.Status.CompletionTimestamp == nil {
+		return false
 	}
+	return restore.Status.CompletionTimestamp.Time.After(t.Add(-restoreSyncGracePeriod))
 }
 
 // deleteOrphanedBackups deletes backup objects (CRDs) from Kubernetes that have the specified location
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -25,15 +26,20 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	core "k8s.io/client-go/testing"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -340,17 +346,22 @@ func TestBackupSyncControllerRun(t *testing.T) {
 			)
 
 			c := NewBackupSyncController(
+				context.Background(),
 				client.VeleroV1(),
 				fakeClient,
 				client.VeleroV1(),
+				client.VeleroV1(),
 				sharedInformers.Velero().V1().Backups().Lister(),
+				sharedInformers.Velero().V1().Restores().Lister(),
 				time.Duration(0),
 				test.namespace,
 				nil, // csiSnapshotClient
 				nil, // kubeClient
 				"",
-				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				NewFakeObjectBackupStoreGetter(backupStores),
+				1, // syncConcurrency
+				metrics.NewServerMetrics(),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -371,7 +382,9 @@ func TestBackupSyncControllerRun(t *testing.T) {
 					backupStore.On("GetBackupMetadata", bucket.backup.Name).Return(bucket.backup, nil)
 					backupStore.On("GetPodVolumeBackups", bucket.backup.Name).Return(bucket.podVolumeBackups, nil)
 				}
+				backupStore.On("GetRevision").Return("", nil)
 				backupStore.On("ListBackups").Return(backupNames, nil)
+				backupStore.On("ListRestores").Return(nil, nil)
 			}
 
 			for _, existingBackup := range test.existingBackups {
@@ -465,6 +478,237 @@ func TestBackupSyncControllerRun(t *testing.T) {
 	}
 }
 
+func TestBackupSyncControllerRunRevision(t *testing.T) {
+	tests := []struct {
+		name                       string
+		lastSyncedRevision         string
+		storeRevision              string
+		fullySyncedRecently        bool
+		getMetadataErr             error
+		expectListChanges          bool
+		expectList                 bool
+		expectedLastSyncedRevision string
+	}{
+		{
+			name:                       "unchanged revision skips the sync",
+			lastSyncedRevision:         "rev-1",
+			storeRevision:              "rev-1",
+			fullySyncedRecently:        true,
+			expectList:                 false,
+			expectedLastSyncedRevision: "rev-1",
+		},
+		{
+			name:                       "changed revision syncs the location and records the new revision",
+			lastSyncedRevision:         "rev-1",
+			storeRevision:              "rev-2",
+			fullySyncedRecently:        true,
+			expectListChanges:          true,
+			expectList:                 true,
+			expectedLastSyncedRevision: "rev-2",
+		},
+		{
+			name:                       "unchanged revision syncs the location when a full resync is due",
+			lastSyncedRevision:         "rev-1",
+			storeRevision:              "rev-1",
+			expectList:                 true,
+			expectedLastSyncedRevision: "rev-1",
+		},
+		{
+			name:                       "store without a revision is always synced",
+			storeRevision:              "",
+			fullySyncedRecently:        true,
+			expectList:                 true,
+			expectedLastSyncedRevision: "",
+		},
+		{
+			name:                       "revision isn't recorded when a backup fails to sync",
+			lastSyncedRevision:         "rev-1",
+			storeRevision:              "rev-2",
+			fullySyncedRecently:        true,
+			getMetadataErr:             errors.New("get metadata failed"),
+			expectListChanges:          true,
+			expectList:                 true,
+			expectedLastSyncedRevision: "rev-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				fakeClient      = velerotest.NewFakeControllerRuntimeClient(t)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = &pluginmocks.Manager{}
+				backupStore     = &persistencemocks.BackupStore{}
+				location        = defaultLocationsList("ns-1")[0]
+			)
+
+			c := NewBackupSyncController(
+				context.Background(),
+				client.VeleroV1(),
+				fakeClient,
+				client.VeleroV1(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups().Lister(),
+				sharedInformers.Velero().V1().Restores().Lister(),
+				time.Duration(0),
+				"ns-1",
+				nil, // csiSnapshotClient
+				nil, // kubeClient
+				"",
+				func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{location.Name: backupStore}),
+				4, // syncConcurrency
+				metrics.NewServerMetrics(),
+				velerotest.NewLogger(),
+			).(*backupSyncController)
+
+			pluginManager.On("CleanupClients").Return(nil)
+
+			location.Status.LastSyncedRevision = types.UID(test.lastSyncedRevision)
+			require.NoError(t, fakeClient.Create(context.Background(), location))
+
+			if test.fullySyncedRecently {
+				c.lastFullSync[location.Name] = time.Now()
+			}
+
+			backupNames := []string{"backup-1", "backup-2", "backup-3"}
+			backupStore.On("GetRevision").Return(test.storeRevision, nil)
+			if test.expectListChanges {
+				// the change log doesn't have the changes since the last synced
+				// revision, so all of the backups are listed.
+				backupStore.On("ListChanges", test.lastSyncedRevision).Return(nil, false, nil)
+			}
+			if test.expectList {
+				backupStore.On("ListBackups").Return(backupNames, nil)
+				backupStore.On("ListRestores").Return(nil, nil)
+				for _, name := range backupNames {
+					if test.getMetadataErr != nil {
+						backupStore.On("GetBackupMetadata", name).Return(nil, test.getMetadataErr)
+						continue
+					}
+					backupStore.On("GetBackupMetadata", name).Return(builder.ForBackup("ns-1", name).Result(), nil)
+					backupStore.On("GetPodVolumeBackups", name).Return(nil, nil)
+				}
+			}
+
+			c.run()
+
+			backupStore.AssertExpectations(t)
+
+			updated := &velerov1api.BackupStorageLocation{}
+			require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: location.Namespace, Name: location.Name}, updated))
+			assert.Equal(t, test.expectedLastSyncedRevision, string(updated.Status.LastSyncedRevision))
+			assert.NotNil(t, updated.Status.LastSyncedTime)
+
+			backups, err := client.VeleroV1().Backups("ns-1").List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+			if test.expectList && test.getMetadataErr == nil {
+				assert.Len(t, backups.Items, len(backupNames))
+			} else {
+				assert.Empty(t, backups.Items)
+			}
+		})
+	}
+}
+
+func TestBackupSyncControllerRunChanges(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		fakeClient      = velerotest.NewFakeControllerRuntimeClient(t)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+		backupStore     = &persistencemocks.BackupStore{}
+		location        = defaultLocationsList("ns-1")[0]
+		lastSynced      = time.Now().Add(-time.Minute)
+	)
+
+	c := NewBackupSyncController(
+		context.Background(),
+		client.VeleroV1(),
+		fakeClient,
+		client.VeleroV1(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups().Lister(),
+		sharedInformers.Velero().V1().Restores().Lister(),
+		time.Duration(0),
+		"ns-1",
+		nil, // csiSnapshotClient
+		nil, // kubeClient
+		"",
+		func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{location.Name: backupStore}),
+		4, // syncConcurrency
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	pluginManager.On("CleanupClients").Return(nil)
+
+	location.Status.LastSyncedRevision = "rev-1"
+	location.Status.LastSyncedTime = &metav1.Time{Time: lastSynced}
+	require.NoError(t, fakeClient.Create(context.Background(), location))
+	c.lastFullSync[location.Name] = lastSynced
+
+	for _, name := range []string{"backup-1", "backup-2", "backup-6"} {
+		backup := builder.ForBackup("ns-1", name).ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, location.Name)).Phase(velerov1api.BackupPhaseCompleted).Result()
+		require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+		_, err := client.VeleroV1().Backups("ns-1").Create(context.TODO(), backup, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	// only the changes since the last synced revision are synced, so the backup
+	// store's backups and restores aren't listed.
+	backupStore.On("GetRevision").Return("rev-2", nil)
+	backupStore.On("ListChanges", "rev-1").Return([]persistence.BackupStoreChange{
+		{Kind: persistence.BackupStoreChangeKindBackup, Name: "backup-1"},
+		{Kind: persistence.BackupStoreChangeKindBackup, Name: "backup-2", Deleted: true},
+		{Kind: persistence.BackupStoreChangeKindBackup, Name: "backup-3"},
+		{Kind: persistence.BackupStoreChangeKindBackup, Name: "backup-4", Deleted: true},
+		{Kind: persistence.BackupStoreChangeKindBackup, Name: "backup-4"},
+		{Kind: persistence.BackupStoreChangeKindBackup, Name: "backup-5"},
+		{Kind: persistence.BackupStoreChangeKindBackup, Name: "backup-5", Deleted: true},
+		{Kind: persistence.BackupStoreChangeKindBackup, Name: "backup-6", Deleted: true},
+		{Kind: persistence.BackupStoreChangeKindRestore, Name: "restore-1"},
+	}, true, nil)
+
+	// only the backups and restores that were put in the backup store and aren't
+	// in the cluster are fetched from it.
+	for _, name := range []string{"backup-3", "backup-4"} {
+		backupStore.On("GetBackupMetadata", name).Return(builder.ForBackup("ns-1", name).Result(), nil)
+		backupStore.On("GetPodVolumeBackups", name).Return(nil, nil)
+	}
+	backupStore.On("GetRestoreMetadata", "restore-1").Return(builder.ForRestore("ns-1", "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(time.Now()).Result(), nil)
+
+	// deleted backups are only deleted from the cluster if they're no longer in
+	// the backup store, e.g. if backup-6 was put in it again.
+	backupStore.On("BackupExists", "bucket-1", "backup-2").Return(false, nil)
+	backupStore.On("BackupExists", "bucket-1", "backup-5").Return(false, nil)
+	backupStore.On("BackupExists", "bucket-1", "backup-6").Return(true, nil)
+
+	c.run()
+
+	backupStore.AssertExpectations(t)
+
+	backups, err := client.VeleroV1().Backups("ns-1").List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	var backupNames []string
+	for _, backup := range backups.Items {
+		backupNames = append(backupNames, backup.Name)
+	}
+	assert.ElementsMatch(t, []string{"backup-1", "backup-3", "backup-4", "backup-6"}, backupNames)
+
+	_, err = client.VeleroV1().Restores("ns-1").Get(context.TODO(), "restore-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	updated := &velerov1api.BackupStorageLocation{}
+	require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: location.Namespace, Name: location.Name}, updated))
+	assert.Equal(t, "rev-2", string(updated.Status.LastSyncedRevision))
+
+	// syncing the changes isn't a full sync
+	assert.Equal(t, lastSynced, c.lastFullSync[location.Name])
+}
+
 func TestDeleteOrphanedBackups(t *testing.T) {
 	baseBuilder := func(name string) *builder.BackupBuilder {
 		return builder.ForBackup("ns-1", name).ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "default"))
@@ -561,10 +805,13 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 			)
 
 			c := NewBackupSyncController(
+				context.Background(),
 				client.VeleroV1(),
 				fakeClient,
 				client.VeleroV1(),
+				client.VeleroV1(),
 				sharedInformers.Velero().V1().Backups().Lister(),
+				sharedInformers.Velero().V1().Restores().Lister(),
 				time.Duration(0),
 				test.namespace,
 				nil, // csiSnapshotClient
@@ -572,6 +819,8 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 				"",
 				nil, // new plugin manager func
 				nil, // backupStoreGetter
+				1,   // syncConcurrency
+				metrics.NewServerMetrics(),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -655,10 +904,13 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 			)
 
 			c := NewBackupSyncController(
+				context.Background(),
 				client.VeleroV1(),
 				fakeClient,
 				client.VeleroV1(),
+				client.VeleroV1(),
 				sharedInformers.Velero().V1().Backups().Lister(),
+				sharedInformers.Velero().V1().Restores().Lister(),
 				time.Duration(0),
 				test.namespace,
 				nil, // csiSnapshotClient
@@ -666,6 +918,8 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 				"",
 				nil, // new plugin manager func
 				nil, // backupStoreGetter
+				1,   // syncConcurrency
+				metrics.NewServerMetrics(),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -732,3 +986,197 @@ func numPodVolumeBackups(t *testing.T, c *fake.Clientset, ns string) (int, error
 
 	return len(existingK8SPodvolumeBackups.Items), nil
 }
+
+func TestBackupSyncControllerSyncRestores(t *testing.T) {
+	now := time.Now()
+	lastSynced := &metav1.Time{Time: now.Add(-time.Hour)}
+
+	tests := []struct {
+		name             string
+		storeRestores    []*velerov1api.Restore
+		clusterRestores  []*velerov1api.Restore
+		syncedBackups    []string
+		lastSynced       *metav1.Time
+		expectedRestores []string
+	}{
+		{
+			name: "all finished restores are synced on the first sync",
+			storeRestores: []*velerov1api.Restore{
+				builder.ForRestore("ns-1", "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now.Add(-48 * time.Hour)).Result(),
+				builder.ForRestore("ns-1", "restore-2").Backup("backup-1").Phase(velerov1api.RestorePhasePartiallyFailed).CompletionTimestamp(now.Add(-24 * time.Hour)).Result(),
+			},
+			expectedRestores: []string{"restore-1", "restore-2"},
+		},
+		{
+			name: "restores that haven't finished aren't synced",
+			storeRestores: []*velerov1api.Restore{
+				builder.ForRestore("ns-1", "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now).Result(),
+				builder.ForRestore("ns-1", "restore-2").Backup("backup-1").Phase(velerov1api.RestorePhaseInProgress).Result(),
+				builder.ForRestore("ns-1", "restore-3").Backup("backup-1").Phase(velerov1api.RestorePhaseNew).Result(),
+			},
+			expectedRestores: []string{"restore-1"},
+		},
+		{
+			name: "restores without metadata aren't synced",
+			storeRestores: []*velerov1api.Restore{
+				builder.ForRestore("ns-1", "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now).Result(),
+				nil,
+			},
+			expectedRestores: []string{"restore-1"},
+		},
+		{
+			name: "restores that completed before the last sync are only synced if their backup was just synced",
+			storeRestores: []*velerov1api.Restore{
+				builder.ForRestore("ns-1", "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now).Result(),
+				builder.ForRestore("ns-1", "restore-2").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now.Add(-48 * time.Hour)).Result(),
+				builder.ForRestore("ns-1", "restore-3").Backup("backup-2").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now.Add(-48 * time.Hour)).Result(),
+			},
+			syncedBackups:    []string{"backup-2"},
+			lastSynced:       lastSynced,
+			expectedRestores: []string{"restore-1", "restore-3"},
+		},
+		{
+			name: "restores that exist in the cluster aren't synced",
+			storeRestores: []*velerov1api.Restore{
+				builder.ForRestore("ns-1", "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now).Result(),
+			},
+			clusterRestores: []*velerov1api.Restore{
+				builder.ForRestore("ns-1", "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).Result(),
+			},
+			expectedRestores: []string{"restore-1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				fakeClient      = velerotest.NewFakeControllerRuntimeClient(t)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = &pluginmocks.Manager{}
+				backupStore     = &persistencemocks.BackupStore{}
+			)
+
+			c := NewBackupSyncController(
+				context.Background(),
+				client.VeleroV1(),
+				fakeClient,
+				client.VeleroV1(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups().Lister(),
+				sharedInformers.Velero().V1().Restores().Lister(),
+				time.Duration(0),
+				"ns-1",
+				nil, // csiSnapshotClient
+				nil, // kubeClient
+				"",
+				func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(nil),
+				1, // syncConcurrency
+				metrics.NewServerMetrics(),
+				velerotest.NewLogger(),
+			).(*backupSyncController)
+
+			for _, restore := range test.clusterRestores {
+				_, err := client.VeleroV1().Restores(restore.Namespace).Create(context.TODO(), restore, metav1.CreateOptions{})
+				require.NoError(t, err)
+				require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(restore))
+			}
+
+			var restoreNames []string
+			for _, restore := range test.storeRestores {
+				name := "legacy-restore"
+				if restore != nil {
+					name = restore.Name
+				}
+				restoreNames = append(restoreNames, name)
+				backupStore.On("GetRestoreMetadata", name).Return(restore, nil)
+			}
+
+			assert.True(t, c.syncRestores("location-1", backupStore, restoreNames, sets.NewString(test.syncedBackups...), test.lastSynced, velerotest.NewLogger()))
+
+			restores, err := client.VeleroV1().Restores("ns-1").List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+
+			var names []string
+			for _, restore := range restores.Items {
+				names = append(names, restore.Name)
+			}
+			assert.ElementsMatch(t, test.expectedRestores, names)
+		})
+	}
+}
+
+func TestBackupSyncControllerSyncRestoresRetriesFailedRestores(t *testing.T) {
+	var (
+		now             = time.Now()
+		client          = fake.NewSimpleClientset()
+		fakeClient      = velerotest.NewFakeControllerRuntimeClient(t)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+		backupStore     = &persistencemocks.BackupStore{}
+	)
+
+	c := NewBackupSyncController(
+		context.Background(),
+		client.VeleroV1(),
+		fakeClient,
+		client.VeleroV1(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups().Lister(),
+		sharedInformers.Velero().V1().Restores().Lister(),
+		time.Duration(0),
+		"ns-1",
+		nil, // csiSnapshotClient
+		nil, // kubeClient
+		"",
+		func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(nil),
+		1, // syncConcurrency
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	storeRestores := []*velerov1api.Restore{
+		builder.ForRestore("ns-1", "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now.Add(-30 * time.Minute)).Result(),
+		builder.ForRestore("ns-1", "restore-2").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now.Add(-30 * time.Minute)).Result(),
+		builder.ForRestore("ns-1", "restore-3").Backup("backup-2").Phase(velerov1api.RestorePhaseCompleted).CompletionTimestamp(now.Add(-48 * time.Hour)).Result(),
+	}
+	var restoreNames []string
+	for _, restore := range storeRestores {
+		restoreNames = append(restoreNames, restore.Name)
+		backupStore.On("GetRestoreMetadata", restore.Name).Return(restore, nil)
+	}
+
+	// creating restore-1 and restore-3 fails the first time.
+	failing := sets.NewString("restore-1", "restore-3")
+	client.PrependReactor("create", "restores", func(action core.Action) (bool, runtime.Object, error) {
+		name := action.(core.CreateAction).GetObject().(*velerov1api.Restore).Name
+		if failing.Has(name) {
+			failing.Delete(name)
+			return true, nil, errors.New("transient error")
+		}
+		return false, nil, nil
+	})
+
+	clusterRestoreNames := func() []string {
+		restores, err := client.VeleroV1().Restores("ns-1").List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+
+		var names []string
+		for _, restore := range restores.Items {
+			names = append(names, restore.Name)
+			require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(restore.DeepCopy()))
+		}
+		return names
+	}
+
+	assert.False(t, c.syncRestores("location-1", backupStore, restoreNames, sets.NewString("backup-2"), &metav1.Time{Time: now.Add(-time.Hour)}, velerotest.NewLogger()))
+	assert.ElementsMatch(t, []string{"restore-2"}, clusterRestoreNames())
+
+	// the next sync doesn't list any restores, is checked against a time after the restores
+	// completed, and doesn't sync backup-2, but the restores that failed are still retried.
+	assert.True(t, c.syncRestores("location-1", backupStore, nil, sets.NewString(), &metav1.Time{Time: now}, velerotest.NewLogger()))
+	assert.ElementsMatch(t, []string{"restore-1", "restore-2", "restore-3"}, clusterRestoreNames())
+	assert.Empty(t, c.failedRestores["location-1"])
+}
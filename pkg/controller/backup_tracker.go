@@ -17,53 +17,71 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"fmt"
 	"sync"
-
-	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // BackupTracker keeps track of in-progress backups.
 type BackupTracker interface {
-	// Add informs the tracker that a backup is in progress.
-	Add(ns, name string)
+	// Add informs the tracker that a backup is in progress. cancel, which
+	// may be nil, cancels the backup's context.
+	Add(ns, name string, cancel context.CancelFunc)
 	// Delete informs the tracker that a backup is no longer in progress.
 	Delete(ns, name string)
 	// Contains returns true if the tracker is tracking the backup.
 	Contains(ns, name string) bool
+	// Cancel cancels the backup's context if it's in progress and can be
+	// cancelled, and returns true if it did.
+	Cancel(ns, name string) bool
 }
 
 type backupTracker struct {
-	lock    sync.RWMutex
-	backups sets.String
+	lock sync.RWMutex
+	// backups maps the keys of the in-progress backups to their cancel funcs.
+	backups map[string]context.CancelFunc
 }
 
 // NewBackupTracker returns a new BackupTracker.
 func NewBackupTracker() BackupTracker {
 	return &backupTracker{
-		backups: sets.NewString(),
+		backups: make(map[string]context.CancelFunc),
 	}
 }
 
-func (bt *backupTracker) Add(ns, name string) {
+func (bt *backupTracker) Add(ns, name string, cancel context.CancelFunc) {
 	bt.lock.Lock()
 	defer bt.lock.Unlock()
 
-	bt.backups.Insert(backupTrackerKey(ns, name))
+	bt.backups[backupTrackerKey(ns, name)] = cancel
 }
 
 func (bt *backupTracker) Delete(ns, name string) {
 	bt.lock.Lock()
 	defer bt.lock.Unlock()
 
-	bt.backups.Delete(backupTrackerKey(ns, name))
+	delete(bt.backups, backupTrackerKey(ns, name))
 }
 
 func (bt *backupTracker) Contains(ns, name string) bool {
 	bt.lock.RLock()
 	defer bt.lock.RUnlock()
 
-	return bt.backups.Has(backupTrackerKey(ns, name))
+	_, found := bt.backups[backupTrackerKey(ns, name)]
+	return found
+}
+
+func (bt *backupTracker) Cancel(ns, name string) bool {
+	bt.lock.RLock()
+	cancel := bt.backups[backupTrackerKey(ns, name)]
+	bt.lock.RUnlock()
+
+	if cancel == nil {
+		return false
+	}
+
+	cancel()
+	return true
 }
 
 func backupTrackerKey(ns, name string) string {
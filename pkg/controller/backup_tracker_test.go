@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,10 +28,10 @@ func TestBackupTracker(t *testing.T) {
 
 	assert.False(t, bt.Contains("ns", "name"))
 
-	bt.Add("ns", "name")
+	bt.Add("ns", "name", nil)
 	assert.True(t, bt.Contains("ns", "name"))
 
-	bt.Add("ns2", "name2")
+	bt.Add("ns2", "name2", nil)
 	assert.True(t, bt.Contains("ns", "name"))
 	assert.True(t, bt.Contains("ns2", "name2"))
 
@@ -41,3 +42,21 @@ func TestBackupTracker(t *testing.T) {
 	bt.Delete("ns2", "name2")
 	assert.False(t, bt.Contains("ns2", "name2"))
 }
+
+func TestBackupTrackerCancel(t *testing.T) {
+	bt := NewBackupTracker()
+
+	assert.False(t, bt.Cancel("ns", "name"))
+
+	// a backup without a cancel func can't be cancelled
+	bt.Add("ns", "name", nil)
+	assert.False(t, bt.Cancel("ns", "name"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bt.Add("ns2", "name2", cancel)
+	assert.True(t, bt.Cancel("ns2", "name2"))
+	assert.Error(t, ctx.Err())
+
+	// the backup is tracked until it's deleted
+	assert.True(t, bt.Contains("ns2", "name2"))
+}
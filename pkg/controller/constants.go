@@ -21,8 +21,11 @@ const (
 	BackupDeletion        = "backup-deletion"
 	BackupStorageLocation = "backup-storage-location"
 	BackupSync            = "backup-sync"
+	DataDownload          = "data-download"
+	DataUpload            = "data-upload"
 	DownloadRequest       = "download-request"
 	GarbageCollection     = "gc"
+	Notification          = "notification"
 	PodVolumeBackup       = "pod-volume-backup"
 	PodVolumeRestore      = "pod-volume-restore"
 	ResticRepo            = "restic-repo"
@@ -36,8 +39,11 @@ var DisableableControllers = []string{
 	Backup,
 	BackupDeletion,
 	BackupSync,
+	DataDownload,
+	DataUpload,
 	DownloadRequest,
 	GarbageCollection,
+	Notification,
 	ResticRepo,
 	Restore,
 	Schedule,
@@ -0,0 +1,268 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+// DataDownloadReconciler reconciles a DataDownload object
+type DataDownloadReconciler struct {
+	Scheme        *runtime.Scheme
+	Client        kbclient.Client
+	PVCClient     corev1client.PersistentVolumeClaimsGetter
+	ResticManager restic.RepositoryManager
+	Clock         clock.Clock
+	// Timeout is how long the download of a snapshot's data is allowed to run.
+	Timeout time.Duration
+
+	Log logrus.FieldLogger
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=datadownloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=datadownloads/status,verbs=get;update;patch
+func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithFields(logrus.Fields{
+		"controller":   "data-download",
+		"dataDownload": req.NamespacedName,
+	})
+
+	dataDownload := &velerov1api.DataDownload{}
+	if err := r.Client.Get(ctx, req.NamespacedName, dataDownload); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find DataDownload")
+			return ctrl.Result{}, nil
+		}
+
+		log.WithError(err).Error("Error getting DataDownload")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	// only process new downloads.
+	if dataDownload.Status.Phase != "" && dataDownload.Status.Phase != velerov1api.DataDownloadPhaseNew {
+		return ctrl.Result{}, nil
+	}
+
+	if dataDownload.Spec.Cancel {
+		return ctrl.Result{}, r.patchStatus(ctx, dataDownload, func(status *velerov1api.DataDownloadStatus) {
+			status.Phase = velerov1api.DataDownloadPhaseCanceled
+			status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+		})
+	}
+
+	restore, err := r.getRestore(ctx, dataDownload)
+	if err != nil {
+		return ctrl.Result{}, r.fail(ctx, dataDownload, err, log)
+	}
+
+	if err := r.patchStatus(ctx, dataDownload, func(status *velerov1api.DataDownloadStatus) {
+		status.Phase = velerov1api.DataDownloadPhaseInProgress
+		status.StartTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Downloading volume snapshot data")
+
+	if err := r.download(ctx, dataDownload, restore, log); err != nil {
+		if errors.Cause(err) == errDataMoveCanceled {
+			log.Info("Data download canceled")
+			err := r.patchStatus(ctx, dataDownload, func(status *velerov1api.DataDownloadStatus) {
+				status.Phase = velerov1api.DataDownloadPhaseCanceled
+				status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+			})
+			if apierrors.IsNotFound(errors.Cause(err)) {
+				return ctrl.Result{}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.fail(ctx, dataDownload, err, log)
+	}
+
+	log.Info("Volume snapshot data downloaded")
+
+	return ctrl.Result{}, r.patchStatus(ctx, dataDownload, func(status *velerov1api.DataDownloadStatus) {
+		status.Phase = velerov1api.DataDownloadPhaseCompleted
+		status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	})
+}
+
+// download restores the data uploaded by a data upload into the target
+// persistent volume claim through a restic helper pod.
+func (r *DataDownloadReconciler) download(ctx context.Context, dataDownload *velerov1api.DataDownload, restore *velerov1api.Restore, log logrus.FieldLogger) error {
+	pvc, err := r.PVCClient.PersistentVolumeClaims(dataDownload.Spec.TargetNamespace).Get(ctx, dataDownload.Spec.TargetPVC, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error getting target persistent volume claim")
+	}
+
+	downloadCtx, cancel := context.WithTimeout(ctx, r.Timeout)
+	defer cancel()
+
+	canceled := watchDataMove(downloadCtx, cancel, func() (bool, error) {
+		current := &velerov1api.DataDownload{}
+		if err := r.Client.Get(downloadCtx, kbclient.ObjectKeyFromObject(dataDownload), current); err != nil {
+			// the data download is deleted along with its restore, so stop it.
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, errors.WithStack(err)
+		}
+
+		progress, err := r.getProgress(downloadCtx, dataDownload.Namespace, pvc)
+		if err == nil && progress != current.Status.Progress {
+			err = r.patchStatus(downloadCtx, current, func(status *velerov1api.DataDownloadStatus) {
+				status.Progress = progress
+			})
+		}
+		if err != nil {
+			log.WithError(err).Warn("Error updating data download progress")
+		}
+
+		return current.Spec.Cancel, nil
+	}, log)
+
+	restorer, err := r.ResticManager.NewRestorer(downloadCtx, restore)
+	if err != nil {
+		return errors.Wrap(err, "error creating restic restorer")
+	}
+
+	errs := restorer.RestorePVC(restic.PVCRestoreData{
+		Restore: restore,
+		PVC:     pvc,
+		// the restorer only needs the ID of the restic snapshot to restore.
+		PodVolumeBackup: &velerov1api.PodVolumeBackup{
+			Status: velerov1api.PodVolumeBackupStatus{SnapshotID: dataDownload.Spec.SnapshotID},
+		},
+		SourceNamespace: dataDownload.Spec.SourceNamespace,
+		BackupLocation:  dataDownload.Spec.BackupStorageLocation,
+	})
+	if canceled() {
+		return errDataMoveCanceled
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// getProgress returns the progress of the pod volume restore into the persistent volume claim.
+func (r *DataDownloadReconciler) getProgress(ctx context.Context, namespace string, pvc *corev1api.PersistentVolumeClaim) (velerov1api.PodVolumeOperationProgress, error) {
+	list := &velerov1api.PodVolumeRestoreList{}
+	if err := r.Client.List(ctx, list, kbclient.InNamespace(namespace), kbclient.MatchingLabels{velerov1api.PVCUIDLabel: string(pvc.UID)}); err != nil {
+		return velerov1api.PodVolumeOperationProgress{}, errors.WithStack(err)
+	}
+
+	if len(list.Items) == 0 {
+		return velerov1api.PodVolumeOperationProgress{}, nil
+	}
+
+	return list.Items[0].Status.Progress, nil
+}
+
+// getRestore returns the restore that owns the data download.
+func (r *DataDownloadReconciler) getRestore(ctx context.Context, dataDownload *velerov1api.DataDownload) (*velerov1api.Restore, error) {
+	for _, owner := range dataDownload.OwnerReferences {
+		if owner.Kind != "Restore" {
+			continue
+		}
+
+		restore := &velerov1api.Restore{}
+		if err := r.Client.Get(ctx, kbclient.ObjectKey{Namespace: dataDownload.Namespace, Name: owner.Name}, restore); err != nil {
+			return nil, errors.Wrap(err, "error getting restore")
+		}
+		return restore, nil
+	}
+
+	return nil, errors.New("data download isn't owned by a restore")
+}
+
+func (r *DataDownloadReconciler) fail(ctx context.Context, dataDownload *velerov1api.DataDownload, err error, log logrus.FieldLogger) error {
+	log.WithError(err).Error("Error downloading volume snapshot data")
+
+	return r.patchStatus(ctx, dataDownload, func(status *velerov1api.DataDownloadStatus) {
+		status.Phase = velerov1api.DataDownloadPhaseFailed
+		status.Message = err.Error()
+		status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	})
+}
+
+func (r *DataDownloadReconciler) patchStatus(ctx context.Context, dataDownload *velerov1api.DataDownload, mutate func(*velerov1api.DataDownloadStatus)) error {
+	original := dataDownload.DeepCopy()
+	mutate(&dataDownload.Status)
+
+	if err := r.Client.Patch(ctx, dataDownload, kbclient.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error updating data download")
+	}
+	return nil
+}
+
+// MarkInProgressDataDownloads marks the data downloads that were left in progress when the
+// Velero server that was running them stopped. Nothing will finish them, so they're
+// marked canceled if they were canceled, and failed otherwise.
+func MarkInProgressDataDownloads(ctx context.Context, client kbclient.Client, namespace string, clock clock.Clock, log logrus.FieldLogger) {
+	list := &velerov1api.DataDownloadList{}
+	if err := client.List(ctx, list, kbclient.InNamespace(namespace)); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing data downloads")
+		return
+	}
+
+	for i := range list.Items {
+		dataDownload := &list.Items[i]
+		if dataDownload.Status.Phase != velerov1api.DataDownloadPhaseInProgress {
+			continue
+		}
+
+		original := dataDownload.DeepCopy()
+		if dataDownload.Spec.Cancel {
+			dataDownload.Status.Phase = velerov1api.DataDownloadPhaseCanceled
+		} else {
+			dataDownload.Status.Phase = velerov1api.DataDownloadPhaseFailed
+			dataDownload.Status.Message = "the Velero server stopped while the data download was in progress"
+		}
+		dataDownload.Status.CompletionTimestamp = &metav1.Time{Time: clock.Now()}
+
+		if err := client.Patch(ctx, dataDownload, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("Error marking in progress data download %s as %s", dataDownload.Name, dataDownload.Status.Phase)
+			continue
+		}
+		log.Infof("Marked in progress data download %s as %s", dataDownload.Name, dataDownload.Status.Phase)
+	}
+}
+
+func (r *DataDownloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.DataDownload{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 10,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,356 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+// dataMoverPollInterval is how often an in progress data upload or download
+// checks whether it has been canceled and updates its progress.
+const dataMoverPollInterval = 10 * time.Second
+
+// DataUploadReconciler reconciles a DataUpload object
+type DataUploadReconciler struct {
+	Scheme        *runtime.Scheme
+	Client        kbclient.Client
+	PVCClient     corev1client.PersistentVolumeClaimsGetter
+	ResticManager restic.RepositoryManager
+	Clock         clock.Clock
+	// Timeout is how long the upload of a snapshot's data is allowed to run.
+	Timeout time.Duration
+
+	Log logrus.FieldLogger
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=datauploads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=datauploads/status,verbs=get;update;patch
+func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithFields(logrus.Fields{
+		"controller": "data-upload",
+		"dataUpload": req.NamespacedName,
+	})
+
+	dataUpload := &velerov1api.DataUpload{}
+	if err := r.Client.Get(ctx, req.NamespacedName, dataUpload); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find DataUpload")
+			return ctrl.Result{}, nil
+		}
+
+		log.WithError(err).Error("Error getting DataUpload")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	// only process new uploads.
+	if dataUpload.Status.Phase != "" && dataUpload.Status.Phase != velerov1api.DataUploadPhaseNew {
+		return ctrl.Result{}, nil
+	}
+
+	if dataUpload.Spec.Cancel {
+		return ctrl.Result{}, r.patchStatus(ctx, dataUpload, func(status *velerov1api.DataUploadStatus) {
+			status.Phase = velerov1api.DataUploadPhaseCanceled
+			status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+		})
+	}
+
+	backup, err := r.getBackup(ctx, dataUpload)
+	if err != nil {
+		return ctrl.Result{}, r.fail(ctx, dataUpload, err, log)
+	}
+
+	if err := r.patchStatus(ctx, dataUpload, func(status *velerov1api.DataUploadStatus) {
+		status.Phase = velerov1api.DataUploadPhaseInProgress
+		status.StartTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Uploading volume snapshot data")
+
+	snapshotID, err := r.upload(ctx, dataUpload, backup, log)
+	if err != nil {
+		if errors.Cause(err) == errDataMoveCanceled {
+			log.Info("Data upload canceled")
+			err := r.patchStatus(ctx, dataUpload, func(status *velerov1api.DataUploadStatus) {
+				status.Phase = velerov1api.DataUploadPhaseCanceled
+				status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+			})
+			if apierrors.IsNotFound(errors.Cause(err)) {
+				return ctrl.Result{}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.fail(ctx, dataUpload, err, log)
+	}
+
+	log.Info("Volume snapshot data uploaded")
+
+	return ctrl.Result{}, r.patchStatus(ctx, dataUpload, func(status *velerov1api.DataUploadStatus) {
+		status.Phase = velerov1api.DataUploadPhaseCompleted
+		status.SnapshotID = snapshotID
+		status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	})
+}
+
+// upload restores the volume snapshot to a temporary persistent volume claim, and
+// backs up the claim's data with restic. It returns the ID of the restic snapshot.
+func (r *DataUploadReconciler) upload(ctx context.Context, dataUpload *velerov1api.DataUpload, backup *velerov1api.Backup, log logrus.FieldLogger) (string, error) {
+	sourcePVC, err := r.PVCClient.PersistentVolumeClaims(dataUpload.Spec.SourceNamespace).Get(ctx, dataUpload.Spec.SourcePVC, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "error getting source persistent volume claim")
+	}
+
+	pvc, err := r.PVCClient.PersistentVolumeClaims(dataUpload.Spec.SourceNamespace).Create(ctx, newDataUploadPVC(dataUpload, sourcePVC), metav1.CreateOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "error creating persistent volume claim from volume snapshot")
+	}
+	log = log.WithField("persistentVolumeClaim", pvc.Namespace+"/"+pvc.Name)
+	log.Info("Created persistent volume claim from volume snapshot")
+
+	defer func() {
+		if err := r.PVCClient.PersistentVolumeClaims(pvc.Namespace).Delete(context.TODO(), pvc.Name, metav1.DeleteOptions{}); err != nil {
+			log.WithError(errors.WithStack(err)).Warn("Error deleting temporary persistent volume claim")
+		}
+	}()
+
+	uploadCtx, cancel := context.WithTimeout(ctx, r.Timeout)
+	defer cancel()
+
+	canceled := watchDataMove(uploadCtx, cancel, func() (bool, error) {
+		current := &velerov1api.DataUpload{}
+		if err := r.Client.Get(uploadCtx, kbclient.ObjectKeyFromObject(dataUpload), current); err != nil {
+			// the data upload is deleted along with its backup, so stop it.
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, errors.WithStack(err)
+		}
+
+		progress, err := r.getProgress(uploadCtx, dataUpload.Namespace, pvc)
+		if err == nil && progress != current.Status.Progress {
+			err = r.patchStatus(uploadCtx, current, func(status *velerov1api.DataUploadStatus) {
+				status.Progress = progress
+			})
+		}
+		if err != nil {
+			log.WithError(err).Warn("Error updating data upload progress")
+		}
+
+		return current.Spec.Cancel, nil
+	}, log)
+
+	backupper, err := r.ResticManager.NewBackupper(uploadCtx, backup)
+	if err != nil {
+		return "", errors.Wrap(err, "error creating restic backupper")
+	}
+
+	podVolumeBackups, errs := backupper.BackupPVC(backup, pvc, log)
+	if canceled() {
+		return "", errDataMoveCanceled
+	}
+	if len(errs) > 0 {
+		return "", kerrors.NewAggregate(errs)
+	}
+	if len(podVolumeBackups) != 1 || podVolumeBackups[0].Status.SnapshotID == "" {
+		return "", errors.New("restic backup of volume snapshot data didn't produce a snapshot")
+	}
+
+	return podVolumeBackups[0].Status.SnapshotID, nil
+}
+
+// getProgress returns the progress of the pod volume backup of the persistent volume claim.
+func (r *DataUploadReconciler) getProgress(ctx context.Context, namespace string, pvc *corev1api.PersistentVolumeClaim) (velerov1api.PodVolumeOperationProgress, error) {
+	list := &velerov1api.PodVolumeBackupList{}
+	if err := r.Client.List(ctx, list, kbclient.InNamespace(namespace), kbclient.MatchingLabels{velerov1api.PVCUIDLabel: string(pvc.UID)}); err != nil {
+		return velerov1api.PodVolumeOperationProgress{}, errors.WithStack(err)
+	}
+
+	if len(list.Items) == 0 {
+		return velerov1api.PodVolumeOperationProgress{}, nil
+	}
+
+	return list.Items[0].Status.Progress, nil
+}
+
+// getBackup returns the backup that owns the data upload.
+func (r *DataUploadReconciler) getBackup(ctx context.Context, dataUpload *velerov1api.DataUpload) (*velerov1api.Backup, error) {
+	for _, owner := range dataUpload.OwnerReferences {
+		if owner.Kind != "Backup" {
+			continue
+		}
+
+		backup := &velerov1api.Backup{}
+		if err := r.Client.Get(ctx, kbclient.ObjectKey{Namespace: dataUpload.Namespace, Name: owner.Name}, backup); err != nil {
+			return nil, errors.Wrap(err, "error getting backup")
+		}
+		return backup, nil
+	}
+
+	return nil, errors.New("data upload isn't owned by a backup")
+}
+
+func (r *DataUploadReconciler) fail(ctx context.Context, dataUpload *velerov1api.DataUpload, err error, log logrus.FieldLogger) error {
+	log.WithError(err).Error("Error uploading volume snapshot data")
+
+	return r.patchStatus(ctx, dataUpload, func(status *velerov1api.DataUploadStatus) {
+		status.Phase = velerov1api.DataUploadPhaseFailed
+		status.Message = err.Error()
+		status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	})
+}
+
+func (r *DataUploadReconciler) patchStatus(ctx context.Context, dataUpload *velerov1api.DataUpload, mutate func(*velerov1api.DataUploadStatus)) error {
+	original := dataUpload.DeepCopy()
+	mutate(&dataUpload.Status)
+
+	if err := r.Client.Patch(ctx, dataUpload, kbclient.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error updating data upload")
+	}
+	return nil
+}
+
+// MarkInProgressDataUploads marks the data uploads that were left in progress when the
+// Velero server that was running them stopped. Nothing will finish them, so they're
+// marked canceled if they were canceled, and failed otherwise.
+func MarkInProgressDataUploads(ctx context.Context, client kbclient.Client, namespace string, clock clock.Clock, log logrus.FieldLogger) {
+	list := &velerov1api.DataUploadList{}
+	if err := client.List(ctx, list, kbclient.InNamespace(namespace)); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing data uploads")
+		return
+	}
+
+	for i := range list.Items {
+		dataUpload := &list.Items[i]
+		if dataUpload.Status.Phase != velerov1api.DataUploadPhaseInProgress {
+			continue
+		}
+
+		original := dataUpload.DeepCopy()
+		if dataUpload.Spec.Cancel {
+			dataUpload.Status.Phase = velerov1api.DataUploadPhaseCanceled
+		} else {
+			dataUpload.Status.Phase = velerov1api.DataUploadPhaseFailed
+			dataUpload.Status.Message = "the Velero server stopped while the data upload was in progress"
+		}
+		dataUpload.Status.CompletionTimestamp = &metav1.Time{Time: clock.Now()}
+
+		if err := client.Patch(ctx, dataUpload, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("Error marking in progress data upload %s as %s", dataUpload.Name, dataUpload.Status.Phase)
+			continue
+		}
+		log.Infof("Marked in progress data upload %s as %s", dataUpload.Name, dataUpload.Status.Phase)
+	}
+}
+
+func (r *DataUploadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.DataUpload{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 10,
+		}).
+		Complete(r)
+}
+
+// newDataUploadPVC returns a persistent volume claim that's provisioned from the
+// data upload's volume snapshot, with the same size and access modes as the
+// claim the snapshot was taken from.
+func newDataUploadPVC(dataUpload *velerov1api.DataUpload, sourcePVC *corev1api.PersistentVolumeClaim) *corev1api.PersistentVolumeClaim {
+	storageClass := sourcePVC.Spec.StorageClassName
+	if dataUpload.Spec.StorageClass != "" {
+		storageClass = &dataUpload.Spec.StorageClass
+	}
+
+	return &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    dataUpload.Spec.SourceNamespace,
+			GenerateName: "velero-data-upload-",
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel: dataUpload.Labels[velerov1api.BackupNameLabel],
+				// the claim is only needed while its data is uploaded,
+				// so make sure it doesn't end up in a backup.
+				velerov1api.ExcludeFromBackupLabel: "true",
+			},
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			AccessModes:      sourcePVC.Spec.AccessModes,
+			Resources:        sourcePVC.Spec.Resources,
+			StorageClassName: storageClass,
+			VolumeMode:       sourcePVC.Spec.VolumeMode,
+			DataSource: &corev1api.TypedLocalObjectReference{
+				APIGroup: &snapshotv1beta1api.SchemeGroupVersion.Group,
+				Kind:     "VolumeSnapshot",
+				Name:     dataUpload.Spec.VolumeSnapshot,
+			},
+		},
+	}
+}
+
+// errDataMoveCanceled is returned when a data upload or download stops
+// because it was canceled.
+var errDataMoveCanceled = errors.New("canceled")
+
+// watchDataMove periodically runs check until ctx is done, and calls cancel
+// once check reports that the data move was canceled. The returned function
+// reports whether that happened.
+func watchDataMove(ctx context.Context, cancel context.CancelFunc, check func() (bool, error), log logrus.FieldLogger) func() bool {
+	canceled := make(chan struct{})
+
+	go wait.Until(func() {
+		isCanceled, err := check()
+		if err != nil {
+			log.WithError(err).Warn("Error checking whether data move was canceled")
+			return
+		}
+		if isCanceled {
+			select {
+			case <-canceled:
+			default:
+				close(canceled)
+				cancel()
+			}
+		}
+	}, dataMoverPollInterval, ctx.Done())
+
+	return func() bool {
+		select {
+		case <-canceled:
+			return true
+		default:
+			return false
+		}
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestDataUploadReconcile(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newDataUpload := func(phase velerov1api.DataUploadPhase, cancel bool) *velerov1api.DataUpload {
+		return &velerov1api.DataUpload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1api.DefaultNamespace,
+				Name:      "du-1",
+			},
+			Spec: velerov1api.DataUploadSpec{
+				VolumeSnapshot:  "vs-1",
+				SourceNamespace: "ns-1",
+				SourcePVC:       "pvc-1",
+				Cancel:          cancel,
+			},
+			Status: velerov1api.DataUploadStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		dataUpload      *velerov1api.DataUpload
+		expectedPhase   velerov1api.DataUploadPhase
+		expectedMessage string
+	}{
+		{
+			name:          "a data upload that's already done is left alone",
+			dataUpload:    newDataUpload(velerov1api.DataUploadPhaseCompleted, false),
+			expectedPhase: velerov1api.DataUploadPhaseCompleted,
+		},
+		{
+			name:          "a new data upload that's canceled is not started",
+			dataUpload:    newDataUpload(velerov1api.DataUploadPhaseNew, true),
+			expectedPhase: velerov1api.DataUploadPhaseCanceled,
+		},
+		{
+			name:            "a data upload that isn't owned by a backup fails",
+			dataUpload:      newDataUpload("", false),
+			expectedPhase:   velerov1api.DataUploadPhaseFailed,
+			expectedMessage: "data upload isn't owned by a backup",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := DataUploadReconciler{
+				Client: velerotest.NewFakeControllerRuntimeClient(t, test.dataUpload),
+				Clock:  clock.NewFakeClock(now),
+				Log:    velerotest.NewLogger(),
+			}
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Namespace: test.dataUpload.Namespace, Name: test.dataUpload.Name},
+			})
+			require.NoError(t, err)
+
+			res := &velerov1api.DataUpload{}
+			require.NoError(t, r.Client.Get(context.Background(), kbclient.ObjectKeyFromObject(test.dataUpload), res))
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+			assert.Equal(t, test.expectedMessage, res.Status.Message)
+		})
+	}
+}
+
+func TestMarkInProgressDataUploads(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newDataUpload := func(name string, phase velerov1api.DataUploadPhase, cancel bool) *velerov1api.DataUpload {
+		return &velerov1api.DataUpload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1api.DefaultNamespace,
+				Name:      name,
+			},
+			Spec: velerov1api.DataUploadSpec{
+				Cancel: cancel,
+			},
+			Status: velerov1api.DataUploadStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		newDataUpload("new", velerov1api.DataUploadPhaseNew, false),
+		newDataUpload("in-progress", velerov1api.DataUploadPhaseInProgress, false),
+		newDataUpload("in-progress-canceled", velerov1api.DataUploadPhaseInProgress, true),
+		newDataUpload("completed", velerov1api.DataUploadPhaseCompleted, false),
+	)
+
+	MarkInProgressDataUploads(context.Background(), client, velerov1api.DefaultNamespace, clock.NewFakeClock(now), velerotest.NewLogger())
+
+	expected := map[string]velerov1api.DataUploadPhase{
+		"new":                  velerov1api.DataUploadPhaseNew,
+		"in-progress":          velerov1api.DataUploadPhaseFailed,
+		"in-progress-canceled": velerov1api.DataUploadPhaseCanceled,
+		"completed":            velerov1api.DataUploadPhaseCompleted,
+	}
+	for name, phase := range expected {
+		res := &velerov1api.DataUpload{}
+		require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: name}, res))
+		assert.Equal(t, phase, res.Status.Phase, name)
+	}
+}
+
+func TestNewDataUploadPVC(t *testing.T) {
+	sourcePVC := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+		StorageClass("standard").
+		VolumeName("pv-1").
+		Result()
+	sourcePVC.Spec.AccessModes = []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteOnce}
+	sourcePVC.Spec.Resources.Requests = corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("1Gi")}
+
+	dataUpload := &velerov1api.DataUpload{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{velerov1api.BackupNameLabel: "backup-1"},
+		},
+		Spec: velerov1api.DataUploadSpec{
+			VolumeSnapshot:  "vs-1",
+			SourceNamespace: "ns-1",
+			SourcePVC:       "pvc-1",
+		},
+	}
+
+	pvc := newDataUploadPVC(dataUpload, sourcePVC)
+	assert.Equal(t, "ns-1", pvc.Namespace)
+	assert.Equal(t, "backup-1", pvc.Labels[velerov1api.BackupNameLabel])
+	assert.Equal(t, "true", pvc.Labels["velero.io/exclude-from-backup"])
+	assert.Equal(t, "standard", *pvc.Spec.StorageClassName)
+	assert.Equal(t, sourcePVC.Spec.AccessModes, pvc.Spec.AccessModes)
+	assert.Equal(t, sourcePVC.Spec.Resources, pvc.Spec.Resources)
+	assert.Empty(t, pvc.Spec.VolumeName)
+	require.NotNil(t, pvc.Spec.DataSource)
+	assert.Equal(t, "snapshot.storage.k8s.io", *pvc.Spec.DataSource.APIGroup)
+	assert.Equal(t, "VolumeSnapshot", pvc.Spec.DataSource.Kind)
+	assert.Equal(t, "vs-1", pvc.Spec.DataSource.Name)
+
+	// the data upload's storage class overrides the source claim's.
+	dataUpload.Spec.StorageClass = "fast"
+	pvc = newDataUploadPVC(dataUpload, sourcePVC)
+	assert.Equal(t, "fast", *pvc.Spec.StorageClassName)
+}
@@ -41,7 +41,7 @@ type DownloadRequestReconciler struct {
 	Clock  clock.Clock
 	// use variables to refer to these functions so they can be
 	// replaced with fakes for testing.
-	NewPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	NewPluginManager  func(context.Context, logrus.FieldLogger) clientmgmt.Manager
 	BackupStoreGetter persistence.ObjectBackupStoreGetter
 
 	Log logrus.FieldLogger
@@ -140,7 +140,7 @@ func (r *DownloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, errors.WithStack(err)
 		}
 
-		pluginManager := r.NewPluginManager(log)
+		pluginManager := r.NewPluginManager(ctx, log)
 		defer pluginManager.CleanupClients()
 
 		backupStore, err := r.BackupStoreGetter.Get(location, pluginManager, log)
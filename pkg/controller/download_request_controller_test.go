@@ -112,7 +112,7 @@ var _ = Describe("Download Request Reconciler", func() {
 			r := DownloadRequestReconciler{
 				Client:            fakeClient,
 				Clock:             rClock,
-				NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewPluginManager:  func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				BackupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
 				Log:               velerotest.NewLogger(),
 			}
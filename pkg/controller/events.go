@@ -0,0 +1,90 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// EventComponent is the component of the Kubernetes events that the Velero server records.
+//
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+const EventComponent = "velero"
+
+// Reasons of the Kubernetes events that controllers record.
+const (
+	eventReasonFailedValidation        = "FailedValidation"
+	eventReasonStarted                 = "Started"
+	eventReasonCompleted               = "Completed"
+	eventReasonPartiallyFailed         = "PartiallyFailed"
+	eventReasonFailed                  = "Failed"
+	eventReasonBackupCreated           = "BackupCreated"
+	eventReasonBackupCreationFailed    = "BackupCreationFailed"
+	eventReasonExpired                 = "Expired"
+	eventReasonGarbageCollectionFailed = "GarbageCollectionFailed"
+	eventReasonDeleting                = "Deleting"
+	eventReasonDeleted                 = "Deleted"
+	eventReasonDeletionFailed          = "DeletionFailed"
+	eventReasonAvailable               = "Available"
+	eventReasonUnavailable             = "Unavailable"
+)
+
+// recordBackupPhaseEvent records an event for backup having moved to its current phase.
+// err is the error that failed the backup, if any.
+func recordBackupPhaseEvent(recorder record.EventRecorder, backup *velerov1api.Backup, err error) {
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseInProgress:
+		recorder.Event(backup, corev1api.EventTypeNormal, eventReasonStarted, "Backup started")
+	case velerov1api.BackupPhaseFailedValidation:
+		recorder.Eventf(backup, corev1api.EventTypeWarning, eventReasonFailedValidation, "Backup failed validation: %s", strings.Join(backup.Status.ValidationErrors, "; "))
+	case velerov1api.BackupPhaseCompleted:
+		recorder.Eventf(backup, corev1api.EventTypeNormal, eventReasonCompleted, "Backup completed with %d warnings", backup.Status.Warnings)
+	case velerov1api.BackupPhasePartiallyFailed:
+		recorder.Eventf(backup, corev1api.EventTypeWarning, eventReasonPartiallyFailed, "Backup partially failed with %d errors and %d warnings; run `velero backup describe %s --details` for details", backup.Status.Errors, backup.Status.Warnings, backup.Name)
+	case velerov1api.BackupPhaseFailedPreBackupActions:
+		recorder.Event(backup, corev1api.EventTypeWarning, eventReasonFailed, "Backup was not run because a pre-backup action failed")
+	case velerov1api.BackupPhaseFailed:
+		if err != nil {
+			recorder.Eventf(backup, corev1api.EventTypeWarning, eventReasonFailed, "Backup failed: %v", err)
+		} else {
+			recorder.Event(backup, corev1api.EventTypeWarning, eventReasonFailed, "Backup failed")
+		}
+	}
+}
+
+// recordRestorePhaseEvent records an event for restore having moved to its current phase.
+func recordRestorePhaseEvent(recorder record.EventRecorder, restore *velerov1api.Restore) {
+	switch restore.Status.Phase {
+	case velerov1api.RestorePhaseInProgress:
+		recorder.Eventf(restore, corev1api.EventTypeNormal, eventReasonStarted, "Restore from backup %s started", restore.Spec.BackupName)
+	case velerov1api.RestorePhaseFailedValidation:
+		recorder.Eventf(restore, corev1api.EventTypeWarning, eventReasonFailedValidation, "Restore failed validation: %s", strings.Join(restore.Status.ValidationErrors, "; "))
+	case velerov1api.RestorePhaseCompleted:
+		recorder.Eventf(restore, corev1api.EventTypeNormal, eventReasonCompleted, "Restore completed with %d warnings", restore.Status.Warnings)
+	case velerov1api.RestorePhasePartiallyFailed:
+		recorder.Eventf(restore, corev1api.EventTypeWarning, eventReasonPartiallyFailed, "Restore partially failed with %d errors and %d warnings; run `velero restore describe %s` for details", restore.Status.Errors, restore.Status.Warnings, restore.Name)
+	case velerov1api.RestorePhaseFailedPreRestoreActions:
+		recorder.Event(restore, corev1api.EventTypeWarning, eventReasonFailed, "Restore was not run because a pre-restore action failed")
+	case velerov1api.RestorePhaseFailed:
+		recorder.Eventf(restore, corev1api.EventTypeWarning, eventReasonFailed, "Restore failed: %s", restore.Status.FailureReason)
+	}
+}
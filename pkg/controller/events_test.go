@@ -0,0 +1,122 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/record"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestRecordBackupPhaseEvent(t *testing.T) {
+	tests := []struct {
+		name   string
+		backup *velerov1api.Backup
+		err    error
+		want   string
+	}{
+		{
+			name:   "new backup doesn't record an event",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseNew).Result(),
+		},
+		{
+			name:   "started backup",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result(),
+			want:   "Normal Started Backup started",
+		},
+		{
+			name: "backup that failed validation",
+			backup: func() *velerov1api.Backup {
+				backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseFailedValidation).Result()
+				backup.Status.ValidationErrors = []string{"error 1", "error 2"}
+				return backup
+			}(),
+			want: "Warning FailedValidation Backup failed validation: error 1; error 2",
+		},
+		{
+			name: "partially failed backup",
+			backup: func() *velerov1api.Backup {
+				backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhasePartiallyFailed).Result()
+				backup.Status.Errors = 2
+				backup.Status.Warnings = 1
+				return backup
+			}(),
+			want: "Warning PartiallyFailed Backup partially failed with 2 errors and 1 warnings; run `velero backup describe backup-1 --details` for details",
+		},
+		{
+			name:   "failed backup",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseFailed).Result(),
+			err:    errors.New("error uploading backup"),
+			want:   "Warning Failed Backup failed: error uploading backup",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			recordBackupPhaseEvent(recorder, test.backup, test.err)
+
+			if test.want == "" {
+				assert.Empty(t, recorder.Events)
+				return
+			}
+			assert.Equal(t, test.want, <-recorder.Events)
+		})
+	}
+}
+
+func TestRecordRestorePhaseEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		restore *velerov1api.Restore
+		want    string
+	}{
+		{
+			name:    "started restore",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseInProgress).Result(),
+			want:    "Normal Started Restore from backup backup-1 started",
+		},
+		{
+			name:    "completed restore",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).Result(),
+			want:    "Normal Completed Restore completed with 0 warnings",
+		},
+		{
+			name: "failed restore",
+			restore: func() *velerov1api.Restore {
+				restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseFailed).Result()
+				restore.Status.FailureReason = "error downloading backup"
+				return restore
+			}(),
+			want: "Warning Failed Restore failed: error downloading backup",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			recordRestorePhaseEvent(recorder, test.restore)
+
+			assert.Equal(t, test.want, <-recorder.Events)
+		})
+	}
+}
@@ -18,15 +18,18 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -54,6 +57,7 @@ type gcController struct {
 	deleteBackupRequestLister velerov1listers.DeleteBackupRequestLister
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter
 	kbClient                  client.Client
+	eventRecorder             record.EventRecorder
 
 	clock clock.Clock
 }
@@ -65,6 +69,7 @@ func NewGCController(
 	deleteBackupRequestLister velerov1listers.DeleteBackupRequestLister,
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter,
 	kbClient client.Client,
+	eventRecorder record.EventRecorder,
 ) Interface {
 	c := &gcController{
 		genericController:         newGenericController(GarbageCollection, logger),
@@ -73,6 +78,7 @@ func NewGCController(
 		deleteBackupRequestLister: deleteBackupRequestLister,
 		deleteBackupRequestClient: deleteBackupRequestClient,
 		kbClient:                  kbClient,
+		eventRecorder:             eventRecorder,
 	}
 
 	c.syncHandler = c.processQueueItem
@@ -138,6 +144,15 @@ func (c *gcController) processQueueItem(key string) error {
 
 	log.Info("Backup has expired")
 
+	// only record an event when the reason the backup can't be garbage-collected changes,
+	// so that a backup isn't flooded with an event on every resync.
+	previousFailure := backup.Labels[garbageCollectionFailure]
+	recordFailure := func(failure, message string) {
+		if failure != previousFailure {
+			c.eventRecorder.Event(backup, corev1api.EventTypeWarning, eventReasonGarbageCollectionFailed, message)
+		}
+	}
+
 	if backup.Labels == nil {
 		backup.Labels = make(map[string]string)
 	}
@@ -150,8 +165,10 @@ func (c *gcController) processQueueItem(key string) error {
 		if apierrors.IsNotFound(err) {
 			log.Warnf("Backup cannot be garbage-collected because backup storage location %s does not exist", backup.Spec.StorageLocation)
 			backup.Labels[garbageCollectionFailure] = gcFailureBSLNotFound
+			recordFailure(gcFailureBSLNotFound, fmt.Sprintf("Backup cannot be garbage-collected because backup storage location %s does not exist", backup.Spec.StorageLocation))
 		} else {
 			backup.Labels[garbageCollectionFailure] = gcFailureBSLCannotGet
+			recordFailure(gcFailureBSLCannotGet, fmt.Sprintf("Backup cannot be garbage-collected because backup storage location %s cannot be retrieved: %v", backup.Spec.StorageLocation, err))
 		}
 		if err := c.kbClient.Update(context.Background(), backup); err != nil {
 			log.WithError(err).Error("error updating backup labels")
@@ -162,6 +179,7 @@ func (c *gcController) processQueueItem(key string) error {
 	if loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
 		log.Infof("Backup cannot be garbage-collected because backup storage location %s is currently in read-only mode", loc.Name)
 		backup.Labels[garbageCollectionFailure] = gcFailureBSLReadOnly
+		recordFailure(gcFailureBSLReadOnly, fmt.Sprintf("Backup cannot be garbage-collected because backup storage location %s is in read-only mode", loc.Name))
 		if err := c.kbClient.Update(context.Background(), backup); err != nil {
 			log.WithError(err).Error("error updating backup labels")
 		}
@@ -200,6 +218,7 @@ func (c *gcController) processQueueItem(key string) error {
 	if _, err = c.deleteBackupRequestClient.DeleteBackupRequests(ns).Create(context.TODO(), req, metav1.CreateOptions{}); err != nil {
 		return errors.Wrap(err, "error creating DeleteBackupRequest")
 	}
+	c.eventRecorder.Event(backup, corev1api.EventTypeNormal, eventReasonExpired, "Backup expired, requesting its deletion")
 
 	return nil
 }
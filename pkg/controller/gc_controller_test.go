@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/watch"
 	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -53,6 +54,7 @@ func TestGCControllerEnqueueAllBackups(t *testing.T) {
 			sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 			client.VeleroV1(),
 			nil,
+			&record.FakeRecorder{},
 		).(*gcController)
 	)
 
@@ -114,6 +116,7 @@ func TestGCControllerHasUpdateFunc(t *testing.T) {
 		sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 		client.VeleroV1(),
 		nil,
+		&record.FakeRecorder{},
 	).(*gcController)
 
 	keys := make(chan string)
@@ -162,6 +165,7 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 		expectDeletion                 bool
 		createDeleteBackupRequestError bool
 		expectError                    bool
+		expectedEvents                 []string
 	}{
 		{
 			name: "can't find backup - no error",
@@ -177,18 +181,21 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("read-only").Result(),
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
 			expectDeletion: false,
+			expectedEvents: []string{"Warning GarbageCollectionFailed Backup cannot be garbage-collected because backup storage location read-only is in read-only mode"},
 		},
 		{
 			name:           "expired backup in read-write storage location is deleted",
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("read-write").Result(),
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-write").AccessMode(velerov1api.BackupStorageLocationAccessModeReadWrite).Result(),
 			expectDeletion: true,
+			expectedEvents: []string{"Normal Expired Backup expired, requesting its deletion"},
 		},
 		{
 			name:           "expired backup with no pending deletion requests is deleted",
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
 			backupLocation: defaultBackupLocation,
 			expectDeletion: true,
+			expectedEvents: []string{"Normal Expired Backup expired, requesting its deletion"},
 		},
 		{
 			name:           "expired backup with a pending deletion request is not deleted",
@@ -231,6 +238,7 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 				},
 			},
 			expectDeletion: true,
+			expectedEvents: []string{"Normal Expired Backup expired, requesting its deletion"},
 		},
 		{
 			name:                           "create DeleteBackupRequest error returns an error",
@@ -262,6 +270,7 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 				sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 				client.VeleroV1(),
 				fakeClient,
+				record.NewFakeRecorder(10),
 			).(*gcController)
 			controller.clock = fakeClock
 
@@ -295,6 +304,14 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			} else {
 				assert.Len(t, client.Actions(), 0)
 			}
+
+			events := controller.eventRecorder.(*record.FakeRecorder).Events
+			close(events)
+			var gotEvents []string
+			for event := range events {
+				gotEvents = append(gotEvents, event)
+			}
+			assert.Equal(t, test.expectedEvents, gotEvents)
 		})
 	}
 }
@@ -0,0 +1,282 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/notification"
+)
+
+const (
+	// notificationRetryBaseDelay and notificationRetryMaxDelay bound the exponential
+	// backoff between attempts to deliver a notification.
+	notificationRetryBaseDelay = 5 * time.Second
+	notificationRetryMaxDelay  = 5 * time.Minute
+
+	// maxRecentNotificationDeliveries is the number of deliveries recorded in a
+	// NotificationSink's status.
+	maxRecentNotificationDeliveries = 10
+)
+
+// notificationDeliverer delivers notifications to the targets of NotificationSinks.
+type notificationDeliverer interface {
+	Deliver(ctx context.Context, sink *velerov1api.NotificationSink, event notification.Event) error
+}
+
+// pendingNotification is a notification waiting to be delivered to a NotificationSink.
+type pendingNotification struct {
+	sink  types.NamespacedName
+	event notification.Event
+}
+
+// notificationController notifies NotificationSinks of the phase transitions of Backups,
+// Restores and DeleteBackupRequests. Only transitions observed while the server runs are
+// notified, so restarting the server doesn't notify transitions again.
+type notificationController struct {
+	*genericController
+
+	ctx          context.Context
+	namespace    string
+	kbClient     client.Client
+	backupLister velerov1listers.BackupLister
+	deliverer    notificationDeliverer
+	clock        clock.Clock
+
+	lock    sync.Mutex
+	pending map[string]pendingNotification
+}
+
+// NewNotificationController constructs a new notificationController.
+func NewNotificationController(
+	ctx context.Context,
+	namespace string,
+	logger logrus.FieldLogger,
+	backupInformer velerov1informers.BackupInformer,
+	restoreInformer velerov1informers.RestoreInformer,
+	deleteBackupRequestInformer velerov1informers.DeleteBackupRequestInformer,
+	kbClient client.Client,
+	deliverer notificationDeliverer,
+) Interface {
+	c := &notificationController{
+		genericController: newGenericController(Notification, logger),
+		ctx:               ctx,
+		namespace:         namespace,
+		kbClient:          kbClient,
+		backupLister:      backupInformer.Lister(),
+		deliverer:         deliverer,
+		clock:             clock.RealClock{},
+		pending:           make(map[string]pendingNotification),
+	}
+
+	// Failed deliveries are retried with a longer backoff than other controllers', since
+	// their targets are often rate-limited.
+	c.queue = workqueue.NewNamedRateLimitingQueue(
+		workqueue.NewItemExponentialFailureRateLimiter(notificationRetryBaseDelay, notificationRetryMaxDelay),
+		Notification,
+	)
+	c.syncHandler = c.processQueueItem
+
+	backupInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldBackup := oldObj.(*velerov1api.Backup)
+				backup := newObj.(*velerov1api.Backup)
+				if oldBackup.Status.Phase == backup.Status.Phase {
+					return
+				}
+				c.notify(notification.ForBackup(backup, oldBackup.Status.Phase, c.clock.Now()))
+			},
+		},
+	)
+
+	restoreInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldRestore := oldObj.(*velerov1api.Restore)
+				restore := newObj.(*velerov1api.Restore)
+				if oldRestore.Status.Phase == restore.Status.Phase {
+					return
+				}
+				backup := c.getBackup(restore.Namespace, restore.Spec.BackupName)
+				c.notify(notification.ForRestore(restore, backup, oldRestore.Status.Phase, c.clock.Now()))
+			},
+		},
+	)
+
+	deleteBackupRequestInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldRequest := oldObj.(*velerov1api.DeleteBackupRequest)
+				request := newObj.(*velerov1api.DeleteBackupRequest)
+				if oldRequest.Status.Phase == request.Status.Phase {
+					return
+				}
+				backup := c.getBackup(request.Namespace, request.Spec.BackupName)
+				c.notify(notification.ForDeleteBackupRequest(request, backup, oldRequest.Status.Phase, c.clock.Now()))
+			},
+		},
+	)
+
+	return c
+}
+
+// getBackup returns the backup with name, or nil if it doesn't exist.
+func (c *notificationController) getBackup(namespace, name string) *velerov1api.Backup {
+	if name == "" {
+		return nil
+	}
+	backup, err := c.backupLister.Backups(namespace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return backup
+}
+
+// notify queues the notification of event for delivery to the NotificationSinks whose
+// filters match it.
+func (c *notificationController) notify(event notification.Event) {
+	log := c.logger.WithFields(logrus.Fields{
+		"kind":  event.Kind,
+		"name":  event.Namespace + "/" + event.Name,
+		"phase": event.Phase,
+	})
+
+	sinks := &velerov1api.NotificationSinkList{}
+	if err := c.kbClient.List(c.ctx, sinks, client.InNamespace(c.namespace)); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing NotificationSinks")
+		return
+	}
+
+	for i := range sinks.Items {
+		sink := &sinks.Items[i]
+		if !notification.Matches(sink.Spec.Filter, event) {
+			continue
+		}
+
+		key := strings.Join([]string{sink.Namespace, sink.Name, string(event.Kind), event.Name, event.Phase}, "/")
+		c.lock.Lock()
+		c.pending[key] = pendingNotification{
+			sink:  types.NamespacedName{Namespace: sink.Namespace, Name: sink.Name},
+			event: event,
+		}
+		c.lock.Unlock()
+
+		log.WithField("notificationSink", sink.Name).Debug("Queueing notification")
+		c.queue.Add(key)
+	}
+}
+
+func (c *notificationController) processQueueItem(key string) error {
+	c.lock.Lock()
+	pending, ok := c.pending[key]
+	c.lock.Unlock()
+	if !ok {
+		return nil
+	}
+
+	log := c.logger.WithFields(logrus.Fields{
+		"notificationSink": pending.sink.Name,
+		"kind":             pending.event.Kind,
+		"name":             pending.event.Namespace + "/" + pending.event.Name,
+		"phase":            pending.event.Phase,
+	})
+
+	sink := &velerov1api.NotificationSink{}
+	if err := c.kbClient.Get(c.ctx, pending.sink, sink); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find NotificationSink")
+			c.forget(key)
+			return nil
+		}
+		return errors.Wrap(err, "error getting NotificationSink")
+	}
+
+	attempts := c.queue.NumRequeues(key) + 1
+	maxAttempts := notification.MaxRetries(sink) + 1
+
+	err := c.deliverer.Deliver(c.ctx, sink, pending.event)
+	if err != nil && attempts < maxAttempts {
+		// Returning the error retries the delivery after a backoff.
+		return errors.Wrapf(err, "error delivering notification (attempt %d of %d)", attempts, maxAttempts)
+	}
+	c.forget(key)
+
+	delivery := velerov1api.NotificationDelivery{
+		Kind:      pending.event.Kind,
+		Name:      pending.event.Name,
+		Phase:     pending.event.Phase,
+		Attempts:  attempts,
+		Timestamp: &metav1.Time{Time: c.clock.Now()},
+	}
+	if err != nil {
+		log.WithError(err).WithField("attempts", attempts).Error("Unable to deliver notification")
+		delivery.Result = velerov1api.NotificationDeliveryResultFailed
+		delivery.Message = err.Error()
+	} else {
+		log.Info("Delivered notification")
+		delivery.Result = velerov1api.NotificationDeliveryResultDelivered
+	}
+
+	if err := c.recordDelivery(sink, delivery); err != nil {
+		log.WithError(err).Error("Error recording notification delivery")
+	}
+	return nil
+}
+
+// forget discards the pending notification with key.
+func (c *notificationController) forget(key string) {
+	c.lock.Lock()
+	delete(c.pending, key)
+	c.lock.Unlock()
+}
+
+// recordDelivery records delivery in sink's status.
+func (c *notificationController) recordDelivery(sink *velerov1api.NotificationSink, delivery velerov1api.NotificationDelivery) error {
+	original := sink.DeepCopy()
+
+	if delivery.Result == velerov1api.NotificationDeliveryResultDelivered {
+		sink.Status.DeliveredCount++
+		sink.Status.LastDeliveryTimestamp = delivery.Timestamp
+	} else {
+		sink.Status.FailedCount++
+		sink.Status.LastFailureTimestamp = delivery.Timestamp
+	}
+
+	sink.Status.RecentDeliveries = append([]velerov1api.NotificationDelivery{delivery}, sink.Status.RecentDeliveries...)
+	if len(sink.Status.RecentDeliveries) > maxRecentNotificationDeliveries {
+		sink.Status.RecentDeliveries = sink.Status.RecentDeliveries[:maxRecentNotificationDeliveries]
+	}
+
+	return errors.Wrap(c.kbClient.Status().Patch(c.ctx, sink, client.MergeFrom(original)), "error patching NotificationSink")
+}
@@ -0,0 +1,185 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/notification"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeNotificationDeliverer struct {
+	err       error
+	delivered []string
+}
+
+func (d *fakeNotificationDeliverer) Deliver(_ context.Context, sink *velerov1api.NotificationSink, event notification.Event) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.delivered = append(d.delivered, sink.Name+":"+event.Name+":"+event.Phase)
+	return nil
+}
+
+func newTestNotificationController(t *testing.T, deliverer *fakeNotificationDeliverer, sinks ...*velerov1api.NotificationSink) (*notificationController, kbclient.Client) {
+	t.Helper()
+
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+	for _, sink := range sinks {
+		require.NoError(t, kbClient.Create(context.Background(), sink))
+	}
+
+	sharedInformers := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+	c := NewNotificationController(
+		context.Background(),
+		velerov1api.DefaultNamespace,
+		velerotest.NewLogger(),
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().Restores(),
+		sharedInformers.Velero().V1().DeleteBackupRequests(),
+		kbClient,
+		deliverer,
+	).(*notificationController)
+	c.clock = clock.NewFakeClock(time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC))
+
+	return c, kbClient
+}
+
+func TestNotificationControllerNotify(t *testing.T) {
+	c, _ := newTestNotificationController(t, &fakeNotificationDeliverer{},
+		builder.ForNotificationSink(velerov1api.DefaultNamespace, "all").Type(velerov1api.NotificationSinkTypeWebhook).Result(),
+		builder.ForNotificationSink(velerov1api.DefaultNamespace, "failures").Type(velerov1api.NotificationSinkTypeWebhook).Phases("Failed").Result(),
+		builder.ForNotificationSink(velerov1api.DefaultNamespace, "restores").Type(velerov1api.NotificationSinkTypeWebhook).Kinds(velerov1api.NotificationEventKindRestore).Result(),
+	)
+	defer c.queue.ShutDown()
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseFailed).Result()
+	c.notify(notification.ForBackup(backup, velerov1api.BackupPhaseInProgress, c.clock.Now()))
+
+	assert.Equal(t, 2, c.queue.Len())
+	assert.Contains(t, c.pending, "velero/all/Backup/backup-1/Failed")
+	assert.Contains(t, c.pending, "velero/failures/Backup/backup-1/Failed")
+}
+
+func TestNotificationControllerProcessQueueItem(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseFailed).Result()
+
+	tests := []struct {
+		name            string
+		maxRetries      int
+		deliverErr      error
+		wantErr         bool
+		wantDelivered   []string
+		wantStatusCount [2]int
+		wantResult      velerov1api.NotificationDeliveryResult
+	}{
+		{
+			name:            "delivered notification is recorded",
+			maxRetries:      1,
+			wantDelivered:   []string{"sink-1:backup-1:Failed"},
+			wantStatusCount: [2]int{1, 0},
+			wantResult:      velerov1api.NotificationDeliveryResultDelivered,
+		},
+		{
+			name:       "failed delivery is retried",
+			maxRetries: 1,
+			deliverErr: errors.New("connection refused"),
+			wantErr:    true,
+		},
+		{
+			name:            "failed delivery without retries left is recorded",
+			maxRetries:      0,
+			deliverErr:      errors.New("connection refused"),
+			wantStatusCount: [2]int{0, 1},
+			wantResult:      velerov1api.NotificationDeliveryResultFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deliverer := &fakeNotificationDeliverer{err: test.deliverErr}
+			sink := builder.ForNotificationSink(velerov1api.DefaultNamespace, "sink-1").
+				Type(velerov1api.NotificationSinkTypeWebhook).
+				MaxRetries(test.maxRetries).
+				Result()
+			c, kbClient := newTestNotificationController(t, deliverer, sink)
+			defer c.queue.ShutDown()
+
+			c.notify(notification.ForBackup(backup, velerov1api.BackupPhaseInProgress, c.clock.Now()))
+			key := "velero/sink-1/Backup/backup-1/Failed"
+			require.Contains(t, c.pending, key)
+
+			err := c.processQueueItem(key)
+			if test.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, c.pending, key)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotContains(t, c.pending, key)
+			assert.Equal(t, test.wantDelivered, deliverer.delivered)
+
+			updated := &velerov1api.NotificationSink{}
+			require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: sink.Namespace, Name: sink.Name}, updated))
+			assert.Equal(t, test.wantStatusCount[0], updated.Status.DeliveredCount)
+			assert.Equal(t, test.wantStatusCount[1], updated.Status.FailedCount)
+			require.Len(t, updated.Status.RecentDeliveries, 1)
+			assert.Equal(t, test.wantResult, updated.Status.RecentDeliveries[0].Result)
+			assert.Equal(t, velerov1api.NotificationEventKindBackup, updated.Status.RecentDeliveries[0].Kind)
+			assert.Equal(t, 1, updated.Status.RecentDeliveries[0].Attempts)
+			if test.deliverErr != nil {
+				assert.Equal(t, test.deliverErr.Error(), updated.Status.RecentDeliveries[0].Message)
+			}
+		})
+	}
+}
+
+func TestNotificationControllerRecordDeliveryKeepsRecentDeliveries(t *testing.T) {
+	sink := builder.ForNotificationSink(velerov1api.DefaultNamespace, "sink-1").Type(velerov1api.NotificationSinkTypeWebhook).Result()
+	c, kbClient := newTestNotificationController(t, &fakeNotificationDeliverer{}, sink)
+	defer c.queue.ShutDown()
+
+	for i := 0; i < maxRecentNotificationDeliveries+2; i++ {
+		current := &velerov1api.NotificationSink{}
+		require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: sink.Namespace, Name: sink.Name}, current))
+		require.NoError(t, c.recordDelivery(current, velerov1api.NotificationDelivery{
+			Kind:   velerov1api.NotificationEventKindBackup,
+			Name:   "backup-" + string(rune('a'+i)),
+			Phase:  "Completed",
+			Result: velerov1api.NotificationDeliveryResultDelivered,
+		}))
+	}
+
+	updated := &velerov1api.NotificationSink{}
+	require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: sink.Namespace, Name: sink.Name}, updated))
+	assert.Equal(t, maxRecentNotificationDeliveries+2, updated.Status.DeliveredCount)
+	require.Len(t, updated.Status.RecentDeliveries, maxRecentNotificationDeliveries)
+	assert.Equal(t, "backup-l", updated.Status.RecentDeliveries[0].Name)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// runPluginActions runs the pre/post backup or restore actions in actions, which are keyed by
+// plugin name, in order of name, and returns the status of each one that was run. If stopOnFailure
+// is true, no more actions are run after one fails. The returned bool is true if any action failed.
+func runPluginActions(actions map[string]func() error, stopOnFailure bool, clock clock.Clock, log logrus.FieldLogger) ([]velerov1api.ActionStatus, bool) {
+	names := make([]string, 0, len(actions))
+	for name := range actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		statuses []velerov1api.ActionStatus
+		failed   bool
+	)
+	for _, name := range names {
+		log := log.WithField("plugin", name)
+
+		status := velerov1api.ActionStatus{
+			PluginName:     name,
+			StartTimestamp: &metav1.Time{Time: clock.Now()},
+		}
+
+		log.Info("Executing action")
+		if err := actions[name](); err != nil {
+			log.WithError(err).Error("Error executing action")
+			status.Phase = velerov1api.ActionPhaseFailed
+			status.Message = err.Error()
+			failed = true
+		} else {
+			status.Phase = velerov1api.ActionPhaseCompleted
+		}
+		status.CompletionTimestamp = &metav1.Time{Time: clock.Now()}
+
+		statuses = append(statuses, status)
+
+		if failed && stopOnFailure {
+			break
+		}
+	}
+
+	return statuses, failed
+}
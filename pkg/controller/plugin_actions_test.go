@@ -0,0 +1,100 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRunPluginActions(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp := metav1.NewTime(now)
+
+	status := func(name string, phase velerov1api.ActionPhase, message string) velerov1api.ActionStatus {
+		return velerov1api.ActionStatus{
+			PluginName:          name,
+			StartTimestamp:      &timestamp,
+			CompletionTimestamp: &timestamp,
+			Phase:               phase,
+			Message:             message,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		actionErrs     map[string]error
+		stopOnFailure  bool
+		wantStatuses   []velerov1api.ActionStatus
+		wantFailed     bool
+		wantExecutions []string
+	}{
+		{
+			name:       "no actions",
+			actionErrs: map[string]error{},
+		},
+		{
+			name:           "actions are run in order of name",
+			actionErrs:     map[string]error{"velero.io/b": nil, "velero.io/a": nil},
+			wantStatuses:   []velerov1api.ActionStatus{status("velero.io/a", velerov1api.ActionPhaseCompleted, ""), status("velero.io/b", velerov1api.ActionPhaseCompleted, "")},
+			wantExecutions: []string{"velero.io/a", "velero.io/b"},
+		},
+		{
+			name:           "failed action stops the rest when stopOnFailure is true",
+			actionErrs:     map[string]error{"velero.io/a": errors.New("a failed"), "velero.io/b": nil},
+			stopOnFailure:  true,
+			wantStatuses:   []velerov1api.ActionStatus{status("velero.io/a", velerov1api.ActionPhaseFailed, "a failed")},
+			wantFailed:     true,
+			wantExecutions: []string{"velero.io/a"},
+		},
+		{
+			name:           "failed action does not stop the rest when stopOnFailure is false",
+			actionErrs:     map[string]error{"velero.io/a": errors.New("a failed"), "velero.io/b": nil},
+			wantStatuses:   []velerov1api.ActionStatus{status("velero.io/a", velerov1api.ActionPhaseFailed, "a failed"), status("velero.io/b", velerov1api.ActionPhaseCompleted, "")},
+			wantFailed:     true,
+			wantExecutions: []string{"velero.io/a", "velero.io/b"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var executions []string
+			actions := make(map[string]func() error, len(tc.actionErrs))
+			for name, err := range tc.actionErrs {
+				name, err := name, err
+				actions[name] = func() error {
+					executions = append(executions, name)
+					return err
+				}
+			}
+
+			statuses, failed := runPluginActions(actions, tc.stopOnFailure, clock.NewFakeClock(now), velerotest.NewLogger())
+
+			assert.Equal(t, tc.wantStatuses, statuses)
+			assert.Equal(t, tc.wantFailed, failed)
+			assert.Equal(t, tc.wantExecutions, executions)
+		})
+	}
+}
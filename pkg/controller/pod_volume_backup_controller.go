@@ -62,6 +62,7 @@ type podVolumeBackupController struct {
 	nodeName              string
 	metrics               *metrics.ServerMetrics
 	credentialsFileStore  credentials.FileStore
+	operationLimiter      *restic.OperationLimiter
 
 	processBackupFunc func(*velerov1api.PodVolumeBackup) error
 	fileSystem        filesystem.Interface
@@ -80,6 +81,7 @@ func NewPodVolumeBackupController(
 	kbClient client.Client,
 	nodeName string,
 	credentialsFileStore credentials.FileStore,
+	operationLimiter *restic.OperationLimiter,
 ) Interface {
 	c := &podVolumeBackupController{
 		genericController:     newGenericController(PodVolumeBackup, logger),
@@ -92,6 +94,7 @@ func NewPodVolumeBackupController(
 		nodeName:              nodeName,
 		metrics:               metrics,
 		credentialsFileStore:  credentialsFileStore,
+		operationLimiter:      operationLimiter,
 
 		fileSystem: filesystem.NewFileSystem(),
 		clock:      &clock.RealClock{},
@@ -163,6 +166,10 @@ func (c *podVolumeBackupController) processQueueItem(key string) error {
 		return nil
 	}
 
+	// wait for the node's other restic operations if there are too many of them running
+	c.operationLimiter.Acquire()
+	defer c.operationLimiter.Release()
+
 	// Don't mutate the shared cache
 	reqCopy := req.DeepCopy()
 	return c.processBackupFunc(reqCopy)
@@ -265,6 +272,7 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 		return c.fail(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 	}
 	resticCmd.Env = env
+	resticCmd.ExtraFlags = append(resticCmd.ExtraFlags, restic.UploadLimitFlags(req.Spec.RateLimit, backupLocation)...)
 
 	// If this is a PVC, look for the most recent completed pod volume backup for it and get
 	// its restic snapshot ID to use as the value of the `--parent` flag. Without this,
@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
@@ -62,6 +63,7 @@ type podVolumeRestoreController struct {
 	kbClient               client.Client
 	nodeName               string
 	credentialsFileStore   credentials.FileStore
+	operationLimiter       *restic.OperationLimiter
 
 	processRestoreFunc func(*velerov1api.PodVolumeRestore) error
 	fileSystem         filesystem.Interface
@@ -79,6 +81,7 @@ func NewPodVolumeRestoreController(
 	kbClient client.Client,
 	nodeName string,
 	credentialsFileStore credentials.FileStore,
+	operationLimiter *restic.OperationLimiter,
 ) Interface {
 	c := &podVolumeRestoreController{
 		genericController:      newGenericController(PodVolumeRestore, logger),
@@ -90,6 +93,7 @@ func NewPodVolumeRestoreController(
 		kbClient:               kbClient,
 		nodeName:               nodeName,
 		credentialsFileStore:   credentialsFileStore,
+		operationLimiter:       operationLimiter,
 
 		fileSystem: filesystem.NewFileSystem(),
 		clock:      &clock.RealClock{},
@@ -149,6 +153,17 @@ func (c *podVolumeRestoreController) pvrHandler(obj interface{}) {
 		return
 	}
 
+	if isFileRestore(pvr) {
+		if !isPodRunning(pod) {
+			log.Debug("Restore's pod is not running, not enqueuing")
+			return
+		}
+
+		log.Debug("Enqueueing")
+		c.enqueue(obj)
+		return
+	}
+
 	if !isResticInitContainerRunning(pod) {
 		log.Debug("Restore's pod is not running restic-wait init container, not enqueuing")
 		return
@@ -174,13 +189,15 @@ func (c *podVolumeRestoreController) podHandler(obj interface{}) {
 		return
 	}
 
-	if !isResticInitContainerRunning(pod) {
+	initContainerRunning := isResticInitContainerRunning(pod)
+	// file restores are done into the volumes of running pods, which don't run the
+	// restic-wait init container
+	if !initContainerRunning && !isPodRunning(pod) {
 		log.Debug("Pod is not running restic-wait init container, not enqueuing restores for pod")
 		return
 	}
 
-	resticInitContainerIndex := getResticInitContainerIndex(pod)
-	if resticInitContainerIndex > 0 {
+	if resticInitContainerIndex := getResticInitContainerIndex(pod); initContainerRunning && resticInitContainerIndex > 0 {
 		log.Warnf(`Init containers before the %s container may cause issues
 		          if they interfere with volumes being restored: %s index %d`, restic.InitContainer, restic.InitContainer, resticInitContainerIndex)
 	}
@@ -205,6 +222,10 @@ func (c *podVolumeRestoreController) podHandler(obj interface{}) {
 			log.Debug("Restore is not new, not enqueuing")
 			continue
 		}
+		if isFileRestore(pvr) == initContainerRunning {
+			log.Debug("Pod is not ready for restore, not enqueuing")
+			continue
+		}
 		log.Debug("Enqueuing")
 		c.enqueue(pvr)
 	}
@@ -214,6 +235,16 @@ func isPVRNew(pvr *velerov1api.PodVolumeRestore) bool {
 	return pvr.Status.Phase == "" || pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseNew
 }
 
+// isFileRestore returns whether the restore is of only some of the files of the
+// volume, into the volume of a running pod.
+func isFileRestore(pvr *velerov1api.PodVolumeRestore) bool {
+	return len(pvr.Spec.IncludePaths) > 0
+}
+
+func isPodRunning(pod *corev1api.Pod) bool {
+	return pod.Status.Phase == corev1api.PodRunning
+}
+
 func isPodOnNode(pod *corev1api.Pod, node string) bool {
 	return pod.Spec.NodeName == node
 }
@@ -256,6 +287,29 @@ func (c *podVolumeRestoreController) processQueueItem(key string) error {
 		return errors.Wrap(err, "error getting PodVolumeRestore")
 	}
 
+	// only process new items, which may have been requeued after they were processed
+	if !isPVRNew(req) {
+		log.Debug("Restore is not new, not processing")
+		return nil
+	}
+
+	pod, err := c.podLister.Pods(req.Spec.Pod.Namespace).Get(req.Spec.Pod.Name)
+	if apierrors.IsNotFound(err) {
+		log.Debugf("Restore's pod %s/%s not found, not processing", req.Spec.Pod.Namespace, req.Spec.Pod.Name)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error getting restore's pod %s/%s", req.Spec.Pod.Namespace, req.Spec.Pod.Name)
+	}
+	if !isPodOnNode(pod, c.nodeName) {
+		log.Debug("Restore's pod is not on this node, not processing")
+		return nil
+	}
+
+	// wait for the node's other restic operations if there are too many of them running
+	c.operationLimiter.Acquire()
+	defer c.operationLimiter.Release()
+
 	// Don't mutate the shared cache
 	reqCopy := req.DeepCopy()
 	return c.processRestoreFunc(reqCopy)
@@ -331,6 +385,12 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 		return errors.Wrap(err, "error identifying path of volume")
 	}
 
+	if req.Spec.TargetPath != "" {
+		if volumePath, err = restoreTargetPath(volumePath, req.Spec.TargetPath); err != nil {
+			return errors.Wrap(err, "error creating target directory")
+		}
+	}
+
 	credsFile, err := c.credentialsFileStore.Path(restic.RepoKeySelector())
 	if err != nil {
 		log.WithError(err).Error("Error creating temp restic credentials file")
@@ -345,6 +405,15 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 		req.Spec.SnapshotID,
 		volumePath,
 	)
+	if isFileRestore(req) {
+		resticCmd = restic.RestoreFilesCommand(
+			req.Spec.RepoIdentifier,
+			credsFile,
+			req.Spec.SnapshotID,
+			volumePath,
+			req.Spec.IncludePaths,
+		)
+	}
 
 	backupLocation := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
@@ -371,6 +440,7 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 		return c.failRestore(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 	}
 	resticCmd.Env = env
+	resticCmd.ExtraFlags = append(resticCmd.ExtraFlags, restic.DownloadLimitFlags(req.Spec.RateLimit, backupLocation)...)
 
 	var stdout, stderr string
 
@@ -379,6 +449,12 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 	}
 	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
 
+	// file restores are done into the volume of a running pod, so there's no init container
+	// waiting for a done file
+	if isFileRestore(req) {
+		return nil
+	}
+
 	// Remove the .velero directory from the restored volume (it may contain done files from previous restores
 	// of this volume, which we don't want to carry over). If this fails for any reason, log and continue, since
 	// this is non-essential cleanup (the done files are named based on restore UID and the init container looks
@@ -440,6 +516,57 @@ func (c *podVolumeRestoreController) patchPodVolumeRestore(req *velerov1api.PodV
 	return req, nil
 }
 
+// restoreTargetPath returns the directory within volumePath that a restore with the given target
+// path restores to, creating it if it doesn't exist. It checks that no symlink in the volume, which
+// the pod may have written, leads the directory outside of the volume, and that the directory isn't
+// a symlink itself.
+func restoreTargetPath(volumePath, targetPath string) (string, error) {
+	root, err := filepath.EvalSymlinks(volumePath)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	// clean the target path as an absolute path so that it can't point outside of the volume
+	target := filepath.Join(root, filepath.Clean("/"+targetPath))
+
+	if info, err := os.Lstat(target); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		return "", errors.Errorf("target path %q is a symlink", targetPath)
+	}
+
+	// resolve the deepest directory that exists before creating the ones below it
+	existing := target
+	for {
+		_, err := os.Lstat(existing)
+		if err == nil {
+			break
+		}
+		if !os.IsNotExist(err) {
+			return "", errors.WithStack(err)
+		}
+		existing = filepath.Dir(existing)
+	}
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if resolved != root && !strings.HasPrefix(resolved, root+string(filepath.Separator)) {
+		return "", errors.Errorf("target path %q is outside of the volume", targetPath)
+	}
+
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	// a symlink may have been created in the meantime
+	if resolved, err = filepath.EvalSymlinks(target); err != nil {
+		return "", errors.WithStack(err)
+	}
+	if resolved != target {
+		return "", errors.Errorf("target path %q is outside of the volume", targetPath)
+	}
+
+	return target, nil
+}
+
 func (c *podVolumeRestoreController) failRestore(req *velerov1api.PodVolumeRestore, msg string, log logrus.FieldLogger) error {
 	if _, err := c.patchPodVolumeRestore(req, func(pvr *velerov1api.PodVolumeRestore) {
 		pvr.Status.Phase = velerov1api.PodVolumeRestorePhaseFailed
@@ -17,6 +17,8 @@ limitations under the License.
 package controller
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -204,6 +206,70 @@ func TestPVRHandler(t *testing.T) {
 			},
 			shouldEnqueue: true,
 		},
+		{
+			name: "Empty phase file restore pvr with pod on node running init container should not be enqueued",
+			obj: &velerov1api.PodVolumeRestore{
+				Spec: velerov1api.PodVolumeRestoreSpec{
+					Pod: corev1api.ObjectReference{
+						Namespace: "ns-1",
+						Name:      "pod-1",
+					},
+					IncludePaths: []string{"/file"},
+				},
+			},
+			pod: &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "pod-1",
+				},
+				Spec: corev1api.PodSpec{
+					NodeName: controllerNode,
+					InitContainers: []corev1api.Container{
+						{
+							Name: restic.InitContainer,
+						},
+					},
+				},
+				Status: corev1api.PodStatus{
+					Phase: corev1api.PodPending,
+					InitContainerStatuses: []corev1api.ContainerStatus{
+						{
+							State: corev1api.ContainerState{
+								Running: &corev1api.ContainerStateRunning{
+									StartedAt: metav1.Time{Time: time.Now()},
+								},
+							},
+						},
+					},
+				},
+			},
+			shouldEnqueue: false,
+		},
+		{
+			name: "Empty phase file restore pvr with running pod on node should be enqueued",
+			obj: &velerov1api.PodVolumeRestore{
+				Spec: velerov1api.PodVolumeRestoreSpec{
+					Pod: corev1api.ObjectReference{
+						Namespace: "ns-1",
+						Name:      "pod-1",
+					},
+					IncludePaths: []string{"/file"},
+				},
+			},
+			pod: &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "pod-1",
+				},
+				Spec: corev1api.PodSpec{
+					NodeName: controllerNode,
+				},
+				Status: corev1api.PodStatus{
+					Phase: corev1api.PodRunning,
+				},
+			},
+			shouldEnqueue: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -385,6 +451,46 @@ func TestPodHandler(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "running pod on controller node has only new file restore PVRs enqueued",
+			pod: &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "pod-1",
+					UID:       types.UID("uid"),
+				},
+				Spec: corev1api.PodSpec{
+					NodeName: controllerNode,
+				},
+				Status: corev1api.PodStatus{
+					Phase: corev1api.PodRunning,
+				},
+			},
+			podVolumeRestores: []*velerov1api.PodVolumeRestore{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "pvr-1",
+						Labels: map[string]string{
+							velerov1api.PodUIDLabel: "uid",
+						},
+					},
+					Spec: velerov1api.PodVolumeRestoreSpec{
+						IncludePaths: []string{"/file"},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "pvr-2",
+						Labels: map[string]string{
+							velerov1api.PodUIDLabel: "uid",
+						},
+					},
+				},
+			},
+			expectedEnqueues: sets.NewString("ns-1/pvr-1"),
+		},
 	}
 
 	for _, test := range tests {
@@ -720,3 +826,174 @@ func TestGetResticInitContainerIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoreTargetPath(t *testing.T) {
+	volume := t.TempDir()
+	outside := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(volume, "data"), 0755))
+	require.NoError(t, os.Symlink(outside, filepath.Join(volume, "escape")))
+	require.NoError(t, os.Symlink(filepath.Join(volume, "data"), filepath.Join(volume, "link")))
+
+	root, err := filepath.EvalSymlinks(volume)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		targetPath string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "existing directory",
+			targetPath: "data",
+			want:       filepath.Join(root, "data"),
+		},
+		{
+			name:       "new directories are created",
+			targetPath: "data/new/dir",
+			want:       filepath.Join(root, "data", "new", "dir"),
+		},
+		{
+			name:       "parent references are cleaned within the volume",
+			targetPath: "../../data",
+			want:       filepath.Join(root, "data"),
+		},
+		{
+			name:       "symlink to outside of the volume in the parents is refused",
+			targetPath: "escape/dir",
+			wantErr:    true,
+		},
+		{
+			name:       "symlink to outside of the volume is refused",
+			targetPath: "escape",
+			wantErr:    true,
+		},
+		{
+			name:       "symlink within the volume is refused",
+			targetPath: "link",
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := restoreTargetPath(volume, test.targetPath)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+			assert.DirExists(t, got)
+		})
+	}
+
+	// nothing was created outside of the volume
+	entries, err := os.ReadDir(outside)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestPVRProcessQueueItem(t *testing.T) {
+	controllerNode := "foo"
+
+	pvr := func(phase velerov1api.PodVolumeRestorePhase) *velerov1api.PodVolumeRestore {
+		return &velerov1api.PodVolumeRestore{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "pvr-1"},
+			Spec: velerov1api.PodVolumeRestoreSpec{
+				Pod: corev1api.ObjectReference{Namespace: "ns-1", Name: "pod-1"},
+			},
+			Status: velerov1api.PodVolumeRestoreStatus{Phase: phase},
+		}
+	}
+	pod := func(node string) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pod-1"},
+			Spec:       corev1api.PodSpec{NodeName: node},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		pvr           *velerov1api.PodVolumeRestore
+		pod           *corev1api.Pod
+		expectProcess bool
+	}{
+		{
+			name:          "new restore of a pod on this node is processed",
+			pvr:           pvr(velerov1api.PodVolumeRestorePhaseNew),
+			pod:           pod(controllerNode),
+			expectProcess: true,
+		},
+		{
+			name: "in-progress restore is skipped",
+			pvr:  pvr(velerov1api.PodVolumeRestorePhaseInProgress),
+			pod:  pod(controllerNode),
+		},
+		{
+			name: "completed restore is skipped",
+			pvr:  pvr(velerov1api.PodVolumeRestorePhaseCompleted),
+			pod:  pod(controllerNode),
+		},
+		{
+			name: "restore of a pod on another node is skipped",
+			pvr:  pvr(velerov1api.PodVolumeRestorePhaseNew),
+			pod:  pod("bar"),
+		},
+		{
+			name: "restore of a pod that isn't found is skipped",
+			pvr:  pvr(velerov1api.PodVolumeRestorePhaseNew),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client      = velerofake.NewSimpleClientset()
+				informers   = veleroinformers.NewSharedInformerFactory(client, 0)
+				pvrInformer = informers.Velero().V1().PodVolumeRestores()
+				podInformer = cache.NewSharedIndexInformer(nil, new(corev1api.Pod), 0, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+				limiter     = restic.NewOperationLimiter(1)
+				processed   bool
+				c           = &podVolumeRestoreController{
+					genericController:      newGenericController(PodVolumeRestore, velerotest.NewLogger()),
+					podVolumeRestoreLister: velerov1listers.NewPodVolumeRestoreLister(pvrInformer.Informer().GetIndexer()),
+					podLister:              corev1listers.NewPodLister(podInformer.GetIndexer()),
+					nodeName:               controllerNode,
+					operationLimiter:       limiter,
+				}
+			)
+			c.processRestoreFunc = func(*velerov1api.PodVolumeRestore) error {
+				processed = true
+				return nil
+			}
+
+			require.NoError(t, pvrInformer.Informer().GetStore().Add(test.pvr))
+			if test.pod != nil {
+				require.NoError(t, podInformer.GetStore().Add(test.pod))
+			}
+
+			// the node's only slot is taken, so only a restore that's processed waits for it
+			limiter.Acquire()
+			done := make(chan error)
+			go func() { done <- c.processQueueItem("velero/pvr-1") }()
+
+			if test.expectProcess {
+				select {
+				case <-done:
+					t.Fatal("restore was processed without waiting for the operation limiter")
+				case <-time.After(100 * time.Millisecond):
+				}
+				limiter.Release()
+			}
+
+			select {
+			case err := <-done:
+				require.NoError(t, err)
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for processQueueItem")
+			}
+			assert.Equal(t, test.expectProcess, processed)
+		})
+	}
+}
@@ -26,17 +26,20 @@ import (
 	"io/ioutil"
 	"os"
 	"sort"
+	"sync"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -44,12 +47,16 @@ import (
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/logstream"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
@@ -79,6 +86,7 @@ var nonRestorableResources = []string{
 
 type restoreController struct {
 	*genericController
+	ctx context.Context
 
 	namespace              string
 	restoreClient          velerov1client.RestoresGetter
@@ -92,12 +100,20 @@ type restoreController struct {
 	metrics                *metrics.ServerMetrics
 	logFormat              logging.Format
 	clock                  clock.Clock
+	eventRecorder          record.EventRecorder
+	logStreams             *logstream.Broker
 
-	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
+	// cancelLock guards cancelFuncs
+	cancelLock sync.Mutex
+	// cancelFuncs cancel the contexts of in-progress restores, keyed by namespace/name.
+	cancelFuncs map[string]context.CancelFunc
+
+	newPluginManager  func(context.Context, logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
 }
 
 func NewRestoreController(
+	ctx context.Context,
 	namespace string,
 	restoreInformer velerov1informers.RestoreInformer,
 	restoreClient velerov1client.RestoresGetter,
@@ -108,13 +124,16 @@ func NewRestoreController(
 	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
 	logger logrus.FieldLogger,
 	restoreLogLevel logrus.Level,
-	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	newPluginManager func(context.Context, logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	logFormat logging.Format,
+	eventRecorder record.EventRecorder,
+	logStreams *logstream.Broker,
 ) Interface {
 	c := &restoreController{
 		genericController:      newGenericController(Restore, logger),
+		ctx:                    ctx,
 		namespace:              namespace,
 		restoreClient:          restoreClient,
 		podVolumeBackupClient:  podVolumeBackupClient,
@@ -127,6 +146,9 @@ func NewRestoreController(
 		metrics:                metrics,
 		logFormat:              logFormat,
 		clock:                  &clock.RealClock{},
+		eventRecorder:          eventRecorder,
+		logStreams:             logStreams,
+		cancelFuncs:            make(map[string]context.CancelFunc),
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
@@ -161,12 +183,32 @@ func NewRestoreController(
 				}
 				c.queue.Add(key)
 			},
+			DeleteFunc: func(obj interface{}) {
+				key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+				if err != nil {
+					c.logger.WithError(err).Error("Error creating queue key for deleted restore")
+					return
+				}
+				c.cancelRestore(key)
+			},
 		},
 	)
 
 	return c
 }
 
+// cancelRestore cancels the context of the restore with key, if it's in progress, which
+// cancels any calls to plugins and waits for pod volume restores that are being made for it.
+func (c *restoreController) cancelRestore(key string) {
+	c.cancelLock.Lock()
+	defer c.cancelLock.Unlock()
+
+	if cancel, found := c.cancelFuncs[key]; found {
+		c.logger.WithField("restore", key).Info("Restore was deleted while in progress, cancelling it")
+		cancel()
+	}
+}
+
 func (c *restoreController) resync() {
 	restores, err := c.restoreLister.List(labels.Everything())
 	if err != nil {
@@ -225,7 +267,7 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	// manager used here is not the same one used by c.runValidatedRestore,
 	// since within that function we want the plugin manager to log to
 	// our per-restore log (which is instantiated within c.runValidatedRestore).
-	pluginManager := c.newPluginManager(c.logger)
+	pluginManager := c.newPluginManager(c.ctx, c.logger)
 	defer pluginManager.CleanupClients()
 	info := c.validateAndComplete(restore, pluginManager)
 
@@ -251,35 +293,119 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	// store ref to just-updated item for creating patch
 	original = updatedRestore
 	restore = updatedRestore.DeepCopy()
+	recordRestorePhaseEvent(c.eventRecorder, restore)
 
 	if restore.Status.Phase == api.RestorePhaseFailedValidation {
 		return nil
 	}
 
-	if err := c.runValidatedRestore(restore, info); err != nil {
+	// The restore's context is cancelled if the restore is deleted while it's in progress.
+	key := kubeutil.NamespaceAndName(restore)
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.cancelLock.Lock()
+	c.cancelFuncs[key] = cancel
+	c.cancelLock.Unlock()
+	defer func() {
+		c.cancelLock.Lock()
+		delete(c.cancelFuncs, key)
+		c.cancelLock.Unlock()
+		cancel()
+	}()
+
+	if err := c.runValidatedRestore(ctx, restore, info); err != nil {
 		c.logger.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
-		c.metrics.RegisterRestoreFailed(backupScheduleName)
+	} else if restore.Status.Phase == api.RestorePhaseFailedPreRestoreActions {
+		c.logger.Debug("Restore not run because a pre-restore action failed")
 	} else if restore.Status.Errors > 0 {
 		c.logger.Debug("Restore partially failed")
 		restore.Status.Phase = api.RestorePhasePartiallyFailed
-		c.metrics.RegisterRestorePartialFailure(backupScheduleName)
 	} else {
 		c.logger.Debug("Restore completed")
 		restore.Status.Phase = api.RestorePhaseCompleted
-		c.metrics.RegisterRestoreSuccess(backupScheduleName)
 	}
 
 	restore.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+
+	if restore.Status.Phase == api.RestorePhaseCompleted || restore.Status.Phase == api.RestorePhasePartiallyFailed {
+		c.runPostRestoreActions(restore, pluginManager)
+	}
+
+	switch restore.Status.Phase {
+	case api.RestorePhaseCompleted:
+		c.metrics.RegisterRestoreSuccess(backupScheduleName)
+	case api.RestorePhasePartiallyFailed:
+		c.metrics.RegisterRestorePartialFailure(backupScheduleName)
+	case api.RestorePhaseFailed, api.RestorePhaseFailedPreRestoreActions:
+		c.metrics.RegisterRestoreFailed(backupScheduleName)
+	}
+	if restore.Status.StartTimestamp != nil {
+		restoreDuration := restore.Status.CompletionTimestamp.Time.Sub(restore.Status.StartTimestamp.Time)
+		c.metrics.RegisterRestoreDuration(backupScheduleName, restoreDuration.Seconds())
+	}
 	c.logger.Debug("Updating restore's final status")
 	if _, err = patchRestore(original, restore, c.restoreClient); err != nil {
 		c.logger.WithError(errors.WithStack(err)).Info("Error updating restore's final status")
 	}
+	recordRestorePhaseEvent(c.eventRecorder, restore)
+
+	// upload the restore's metadata so that it can be synced into other clusters
+	// that use the same backup storage location, unless the restore was cancelled,
+	// in which case it may have been deleted.
+	if ctx.Err() == nil {
+		if err := c.putRestoreMetadata(restore, info, pluginManager); err != nil {
+			c.logger.WithError(err).Error("Error uploading restore metadata to backup storage")
+		}
+	}
 
 	return nil
 }
 
+// runPostRestoreActions runs the post-restore action plugins for a restore that has run to
+// completion, recording their statuses. A completed restore is partially failed if any of
+// them fail.
+func (c *restoreController) runPostRestoreActions(restore *api.Restore, pluginManager clientmgmt.Manager) {
+	log := c.logger.WithField("restore", kubeutil.NamespaceAndName(restore))
+
+	postRestoreActions, err := pluginManager.GetPostRestoreActions()
+	if err != nil {
+		log.WithError(err).Error("Error getting post-restore actions")
+		return
+	}
+	if len(postRestoreActions) == 0 {
+		return
+	}
+
+	actions := make(map[string]func() error, len(postRestoreActions))
+	for name, action := range postRestoreActions {
+		action := action
+		actions[name] = func() error { return action.Execute(restore) }
+	}
+
+	statuses, failed := runPluginActions(actions, false, c.clock, log)
+	restore.Status.PostRestoreActionsStatuses = statuses
+	if failed && restore.Status.Phase == api.RestorePhaseCompleted {
+		restore.Status.Phase = api.RestorePhasePartiallyFailed
+	}
+}
+
+func (c *restoreController) putRestoreMetadata(restore *api.Restore, info backupInfo, pluginManager clientmgmt.Manager) error {
+	// re-instantiate the backup store because credentials could have changed since the original
+	// instantiation, if this was a long-running restore
+	backupStore, err := c.backupStoreGetter.Get(info.location, pluginManager, c.logger)
+	if err != nil {
+		return errors.Wrap(err, "error setting up backup store to persist restore metadata")
+	}
+
+	restoreJSON := new(bytes.Buffer)
+	if err := encode.EncodeTo(restore, "json", restoreJSON); err != nil {
+		return errors.Wrap(err, "error encoding restore to JSON")
+	}
+
+	return backupStore.PutRestoreMetadata(restore.Spec.BackupName, restore.Name, restoreJSON)
+}
+
 type backupInfo struct {
 	backup      *api.Backup
 	location    *velerov1api.BackupStorageLocation
@@ -427,17 +553,21 @@ func (c *restoreController) fetchBackupInfo(backupName string, pluginManager cli
 // runValidatedRestore takes a validated restore API object and executes the restore process.
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
-// counts, but *does not* update its phase or patch it via the API.
-func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo) error {
+// counts, but *does not* update its phase or patch it via the API. Calls to plugins and waits for
+// pod volume restores are cancelled when ctx is done, in which case the results aren't uploaded.
+func (c *restoreController) runValidatedRestore(ctx context.Context, restore *api.Restore, info backupInfo) error {
+	ctx, span := tracing.Tracer().Start(ctx, "Restore", trace.WithAttributes(tracing.RestoreNameKey.String(restore.Name)))
+	defer span.End()
+
 	// instantiate the per-restore logger that will output both to a temp file
 	// (for upload to object storage) and to stdout.
-	restoreLog, err := newRestoreLogger(restore, c.logger, c.restoreLogLevel, c.logFormat)
+	restoreLog, err := newRestoreLogger(restore, c.logger, c.restoreLogLevel, c.logFormat, c.logStreams)
 	if err != nil {
 		return err
 	}
 	defer restoreLog.closeAndRemove(c.logger)
 
-	pluginManager := c.newPluginManager(restoreLog)
+	pluginManager := c.newPluginManager(ctx, restoreLog)
 	defer pluginManager.CleanupClients()
 
 	actions, err := pluginManager.GetRestoreItemActions()
@@ -452,7 +582,14 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	}
 	snapshotItemResolver := framework.NewItemSnapshotterResolver(itemSnapshotters)
 
+	preRestoreActions, err := pluginManager.GetPreRestoreActions()
+	if err != nil {
+		return errors.Wrap(err, "error getting pre-restore actions")
+	}
+
+	_, downloadSpan := tracing.Tracer().Start(ctx, "GetBackupContents")
 	backupFile, err := downloadToTempFile(restore.Spec.BackupName, info.backupStore, restoreLog)
+	tracing.EndSpan(downloadSpan, err)
 	if err != nil {
 		return errors.Wrap(err, "error downloading backup")
 	}
@@ -470,6 +607,36 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error fetching volume snapshots metadata")
 	}
 
+	var dataUploads []*velerov1api.DataUpload
+	if boolptr.IsSetToTrue(info.backup.Spec.SnapshotMoveData) {
+		if dataUploads, err = info.backupStore.GetDataUploads(restore.Spec.BackupName); err != nil {
+			return errors.Wrap(err, "error fetching data uploads metadata")
+		}
+	}
+
+	if len(preRestoreActions) > 0 {
+		actions := make(map[string]func() error, len(preRestoreActions))
+		for name, action := range preRestoreActions {
+			action := action
+			actions[name] = func() error { return action.Execute(restore) }
+		}
+
+		restoreLog.Info("running pre-restore actions")
+		statuses, failed := runPluginActions(actions, true, c.clock, restoreLog)
+		restore.Status.PreRestoreActionsStatuses = statuses
+		if failed {
+			restoreLog.Error("not running restore because a pre-restore action failed")
+			restore.Status.Phase = api.RestorePhaseFailedPreRestoreActions
+
+			if logReader, err := restoreLog.done(c.logger); err != nil {
+				c.logger.WithError(err).Error("Error getting restore log reader")
+			} else if err := info.backupStore.PutRestoreLog(restore.Spec.BackupName, restore.Name, logReader); err != nil {
+				c.logger.WithError(err).Error("Error uploading restore log to backup storage")
+			}
+			return nil
+		}
+	}
+
 	restoreLog.Info("starting restore")
 
 	var podVolumeBackups []*velerov1api.PodVolumeBackup
@@ -482,11 +649,18 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		Backup:           info.backup,
 		PodVolumeBackups: podVolumeBackups,
 		VolumeSnapshots:  volumeSnapshots,
+		DataUploads:      dataUploads,
 		BackupReader:     backupFile,
 	}
-	restoreWarnings, restoreErrors := c.restorer.RestoreWithResolvers(restoreReq, actionsResolver, snapshotItemResolver,
+	restoreWarnings, restoreErrors := c.restorer.RestoreWithResolvers(ctx, restoreReq, actionsResolver, snapshotItemResolver,
 		c.snapshotLocationLister, pluginManager)
 
+	// If the restore was deleted or the server is shutting down, don't upload the
+	// results of the cancelled restore.
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "restore was cancelled because it was deleted or the server is shutting down")
+	}
+
 	// log errors and warnings to the restore log
 	for _, msg := range restoreErrors.Velero {
 		restoreLog.Errorf("Velero restore error: %v", msg)
@@ -635,9 +809,12 @@ type restoreLogger struct {
 	logrus.FieldLogger
 	file *os.File
 	w    *gzip.Writer
+	// stream keeps the log in memory while the restore is in progress so that it can be
+	// followed. It's nil if the restore controller doesn't have a log stream broker.
+	stream *logstream.Stream
 }
 
-func newRestoreLogger(restore *api.Restore, baseLogger logrus.FieldLogger, logLevel logrus.Level, logFormat logging.Format) (*restoreLogger, error) {
+func newRestoreLogger(restore *api.Restore, baseLogger logrus.FieldLogger, logLevel logrus.Level, logFormat logging.Format, logStreams *logstream.Broker) (*restoreLogger, error) {
 	file, err := ioutil.TempFile("", "")
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating temp file")
@@ -647,10 +824,17 @@ func newRestoreLogger(restore *api.Restore, baseLogger logrus.FieldLogger, logLe
 	logger := logging.DefaultLogger(logLevel, logFormat)
 	logger.Out = io.MultiWriter(os.Stdout, w)
 
+	var stream *logstream.Stream
+	if logStreams != nil {
+		stream = logStreams.Open(logstream.KindRestore, restore.Name, logger.Formatter)
+		logger.Hooks.Add(stream)
+	}
+
 	return &restoreLogger{
 		FieldLogger: logger.WithField("restore", kubeutil.NamespaceAndName(restore)),
 		file:        file,
 		w:           w,
+		stream:      stream,
 	}, nil
 }
 
@@ -659,6 +843,9 @@ func newRestoreLogger(restore *api.Restore, baseLogger logrus.FieldLogger, logLe
 // restoreLogger to log after calling done will panic.
 func (l *restoreLogger) done(log logrus.FieldLogger) (io.Reader, error) {
 	l.FieldLogger = nil
+	if l.stream != nil {
+		l.stream.Close()
+	}
 
 	if err := l.w.Close(); err != nil {
 		log.WithError(errors.WithStack(err)).Error("error closing gzip writer")
@@ -675,5 +862,8 @@ func (l *restoreLogger) done(log logrus.FieldLogger) (io.Reader, error) {
 // method should be called when all logging and reading from the logger is
 // complete.
 func (l *restoreLogger) closeAndRemove(log logrus.FieldLogger) {
+	if l.stream != nil {
+		l.stream.Close()
+	}
 	closeAndRemoveFile(l.file, log)
 }
@@ -35,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/clock"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -48,6 +49,7 @@ import (
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
+	veleromocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -107,6 +109,7 @@ func TestFetchBackupInfo(t *testing.T) {
 			defer backupStore.AssertExpectations(t)
 
 			c := NewRestoreController(
+				context.Background(),
 				velerov1api.DefaultNamespace,
 				sharedInformers.Velero().V1().Restores(),
 				client.VeleroV1(),
@@ -117,10 +120,12 @@ func TestFetchBackupInfo(t *testing.T) {
 				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				logger,
 				logrus.InfoLevel,
-				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				NewFakeSingleObjectBackupStoreGetter(backupStore),
 				metrics.NewServerMetrics(),
 				formatFlag,
+				&record.FakeRecorder{},
+				nil,
 			).(*restoreController)
 
 			if test.backupStoreError == nil {
@@ -199,6 +204,7 @@ func TestProcessQueueItemSkips(t *testing.T) {
 			)
 
 			c := NewRestoreController(
+				context.Background(),
 				velerov1api.DefaultNamespace,
 				sharedInformers.Velero().V1().Restores(),
 				client.VeleroV1(),
@@ -213,6 +219,8 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				nil, // backupStoreGetter
 				metrics.NewServerMetrics(),
 				formatFlag,
+				&record.FakeRecorder{},
+				nil,
 			).(*restoreController)
 
 			if test.restore != nil {
@@ -254,6 +262,9 @@ func TestProcessQueueItem(t *testing.T) {
 		backupStoreGetBackupContentsErr error
 		putRestoreLogErr                error
 		expectedFinalPhase              string
+		preRestoreActionErr             error
+		postRestoreActionErr            error
+		expectedActionStatuses          []velerov1api.ActionStatus
 	}{
 		{
 			name:                     "restore with both namespace in both includedNamespaces and excludedNamespaces fails validation",
@@ -331,6 +342,36 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedCompletedTime: &timestamp,
 			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
 		},
+		{
+			name:                "failed pre-restore action stops the restore",
+			location:            defaultStorageLocation,
+			restore:             NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:              defaultBackup().StorageLocation("default").Result(),
+			preRestoreActionErr: errors.New("pre-restore error"),
+			expectedErr:         false,
+			expectedPhase:       string(velerov1api.RestorePhaseInProgress),
+			expectedStartTime:   &timestamp,
+			expectedFinalPhase:  string(velerov1api.RestorePhaseFailedPreRestoreActions),
+			expectedActionStatuses: []velerov1api.ActionStatus{
+				{PluginName: "velero.io/pre", StartTimestamp: &timestamp, CompletionTimestamp: &timestamp, Phase: velerov1api.ActionPhaseFailed, Message: "pre-restore error"},
+			},
+		},
+		{
+			name:                  "failed post-restore action makes the restore partially failed",
+			location:              defaultStorageLocation,
+			restore:               NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                defaultBackup().StorageLocation("default").Result(),
+			postRestoreActionErr:  errors.New("post-restore error"),
+			expectedErr:           false,
+			expectedPhase:         string(velerov1api.RestorePhaseInProgress),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
+			expectedFinalPhase:    string(velerov1api.RestorePhasePartiallyFailed),
+			expectedActionStatuses: []velerov1api.ActionStatus{
+				{PluginName: "velero.io/post", StartTimestamp: &timestamp, CompletionTimestamp: &timestamp, Phase: velerov1api.ActionPhaseFailed, Message: "post-restore error"},
+			},
+		},
 		{
 			name:          "restoration of nodes is not supported",
 			location:      defaultStorageLocation,
@@ -426,6 +467,7 @@ func TestProcessQueueItem(t *testing.T) {
 			}()
 
 			c := NewRestoreController(
+				context.Background(),
 				velerov1api.DefaultNamespace,
 				sharedInformers.Velero().V1().Restores(),
 				client.VeleroV1(),
@@ -436,10 +478,12 @@ func TestProcessQueueItem(t *testing.T) {
 				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				logger,
 				logrus.InfoLevel,
-				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				func(context.Context, logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				NewFakeSingleObjectBackupStoreGetter(backupStore),
 				metrics.NewServerMetrics(),
 				formatFlag,
+				&record.FakeRecorder{},
+				nil,
 			).(*restoreController)
 
 			c.clock = clock.NewFakeClock(now)
@@ -504,6 +548,12 @@ func TestProcessQueueItem(t *testing.T) {
 			if test.putRestoreLogErr != nil {
 				errors.Velero = append(errors.Velero, "error uploading log file to object storage: "+test.putRestoreLogErr.Error())
 			}
+			if test.preRestoreActionErr != nil {
+				backupStore.On("GetBackupContents", test.backup.Name).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+				backupStore.On("GetBackupVolumeSnapshots", test.backup.Name).Return(nil, nil)
+				backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
+				backupStore.On("PutRestoreMetadata", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
+			}
 			if test.expectedRestorerCall != nil {
 				backupStore.On("GetBackupContents", test.backup.Name).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 
@@ -514,6 +564,8 @@ func TestProcessQueueItem(t *testing.T) {
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
 
+				backupStore.On("PutRestoreMetadata", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
+
 				volumeSnapshots := []*volume.Snapshot{
 					{
 						Spec: volume.SnapshotSpec{
@@ -544,12 +596,29 @@ func TestProcessQueueItem(t *testing.T) {
 			if test.backupStoreGetBackupContentsErr != nil {
 				// TODO why do I need .Maybe() here?
 				backupStore.On("GetBackupContents", test.restore.Spec.BackupName).Return(nil, test.backupStoreGetBackupContentsErr).Maybe()
+				backupStore.On("PutRestoreMetadata", test.restore.Spec.BackupName, test.restore.Name, mock.Anything).Return(nil)
 			}
 
 			if test.restore != nil {
 				pluginManager.On("GetRestoreItemActions").Return(nil, nil)
 				pluginManager.On("GetItemSnapshotters").Return([]isv1.ItemSnapshotter{}, nil)
 				pluginManager.On("CleanupClients")
+
+				preRestoreActions := make(map[string]velero.PreRestoreAction)
+				if test.preRestoreActionErr != nil {
+					action := new(veleromocks.PreRestoreAction)
+					action.On("Execute", mock.Anything).Return(test.preRestoreActionErr)
+					preRestoreActions["velero.io/pre"] = action
+				}
+				pluginManager.On("GetPreRestoreActions").Return(preRestoreActions, nil)
+
+				postRestoreActions := make(map[string]velero.PostRestoreAction)
+				if test.postRestoreActionErr != nil {
+					action := new(veleromocks.PostRestoreAction)
+					action.On("Execute", mock.Anything).Return(test.postRestoreActionErr)
+					postRestoreActions["velero.io/post"] = action
+				}
+				pluginManager.On("GetPostRestoreActions").Return(postRestoreActions, nil)
 			}
 
 			err = c.processQueueItem(key)
@@ -568,11 +637,13 @@ func TestProcessQueueItem(t *testing.T) {
 			}
 
 			type StatusPatch struct {
-				Phase               velerov1api.RestorePhase `json:"phase"`
-				ValidationErrors    []string                 `json:"validationErrors"`
-				Errors              int                      `json:"errors"`
-				StartTimestamp      *metav1.Time             `json:"startTimestamp"`
-				CompletionTimestamp *metav1.Time             `json:"completionTimestamp"`
+				Phase               velerov1api.RestorePhase   `json:"phase"`
+				ValidationErrors    []string                   `json:"validationErrors"`
+				PreRestoreActions   []velerov1api.ActionStatus `json:"preRestoreActionsStatuses"`
+				PostRestoreActions  []velerov1api.ActionStatus `json:"postRestoreActionsStatuses"`
+				Errors              int                        `json:"errors"`
+				StartTimestamp      *metav1.Time               `json:"startTimestamp"`
+				CompletionTimestamp *metav1.Time               `json:"completionTimestamp"`
 			}
 
 			type Patch struct {
@@ -609,6 +680,18 @@ func TestProcessQueueItem(t *testing.T) {
 
 			velerotest.ValidatePatch(t, actions[0], expected, decode)
 
+			// if a pre-restore action failed, validate the final phase and action statuses
+			if test.preRestoreActionErr != nil {
+				expected = Patch{
+					Status: StatusPatch{
+						Phase:               velerov1api.RestorePhase(test.expectedFinalPhase),
+						CompletionTimestamp: &timestamp,
+						PreRestoreActions:   test.expectedActionStatuses,
+					},
+				}
+				velerotest.ValidatePatch(t, actions[len(actions)-1], expected, decode)
+			}
+
 			// if we don't expect a restore, validate it wasn't called and exit the test
 			if test.expectedRestorerCall == nil {
 				assert.Empty(t, restorer.Calls)
@@ -616,6 +699,7 @@ func TestProcessQueueItem(t *testing.T) {
 				return
 			}
 			assert.Equal(t, 1, len(restorer.Calls))
+			backupStore.AssertCalled(t, "PutRestoreMetadata", test.backup.Name, test.restore.Name, mock.Anything)
 
 			// validate Patch call 2 (setting phase)
 
@@ -633,6 +717,7 @@ func TestProcessQueueItem(t *testing.T) {
 						Phase:               velerov1api.RestorePhase(test.expectedFinalPhase),
 						Errors:              test.expectedRestoreErrors,
 						CompletionTimestamp: test.expectedCompletedTime,
+						PostRestoreActions:  test.expectedActionStatuses,
 					},
 				}
 			}
@@ -658,6 +743,7 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 	)
 
 	c := NewRestoreController(
+		context.Background(),
 		velerov1api.DefaultNamespace,
 		sharedInformers.Velero().V1().Restores(),
 		client.VeleroV1(),
@@ -672,6 +758,8 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		nil, // backupStoreGetter
 		nil,
 		formatFlag,
+		&record.FakeRecorder{},
+		nil,
 	).(*restoreController)
 
 	restore := &velerov1api.Restore{
@@ -863,7 +951,7 @@ func (r *fakeRestorer) Restore(
 	return res.Get(0).(pkgrestore.Result), res.Get(1).(pkgrestore.Result)
 }
 
-func (r *fakeRestorer) RestoreWithResolvers(req pkgrestore.Request,
+func (r *fakeRestorer) RestoreWithResolvers(ctx context.Context, req pkgrestore.Request,
 	resolver framework.RestoreItemActionResolver,
 	itemSnapshotterResolver framework.ItemSnapshotterResolver,
 	snapshotLocationLister listers.VolumeSnapshotLocationLister,
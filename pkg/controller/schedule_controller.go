@@ -20,18 +20,23 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/robfig/cron"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -44,6 +49,19 @@ import (
 
 const (
 	scheduleSyncPeriod = time.Minute
+
+	// missedRunGracePeriod is how late a scheduled run can be processed
+	// before it's considered missed.
+	missedRunGracePeriod = 5 * time.Minute
+
+	// defaultMissedRunWindow is how long ago a missed run can have been due
+	// for it to still be run with the RunAll missed run policy, if the
+	// schedule doesn't specify a window.
+	defaultMissedRunWindow = 24 * time.Hour
+
+	// maxMissedRuns is the maximum number of backups that are run at once
+	// for missed runs with the RunAll missed run policy.
+	maxMissedRuns = 24
 )
 
 type scheduleController struct {
@@ -53,8 +71,13 @@ type scheduleController struct {
 	schedulesClient velerov1client.SchedulesGetter
 	backupsClient   velerov1client.BackupsGetter
 	schedulesLister velerov1listers.ScheduleLister
+	backupLister    velerov1listers.BackupLister
+	backupTracker   BackupTracker
 	clock           clock.Clock
 	metrics         *metrics.ServerMetrics
+	eventRecorder   record.EventRecorder
+
+	backupCancelTimeout time.Duration
 }
 
 func NewScheduleController(
@@ -62,8 +85,11 @@ func NewScheduleController(
 	schedulesClient velerov1client.SchedulesGetter,
 	backupsClient velerov1client.BackupsGetter,
 	schedulesInformer velerov1informers.ScheduleInformer,
+	backupLister velerov1listers.BackupLister,
+	backupTracker BackupTracker,
 	logger logrus.FieldLogger,
 	metrics *metrics.ServerMetrics,
+	eventRecorder record.EventRecorder,
 ) *scheduleController {
 	c := &scheduleController{
 		genericController: newGenericController(Schedule, logger),
@@ -71,8 +97,13 @@ func NewScheduleController(
 		schedulesClient:   schedulesClient,
 		backupsClient:     backupsClient,
 		schedulesLister:   schedulesInformer.Lister(),
+		backupLister:      backupLister,
+		backupTracker:     backupTracker,
 		clock:             clock.RealClock{},
 		metrics:           metrics,
+		eventRecorder:     eventRecorder,
+
+		backupCancelTimeout: backupCancelTimeout,
 	}
 
 	c.syncHandler = c.processSchedule
@@ -185,6 +216,10 @@ func (c *scheduleController) processSchedule(key string) error {
 			return errors.Wrapf(err, "error updating Schedule phase to %s", schedule.Status.Phase)
 		}
 		schedule = updatedSchedule
+
+		if schedule.Status.Phase == api.SchedulePhaseFailedValidation {
+			c.eventRecorder.Eventf(schedule, corev1api.EventTypeWarning, eventReasonFailedValidation, "Schedule failed validation: %s", strings.Join(schedule.Status.ValidationErrors, "; "))
+		}
 	}
 
 	if schedule.Status.Phase != api.SchedulePhaseEnabled {
@@ -232,6 +267,20 @@ func parseCronSchedule(itm *api.Schedule, logger logrus.FieldLogger) (cron.Sched
 		}
 	}()
 
+	if itm.Spec.TimeZone != "" {
+		location, err := time.LoadLocation(itm.Spec.TimeZone)
+		if err != nil {
+			log.WithError(errors.WithStack(err)).WithField("timeZone", itm.Spec.TimeZone).Debug("Error loading time zone")
+			validationErrors = append(validationErrors, fmt.Sprintf("invalid time zone: %v", err))
+		} else if schedule != nil {
+			schedule = &locationSchedule{Schedule: schedule, location: location}
+		}
+	}
+
+	if itm.Spec.MissedRunWindow != nil && itm.Spec.MissedRunWindow.Duration < 0 {
+		validationErrors = append(validationErrors, "missed run window must not be negative")
+	}
+
 	if len(validationErrors) > 0 {
 		return nil, validationErrors
 	}
@@ -239,6 +288,17 @@ func parseCronSchedule(itm *api.Schedule, logger logrus.FieldLogger) (cron.Sched
 	return schedule, nil
 }
 
+// locationSchedule is a cron schedule that's evaluated in a time zone, so that
+// its runs happen at the same wall clock time across daylight saving changes.
+type locationSchedule struct {
+	cron.Schedule
+	location *time.Location
+}
+
+func (s *locationSchedule) Next(t time.Time) time.Time {
+	return s.Schedule.Next(t.In(s.location))
+}
+
 func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule cron.Schedule) error {
 	var (
 		now                = c.clock.Now()
@@ -246,27 +306,57 @@ func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule
 		log                = c.logger.WithField("schedule", kubeutil.NamespaceAndName(item))
 	)
 
+	original := item
+	schedule := item.DeepCopy()
+
 	if !isDue {
 		log.WithField("nextRunTime", nextRunTime).Debug("Schedule is not due, skipping")
+
+		if schedule.Status.NextRunTime == nil || !schedule.Status.NextRunTime.Time.Equal(nextRunTime) {
+			schedule.Status.NextRunTime = &metav1.Time{Time: nextRunTime}
+			if _, err := patchSchedule(original, schedule, c.schedulesClient); err != nil {
+				return errors.Wrapf(err, "error updating Schedule's NextRunTime to %v", schedule.Status.NextRunTime)
+			}
+		}
 		return nil
 	}
 
-	// Don't attempt to "catch up" if there are any missed or failed runs - simply
-	// trigger a Backup if it's time.
-	//
-	// It might also make sense in the future to explicitly check for currently-running
-	// backups so that we don't overlap runs (for disk snapshots in particular, this can
-	// lead to performance issues).
-	log.WithField("nextRunTime", nextRunTime).Info("Schedule is due, submitting Backup")
-	backup := getBackup(item, now)
-	if _, err := c.backupsClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{}); err != nil {
-		return errors.Wrap(err, "error creating Backup")
+	runTimes, lastSkipped := getRunTimes(schedule, cronSchedule, now)
+	if lastSkipped != nil {
+		log.WithField("skippedRunTime", *lastSkipped).Info("Skipping missed schedule runs")
+		schedule.Status.LastSkipped = &metav1.Time{Time: *lastSkipped}
 	}
 
-	original := item
-	schedule := item.DeepCopy()
+	if len(runTimes) > 0 {
+		var err error
+		if runTimes, err = c.applyConcurrencyPolicy(schedule, runTimes, log); err != nil {
+			return err
+		}
+	}
 
-	schedule.Status.LastBackup = &metav1.Time{Time: now}
+	for _, runTime := range runTimes {
+		// a backup is run for each of the missed runs with the RunAll policy, so
+		// name them after the time they were due instead of the current time.
+		timestamp := now
+		if schedule.Spec.MissedRunPolicy == api.ScheduleMissedRunPolicyRunAll {
+			timestamp = runTime
+		}
+
+		log.WithField("runTime", runTime).Info("Schedule is due, submitting Backup")
+		backup := getBackup(item, timestamp)
+		if _, err := c.backupsClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{}); err != nil {
+			c.eventRecorder.Eventf(item, corev1api.EventTypeWarning, eventReasonBackupCreationFailed, "Error creating backup %s: %v", backup.Name, err)
+			return errors.Wrap(err, "error creating Backup")
+		}
+		c.eventRecorder.Eventf(item, corev1api.EventTypeNormal, eventReasonBackupCreated, "Created backup %s", backup.Name)
+	}
+
+	if len(runTimes) > 0 {
+		schedule.Status.LastBackup = &metav1.Time{Time: now}
+	}
+
+	_, nextRunTime = getNextRunTime(schedule, cronSchedule, now)
+	schedule.Status.NextRunTime = &metav1.Time{Time: nextRunTime}
 
 	if _, err := patchSchedule(original, schedule, c.schedulesClient); err != nil {
 		return errors.Wrapf(err, "error updating Schedule's LastBackup time to %v", schedule.Status.LastBackup)
@@ -275,19 +365,164 @@ func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule
 	return nil
 }
 
-func getNextRunTime(schedule *api.Schedule, cronSchedule cron.Schedule, asOf time.Time) (bool, time.Time) {
-	var lastBackupTime time.Time
+// applyConcurrencyPolicy returns the run times that backups should be created for
+// according to the schedule's concurrency policy, given the backups from the schedule
+// that haven't completed yet. Skipped runs are recorded on the schedule's status.
+func (c *scheduleController) applyConcurrencyPolicy(schedule *api.Schedule, runTimes []time.Time, log logrus.FieldLogger) ([]time.Time, error) {
+	policy := schedule.Spec.ConcurrencyPolicy
+	if policy == "" || policy == api.ScheduleConcurrencyPolicyAllow {
+		return runTimes, nil
+	}
+
+	// runs must not overlap each other either, so only the latest one is kept.
+	latest := runTimes[len(runTimes)-1]
+	if len(runTimes) > 1 {
+		schedule.Status.LastSkipped = &metav1.Time{Time: runTimes[len(runTimes)-2]}
+	}
+
+	activeBackups, err := c.getActiveBackups(schedule)
+	if err != nil {
+		return nil, err
+	}
+	if len(activeBackups) == 0 {
+		return []time.Time{latest}, nil
+	}
+
+	switch policy {
+	case api.ScheduleConcurrencyPolicyForbid:
+		log.WithFields(logrus.Fields{
+			"runTime":      latest,
+			"activeBackup": activeBackups[0].Name,
+		}).Info("Skipping schedule run because a previous backup hasn't completed")
+		schedule.Status.LastSkipped = &metav1.Time{Time: latest}
+		return nil, nil
+	case api.ScheduleConcurrencyPolicyReplace:
+		for _, backup := range activeBackups {
+			if backup.Status.Phase == api.BackupPhaseInProgress {
+				// cancel the backup and wait for it to stop, the same way deleting it
+				// does, so that it isn't persisted after it's been replaced.
+				if !c.backupTracker.Cancel(backup.Namespace, backup.Name) {
+					log.WithField("backup", backup.Name).Info("Previous backup is in progress but isn't running on this server, letting it complete")
+					continue
+				}
+				log.WithField("backup", backup.Name).Info("Cancelling previous backup that's in progress to replace it")
+
+				err := wait.PollImmediate(time.Second, c.backupCancelTimeout, func() (bool, error) {
+					return !c.backupTracker.Contains(backup.Namespace, backup.Name), nil
+				})
+				if err != nil {
+					return nil, errors.Wrapf(err, "error waiting for in-progress Backup %s to be cancelled", kubeutil.NamespaceAndName(backup))
+				}
+			}
+
+			log.WithField("backup", backup.Name).Info("Deleting previous backup to replace it")
+			err := c.backupsClient.Backups(backup.Namespace).Delete(context.TODO(), backup.Name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return nil, errors.Wrapf(err, "error deleting Backup %s", kubeutil.NamespaceAndName(backup))
+			}
+		}
+	}
+
+	return []time.Time{latest}, nil
+}
+
+// getActiveBackups returns the backups created by the schedule that are new
+// or in progress, oldest first.
+func (c *scheduleController) getActiveBackups(schedule *api.Schedule) ([]*api.Backup, error) {
+	selector := labels.SelectorFromSet(labels.Set{api.ScheduleNameLabel: schedule.Name})
+	backups, err := c.backupLister.Backups(schedule.Namespace).List(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing Backups for Schedule")
+	}
+
+	var active []*api.Backup
+	for _, backup := range backups {
+		switch backup.Status.Phase {
+		case "", api.BackupPhaseNew, api.BackupPhaseInProgress:
+			active = append(active, backup)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].CreationTimestamp.Before(&active[j].CreationTimestamp)
+	})
+
+	return active, nil
+}
+
+// getLastRunTime returns the time the schedule last ran or skipped a run, or the
+// time it was created if it has never done either.
+func getLastRunTime(schedule *api.Schedule) time.Time {
+	lastRunTime := schedule.CreationTimestamp.Time
 	if schedule.Status.LastBackup != nil {
-		lastBackupTime = schedule.Status.LastBackup.Time
-	} else {
-		lastBackupTime = schedule.CreationTimestamp.Time
+		lastRunTime = schedule.Status.LastBackup.Time
 	}
+	if schedule.Status.LastSkipped != nil && schedule.Status.LastSkipped.Time.After(lastRunTime) {
+		lastRunTime = schedule.Status.LastSkipped.Time
+	}
+
+	return lastRunTime
+}
 
-	nextRunTime := cronSchedule.Next(lastBackupTime)
+func getNextRunTime(schedule *api.Schedule, cronSchedule cron.Schedule, asOf time.Time) (bool, time.Time) {
+	nextRunTime := cronSchedule.Next(getLastRunTime(schedule))
 
 	return asOf.After(nextRunTime), nextRunTime
 }
 
+// getRunTimes returns the times of the due runs that a backup should be run for
+// according to the schedule's missed run policy, oldest first, and the time of the
+// latest due run that is skipped, if any.
+func getRunTimes(schedule *api.Schedule, cronSchedule cron.Schedule, asOf time.Time) ([]time.Time, *time.Time) {
+	window := defaultMissedRunWindow
+	if schedule.Spec.MissedRunWindow != nil {
+		window = schedule.Spec.MissedRunWindow.Duration
+	}
+
+	var (
+		dueRunTimes   []time.Time
+		outsideWindow *time.Time
+	)
+	for runTime := cronSchedule.Next(getLastRunTime(schedule)); !runTime.IsZero() && asOf.After(runTime); runTime = cronSchedule.Next(runTime) {
+		if asOf.Sub(runTime) > window {
+			skipped := runTime
+			outsideWindow = &skipped
+			continue
+		}
+		dueRunTimes = append(dueRunTimes, runTime)
+	}
+
+	var latest time.Time
+	switch {
+	case len(dueRunTimes) > 0:
+		latest = dueRunTimes[len(dueRunTimes)-1]
+	case outsideWindow != nil:
+		latest = *outsideWindow
+	default:
+		return nil, nil
+	}
+
+	switch {
+	case schedule.Spec.Paused:
+		return nil, &latest
+	case schedule.Spec.MissedRunPolicy == api.ScheduleMissedRunPolicySkip:
+		if asOf.Sub(latest) > missedRunGracePeriod {
+			return nil, &latest
+		}
+		return []time.Time{latest}, nil
+	case schedule.Spec.MissedRunPolicy == api.ScheduleMissedRunPolicyRunAll:
+		// don't flood the cluster with backups if the schedule runs very often.
+		if len(dueRunTimes) > maxMissedRuns {
+			skipped := dueRunTimes[len(dueRunTimes)-maxMissedRuns-1]
+			outsideWindow = &skipped
+			dueRunTimes = dueRunTimes[len(dueRunTimes)-maxMissedRuns:]
+		}
+		return dueRunTimes, outsideWindow
+	default:
+		// a single backup covers all of the missed runs.
+		return []time.Time{latest}, nil
+	}
+}
+
 func getBackup(item *api.Schedule, timestamp time.Time) *api.Backup {
 	name := item.TimestampedName(timestamp)
 	backup := builder.
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/clock"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -48,12 +49,17 @@ func TestProcessSchedule(t *testing.T) {
 		name                     string
 		scheduleKey              string
 		schedule                 *velerov1api.Schedule
+		backups                  []*velerov1api.Backup
+		runningBackups           []string
 		fakeClockTime            string
 		expectedErr              bool
 		expectedPhase            string
 		expectedValidationErrors []string
+		expectedBackupDelete     string
 		expectedBackupCreate     *velerov1api.Backup
 		expectedLastBackup       string
+		expectedLastSkipped      string
+		expectedNextRunTime      string
 	}{
 		{
 			name:        "invalid key returns error",
@@ -99,6 +105,7 @@ func TestProcessSchedule(t *testing.T) {
 			expectedPhase:        string(velerov1api.SchedulePhaseEnabled),
 			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
 			expectedLastBackup:   "2017-01-01 12:00:00",
+			expectedNextRunTime:  "2017-01-01 12:05:00",
 		},
 		{
 			name:                 "schedule with phase Enabled gets re-validated and triggers a backup if valid",
@@ -107,6 +114,7 @@ func TestProcessSchedule(t *testing.T) {
 			expectedErr:          false,
 			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
 			expectedLastBackup:   "2017-01-01 12:00:00",
+			expectedNextRunTime:  "2017-01-01 12:05:00",
 		},
 		{
 			name:                 "schedule that's already run gets LastBackup updated",
@@ -115,6 +123,104 @@ func TestProcessSchedule(t *testing.T) {
 			expectedErr:          false,
 			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
 			expectedLastBackup:   "2017-01-01 12:00:00",
+			expectedNextRunTime:  "2017-01-01 12:05:00",
+		},
+		{
+			name:                "paused schedule skips its due run",
+			schedule:            newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("0 * * * *").Paused(true).LastBackupTime("2017-01-01 09:30:00").Result(),
+			fakeClockTime:       "2017-01-01 12:30:00",
+			expectedLastSkipped: "2017-01-01 12:00:00",
+			expectedNextRunTime: "2017-01-01 13:00:00",
+		},
+		{
+			name:                "schedule with the Skip missed run policy skips runs that are late",
+			schedule:            newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("0 * * * *").MissedRunPolicy(velerov1api.ScheduleMissedRunPolicySkip).LastBackupTime("2017-01-01 09:30:00").Result(),
+			fakeClockTime:       "2017-01-01 12:30:00",
+			expectedLastSkipped: "2017-01-01 12:00:00",
+			expectedNextRunTime: "2017-01-01 13:00:00",
+		},
+		{
+			name:                 "schedule with a time zone is evaluated in it",
+			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("0 9 * * *").TimeZone("America/New_York").LastBackupTime("2017-01-01 00:00:00").Result(),
+			fakeClockTime:        "2017-01-01 14:01:00",
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101140100").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 14:01:00",
+			expectedNextRunTime:  "2017-01-02 14:00:00",
+		},
+		{
+			name:                     "schedule with an invalid time zone fails validation",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").TimeZone("Not/AZone").Result(),
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid time zone: unknown time zone Not/AZone"},
+		},
+		{
+			name:     "schedule with the Allow concurrency policy triggers a backup while a previous one is in progress",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").ConcurrencyPolicy(velerov1api.ScheduleConcurrencyPolicyAllow).Result(),
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20170101115500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseInProgress).Result(),
+			},
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+			expectedNextRunTime:  "2017-01-01 12:05:00",
+		},
+		{
+			name:     "schedule with the Forbid concurrency policy skips its run while a previous backup is in progress",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("0 * * * *").ConcurrencyPolicy(velerov1api.ScheduleConcurrencyPolicyForbid).LastBackupTime("2017-01-01 11:00:00").Result(),
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20170101110000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseInProgress).Result(),
+			},
+			fakeClockTime:       "2017-01-01 12:01:00",
+			expectedLastSkipped: "2017-01-01 12:00:00",
+			expectedNextRunTime: "2017-01-01 13:00:00",
+		},
+		{
+			name:     "schedule with the Forbid concurrency policy triggers a backup when previous backups have completed",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").ConcurrencyPolicy(velerov1api.ScheduleConcurrencyPolicyForbid).Result(),
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20170101115500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseCompleted).Result(),
+				builder.ForBackup("ns", "other-20170101115500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "other")).Phase(velerov1api.BackupPhaseInProgress).Result(),
+			},
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+			expectedNextRunTime:  "2017-01-01 12:05:00",
+		},
+		{
+			name:     "schedule with the Replace concurrency policy deletes a previous backup that hasn't started",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").ConcurrencyPolicy(velerov1api.ScheduleConcurrencyPolicyReplace).Result(),
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20170101115500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseNew).Result(),
+			},
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedBackupDelete: "name-20170101115500",
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+			expectedNextRunTime:  "2017-01-01 12:05:00",
+		},
+		{
+			name:     "schedule with the Replace concurrency policy cancels and deletes a previous backup in progress",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").ConcurrencyPolicy(velerov1api.ScheduleConcurrencyPolicyReplace).Result(),
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20170101115500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseInProgress).Result(),
+			},
+			runningBackups:       []string{"name-20170101115500"},
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedBackupDelete: "name-20170101115500",
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+			expectedNextRunTime:  "2017-01-01 12:05:00",
+		},
+		{
+			name:     "schedule with the Replace concurrency policy lets a previous backup in progress on another server complete",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").ConcurrencyPolicy(velerov1api.ScheduleConcurrencyPolicyReplace).Result(),
+			backups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20170101115500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseInProgress).Result(),
+			},
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+			expectedNextRunTime:  "2017-01-01 12:05:00",
 		},
 	}
 
@@ -126,13 +232,23 @@ func TestProcessSchedule(t *testing.T) {
 				logger          = velerotest.NewLogger()
 			)
 
+			// running backups stop as soon as they're cancelled.
+			backupTracker := NewBackupTracker()
+			for _, name := range test.runningBackups {
+				name := name
+				backupTracker.Add("ns", name, func() { backupTracker.Delete("ns", name) })
+			}
+
 			c := NewScheduleController(
 				"namespace",
 				client.VeleroV1(),
 				client.VeleroV1(),
 				sharedInformers.Velero().V1().Schedules(),
+				sharedInformers.Velero().V1().Backups().Lister(),
+				backupTracker,
 				logger,
 				metrics.NewServerMetrics(),
+				&record.FakeRecorder{},
 			)
 
 			var (
@@ -145,6 +261,10 @@ func TestProcessSchedule(t *testing.T) {
 			}
 			c.clock = clock.NewFakeClock(testTime)
 
+			for _, backup := range test.backups {
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			}
+
 			if test.schedule != nil {
 				sharedInformers.Velero().V1().Schedules().Informer().GetStore().Add(test.schedule)
 
@@ -198,6 +318,8 @@ func TestProcessSchedule(t *testing.T) {
 				ValidationErrors []string                  `json:"validationErrors"`
 				Phase            velerov1api.SchedulePhase `json:"phase"`
 				LastBackup       time.Time                 `json:"lastBackup"`
+				LastSkipped      time.Time                 `json:"lastSkipped"`
+				NextRunTime      time.Time                 `json:"nextRunTime"`
 			}
 
 			type Patch struct {
@@ -226,6 +348,19 @@ func TestProcessSchedule(t *testing.T) {
 				index++
 			}
 
+			if test.expectedBackupDelete != "" {
+				require.True(t, len(actions) > index, "len(actions) is too small")
+
+				action := core.NewDeleteAction(
+					velerov1api.SchemeGroupVersion.WithResource("backups"),
+					"ns",
+					test.expectedBackupDelete)
+
+				assert.Equal(t, action, actions[index])
+
+				index++
+			}
+
 			if created := test.expectedBackupCreate; created != nil {
 				require.True(t, len(actions) > index, "len(actions) is too small")
 
@@ -239,12 +374,14 @@ func TestProcessSchedule(t *testing.T) {
 				index++
 			}
 
-			if test.expectedLastBackup != "" {
+			if test.expectedNextRunTime != "" {
 				require.True(t, len(actions) > index, "len(actions) is too small")
 
 				expected := Patch{
 					Status: PatchStatus{
-						LastBackup: parseTime(test.expectedLastBackup),
+						LastBackup:  parseTime(test.expectedLastBackup),
+						LastSkipped: parseTime(test.expectedLastSkipped),
+						NextRunTime: parseTime(test.expectedNextRunTime),
 					},
 				}
 
@@ -390,6 +527,101 @@ func TestParseCronSchedule(t *testing.T) {
 	assert.Equal(t, time.Date(2017, 8, 12, 9, 0, 0, 0, time.UTC), next)
 }
 
+func TestGetRunTimes(t *testing.T) {
+	hourly := func() *builder.ScheduleBuilder {
+		return builder.ForSchedule("velero", "schedule-1").CronSchedule("0 * * * *").LastBackupTime("2017-01-01 09:30:00")
+	}
+	at := func(hour int) time.Time {
+		return time.Date(2017, 1, 1, hour, 0, 0, 0, time.UTC)
+	}
+	// the last maxMissedRuns five-minute runs up to and including noon
+	var lastFiveMinuteRuns []time.Time
+	for i := maxMissedRuns - 1; i >= 0; i-- {
+		lastFiveMinuteRuns = append(lastFiveMinuteRuns, at(12).Add(-time.Duration(i)*5*time.Minute))
+	}
+
+	tests := []struct {
+		name                string
+		schedule            *velerov1api.Schedule
+		asOf                time.Time
+		expectedRunTimes    []time.Time
+		expectedLastSkipped *time.Time
+	}{
+		{
+			name:     "not due",
+			schedule: hourly().Result(),
+			asOf:     time.Date(2017, 1, 1, 9, 45, 0, 0, time.UTC),
+		},
+		{
+			name:             "RunOnce runs the latest missed run",
+			schedule:         hourly().Result(),
+			asOf:             time.Date(2017, 1, 1, 12, 30, 0, 0, time.UTC),
+			expectedRunTimes: []time.Time{at(12)},
+		},
+		{
+			name:                "paused schedule skips the latest missed run",
+			schedule:            hourly().Paused(true).Result(),
+			asOf:                time.Date(2017, 1, 1, 12, 30, 0, 0, time.UTC),
+			expectedLastSkipped: &[]time.Time{at(12)}[0],
+		},
+		{
+			name:             "Skip runs the latest run within the grace period",
+			schedule:         hourly().MissedRunPolicy(velerov1api.ScheduleMissedRunPolicySkip).Result(),
+			asOf:             time.Date(2017, 1, 1, 12, 1, 0, 0, time.UTC),
+			expectedRunTimes: []time.Time{at(12)},
+		},
+		{
+			name:                "Skip skips the latest run outside the grace period",
+			schedule:            hourly().MissedRunPolicy(velerov1api.ScheduleMissedRunPolicySkip).Result(),
+			asOf:                time.Date(2017, 1, 1, 12, 30, 0, 0, time.UTC),
+			expectedLastSkipped: &[]time.Time{at(12)}[0],
+		},
+		{
+			name:             "RunAll runs every missed run",
+			schedule:         hourly().MissedRunPolicy(velerov1api.ScheduleMissedRunPolicyRunAll).Result(),
+			asOf:             time.Date(2017, 1, 1, 12, 30, 0, 0, time.UTC),
+			expectedRunTimes: []time.Time{at(10), at(11), at(12)},
+		},
+		{
+			name:                "RunAll skips runs outside the missed run window",
+			schedule:            hourly().MissedRunPolicy(velerov1api.ScheduleMissedRunPolicyRunAll).MissedRunWindow(90 * time.Minute).Result(),
+			asOf:                time.Date(2017, 1, 1, 12, 30, 0, 0, time.UTC),
+			expectedRunTimes:    []time.Time{at(11), at(12)},
+			expectedLastSkipped: &[]time.Time{at(10)}[0],
+		},
+		{
+			name:                "RunAll caps the number of missed runs",
+			schedule:            builder.ForSchedule("velero", "schedule-1").CronSchedule("*/5 * * * *").MissedRunPolicy(velerov1api.ScheduleMissedRunPolicyRunAll).LastBackupTime("2017-01-01 09:00:00").Result(),
+			asOf:                time.Date(2017, 1, 1, 12, 1, 0, 0, time.UTC),
+			expectedRunTimes:    lastFiveMinuteRuns,
+			expectedLastSkipped: &[]time.Time{time.Date(2017, 1, 1, 10, 0, 0, 0, time.UTC)}[0],
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, errs := parseCronSchedule(test.schedule, velerotest.NewLogger())
+			require.Empty(t, errs)
+
+			runTimes, lastSkipped := getRunTimes(test.schedule, c, test.asOf)
+			assert.Equal(t, test.expectedRunTimes, runTimes)
+			assert.Equal(t, test.expectedLastSkipped, lastSkipped)
+		})
+	}
+}
+
+func TestParseCronScheduleTimeZone(t *testing.T) {
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 9 * * *").TimeZone("Europe/Berlin").LastBackupTime("2017-08-10 12:27:00").Result()
+
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	// 9am in Berlin is 7am UTC during daylight saving time
+	due, next := getNextRunTime(s, c, time.Date(2017, 8, 10, 13, 0, 0, 0, time.UTC))
+	assert.False(t, due)
+	assert.True(t, next.Equal(time.Date(2017, 8, 11, 7, 0, 0, 0, time.UTC)), "unexpected next run time %v", next)
+}
+
 func TestGetBackup(t *testing.T) {
 	tests := []struct {
 		name           string
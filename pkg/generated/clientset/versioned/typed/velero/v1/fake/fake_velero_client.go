@@ -36,6 +36,14 @@ func (c *FakeVeleroV1) BackupStorageLocations(namespace string) v1.BackupStorage
 	return &FakeBackupStorageLocations{c, namespace}
 }
 
+func (c *FakeVeleroV1) DataDownloads(namespace string) v1.DataDownloadInterface {
+	return &FakeDataDownloads{c, namespace}
+}
+
+func (c *FakeVeleroV1) DataUploads(namespace string) v1.DataUploadInterface {
+	return &FakeDataUploads{c, namespace}
+}
+
 func (c *FakeVeleroV1) DeleteBackupRequests(namespace string) v1.DeleteBackupRequestInterface {
 	return &FakeDeleteBackupRequests{c, namespace}
 }
@@ -44,6 +52,10 @@ func (c *FakeVeleroV1) DownloadRequests(namespace string) v1.DownloadRequestInte
 	return &FakeDownloadRequests{c, namespace}
 }
 
+func (c *FakeVeleroV1) NotificationSinks(namespace string) v1.NotificationSinkInterface {
+	return &FakeNotificationSinks{c, namespace}
+}
+
 func (c *FakeVeleroV1) PodVolumeBackups(namespace string) v1.PodVolumeBackupInterface {
 	return &FakePodVolumeBackups{c, namespace}
 }
@@ -68,6 +80,10 @@ func (c *FakeVeleroV1) ServerStatusRequests(namespace string) v1.ServerStatusReq
 	return &FakeServerStatusRequests{c, namespace}
 }
 
+func (c *FakeVeleroV1) VeleroPlugins(namespace string) v1.VeleroPluginInterface {
+	return &FakeVeleroPlugins{c, namespace}
+}
+
 func (c *FakeVeleroV1) VolumeSnapshotLocations(namespace string) v1.VolumeSnapshotLocationInterface {
 	return &FakeVolumeSnapshotLocations{c, namespace}
 }
@@ -22,10 +22,16 @@ type BackupExpansion interface{}
 
 type BackupStorageLocationExpansion interface{}
 
+type DataDownloadExpansion interface{}
+
+type DataUploadExpansion interface{}
+
 type DeleteBackupRequestExpansion interface{}
 
 type DownloadRequestExpansion interface{}
 
+type NotificationSinkExpansion interface{}
+
 type PodVolumeBackupExpansion interface{}
 
 type PodVolumeRestoreExpansion interface{}
@@ -38,4 +44,6 @@ type ScheduleExpansion interface{}
 
 type ServerStatusRequestExpansion interface{}
 
+type VeleroPluginExpansion interface{}
+
 type VolumeSnapshotLocationExpansion interface{}
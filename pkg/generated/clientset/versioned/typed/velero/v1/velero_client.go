@@ -28,14 +28,18 @@ type VeleroV1Interface interface {
 	RESTClient() rest.Interface
 	BackupsGetter
 	BackupStorageLocationsGetter
+	DataDownloadsGetter
+	DataUploadsGetter
 	DeleteBackupRequestsGetter
 	DownloadRequestsGetter
+	NotificationSinksGetter
 	PodVolumeBackupsGetter
 	PodVolumeRestoresGetter
 	ResticRepositoriesGetter
 	RestoresGetter
 	SchedulesGetter
 	ServerStatusRequestsGetter
+	VeleroPluginsGetter
 	VolumeSnapshotLocationsGetter
 }
 
@@ -52,6 +56,14 @@ func (c *VeleroV1Client) BackupStorageLocations(namespace string) BackupStorageL
 	return newBackupStorageLocations(c, namespace)
 }
 
+func (c *VeleroV1Client) DataDownloads(namespace string) DataDownloadInterface {
+	return newDataDownloads(c, namespace)
+}
+
+func (c *VeleroV1Client) DataUploads(namespace string) DataUploadInterface {
+	return newDataUploads(c, namespace)
+}
+
 func (c *VeleroV1Client) DeleteBackupRequests(namespace string) DeleteBackupRequestInterface {
 	return newDeleteBackupRequests(c, namespace)
 }
@@ -60,6 +72,10 @@ func (c *VeleroV1Client) DownloadRequests(namespace string) DownloadRequestInter
 	return newDownloadRequests(c, namespace)
 }
 
+func (c *VeleroV1Client) NotificationSinks(namespace string) NotificationSinkInterface {
+	return newNotificationSinks(c, namespace)
+}
+
 func (c *VeleroV1Client) PodVolumeBackups(namespace string) PodVolumeBackupInterface {
 	return newPodVolumeBackups(c, namespace)
 }
@@ -84,6 +100,10 @@ func (c *VeleroV1Client) ServerStatusRequests(namespace string) ServerStatusRequ
 	return newServerStatusRequests(c, namespace)
 }
 
+func (c *VeleroV1Client) VeleroPlugins(namespace string) VeleroPluginInterface {
+	return newVeleroPlugins(c, namespace)
+}
+
 func (c *VeleroV1Client) VolumeSnapshotLocations(namespace string) VolumeSnapshotLocationInterface {
 	return newVolumeSnapshotLocations(c, namespace)
 }
@@ -28,10 +28,16 @@ type Interface interface {
 	Backups() BackupInformer
 	// BackupStorageLocations returns a BackupStorageLocationInformer.
 	BackupStorageLocations() BackupStorageLocationInformer
+	// DataDownloads returns a DataDownloadInformer.
+	DataDownloads() DataDownloadInformer
+	// DataUploads returns a DataUploadInformer.
+	DataUploads() DataUploadInformer
 	// DeleteBackupRequests returns a DeleteBackupRequestInformer.
 	DeleteBackupRequests() DeleteBackupRequestInformer
 	// DownloadRequests returns a DownloadRequestInformer.
 	DownloadRequests() DownloadRequestInformer
+	// NotificationSinks returns a NotificationSinkInformer.
+	NotificationSinks() NotificationSinkInformer
 	// PodVolumeBackups returns a PodVolumeBackupInformer.
 	PodVolumeBackups() PodVolumeBackupInformer
 	// PodVolumeRestores returns a PodVolumeRestoreInformer.
@@ -44,6 +50,8 @@ type Interface interface {
 	Schedules() ScheduleInformer
 	// ServerStatusRequests returns a ServerStatusRequestInformer.
 	ServerStatusRequests() ServerStatusRequestInformer
+	// VeleroPlugins returns a VeleroPluginInformer.
+	VeleroPlugins() VeleroPluginInformer
 	// VolumeSnapshotLocations returns a VolumeSnapshotLocationInformer.
 	VolumeSnapshotLocations() VolumeSnapshotLocationInformer
 }
@@ -69,6 +77,16 @@ func (v *version) BackupStorageLocations() BackupStorageLocationInformer {
 	return &backupStorageLocationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DataDownloads returns a DataDownloadInformer.
+func (v *version) DataDownloads() DataDownloadInformer {
+	return &dataDownloadInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// DataUploads returns a DataUploadInformer.
+func (v *version) DataUploads() DataUploadInformer {
+	return &dataUploadInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // DeleteBackupRequests returns a DeleteBackupRequestInformer.
 func (v *version) DeleteBackupRequests() DeleteBackupRequestInformer {
 	return &deleteBackupRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -79,6 +97,11 @@ func (v *version) DownloadRequests() DownloadRequestInformer {
 	return &downloadRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NotificationSinks returns a NotificationSinkInformer.
+func (v *version) NotificationSinks() NotificationSinkInformer {
+	return &notificationSinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // PodVolumeBackups returns a PodVolumeBackupInformer.
 func (v *version) PodVolumeBackups() PodVolumeBackupInformer {
 	return &podVolumeBackupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -109,6 +132,11 @@ func (v *version) ServerStatusRequests() ServerStatusRequestInformer {
 	return &serverStatusRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VeleroPlugins returns a VeleroPluginInformer.
+func (v *version) VeleroPlugins() VeleroPluginInformer {
+	return &veleroPluginInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VolumeSnapshotLocations returns a VolumeSnapshotLocationInformer.
 func (v *version) VolumeSnapshotLocations() VolumeSnapshotLocationInformer {
 	return &volumeSnapshotLocationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -34,6 +34,22 @@ type BackupStorageLocationListerExpansion interface{}
 // BackupStorageLocationNamespaceLister.
 type BackupStorageLocationNamespaceListerExpansion interface{}
 
+// DataDownloadListerExpansion allows custom methods to be added to
+// DataDownloadLister.
+type DataDownloadListerExpansion interface{}
+
+// DataDownloadNamespaceListerExpansion allows custom methods to be added to
+// DataDownloadNamespaceLister.
+type DataDownloadNamespaceListerExpansion interface{}
+
+// DataUploadListerExpansion allows custom methods to be added to
+// DataUploadLister.
+type DataUploadListerExpansion interface{}
+
+// DataUploadNamespaceListerExpansion allows custom methods to be added to
+// DataUploadNamespaceLister.
+type DataUploadNamespaceListerExpansion interface{}
+
 // DeleteBackupRequestListerExpansion allows custom methods to be added to
 // DeleteBackupRequestLister.
 type DeleteBackupRequestListerExpansion interface{}
@@ -50,6 +66,14 @@ type DownloadRequestListerExpansion interface{}
 // DownloadRequestNamespaceLister.
 type DownloadRequestNamespaceListerExpansion interface{}
 
+// NotificationSinkListerExpansion allows custom methods to be added to
+// NotificationSinkLister.
+type NotificationSinkListerExpansion interface{}
+
+// NotificationSinkNamespaceListerExpansion allows custom methods to be added to
+// NotificationSinkNamespaceLister.
+type NotificationSinkNamespaceListerExpansion interface{}
+
 // PodVolumeBackupListerExpansion allows custom methods to be added to
 // PodVolumeBackupLister.
 type PodVolumeBackupListerExpansion interface{}
@@ -98,6 +122,14 @@ type ServerStatusRequestListerExpansion interface{}
 // ServerStatusRequestNamespaceLister.
 type ServerStatusRequestNamespaceListerExpansion interface{}
 
+// VeleroPluginListerExpansion allows custom methods to be added to
+// VeleroPluginLister.
+type VeleroPluginListerExpansion interface{}
+
+// VeleroPluginNamespaceListerExpansion allows custom methods to be added to
+// VeleroPluginNamespaceLister.
+type VeleroPluginNamespaceListerExpansion interface{}
+
 // VolumeSnapshotLocationListerExpansion allows custom methods to be added to
 // VolumeSnapshotLocationLister.
 type VolumeSnapshotLocationListerExpansion interface{}
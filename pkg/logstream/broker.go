@@ -0,0 +1,218 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package logstream keeps the logs of the backups and restores that are in progress in the
+// Velero server's memory, and serves them over HTTP so that they can be followed before they're
+// uploaded to object storage.
+package logstream
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Kind is the kind of operation that a log stream is for.
+type Kind string
+
+const (
+	KindBackup  Kind = "backups"
+	KindRestore Kind = "restores"
+)
+
+// DefaultMaxLines is the number of log lines that a Stream keeps by default. Older lines are
+// dropped once a stream holds more than this.
+const DefaultMaxLines = 10000
+
+// Broker keeps the log streams of the backups and restores that are in progress.
+type Broker struct {
+	maxLines int
+
+	mu      sync.Mutex
+	streams map[streamKey]*Stream
+}
+
+type streamKey struct {
+	kind Kind
+	name string
+}
+
+// NewBroker returns a Broker whose streams keep the last maxLines lines of each log.
+func NewBroker(maxLines int) *Broker {
+	return &Broker{
+		maxLines: maxLines,
+		streams:  make(map[streamKey]*Stream),
+	}
+}
+
+// Open starts a log stream for the operation of the given kind and name, which replaces any
+// previous stream for it. The stream is a logrus hook that must be added to the operation's
+// logger, and formats the entries fired on it with formatter. It's removed from the broker when
+// it's closed.
+func (b *Broker) Open(kind Kind, name string, formatter logrus.Formatter) *Stream {
+	key := streamKey{kind: kind, name: name}
+	s := &Stream{
+		formatter: formatter,
+		maxLines:  b.maxLines,
+		changed:   make(chan struct{}),
+		remove: func(s *Stream) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			if b.streams[key] == s {
+				delete(b.streams, key)
+			}
+		},
+	}
+
+	b.mu.Lock()
+	previous := b.streams[key]
+	b.streams[key] = s
+	b.mu.Unlock()
+
+	if previous != nil {
+		previous.Close()
+	}
+
+	return s
+}
+
+// Get returns the open log stream for the operation of the given kind and name, if any.
+func (b *Broker) Get(kind Kind, name string) (*Stream, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s, ok := b.streams[streamKey{kind: kind, name: name}]
+	return s, ok
+}
+
+// Stream is a logrus hook that keeps the formatted lines of an operation's log, and lets them
+// be copied to readers as they're written.
+type Stream struct {
+	formatter logrus.Formatter
+	maxLines  int
+	remove    func(*Stream)
+
+	mu sync.Mutex
+	// lines are the formatted log lines kept by the stream, of which only the last maxLines are
+	// copied to readers.
+	lines [][]byte
+	// dropped is the number of lines that were dropped from the start of lines.
+	dropped int
+	closed  bool
+	// changed is closed, and replaced, whenever a line is written or the stream is closed.
+	changed chan struct{}
+}
+
+// Levels returns the logrus levels that the hook should be fired for.
+func (s *Stream) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire formats entry and appends it to the stream.
+func (s *Stream) Fire(entry *logrus.Entry) error {
+	line, err := s.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+	// the formatter may reuse its buffer for the next entry
+	line = append([]byte(nil), line...)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.lines = append(s.lines, line)
+	// the lines before the last maxLines are dropped in batches, once there are twice as many, so
+	// that they aren't copied on every line that's written
+	if s.maxLines > 0 && len(s.lines) >= 2*s.maxLines {
+		drop := len(s.lines) - s.maxLines
+		s.lines = append(make([][]byte, 0, 2*s.maxLines), s.lines[drop:]...)
+		s.dropped += drop
+	}
+	s.notify()
+
+	return nil
+}
+
+// Close marks the end of the log, which stops the copies of the stream that follow it, and
+// removes the stream from its broker. It's safe to call Close more than once.
+func (s *Stream) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	s.notify()
+	s.mu.Unlock()
+
+	if s.remove != nil {
+		s.remove(s)
+	}
+}
+
+// notify wakes up the copies of the stream that wait for it to change. It must be called with
+// s.mu held.
+func (s *Stream) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// CopyTo writes the lines of the stream to w. If follow is true, it then keeps writing the lines
+// as they're appended, until the stream is closed or ctx is done. Lines that are dropped from the
+// stream before they're written to w are skipped.
+func (s *Stream) CopyTo(ctx context.Context, w io.Writer, follow bool) error {
+	// next is the index of the next line to write, counting the dropped lines
+	next := 0
+	for {
+		s.mu.Lock()
+		if first := s.dropped + len(s.lines) - s.maxLines; s.maxLines > 0 && next < first {
+			next = first
+		}
+		if next < s.dropped {
+			next = s.dropped
+		}
+		pending := s.lines[next-s.dropped:]
+		next += len(pending)
+		closed, changed := s.closed, s.changed
+		s.mu.Unlock()
+
+		for _, line := range pending {
+			if _, err := w.Write(line); err != nil {
+				return err
+			}
+		}
+		if flusher, ok := w.(interface{ Flush() }); ok && len(pending) > 0 {
+			flusher.Flush()
+		}
+
+		if !follow || closed {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
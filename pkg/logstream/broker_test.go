@@ -0,0 +1,225 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logstream
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger(stream *Stream) *logrus.Logger {
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+	logger.Hooks.Add(stream)
+	return logger
+}
+
+var testFormatter = &logrus.TextFormatter{DisableTimestamp: true, DisableColors: true}
+
+func TestBrokerOpenGetClose(t *testing.T) {
+	b := NewBroker(DefaultMaxLines)
+
+	_, ok := b.Get(KindBackup, "backup-1")
+	assert.False(t, ok)
+
+	s := b.Open(KindBackup, "backup-1", testFormatter)
+	got, ok := b.Get(KindBackup, "backup-1")
+	require.True(t, ok)
+	assert.Same(t, s, got)
+
+	_, ok = b.Get(KindRestore, "backup-1")
+	assert.False(t, ok)
+
+	// re-opening a stream closes the previous one
+	replacement := b.Open(KindBackup, "backup-1", testFormatter)
+	assert.True(t, s.closed)
+	got, ok = b.Get(KindBackup, "backup-1")
+	require.True(t, ok)
+	assert.Same(t, replacement, got)
+
+	// closing the replaced stream again doesn't remove the replacement
+	s.Close()
+	_, ok = b.Get(KindBackup, "backup-1")
+	assert.True(t, ok)
+
+	replacement.Close()
+	replacement.Close()
+	_, ok = b.Get(KindBackup, "backup-1")
+	assert.False(t, ok)
+}
+
+func TestStreamCopyTo(t *testing.T) {
+	s := NewBroker(2).Open(KindBackup, "backup-1", testFormatter)
+	logger := newTestLogger(s)
+
+	logger.Info("one")
+	logger.Warn("two")
+	logger.Error("three")
+
+	// only the last maxLines lines are kept
+	buf := new(bytes.Buffer)
+	require.NoError(t, s.CopyTo(context.Background(), buf, false))
+	assert.Equal(t, "level=warning msg=two\nlevel=error msg=three\n", buf.String())
+
+	// lines written after the stream is closed are ignored
+	s.Close()
+	logger.Info("four")
+	buf.Reset()
+	require.NoError(t, s.CopyTo(context.Background(), buf, true))
+	assert.Equal(t, "level=warning msg=two\nlevel=error msg=three\n", buf.String())
+}
+
+func TestStreamCopyToFollow(t *testing.T) {
+	s := NewBroker(DefaultMaxLines).Open(KindRestore, "restore-1", testFormatter)
+	logger := newTestLogger(s)
+	logger.Info("one")
+
+	p := &linePipe{lines: make(chan string, 10)}
+	done := make(chan error)
+	go func() { done <- s.CopyTo(context.Background(), p, true) }()
+
+	assert.Equal(t, "level=info msg=one\n", p.next(t))
+	logger.Info("two")
+	assert.Equal(t, "level=info msg=two\n", p.next(t))
+
+	s.Close()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("CopyTo didn't return after the stream was closed")
+	}
+}
+
+func TestStreamCopyToFollowCancelled(t *testing.T) {
+	s := NewBroker(DefaultMaxLines).Open(KindBackup, "backup-1", testFormatter)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.CopyTo(ctx, ioutil.Discard, true) }()
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("CopyTo didn't return after its context was cancelled")
+	}
+}
+
+func TestHandler(t *testing.T) {
+	b := NewBroker(DefaultMaxLines)
+	s := b.Open(KindBackup, "backup-1", testFormatter)
+	newTestLogger(s).Info("one")
+
+	server := httptest.NewServer(Handler(b))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "open stream is served",
+			path:         Path(KindBackup, "backup-1"),
+			expectedCode: http.StatusOK,
+			expectedBody: "level=info msg=one\n",
+		},
+		{
+			name:         "unknown operation is not found",
+			path:         Path(KindBackup, "backup-2"),
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "unknown kind is not found",
+			path:         Path("schedules", "backup-1"),
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "invalid follow parameter is rejected",
+			path:         Path(KindBackup, "backup-1") + "?follow=maybe",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := http.Get(server.URL + test.path)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, test.expectedCode, res.StatusCode)
+			if test.expectedBody != "" {
+				body, err := ioutil.ReadAll(res.Body)
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedBody, string(body))
+			}
+		})
+	}
+}
+
+// linePipe passes each write to the test through a channel, so that the test can wait for each
+// line written by a follower.
+type linePipe struct {
+	lines chan string
+}
+
+func (p *linePipe) Write(b []byte) (int, error) {
+	p.lines <- string(b)
+	return len(b), nil
+}
+
+func (p *linePipe) next(t *testing.T) string {
+	select {
+	case line := <-p.lines:
+		return line
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a line")
+		return ""
+	}
+}
+
+func TestStreamDropsLinesInBatches(t *testing.T) {
+	s := NewBroker(2).Open(KindBackup, "backup-1", testFormatter)
+	logger := newTestLogger(s)
+
+	logger.Info("one")
+	logger.Info("two")
+	logger.Info("three")
+	// the lines before the last maxLines are kept until there are twice as many
+	assert.Len(t, s.lines, 3)
+	assert.Equal(t, 0, s.dropped)
+
+	logger.Info("four")
+	assert.Len(t, s.lines, 2)
+	assert.Equal(t, 2, s.dropped)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, s.CopyTo(context.Background(), buf, false))
+	assert.Equal(t, "level=info msg=three\nlevel=info msg=four\n", buf.String())
+}
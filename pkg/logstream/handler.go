@@ -0,0 +1,81 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logstream
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// PathPrefix is the path that the log streams are served under, as PathPrefix/<kind>/<name>.
+const PathPrefix = "/logs/"
+
+// FollowParam is the query parameter that asks for the log stream to be followed until the
+// operation is done.
+const FollowParam = "follow"
+
+// Path returns the path that the log stream of the operation of the given kind and name is
+// served at.
+func Path(kind Kind, name string) string {
+	return path.Join(PathPrefix, string(kind), name)
+}
+
+// Handler returns an http.Handler that serves the log streams of b under PathPrefix. It responds
+// with 404 Not Found if the operation doesn't have an open log stream, either because it hasn't
+// started yet or because it's done and its log has been uploaded to object storage.
+func Handler(b *Broker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, PathPrefix), "/")
+		if len(parts) != 2 || parts[1] == "" {
+			http.NotFound(w, r)
+			return
+		}
+		kind, name := Kind(parts[0]), parts[1]
+		if kind != KindBackup && kind != KindRestore {
+			http.NotFound(w, r)
+			return
+		}
+
+		var follow bool
+		if val := r.URL.Query().Get(FollowParam); val != "" {
+			var err error
+			if follow, err = strconv.ParseBool(val); err != nil {
+				http.Error(w, fmt.Sprintf("invalid value %q for %s", val, FollowParam), http.StatusBadRequest)
+				return
+			}
+		}
+
+		stream, ok := b.Get(kind, name)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		// the response has already started, so a failure to write it can't be reported
+		_ = stream.CopyTo(r.Context(), w, follow)
+	})
+}
@@ -54,6 +54,21 @@ const (
 	volumeSnapshotAttemptTotal    = "volume_snapshot_attempt_total"
 	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
 	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
+	backupSyncDurationSeconds     = "backup_sync_duration_seconds"
+	backupSyncSkippedTotal        = "backup_sync_skipped_total"
+	backupSyncBackupsTotal        = "backup_sync_backups_total"
+	backupSyncFailureTotal        = "backup_sync_failure_total"
+	pluginRestartTotal            = "plugin_restart_total"
+	pluginRestartFailureTotal     = "plugin_restart_failure_total"
+	restoreDurationSeconds        = "restore_duration_seconds"
+	backupResourceItemsTotal      = "backup_resource_items_total"
+	restoreResourceItemsTotal     = "restore_resource_items_total"
+	pluginCallDurationSeconds     = "plugin_call_duration_seconds"
+	hookSuccessTotal              = "hook_success_total"
+	hookFailureTotal              = "hook_failure_total"
+
+	backupStorageLocationAvailableGauge          = "backup_storage_location_available"
+	backupStorageLocationLastValidationTimestamp = "backup_storage_location_last_validation_timestamp"
 
 	// Restic metrics
 	podVolumeBackupEnqueueTotal        = "pod_volume_backup_enqueue_count"
@@ -67,6 +82,11 @@ const (
 	pvbNameLabel         = "pod_volume_backup"
 	scheduleLabel        = "schedule"
 	backupNameLabel      = "backupName"
+	backupLocationLabel  = "backupLocation"
+	pluginLabel          = "plugin"
+	pluginKindLabel      = "kind"
+	resourceLabel        = "resource"
+	hookPhaseLabel       = "hookPhase"
 
 	secondsInMinute = 60.0
 )
@@ -268,6 +288,149 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupSyncDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      backupSyncDurationSeconds,
+					Help:      "Time taken to sync a backup storage location's backups into the cluster, in seconds",
+					Buckets: []float64{
+						0.1,
+						1,
+						5,
+						toSeconds(30 * time.Second),
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(15 * time.Minute),
+					},
+				},
+				[]string{backupLocationLabel},
+			),
+			backupSyncSkippedTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupSyncSkippedTotal,
+					Help:      "Total number of backup syncs skipped because the backup storage location's revision didn't change",
+				},
+				[]string{backupLocationLabel},
+			),
+			backupSyncBackupsTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupSyncBackupsTotal,
+					Help:      "Total number of backups synced from backup storage locations into the cluster",
+				},
+				[]string{backupLocationLabel},
+			),
+			backupSyncFailureTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupSyncFailureTotal,
+					Help:      "Total number of backups that failed to sync from backup storage locations into the cluster",
+				},
+				[]string{backupLocationLabel},
+			),
+			pluginRestartTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      pluginRestartTotal,
+					Help:      "Total number of times a plugin process has been restarted after exiting or failing a health check",
+				},
+				[]string{pluginLabel},
+			),
+			pluginRestartFailureTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      pluginRestartFailureTotal,
+					Help:      "Total number of failed attempts to restart a plugin process",
+				},
+				[]string{pluginLabel},
+			),
+			restoreDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      restoreDurationSeconds,
+					Help:      "Time taken to complete restore, in seconds",
+					Buckets: []float64{
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(10 * time.Minute),
+						toSeconds(15 * time.Minute),
+						toSeconds(30 * time.Minute),
+						toSeconds(1 * time.Hour),
+						toSeconds(2 * time.Hour),
+						toSeconds(3 * time.Hour),
+						toSeconds(4 * time.Hour),
+					},
+				},
+				[]string{scheduleLabel},
+			),
+			backupResourceItemsTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupResourceItemsTotal,
+					Help:      "Total number of items backed up, by resource",
+				},
+				[]string{scheduleLabel, resourceLabel},
+			),
+			restoreResourceItemsTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      restoreResourceItemsTotal,
+					Help:      "Total number of items restored, by resource",
+				},
+				[]string{scheduleLabel, resourceLabel},
+			),
+			backupStorageLocationAvailableGauge: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageLocationAvailableGauge,
+					Help:      "Whether a backup storage location was available (1) or unavailable (0) when it was last validated",
+				},
+				[]string{backupLocationLabel},
+			),
+			backupStorageLocationLastValidationTimestamp: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageLocationLastValidationTimestamp,
+					Help:      "Last time a backup storage location was validated, Unix timestamp in seconds",
+				},
+				[]string{backupLocationLabel},
+			),
+			pluginCallDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      pluginCallDurationSeconds,
+					Help:      "Time taken by calls to plugins, in seconds",
+					Buckets: []float64{
+						0.01,
+						0.05,
+						0.1,
+						0.5,
+						1,
+						5,
+						toSeconds(30 * time.Second),
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+					},
+				},
+				[]string{pluginKindLabel, pluginLabel},
+			),
+			hookSuccessTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      hookSuccessTotal,
+					Help:      "Total number of successfully executed backup and restore hooks",
+				},
+				[]string{hookPhaseLabel},
+			),
+			hookFailureTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      hookFailureTotal,
+					Help:      "Total number of backup and restore hooks that failed or weren't executed",
+				},
+				[]string{hookPhaseLabel},
+			),
 		},
 	}
 }
@@ -573,6 +736,27 @@ func (m *ServerMetrics) RegisterRestoreValidationFailed(backupSchedule string) {
 	}
 }
 
+// RegisterRestoreDuration records the number of seconds a restore took.
+func (m *ServerMetrics) RegisterRestoreDuration(backupSchedule string, seconds float64) {
+	if c, ok := m.metrics[restoreDurationSeconds].(*prometheus.HistogramVec); ok {
+		c.WithLabelValues(backupSchedule).Observe(seconds)
+	}
+}
+
+// RegisterBackupItem records an item of resource backed up.
+func (m *ServerMetrics) RegisterBackupItem(backupSchedule, resource string) {
+	if c, ok := m.metrics[backupResourceItemsTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule, resource).Inc()
+	}
+}
+
+// RegisterRestoreItem records an item of resource restored.
+func (m *ServerMetrics) RegisterRestoreItem(backupSchedule, resource string) {
+	if c, ok := m.metrics[restoreResourceItemsTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule, resource).Inc()
+	}
+}
+
 // RegisterVolumeSnapshotAttempts records an attempt to snapshot a volume.
 func (m *ServerMetrics) RegisterVolumeSnapshotAttempts(backupSchedule string, volumeSnapshotsAttempted int) {
 	if c, ok := m.metrics[volumeSnapshotAttemptTotal].(*prometheus.CounterVec); ok {
@@ -593,3 +777,95 @@ func (m *ServerMetrics) RegisterVolumeSnapshotFailures(backupSchedule string, vo
 		c.WithLabelValues(backupSchedule).Add(float64(volumeSnapshotsFailed))
 	}
 }
+
+// RegisterBackupSyncDuration records the number of seconds a backup storage location's sync took.
+func (m *ServerMetrics) RegisterBackupSyncDuration(location string, seconds float64) {
+	if c, ok := m.metrics[backupSyncDurationSeconds].(*prometheus.HistogramVec); ok {
+		c.WithLabelValues(location).Observe(seconds)
+	}
+}
+
+// RegisterBackupSyncSkipped records a backup storage location sync skipped because it was unchanged.
+func (m *ServerMetrics) RegisterBackupSyncSkipped(location string) {
+	if c, ok := m.metrics[backupSyncSkippedTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(location).Inc()
+	}
+}
+
+// RegisterBackupSynced records a backup synced into the cluster.
+func (m *ServerMetrics) RegisterBackupSynced(location string) {
+	if c, ok := m.metrics[backupSyncBackupsTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(location).Inc()
+	}
+}
+
+// RegisterBackupSyncFailure records a backup that failed to sync into the cluster.
+func (m *ServerMetrics) RegisterBackupSyncFailure(location string) {
+	if c, ok := m.metrics[backupSyncFailureTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(location).Inc()
+	}
+}
+
+// RegisterPluginRestart records a restart of the process for the plugin executable.
+func (m *ServerMetrics) RegisterPluginRestart(plugin string) {
+	if c, ok := m.metrics[pluginRestartTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(plugin).Inc()
+	}
+}
+
+// RegisterPluginRestartFailure records a failed attempt to restart the process for the plugin executable.
+func (m *ServerMetrics) RegisterPluginRestartFailure(plugin string) {
+	if c, ok := m.metrics[pluginRestartFailureTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(plugin).Inc()
+	}
+}
+
+// ObservePluginCallDuration records the number of seconds a call to a plugin of kind took.
+func (m *ServerMetrics) ObservePluginCallDuration(kind, plugin string, seconds float64) {
+	if h, ok := m.metrics[pluginCallDurationSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(kind, plugin).Observe(seconds)
+	}
+}
+
+// SetBackupStorageLocationAvailable records whether a backup storage location is available.
+func (m *ServerMetrics) SetBackupStorageLocationAvailable(location string, available bool) {
+	if g, ok := m.metrics[backupStorageLocationAvailableGauge].(*prometheus.GaugeVec); ok {
+		var value float64
+		if available {
+			value = 1
+		}
+		g.WithLabelValues(location).Set(value)
+	}
+}
+
+// SetBackupStorageLocationLastValidationTimestamp records the last time a backup storage location was
+// validated, Unix timestamp in seconds.
+func (m *ServerMetrics) SetBackupStorageLocationLastValidationTimestamp(location string, time time.Time) {
+	if g, ok := m.metrics[backupStorageLocationLastValidationTimestamp].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(location).Set(float64(time.Unix()))
+	}
+}
+
+// RemoveBackupStorageLocation removes the metrics of a backup storage location that was deleted.
+func (m *ServerMetrics) RemoveBackupStorageLocation(location string) {
+	if g, ok := m.metrics[backupStorageLocationAvailableGauge].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(location)
+	}
+	if g, ok := m.metrics[backupStorageLocationLastValidationTimestamp].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(location)
+	}
+}
+
+// RegisterHookSuccess records a hook executed successfully in hookPhase, e.g. "pre-backup".
+func (m *ServerMetrics) RegisterHookSuccess(hookPhase string) {
+	if c, ok := m.metrics[hookSuccessTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(hookPhase).Inc()
+	}
+}
+
+// RegisterHookFailure records a hook that failed, or wasn't executed, in hookPhase.
+func (m *ServerMetrics) RegisterHookFailure(hookPhase string) {
+	if c, ok := m.metrics[hookFailureTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(hookPhase).Inc()
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHookMetrics(t *testing.T) {
+	m := NewServerMetrics()
+
+	m.RegisterHookSuccess("pre-backup")
+	m.RegisterHookSuccess("pre-backup")
+	m.RegisterHookFailure("post-restore")
+
+	success := m.metrics[hookSuccessTotal].(*prometheus.CounterVec)
+	failure := m.metrics[hookFailureTotal].(*prometheus.CounterVec)
+	assert.Equal(t, float64(2), testutil.ToFloat64(success.WithLabelValues("pre-backup")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(failure.WithLabelValues("pre-backup")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(failure.WithLabelValues("post-restore")))
+}
+
+func TestResourceItemMetrics(t *testing.T) {
+	m := NewServerMetrics()
+
+	m.RegisterBackupItem("daily", "pods")
+	m.RegisterBackupItem("daily", "pods")
+	m.RegisterBackupItem("daily", "deployments.apps")
+	m.RegisterRestoreItem("", "pods")
+
+	backupItems := m.metrics[backupResourceItemsTotal].(*prometheus.CounterVec)
+	restoreItems := m.metrics[restoreResourceItemsTotal].(*prometheus.CounterVec)
+	assert.Equal(t, float64(2), testutil.ToFloat64(backupItems.WithLabelValues("daily", "pods")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(backupItems.WithLabelValues("daily", "deployments.apps")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(restoreItems.WithLabelValues("", "pods")))
+}
+
+func TestBackupStorageLocationMetrics(t *testing.T) {
+	m := NewServerMetrics()
+	validated := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	m.SetBackupStorageLocationAvailable("default", true)
+	m.SetBackupStorageLocationAvailable("secondary", false)
+	m.SetBackupStorageLocationLastValidationTimestamp("default", validated)
+
+	available := m.metrics[backupStorageLocationAvailableGauge].(*prometheus.GaugeVec)
+	lastValidation := m.metrics[backupStorageLocationLastValidationTimestamp].(*prometheus.GaugeVec)
+	assert.Equal(t, float64(1), testutil.ToFloat64(available.WithLabelValues("default")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(available.WithLabelValues("secondary")))
+	assert.Equal(t, float64(validated.Unix()), testutil.ToFloat64(lastValidation.WithLabelValues("default")))
+}
+
+func TestRemoveBackupStorageLocation(t *testing.T) {
+	m := NewServerMetrics()
+
+	m.SetBackupStorageLocationAvailable("default", true)
+	m.SetBackupStorageLocationAvailable("deleted", true)
+	m.SetBackupStorageLocationLastValidationTimestamp("deleted", time.Now())
+	m.RemoveBackupStorageLocation("deleted")
+
+	available := m.metrics[backupStorageLocationAvailableGauge].(*prometheus.GaugeVec)
+	lastValidation := m.metrics[backupStorageLocationLastValidationTimestamp].(*prometheus.GaugeVec)
+	assert.Equal(t, 1, testutil.CollectAndCount(available))
+	assert.Equal(t, 0, testutil.CollectAndCount(lastValidation))
+}
@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// DefaultTemplate is the template of the messages of NotificationSinks that don't
+	// set one.
+	DefaultTemplate = `Velero {{.Kind}} {{.Namespace}}/{{.Name}} is {{.Phase}}` +
+		`{{if .FailureReason}}: {{.FailureReason}}{{end}}` +
+		`{{if or .Errors .Warnings}} ({{.Errors}} errors, {{.Warnings}} warnings){{end}}`
+
+	// DefaultMaxRetries is the number of times a failed delivery is retried for
+	// NotificationSinks that don't set it.
+	DefaultMaxRetries = 5
+
+	// DefaultTimeout is how long each delivery attempt may take for NotificationSinks
+	// that don't set it.
+	DefaultTimeout = 30 * time.Second
+)
+
+// MaxRetries returns the number of times a failed delivery to sink is retried.
+func MaxRetries(sink *velerov1api.NotificationSink) int {
+	if sink.Spec.MaxRetries == nil {
+		return DefaultMaxRetries
+	}
+	return *sink.Spec.MaxRetries
+}
+
+// Timeout returns how long each delivery attempt to sink may take.
+func Timeout(sink *velerov1api.NotificationSink) time.Duration {
+	if sink.Spec.Timeout.Duration <= 0 {
+		return DefaultTimeout
+	}
+	return sink.Spec.Timeout.Duration
+}
+
+// Render renders the message of event with tmpl, or with DefaultTemplate if tmpl is empty.
+func Render(tmpl string, event Event) (string, error) {
+	if tmpl == "" {
+		tmpl = DefaultTemplate
+	}
+
+	t, err := template.New("notification").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, "error parsing template")
+	}
+
+	buf := new(bytes.Buffer)
+	if err := t.Execute(buf, event); err != nil {
+		return "", errors.Wrap(err, "error executing template")
+	}
+	return buf.String(), nil
+}
+
+// Deliverer delivers notifications to the targets of NotificationSinks.
+type Deliverer struct {
+	client kbclient.Client
+}
+
+// NewDeliverer returns a Deliverer that reads the secrets that NotificationSinks refer to
+// with client.
+func NewDeliverer(client kbclient.Client) *Deliverer {
+	return &Deliverer{
+		client: client,
+	}
+}
+
+// Deliver makes one attempt to deliver the notification of event to sink's target.
+func (d *Deliverer) Deliver(ctx context.Context, sink *velerov1api.NotificationSink, event Event) error {
+	message, err := Render(sink.Spec.Template, event)
+	if err != nil {
+		return errors.Wrap(err, "error rendering notification")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, Timeout(sink))
+	defer cancel()
+
+	switch sink.Spec.Type {
+	case velerov1api.NotificationSinkTypeWebhook, velerov1api.NotificationSinkTypeSlack, velerov1api.NotificationSinkTypeCloudEvents:
+		return d.post(ctx, sink, event, message)
+	case velerov1api.NotificationSinkTypeSMTP:
+		return d.mail(ctx, sink, event, message)
+	default:
+		return errors.Errorf("unsupported notification sink type %q", sink.Spec.Type)
+	}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+var testEvent = Event{
+	Kind:          velerov1api.NotificationEventKindBackup,
+	Namespace:     velerov1api.DefaultNamespace,
+	Name:          "backup-1",
+	UID:           "1234",
+	Phase:         "Failed",
+	PreviousPhase: "InProgress",
+	Errors:        2,
+	Time:          time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC),
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "default template",
+			want: "Velero Backup velero/backup-1 is Failed (2 errors, 0 warnings)",
+		},
+		{
+			name:     "custom template",
+			template: "{{.Name}}: {{.PreviousPhase}} -> {{.Phase}}",
+			want:     "backup-1: InProgress -> Failed",
+		},
+		{
+			name:     "invalid template",
+			template: "{{.Name",
+			wantErr:  true,
+		},
+		{
+			name:     "unknown field",
+			template: "{{.Unknown}}",
+			wantErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Render(test.template, testEvent)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestDeliverHTTP(t *testing.T) {
+	tests := []struct {
+		name       string
+		sinkType   velerov1api.NotificationSinkType
+		status     int
+		wantBody   map[string]interface{}
+		wantHeader map[string]string
+		wantErr    string
+	}{
+		{
+			name:     "webhook",
+			sinkType: velerov1api.NotificationSinkTypeWebhook,
+			status:   http.StatusOK,
+			wantBody: map[string]interface{}{
+				"message":       "Velero Backup velero/backup-1 is Failed (2 errors, 0 warnings)",
+				"kind":          "Backup",
+				"namespace":     "velero",
+				"name":          "backup-1",
+				"uid":           "1234",
+				"phase":         "Failed",
+				"previousPhase": "InProgress",
+				"errors":        float64(2),
+				"time":          "2021-10-01T12:00:00Z",
+			},
+			wantHeader: map[string]string{"Authorization": "Bearer token", "X-Team": "storage"},
+		},
+		{
+			name:     "slack",
+			sinkType: velerov1api.NotificationSinkTypeSlack,
+			status:   http.StatusOK,
+			wantBody: map[string]interface{}{
+				"text": "Velero Backup velero/backup-1 is Failed (2 errors, 0 warnings)",
+			},
+		},
+		{
+			name:     "cloudevents",
+			sinkType: velerov1api.NotificationSinkTypeCloudEvents,
+			status:   http.StatusAccepted,
+			wantHeader: map[string]string{
+				"ce-specversion": "1.0",
+				"ce-id":          "1234/Failed",
+				"ce-source":      "velero.io/velero",
+				"ce-type":        "io.velero.backup.failed",
+				"ce-subject":     "backup-1",
+				"ce-time":        "2021-10-01T12:00:00Z",
+			},
+		},
+		{
+			name:     "rejected notification",
+			sinkType: velerov1api.NotificationSinkTypeWebhook,
+			status:   http.StatusBadRequest,
+			wantErr:  "notification was rejected with status 400 Bad Request: bad payload",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				gotBody   map[string]interface{}
+				gotHeader http.Header
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotHeader = r.Header
+				body, _ := ioutil.ReadAll(r.Body)
+				_ = json.Unmarshal(body, &gotBody)
+				w.WriteHeader(test.status)
+				if test.status >= 300 {
+					_, _ = w.Write([]byte("bad payload\n"))
+				}
+			}))
+			defer server.Close()
+
+			client := velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForSecret(velerov1api.DefaultNamespace, "notifications").Data(map[string][]byte{
+					"url":   []byte(server.URL + "/hook\n"),
+					"token": []byte("Bearer token"),
+				}).Result(),
+			)
+			sink := builder.ForNotificationSink(velerov1api.DefaultNamespace, "sink-1").
+				Type(test.sinkType).
+				HTTP(&velerov1api.HTTPNotificationTarget{
+					URLSecret:           builder.ForSecretKeySelector("notifications", "url").Result(),
+					Headers:             map[string]string{"X-Team": "storage"},
+					AuthorizationSecret: builder.ForSecretKeySelector("notifications", "token").Result(),
+				}).
+				Result()
+
+			err := NewDeliverer(client).Deliver(context.Background(), sink, testEvent)
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, test.wantErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+			for name, value := range test.wantHeader {
+				assert.Equal(t, value, gotHeader.Get(name), name)
+			}
+			if test.wantBody != nil {
+				assert.Equal(t, test.wantBody, gotBody)
+			}
+		})
+	}
+}
+
+func TestDeliverHTTPErrorDoesNotIncludeURL(t *testing.T) {
+	sink := builder.ForNotificationSink(velerov1api.DefaultNamespace, "sink-1").
+		Type(velerov1api.NotificationSinkTypeSlack).
+		URL("http://127.0.0.1:1/services/secret-token").
+		Result()
+
+	err := NewDeliverer(velerotest.NewFakeControllerRuntimeClient(t)).Deliver(context.Background(), sink, testEvent)
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret-token")
+}
+
+func TestDeliverSMTP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan []string, 1)
+	go serveSMTP(listener, received)
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	sink := builder.ForNotificationSink(velerov1api.DefaultNamespace, "sink-1").
+		Type(velerov1api.NotificationSinkTypeSMTP).
+		SMTP(&velerov1api.SMTPNotificationTarget{
+			Host: host,
+			Port: portNumber,
+			From: "velero@example.com",
+			To:   []string{"oncall@example.com", "storage@example.com"},
+		}).
+		Result()
+
+	require.NoError(t, NewDeliverer(velerotest.NewFakeControllerRuntimeClient(t)).Deliver(context.Background(), sink, testEvent))
+
+	var commands []string
+	select {
+	case commands = <-received:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the email")
+	}
+
+	assert.Contains(t, commands, "MAIL FROM:<velero@example.com>")
+	assert.Contains(t, commands, "RCPT TO:<oncall@example.com>")
+	assert.Contains(t, commands, "RCPT TO:<storage@example.com>")
+	assert.Contains(t, commands, "Subject: [Velero] Backup velero/backup-1 is Failed")
+	assert.Contains(t, commands, "Velero Backup velero/backup-1 is Failed (2 errors, 0 warnings)")
+}
+
+// serveSMTP serves a single SMTP session on listener, and sends the lines that the client
+// sent, including the message's, to received.
+func serveSMTP(listener net.Listener, received chan<- []string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var lines []string
+	r := bufio.NewReader(conn)
+	reply := func(s string) { _, _ = conn.Write([]byte(s + "\r\n")) }
+
+	reply("220 localhost ESMTP")
+	inData := false
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			received <- lines
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		lines = append(lines, line)
+
+		switch {
+		case inData && line == ".":
+			inData = false
+			reply("250 OK")
+		case inData:
+		case strings.HasPrefix(line, "EHLO"), strings.HasPrefix(line, "HELO"):
+			reply("250 localhost")
+		case line == "DATA":
+			inData = true
+			reply("354 Go ahead")
+		case line == "QUIT":
+			reply("221 Bye")
+			received <- lines
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
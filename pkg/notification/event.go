@@ -0,0 +1,211 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notification delivers notifications of the phase transitions of Backups, Restores
+// and DeleteBackupRequests to the targets of NotificationSinks.
+package notification
+
+import (
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// Event is a phase transition of a Backup, Restore or DeleteBackupRequest. It's the data
+// that notification templates are rendered with.
+type Event struct {
+	// Kind is the kind of the resource that transitioned.
+	Kind velerov1api.NotificationEventKind `json:"kind"`
+
+	// Namespace, Name and UID identify the resource that transitioned.
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid,omitempty"`
+
+	// Phase is the phase the resource transitioned to, and PreviousPhase the phase it
+	// transitioned from.
+	Phase         string `json:"phase"`
+	PreviousPhase string `json:"previousPhase,omitempty"`
+
+	// BackupName is the name of the backup that a Restore restores from, or that a
+	// DeleteBackupRequest deletes.
+	BackupName string `json:"backupName,omitempty"`
+
+	// Schedule is the name of the schedule that created the backup.
+	Schedule string `json:"schedule,omitempty"`
+
+	// IncludedNamespaces are the namespaces that the backup or restore includes.
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// Errors and Warnings are the numbers of errors and warnings encountered.
+	Errors   int `json:"errors,omitempty"`
+	Warnings int `json:"warnings,omitempty"`
+
+	// FailureReason is why the resource failed, if it did.
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// ValidationErrors are the resource's validation errors, if it failed validation.
+	ValidationErrors []string `json:"validationErrors,omitempty"`
+
+	// StartTimestamp and CompletionTimestamp are when the backup or restore started and
+	// completed.
+	StartTimestamp      *time.Time `json:"startTimestamp,omitempty"`
+	CompletionTimestamp *time.Time `json:"completionTimestamp,omitempty"`
+
+	// Time is when the transition was observed.
+	Time time.Time `json:"time"`
+}
+
+// ForBackup returns the event of backup transitioning from previousPhase to its current
+// phase.
+func ForBackup(backup *velerov1api.Backup, previousPhase velerov1api.BackupPhase, now time.Time) Event {
+	return Event{
+		Kind:                velerov1api.NotificationEventKindBackup,
+		Namespace:           backup.Namespace,
+		Name:                backup.Name,
+		UID:                 backup.UID,
+		Phase:               string(backup.Status.Phase),
+		PreviousPhase:       string(previousPhase),
+		Schedule:            backup.Labels[velerov1api.ScheduleNameLabel],
+		IncludedNamespaces:  backup.Spec.IncludedNamespaces,
+		Errors:              backup.Status.Errors,
+		Warnings:            backup.Status.Warnings,
+		ValidationErrors:    backup.Status.ValidationErrors,
+		StartTimestamp:      timeOf(backup.Status.StartTimestamp),
+		CompletionTimestamp: timeOf(backup.Status.CompletionTimestamp),
+		Time:                now,
+	}
+}
+
+// ForRestore returns the event of restore transitioning from previousPhase to its current
+// phase. backup is the backup that the restore restores from, or nil if it's not known.
+func ForRestore(restore *velerov1api.Restore, backup *velerov1api.Backup, previousPhase velerov1api.RestorePhase, now time.Time) Event {
+	event := Event{
+		Kind:                velerov1api.NotificationEventKindRestore,
+		Namespace:           restore.Namespace,
+		Name:                restore.Name,
+		UID:                 restore.UID,
+		Phase:               string(restore.Status.Phase),
+		PreviousPhase:       string(previousPhase),
+		BackupName:          restore.Spec.BackupName,
+		Schedule:            restore.Spec.ScheduleName,
+		IncludedNamespaces:  restore.Spec.IncludedNamespaces,
+		Errors:              restore.Status.Errors,
+		Warnings:            restore.Status.Warnings,
+		FailureReason:       restore.Status.FailureReason,
+		ValidationErrors:    restore.Status.ValidationErrors,
+		StartTimestamp:      timeOf(restore.Status.StartTimestamp),
+		CompletionTimestamp: timeOf(restore.Status.CompletionTimestamp),
+		Time:                now,
+	}
+	if backup != nil {
+		if event.BackupName == "" {
+			event.BackupName = backup.Name
+		}
+		if event.Schedule == "" {
+			event.Schedule = backup.Labels[velerov1api.ScheduleNameLabel]
+		}
+	}
+	return event
+}
+
+// ForDeleteBackupRequest returns the event of request transitioning from previousPhase to
+// its current phase. backup is the backup that the request deletes, or nil if it's not
+// known; its schedule and included namespaces are the event's.
+func ForDeleteBackupRequest(request *velerov1api.DeleteBackupRequest, backup *velerov1api.Backup, previousPhase velerov1api.DeleteBackupRequestPhase, now time.Time) Event {
+	event := Event{
+		Kind:          velerov1api.NotificationEventKindDeleteBackupRequest,
+		Namespace:     request.Namespace,
+		Name:          request.Name,
+		UID:           request.UID,
+		Phase:         string(request.Status.Phase),
+		PreviousPhase: string(previousPhase),
+		BackupName:    request.Spec.BackupName,
+		Errors:        len(request.Status.Errors),
+		FailureReason: strings.Join(request.Status.Errors, "; "),
+		Time:          now,
+	}
+	if backup != nil {
+		event.Schedule = backup.Labels[velerov1api.ScheduleNameLabel]
+		event.IncludedNamespaces = backup.Spec.IncludedNamespaces
+	}
+	return event
+}
+
+// Matches returns whether filter matches event.
+func Matches(filter velerov1api.NotificationFilter, event Event) bool {
+	if len(filter.Kinds) > 0 {
+		found := false
+		for _, kind := range filter.Kinds {
+			if kind == event.Kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(filter.Phases) > 0 && !contains(filter.Phases, event.Phase) {
+		return false
+	}
+
+	if len(filter.Schedules) > 0 && !contains(filter.Schedules, event.Schedule) {
+		return false
+	}
+
+	if len(filter.Namespaces) > 0 && !includesAnyNamespace(event.IncludedNamespaces, filter.Namespaces) {
+		return false
+	}
+
+	return true
+}
+
+// includesAnyNamespace returns whether includedNamespaces, the namespaces a backup or
+// restore includes, includes any of namespaces. Empty included namespaces and "*"
+// include all namespaces.
+func includesAnyNamespace(includedNamespaces, namespaces []string) bool {
+	if len(includedNamespaces) == 0 || contains(includedNamespaces, "*") {
+		return true
+	}
+	for _, ns := range namespaces {
+		if contains(includedNamespaces, ns) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func timeOf(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
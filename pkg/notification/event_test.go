@@ -0,0 +1,142 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestMatches(t *testing.T) {
+	event := Event{
+		Kind:               velerov1api.NotificationEventKindBackup,
+		Name:               "backup-1",
+		Phase:              "Failed",
+		Schedule:           "daily",
+		IncludedNamespaces: []string{"ns-1", "ns-2"},
+	}
+
+	tests := []struct {
+		name   string
+		filter velerov1api.NotificationFilter
+		event  Event
+		want   bool
+	}{
+		{
+			name: "empty filter matches any event",
+			want: true,
+		},
+		{
+			name:   "matching filter matches",
+			filter: velerov1api.NotificationFilter{Kinds: []velerov1api.NotificationEventKind{"Restore", "Backup"}, Phases: []string{"PartiallyFailed", "Failed"}, Schedules: []string{"daily"}, Namespaces: []string{"ns-2"}},
+			want:   true,
+		},
+		{
+			name:   "different kind doesn't match",
+			filter: velerov1api.NotificationFilter{Kinds: []velerov1api.NotificationEventKind{"Restore"}},
+		},
+		{
+			name:   "different phase doesn't match",
+			filter: velerov1api.NotificationFilter{Phases: []string{"Completed"}},
+		},
+		{
+			name:   "different schedule doesn't match",
+			filter: velerov1api.NotificationFilter{Schedules: []string{"weekly"}},
+		},
+		{
+			name:   "event without a schedule doesn't match schedules",
+			filter: velerov1api.NotificationFilter{Schedules: []string{"daily"}},
+			event:  Event{Kind: velerov1api.NotificationEventKindBackup, Phase: "Failed"},
+		},
+		{
+			name:   "different namespaces don't match",
+			filter: velerov1api.NotificationFilter{Namespaces: []string{"ns-3"}},
+		},
+		{
+			name:   "event including all namespaces matches any namespace",
+			filter: velerov1api.NotificationFilter{Namespaces: []string{"ns-3"}},
+			event:  Event{Kind: velerov1api.NotificationEventKindBackup, IncludedNamespaces: []string{"*"}},
+			want:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := test.event
+			if e.Kind == "" {
+				e = event
+			}
+			assert.Equal(t, test.want, Matches(test.filter, e))
+		})
+	}
+}
+
+func TestForRestore(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").
+		Backup("backup-1").
+		IncludedNamespaces("ns-1").
+		Phase(velerov1api.RestorePhasePartiallyFailed).
+		Result()
+	restore.Status.Errors = 2
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+		ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+		Result()
+
+	assert.Equal(t, Event{
+		Kind:               velerov1api.NotificationEventKindRestore,
+		Namespace:          velerov1api.DefaultNamespace,
+		Name:               "restore-1",
+		Phase:              "PartiallyFailed",
+		PreviousPhase:      "InProgress",
+		BackupName:         "backup-1",
+		Schedule:           "daily",
+		IncludedNamespaces: []string{"ns-1"},
+		Errors:             2,
+		Time:               now,
+	}, ForRestore(restore, backup, velerov1api.RestorePhaseInProgress, now))
+}
+
+func TestForDeleteBackupRequest(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	request := &velerov1api.DeleteBackupRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "backup-1-abcde"},
+		Spec:       velerov1api.DeleteBackupRequestSpec{BackupName: "backup-1"},
+		Status: velerov1api.DeleteBackupRequestStatus{
+			Phase:  velerov1api.DeleteBackupRequestPhaseProcessed,
+			Errors: []string{"error deleting snapshot", "error deleting backup files"},
+		},
+	}
+
+	assert.Equal(t, Event{
+		Kind:          velerov1api.NotificationEventKindDeleteBackupRequest,
+		Namespace:     velerov1api.DefaultNamespace,
+		Name:          "backup-1-abcde",
+		Phase:         "Processed",
+		PreviousPhase: "InProgress",
+		BackupName:    "backup-1",
+		Errors:        2,
+		FailureReason: "error deleting snapshot; error deleting backup files",
+		Time:          now,
+	}, ForDeleteBackupRequest(request, nil, velerov1api.DeleteBackupRequestPhaseInProgress, now))
+}
@@ -0,0 +1,170 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// cloudEventsSpecVersion is the version of the CloudEvents specification that events
+	// are sent with.
+	cloudEventsSpecVersion = "1.0"
+
+	// cloudEventTypePrefix prefixes the types of CloudEvents, which are followed by the
+	// lower-cased kind and phase of the transition, e.g. io.velero.backup.failed.
+	cloudEventTypePrefix = "io.velero."
+
+	// maxErrorBodySize is the most of an error response's body that's included in the
+	// delivery's error.
+	maxErrorBodySize = 512
+)
+
+// webhookPayload is the body of Webhook and CloudEvents notifications.
+type webhookPayload struct {
+	Message string `json:"message"`
+	Event
+}
+
+// slackPayload is the body of Slack notifications.
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// post POSTs the notification of event to sink's HTTP target.
+func (d *Deliverer) post(ctx context.Context, sink *velerov1api.NotificationSink, event Event, message string) error {
+	target := sink.Spec.HTTP
+	if target == nil {
+		return errors.New("notification sink has no HTTP target")
+	}
+
+	endpoint := target.URL
+	if target.URLSecret != nil {
+		data, err := kube.GetSecretKey(d.client, sink.Namespace, target.URLSecret)
+		if err != nil {
+			return errors.Wrap(err, "error getting URL secret")
+		}
+		endpoint = strings.TrimSpace(string(data))
+	}
+	if endpoint == "" {
+		return errors.New("notification sink's HTTP target has no URL")
+	}
+
+	var payload interface{} = webhookPayload{Message: message, Event: event}
+	if sink.Spec.Type == velerov1api.NotificationSinkTypeSlack {
+		payload = slackPayload{Text: message}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "error encoding notification")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "error creating request")
+	}
+	for name, value := range target.Headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if target.AuthorizationSecret != nil {
+		data, err := kube.GetSecretKey(d.client, sink.Namespace, target.AuthorizationSecret)
+		if err != nil {
+			return errors.Wrap(err, "error getting authorization secret")
+		}
+		req.Header.Set("Authorization", strings.TrimSpace(string(data)))
+	}
+	if sink.Spec.Type == velerov1api.NotificationSinkTypeCloudEvents {
+		setCloudEventHeaders(req.Header, event)
+	}
+
+	client, err := httpClient(target)
+	if err != nil {
+		return err
+	}
+
+	// The error of a failed request includes its URL, which mustn't be recorded since
+	// it may be a secret.
+	res, err := client.Do(req)
+	if err != nil {
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		return errors.Wrap(err, "error sending notification")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		resBody, _ := ioutil.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+		return errors.Errorf("notification was rejected with status %s: %s", res.Status, strings.TrimSpace(string(resBody)))
+	}
+	return nil
+}
+
+// setCloudEventHeaders sets the headers of a CloudEvent in the HTTP binary content mode.
+// The event's ID is the same for every attempt to deliver it, so that receivers can
+// discard duplicates.
+func setCloudEventHeaders(header http.Header, event Event) {
+	id := string(event.UID)
+	if id == "" {
+		id = event.Namespace + "/" + event.Name
+	}
+
+	header.Set("ce-specversion", cloudEventsSpecVersion)
+	header.Set("ce-id", id+"/"+event.Phase)
+	header.Set("ce-source", "velero.io/"+event.Namespace)
+	header.Set("ce-type", cloudEventTypePrefix+strings.ToLower(string(event.Kind))+"."+strings.ToLower(event.Phase))
+	header.Set("ce-subject", event.Name)
+	header.Set("ce-time", event.Time.UTC().Format(time.RFC3339))
+}
+
+// httpClient returns an HTTP client that trusts target's certificates.
+func httpClient(target *velerov1api.HTTPNotificationTarget) (*http.Client, error) {
+	if len(target.CACert) == 0 && !target.InsecureSkipTLSVerify {
+		return http.DefaultClient, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: target.InsecureSkipTLSVerify, // nolint:gosec
+	}
+	if len(target.CACert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(target.CACert) {
+			return nil, errors.New("notification sink's HTTP target has an invalid CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// defaultSMTPPort is the port of mail servers of SMTP targets that don't set one, the
+// message submission port.
+const defaultSMTPPort = 587
+
+// mail emails the notification of event through sink's SMTP target. The connection is
+// upgraded with STARTTLS if the mail server supports it.
+func (d *Deliverer) mail(ctx context.Context, sink *velerov1api.NotificationSink, event Event, message string) error {
+	target := sink.Spec.SMTP
+	if target == nil {
+		return errors.New("notification sink has no SMTP target")
+	}
+
+	var password string
+	if target.PasswordSecret != nil {
+		data, err := kube.GetSecretKey(d.client, sink.Namespace, target.PasswordSecret)
+		if err != nil {
+			return errors.Wrap(err, "error getting password secret")
+		}
+		password = strings.TrimSpace(string(data))
+	}
+
+	port := target.Port
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(target.Host, strconv.Itoa(port)))
+	if err != nil {
+		return errors.Wrap(err, "error connecting to mail server")
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return errors.WithStack(err)
+		}
+	}
+
+	client, err := smtp.NewClient(conn, target.Host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrap(err, "error connecting to mail server")
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: target.Host}); err != nil {
+			return errors.Wrap(err, "error starting TLS")
+		}
+	}
+	if target.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", target.Username, password, target.Host)); err != nil {
+			return errors.Wrap(err, "error authenticating to mail server")
+		}
+	}
+
+	if err := client.Mail(target.From); err != nil {
+		return errors.Wrap(err, "error setting sender")
+	}
+	for _, to := range target.To {
+		if err := client.Rcpt(to); err != nil {
+			return errors.Wrapf(err, "error adding recipient %s", to)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "error sending message")
+	}
+	if _, err := w.Write(mailMessage(target, event, message)); err != nil {
+		return errors.Wrap(err, "error sending message")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "error sending message")
+	}
+
+	return errors.WithStack(client.Quit())
+}
+
+// mailMessage returns the email of the notification of event.
+func mailMessage(target *velerov1api.SMTPNotificationTarget, event Event, message string) []byte {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "From: %s\r\n", target.From)
+	fmt.Fprintf(buf, "To: %s\r\n", strings.Join(target.To, ", "))
+	fmt.Fprintf(buf, "Subject: [Velero] %s %s/%s is %s\r\n", event.Kind, event.Namespace, event.Name, event.Phase)
+	fmt.Fprintf(buf, "Date: %s\r\n", event.Time.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(message, "\r\n", "\n"), "\n", "\r\n"))
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
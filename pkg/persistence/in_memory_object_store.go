@@ -106,6 +106,7 @@ func (o *inMemoryObjectStore) ListCommonPrefixes(bucket, prefix, delimiter strin
 	// If not, skip it; if so, return the prefix of the key up to/including the delimiter.
 
 	var prefixes []string
+	seen := make(map[string]bool)
 	for _, key := range keys {
 		// everything after 'prefix'
 		afterPrefix := key[len(prefix):]
@@ -120,6 +121,12 @@ func (o *inMemoryObjectStore) ListCommonPrefixes(bucket, prefix, delimiter strin
 		// the delimiter, plus the delimiter
 		fullPrefix := prefix + afterPrefix[0:delimiterStart] + delimiter
 
+		// like object stores, only return each prefix once
+		if seen[fullPrefix] {
+			continue
+		}
+		seen[fullPrefix] = true
+
 		prefixes = append(prefixes, fullPrefix)
 	}
 
@@ -209,6 +209,57 @@ func (_m *BackupStore) IsValid() error {
 	return r0
 }
 
+// GetRevision provides a mock function with given fields:
+func (_m *BackupStore) GetRevision() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChanges provides a mock function with given fields: sinceRevision
+func (_m *BackupStore) ListChanges(sinceRevision string) ([]persistence.BackupStoreChange, bool, error) {
+	ret := _m.Called(sinceRevision)
+
+	var r0 []persistence.BackupStoreChange
+	if rf, ok := ret.Get(0).(func(string) []persistence.BackupStoreChange); ok {
+		r0 = rf(sinceRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]persistence.BackupStoreChange)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(sinceRevision)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(sinceRevision)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ListBackups provides a mock function with given fields:
 func (_m *BackupStore) ListBackups() ([]string, error) {
 	ret := _m.Called()
@@ -246,6 +297,80 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// GetRestoreMetadata provides a mock function with given fields: name
+func (_m *BackupStore) GetRestoreMetadata(name string) (*v1.Restore, error) {
+	ret := _m.Called(name)
+
+	var r0 *v1.Restore
+	if rf, ok := ret.Get(0).(func(string) *v1.Restore); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.Restore)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRestores provides a mock function with given fields:
+func (_m *BackupStore) ListRestores() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutBackupMetadata provides a mock function with given fields: name, metadata
+func (_m *BackupStore) PutBackupMetadata(name string, metadata io.Reader) error {
+	ret := _m.Called(name, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(name, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestoreMetadata provides a mock function with given fields: backup, restore, metadata
+func (_m *BackupStore) PutRestoreMetadata(backup string, restore string, metadata io.Reader) error {
+	ret := _m.Called(backup, restore, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(backup, restore, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreLog provides a mock function with given fields: backup, restore, log
 func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	ret := _m.Called(backup, restore, log)
@@ -284,6 +409,29 @@ func (_m *BackupStore) GetCSIVolumeSnapshotContents(backup string) ([]*snapshotv
 	return nil, nil
 }
 
+// GetDataUploads provides a mock function with given fields: name
+func (_m *BackupStore) GetDataUploads(name string) ([]*v1.DataUpload, error) {
+	ret := _m.Called(name)
+
+	var r0 []*v1.DataUpload
+	if rf, ok := ret.Get(0).(func(string) []*v1.DataUpload); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v1.DataUpload)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 func (_m *BackupStore) GetItemSnapshots(name string) ([]*volume.ItemSnapshot, error) {
 	panic("implement me")
 }
@@ -21,13 +21,15 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"path"
+	"sort"
 	"strings"
 	"time"
 
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
-
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/clock"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
@@ -42,12 +44,14 @@ type BackupInfo struct {
 	Metadata,
 	Contents,
 	Log,
+	Results,
 	PodVolumeBackups,
 	VolumeSnapshots,
 	ItemSnapshots,
 	BackupResourceList,
 	CSIVolumeSnapshots,
-	CSIVolumeSnapshotContents io.Reader
+	CSIVolumeSnapshotContents,
+	DataUploads io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -57,7 +61,20 @@ type BackupStore interface {
 
 	ListBackups() ([]string, error)
 
+	// GetRevision returns the backup store's revision, which is the name of the
+	// last change in the store's change log, or an empty string if the store
+	// doesn't have a revision yet.
+	GetRevision() (string, error)
+	// ListChanges returns the changes in the backup store's change log since the
+	// given revision, oldest first, and true. It returns false if the change log
+	// might not have all of the changes since the revision, in which case the
+	// store's backups and restores need to be listed instead.
+	ListChanges(sinceRevision string) ([]BackupStoreChange, bool, error)
+
 	PutBackup(info BackupInfo) error
+	// PutBackupMetadata replaces the metadata file of a backup that has already
+	// been put in the backup store.
+	PutBackupMetadata(name string, metadata io.Reader) error
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
 	GetItemSnapshots(name string) ([]*volume.ItemSnapshot, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
@@ -65,12 +82,18 @@ type BackupStore interface {
 	GetBackupContents(name string) (io.ReadCloser, error)
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1beta1api.VolumeSnapshot, error)
 	GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1beta1api.VolumeSnapshotContent, error)
+	GetDataUploads(name string) ([]*velerov1api.DataUpload, error)
 
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
 	DeleteBackup(name string) error
 
+	ListRestores() ([]string, error)
+	// GetRestoreMetadata returns the restore stored in the backup store, or nil
+	// if the restore doesn't have a metadata file.
+	GetRestoreMetadata(name string) (*velerov1api.Restore, error)
+	PutRestoreMetadata(backup, restore string, metadata io.Reader) error
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
 	DeleteRestore(name string) error
@@ -81,11 +104,47 @@ type BackupStore interface {
 // DownloadURLTTL is how long a download URL is valid for.
 const DownloadURLTTL = 10 * time.Minute
 
+const (
+	BackupStoreChangeKindBackup  = "backup"
+	BackupStoreChangeKindRestore = "restore"
+)
+
+// BackupStoreChange is a backup or restore that was put in or deleted from a
+// backup store, as recorded in the store's change log.
+type BackupStoreChange struct {
+	// Kind is BackupStoreChangeKindBackup or BackupStoreChangeKindRestore.
+	Kind    string
+	Name    string
+	Deleted bool
+}
+
+const (
+	// changeLogRetention is how long changes are kept in a backup store's change
+	// log. Changes since revisions older than this are listed by listing all of
+	// the store's backups and restores.
+	changeLogRetention = 24 * time.Hour
+
+	// changeLogClockSkew is how long before a revision's change the changes since
+	// the revision are listed from, to allow for the clocks of the clusters that
+	// write to the backup store being out of sync.
+	changeLogClockSkew = 5 * time.Minute
+
+	// changeTimeFormat is the format of the time each change's name starts with,
+	// which sorts changes in the order they were made.
+	changeTimeFormat = "20060102T150405.000000000Z"
+
+	// changeHourFormat is the format of the directories that changes are grouped
+	// into by the hour they were made in, so that old changes can be skipped and
+	// pruned without listing them.
+	changeHourFormat = "20060102T15"
+)
+
 type objectBackupStore struct {
 	objectStore velero.ObjectStore
 	bucket      string
 	layout      *ObjectStoreLayout
 	logger      logrus.FieldLogger
+	clock       clock.Clock
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -174,6 +233,7 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		bucket:      bucket,
 		layout:      NewObjectStoreLayout(prefix),
 		logger:      log,
+		clock:       clock.RealClock{},
 	}, nil
 }
 
@@ -203,7 +263,17 @@ func (s *objectBackupStore) IsValid() error {
 }
 
 func (s *objectBackupStore) ListBackups() ([]string, error) {
-	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.subdirs["backups"], "/")
+	return s.listDirs(s.layout.subdirs["backups"])
+}
+
+func (s *objectBackupStore) ListRestores() ([]string, error) {
+	return s.listDirs(s.layout.subdirs["restores"])
+}
+
+// listDirs returns the names of the directories directly under the
+// given prefix.
+func (s *objectBackupStore) listDirs(dirPrefix string) ([]string, error) {
+	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, dirPrefix, "/")
 	if err != nil {
 		return nil, err
 	}
@@ -216,11 +286,11 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 	for _, prefix := range prefixes {
 		// values returned from a call to ObjectStore's
 		// ListCommonPrefixes method return the *full* prefix, inclusive
-		// of s.backupsPrefix, and include the delimiter ("/") as a suffix. Trim
-		// each of those off to get the backup name.
-		backupName := strings.TrimSuffix(strings.TrimPrefix(prefix, s.layout.subdirs["backups"]), "/")
+		// of dirPrefix, and include the delimiter ("/") as a suffix. Trim
+		// each of those off to get the directory name.
+		name := strings.TrimSuffix(strings.TrimPrefix(prefix, dirPrefix), "/")
 
-		output = append(output, backupName)
+		output = append(output, name)
 	}
 
 	return output, nil
@@ -233,6 +303,11 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
 	}
 
+	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupResultsKey(info.Name), info.Results); err != nil {
+		// Like the log file, the results file is best-effort.
+		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading results file")
+	}
+
 	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
 		// failure to upload metadata file is a hard-stop
 		return err
@@ -252,6 +327,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
 		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
 		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
+		s.layout.getDataUploadsKey(info.Name):               info.DataUploads,
 	}
 
 	for key, reader := range backupObjs {
@@ -268,9 +344,176 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		}
 	}
 
+	if err := s.putChange(BackupStoreChange{Kind: BackupStoreChangeKindBackup, Name: info.Name}); err != nil {
+		// the change log only lets backup sync avoid listing all of the store's
+		// backups, which it still does every so often, so failing to update it
+		// doesn't fail the backup.
+		s.logger.WithField("backup", info.Name).WithError(err).Warn("Error updating backup store change log")
+	}
+
 	return nil
 }
 
+func (s *objectBackupStore) PutBackupMetadata(name string, metadata io.Reader) error {
+	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(name), metadata); err != nil {
+		return err
+	}
+
+	if err := s.putChange(BackupStoreChange{Kind: BackupStoreChangeKindBackup, Name: name}); err != nil {
+		s.logger.WithField("backup", name).WithError(err).Warn("Error updating backup store change log")
+	}
+
+	return nil
+}
+
+func (s *objectBackupStore) GetRevision() (string, error) {
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRevisionKey())
+	if err != nil {
+		return "", err
+	}
+	if res == nil {
+		return "", nil
+	}
+	defer res.Close()
+
+	data, err := ioutil.ReadAll(res)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (s *objectBackupStore) ListChanges(sinceRevision string) ([]BackupStoreChange, bool, error) {
+	since, _, err := parseChangeName(sinceRevision)
+	if err != nil {
+		// the revision wasn't written by a Velero version that keeps a change log.
+		return nil, false, nil
+	}
+
+	from := since.Add(-changeLogClockSkew)
+	if s.clock.Since(from) >= changeLogRetention-changeLogClockSkew {
+		// changes since the revision might have been pruned.
+		return nil, false, nil
+	}
+	fromHour := from.UTC().Format(changeHourFormat)
+	fromName := from.UTC().Format(changeTimeFormat)
+
+	hours, err := s.listDirs(s.layout.getChangesDir())
+	if err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+
+	var names []string
+	for _, hour := range hours {
+		if hour < fromHour {
+			continue
+		}
+
+		keys, err := s.objectStore.ListObjects(s.bucket, s.layout.getChangeHourDir(hour))
+		if err != nil {
+			return nil, false, errors.WithStack(err)
+		}
+		for _, key := range keys {
+			if name := path.Base(key); name >= fromName {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	changes := make([]BackupStoreChange, 0, len(names))
+	for _, name := range names {
+		_, change, err := parseChangeName(name)
+		if err != nil {
+			s.logger.WithError(err).Warn("Skipping invalid change in backup store change log")
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, true, nil
+}
+
+// putChange adds a change to the backup store's change log, updates the store's
+// revision to the change's name, and prunes the changes that are older than the
+// change log's retention.
+func (s *objectBackupStore) putChange(change BackupStoreChange) error {
+	now := s.clock.Now()
+	name := changeName(now, change)
+
+	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getChangeKey(now, name), strings.NewReader("")); err != nil {
+		return errors.Wrap(err, "error adding change to change log")
+	}
+
+	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getRevisionKey(), strings.NewReader(name)); err != nil {
+		return errors.Wrap(err, "error updating revision file")
+	}
+
+	if err := s.pruneChanges(now); err != nil {
+		s.logger.WithError(err).Warn("Error pruning backup store change log")
+	}
+
+	return nil
+}
+
+// pruneChanges deletes the changes that were made in the hours before the
+// change log's retention.
+func (s *objectBackupStore) pruneChanges(now time.Time) error {
+	hours, err := s.listDirs(s.layout.getChangesDir())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	oldestHour := now.Add(-changeLogRetention).UTC().Format(changeHourFormat)
+
+	var errs []error
+	for _, hour := range hours {
+		if hour >= oldestHour {
+			continue
+		}
+
+		keys, err := s.objectStore.ListObjects(s.bucket, s.layout.getChangeHourDir(hour))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, key := range keys {
+			if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.WithStack(kerrors.NewAggregate(errs))
+}
+
+// changeName returns the name of a change in a backup store's change log, which
+// starts with the time of the change.
+func changeName(t time.Time, change BackupStoreChange) string {
+	op := "put"
+	if change.Deleted {
+		op = "delete"
+	}
+
+	return strings.Join([]string{t.UTC().Format(changeTimeFormat), change.Kind, op, change.Name}, "_")
+}
+
+// parseChangeName returns the time and the change of a change name.
+func parseChangeName(name string) (time.Time, BackupStoreChange, error) {
+	parts := strings.SplitN(name, "_", 4)
+	if len(parts) != 4 || (parts[2] != "put" && parts[2] != "delete") {
+		return time.Time{}, BackupStoreChange{}, errors.Errorf("invalid change name %q", name)
+	}
+
+	t, err := time.Parse(changeTimeFormat, parts[0])
+	if err != nil {
+		return time.Time{}, BackupStoreChange{}, errors.Wrapf(err, "invalid change name %q", name)
+	}
+
+	return t, BackupStoreChange{Kind: parts[1], Name: parts[3], Deleted: parts[2] == "delete"}, nil
+}
+
 func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup, error) {
 	metadataKey := s.layout.getBackupMetadataKey(name)
 
@@ -407,6 +650,24 @@ func (s *objectBackupStore) GetCSIVolumeSnapshotContents(name string) ([]*snapsh
 	return snapConts, nil
 }
 
+func (s *objectBackupStore) GetDataUploads(name string) ([]*velerov1api.DataUpload, error) {
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getDataUploadsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		// this indicates that the backup didn't move any CSI snapshot data
+		return nil, nil
+	}
+	defer res.Close()
+
+	var dataUploads []*velerov1api.DataUpload
+	if err := decode(res, &dataUploads); err != nil {
+		return nil, err
+	}
+	return dataUploads, nil
+}
+
 func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error) {
 	// if the podvolumebackups file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no pod volume backups would not have this file, so
@@ -452,6 +713,10 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 		}
 	}
 
+	if err := s.putChange(BackupStoreChange{Kind: BackupStoreChangeKindBackup, Name: name, Deleted: true}); err != nil {
+		s.logger.WithField("backup", name).WithError(err).Warn("Error updating backup store change log")
+	}
+
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
@@ -471,9 +736,58 @@ func (s *objectBackupStore) DeleteRestore(name string) error {
 		}
 	}
 
+	if err := s.putChange(BackupStoreChange{Kind: BackupStoreChangeKindRestore, Name: name, Deleted: true}); err != nil {
+		s.logger.WithField("restore", name).WithError(err).Warn("Error updating backup store change log")
+	}
+
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+func (s *objectBackupStore) GetRestoreMetadata(name string) (*velerov1api.Restore, error) {
+	metadataKey := s.layout.getRestoreMetadataKey(name)
+
+	// restores run by Velero versions that didn't upload restore metadata
+	// only have logs and results.
+	res, err := tryGet(s.objectStore, s.bucket, metadataKey)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	data, err := ioutil.ReadAll(res)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	decoder := scheme.Codecs.UniversalDecoder(velerov1api.SchemeGroupVersion)
+	obj, _, err := decoder.Decode(data, nil, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	restoreObj, ok := obj.(*velerov1api.Restore)
+	if !ok {
+		return nil, errors.Errorf("unexpected type for %s/%s: %T", s.bucket, metadataKey, obj)
+	}
+
+	return restoreObj, nil
+}
+
+func (s *objectBackupStore) PutRestoreMetadata(backup string, restore string, metadata io.Reader) error {
+	if err := s.objectStore.PutObject(s.bucket, s.layout.getRestoreMetadataKey(restore), metadata); err != nil {
+		return err
+	}
+
+	if err := s.putChange(BackupStoreChange{Kind: BackupStoreChangeKindRestore, Name: restore}); err != nil {
+		s.logger.WithField("restore", restore).WithError(err).Warn("Error updating backup store change log")
+	}
+
+	return nil
+}
+
 func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreLogKey(restore), log)
 }
@@ -494,6 +808,8 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getItemSnapshotsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupResourceList:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupResults:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResultsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreLog:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreResults:
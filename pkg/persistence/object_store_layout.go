@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"time"
 )
 
 // ObjectStoreLayout defines how Velero's persisted files map to
@@ -60,6 +61,24 @@ func (l *ObjectStoreLayout) isValidSubdir(name string) bool {
 	return ok
 }
 
+func (l *ObjectStoreLayout) getRevisionKey() string {
+	return path.Join(l.subdirs["metadata"], "revision")
+}
+
+func (l *ObjectStoreLayout) getChangesDir() string {
+	return path.Join(l.subdirs["metadata"], "changes") + "/"
+}
+
+// getChangeHourDir returns the directory of the changes that were made in
+// the given hour, formatted with changeHourFormat.
+func (l *ObjectStoreLayout) getChangeHourDir(hour string) string {
+	return path.Join(l.getChangesDir(), hour) + "/"
+}
+
+func (l *ObjectStoreLayout) getChangeKey(t time.Time, change string) string {
+	return path.Join(l.getChangeHourDir(t.UTC().Format(changeHourFormat)), change)
+}
+
 func (l *ObjectStoreLayout) getBackupDir(backup string) string {
 	return path.Join(l.subdirs["backups"], backup) + "/"
 }
@@ -80,6 +99,10 @@ func (l *ObjectStoreLayout) getBackupLogKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-logs.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupResultsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-results.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getPodVolumeBackupsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-podvolumebackups.json.gz", backup))
 }
@@ -96,6 +119,10 @@ func (l *ObjectStoreLayout) getBackupResourceListKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-resource-list.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getRestoreMetadataKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, "velero-restore.json")
+}
+
 func (l *ObjectStoreLayout) getRestoreLogKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-logs.gz", restore))
 }
@@ -111,3 +138,7 @@ func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotContentsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshotcontents.json.gz", backup))
 }
+
+func (l *ObjectStoreLayout) getDataUploadsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-datauploads.json.gz", backup))
+}
@@ -27,11 +27,14 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -49,10 +52,15 @@ type objectBackupStoreTestHarness struct {
 
 	objectStore    *inMemoryObjectStore
 	bucket, prefix string
+	clock          *clock.FakeClock
 }
 
+// testChangeTime is the time that the test harness's backup store makes changes at.
+var testChangeTime = time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
 func newObjectBackupStoreTestHarness(bucket, prefix string) *objectBackupStoreTestHarness {
 	objectStore := newInMemoryObjectStore(bucket)
+	clock := clock.NewFakeClock(testChangeTime)
 
 	return &objectBackupStoreTestHarness{
 		objectBackupStore: &objectBackupStore{
@@ -60,10 +68,12 @@ func newObjectBackupStoreTestHarness(bucket, prefix string) *objectBackupStoreTe
 			bucket:      bucket,
 			layout:      NewObjectStoreLayout(prefix),
 			logger:      velerotest.NewLogger(),
+			clock:       clock,
 		},
 		objectStore: objectStore,
 		bucket:      bucket,
 		prefix:      prefix,
+		clock:       clock,
 	}
 }
 
@@ -221,6 +231,7 @@ func TestPutBackup(t *testing.T) {
 		metadata        io.Reader
 		contents        io.Reader
 		log             io.Reader
+		results         io.Reader
 		podVolumeBackup io.Reader
 		snapshots       io.Reader
 		itemSnapshots   io.Reader
@@ -233,6 +244,7 @@ func TestPutBackup(t *testing.T) {
 			metadata:        newStringReadSeeker("metadata"),
 			contents:        newStringReadSeeker("contents"),
 			log:             newStringReadSeeker("log"),
+			results:         newStringReadSeeker("results"),
 			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
 			snapshots:       newStringReadSeeker("snapshots"),
 			itemSnapshots:   newStringReadSeeker("itemSnapshots"),
@@ -242,10 +254,13 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/velero-backup.json",
 				"backups/backup-1/backup-1.tar.gz",
 				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-results.gz",
 				"backups/backup-1/backup-1-podvolumebackups.json.gz",
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-itemsnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
+				"metadata/revision",
+				"metadata/changes/20220102T03/20220102T030405.000000000Z_backup_put_backup-1",
 			},
 		},
 		{
@@ -267,6 +282,8 @@ func TestPutBackup(t *testing.T) {
 				"prefix-1/backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"prefix-1/backups/backup-1/backup-1-itemsnapshots.json.gz",
 				"prefix-1/backups/backup-1/backup-1-resource-list.json.gz",
+				"prefix-1/metadata/revision",
+				"prefix-1/metadata/changes/20220102T03/20220102T030405.000000000Z_backup_put_backup-1",
 			},
 		},
 		{
@@ -309,6 +326,29 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-itemsnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
+				"metadata/revision",
+				"metadata/changes/20220102T03/20220102T030405.000000000Z_backup_put_backup-1",
+			},
+		},
+		{
+			name:            "error on results upload is ok",
+			metadata:        newStringReadSeeker("foo"),
+			contents:        newStringReadSeeker("bar"),
+			log:             newStringReadSeeker("log"),
+			results:         new(errorReader),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			expectedErr:     "",
+			expectedKeys: []string{
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar.gz",
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-podvolumebackups.json.gz",
+				"backups/backup-1/backup-1-volumesnapshots.json.gz",
+				"backups/backup-1/backup-1-resource-list.json.gz",
+				"metadata/revision",
+				"metadata/changes/20220102T03/20220102T030405.000000000Z_backup_put_backup-1",
 			},
 		},
 		{
@@ -326,6 +366,8 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-podvolumebackups.json.gz",
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
+				"metadata/revision",
+				"metadata/changes/20220102T03/20220102T030405.000000000Z_backup_put_backup-1",
 			},
 		},
 	}
@@ -339,6 +381,7 @@ func TestPutBackup(t *testing.T) {
 				Metadata:           tc.metadata,
 				Contents:           tc.contents,
 				Log:                tc.log,
+				Results:            tc.results,
 				PodVolumeBackups:   tc.podVolumeBackup,
 				VolumeSnapshots:    tc.snapshots,
 				ItemSnapshots:      tc.itemSnapshots,
@@ -355,6 +398,117 @@ func TestPutBackup(t *testing.T) {
 	}
 }
 
+func TestGetRevision(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// a store without a revision file doesn't have a revision
+	revision, err := harness.GetRevision()
+	require.NoError(t, err)
+	assert.Empty(t, revision)
+
+	// putting a backup creates a revision, which is the name of the change
+	require.NoError(t, harness.PutBackup(BackupInfo{Name: "backup-1", Metadata: newStringReadSeeker("metadata")}))
+	revision, err = harness.GetRevision()
+	require.NoError(t, err)
+	assert.Equal(t, "20220102T030405.000000000Z_backup_put_backup-1", revision)
+
+	// deleting a backup changes the revision
+	require.NoError(t, harness.DeleteBackup("backup-1"))
+	newRevision, err := harness.GetRevision()
+	require.NoError(t, err)
+	assert.Equal(t, "20220102T030405.000000000Z_backup_delete_backup-1", newRevision)
+}
+
+func TestPutBackupMetadata(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	require.NoError(t, harness.PutBackup(BackupInfo{Name: "backup-1", Metadata: newStringReadSeeker("metadata")}))
+	revision, err := harness.GetRevision()
+	require.NoError(t, err)
+
+	harness.clock.Step(time.Second)
+	require.NoError(t, harness.PutBackupMetadata("backup-1", newStringReadSeeker("new metadata")))
+	assert.Equal(t, "new metadata", string(harness.objectStore.Data[harness.bucket]["backups/backup-1/velero-backup.json"]))
+
+	// replacing the metadata changes the revision so that backup sync picks it up
+	newRevision, err := harness.GetRevision()
+	require.NoError(t, err)
+	assert.NotEqual(t, revision, newRevision)
+}
+
+func TestListChanges(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "prefix-1")
+
+	require.NoError(t, harness.PutBackup(BackupInfo{Name: "backup-1", Metadata: newStringReadSeeker("metadata")}))
+	revision, err := harness.GetRevision()
+	require.NoError(t, err)
+
+	// changes made within the clock skew of the revision are listed too, in
+	// case they were made by a cluster whose clock is behind.
+	harness.clock.Step(changeLogClockSkew - time.Second)
+	require.NoError(t, harness.PutRestoreMetadata("backup-1", "restore-1", newStringReadSeeker("metadata")))
+	harness.clock.Step(time.Hour)
+	require.NoError(t, harness.DeleteBackup("backup-2"))
+	require.NoError(t, harness.DeleteRestore("restore-2"))
+
+	harness.clock.SetTime(testChangeTime.Add(changeLogClockSkew + time.Second))
+	require.NoError(t, harness.PutBackup(BackupInfo{Name: "backup-0", Metadata: newStringReadSeeker("metadata")}))
+
+	changes, ok, err := harness.ListChanges(revision)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []BackupStoreChange{
+		{Kind: BackupStoreChangeKindBackup, Name: "backup-1"},
+		{Kind: BackupStoreChangeKindRestore, Name: "restore-1"},
+		{Kind: BackupStoreChangeKindBackup, Name: "backup-0"},
+		{Kind: BackupStoreChangeKindBackup, Name: "backup-2", Deleted: true},
+		{Kind: BackupStoreChangeKindRestore, Name: "restore-2", Deleted: true},
+	}, changes)
+
+	// changes made long enough before the revision aren't listed
+	harness.clock.SetTime(testChangeTime.Add(2 * time.Hour))
+	require.NoError(t, harness.PutBackup(BackupInfo{Name: "backup-3", Metadata: newStringReadSeeker("metadata")}))
+	revision, err = harness.GetRevision()
+	require.NoError(t, err)
+
+	changes, ok, err = harness.ListChanges(revision)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []BackupStoreChange{{Kind: BackupStoreChangeKindBackup, Name: "backup-3"}}, changes)
+
+	// revisions that aren't changes, or whose changes might have been pruned,
+	// can't be listed from
+	for _, revision := range []string{"", "8f2a1b5e-3a0c-4b8e-9e2f-1c7d6a5b4c3d", revision} {
+		harness.clock.SetTime(testChangeTime.Add(2*time.Hour + changeLogRetention))
+
+		changes, ok, err = harness.ListChanges(revision)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Empty(t, changes)
+	}
+}
+
+func TestPutChangePrunesOldChanges(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	require.NoError(t, harness.PutBackup(BackupInfo{Name: "backup-1", Metadata: newStringReadSeeker("metadata")}))
+	harness.clock.Step(changeLogRetention)
+	require.NoError(t, harness.PutBackup(BackupInfo{Name: "backup-2", Metadata: newStringReadSeeker("metadata")}))
+
+	// the first change is still within the retention
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "metadata/changes/20220102T03/20220102T030405.000000000Z_backup_put_backup-1")
+
+	harness.clock.Step(time.Hour)
+	require.NoError(t, harness.PutBackup(BackupInfo{Name: "backup-3", Metadata: newStringReadSeeker("metadata")}))
+
+	keys, err := harness.objectStore.ListObjects(harness.bucket, "metadata/changes/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"metadata/changes/20220103T03/20220103T030405.000000000Z_backup_put_backup-2",
+		"metadata/changes/20220103T04/20220103T040405.000000000Z_backup_put_backup-3",
+	}, keys)
+}
+
 func TestGetBackupMetadata(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -528,6 +682,7 @@ func TestDeleteBackup(t *testing.T) {
 				bucket:      "test-bucket",
 				layout:      NewObjectStoreLayout(test.prefix),
 				logger:      velerotest.NewLogger(),
+				clock:       clock.NewFakeClock(testChangeTime),
 			}
 			defer objectStore.AssertExpectations(t)
 
@@ -542,6 +697,9 @@ func TestDeleteBackup(t *testing.T) {
 
 				objectStore.On("DeleteObject", backupStore.bucket, obj).Return(err)
 			}
+			objectStore.On("PutObject", backupStore.bucket, test.prefix+"metadata/changes/20220102T03/20220102T030405.000000000Z_backup_delete_bak", mock.Anything).Return(nil)
+			objectStore.On("PutObject", backupStore.bucket, test.prefix+"metadata/revision", mock.Anything).Return(nil)
+			objectStore.On("ListCommonPrefixes", backupStore.bucket, test.prefix+"metadata/changes/", "/").Return([]string{test.prefix + "metadata/changes/20220102T03/"}, nil)
 
 			err := backupStore.DeleteBackup("bak")
 
@@ -566,6 +724,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupItemSnapshots:   "backups/my-backup/my-backup-itemsnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupResults:         "backups/my-backup/my-backup-results.gz",
 			},
 		},
 		{
@@ -578,6 +737,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "velero-backups/backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupItemSnapshots:   "velero-backups/backups/my-backup/my-backup-itemsnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "velero-backups/backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupResults:         "velero-backups/backups/my-backup/my-backup-results.gz",
 			},
 		},
 		{
@@ -18,15 +18,19 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"os"
 	"os/exec"
+	"time"
 
 	hclog "github.com/hashicorp/go-hclog"
 	hcplugin "github.com/hashicorp/go-plugin"
 	"github.com/sirupsen/logrus"
 
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 )
 
 // clientBuilder builds go-plugin Clients.
@@ -35,6 +39,12 @@ type clientBuilder struct {
 	commandArgs  []string
 	clientLogger logrus.FieldLogger
 	pluginLogger hclog.Logger
+
+	// ctx is the context for calls to the plugins, and timeouts are how long the calls may take.
+	ctx      context.Context
+	timeouts Timeouts
+	// metrics, if set, records the duration of the calls.
+	metrics *metrics.ServerMetrics
 }
 
 // newClientBuilder returns a new clientBuilder with commandName to name. If the command matches the currently running
@@ -62,22 +72,52 @@ func newLogrusAdapter(pluginLogger logrus.FieldLogger, logLevel logrus.Level) *l
 	return &logrusAdapter{impl: pluginLogger, level: logLevel}
 }
 
+// pluginOptions returns the client options for plugins of kind.
+func (b *clientBuilder) pluginOptions(kind framework.PluginKind) []framework.PluginOption {
+	options := []framework.PluginOption{
+		framework.ClientLogger(b.clientLogger),
+		framework.ClientContext(b.ctx),
+		framework.ClientTimeout(b.timeouts.forKind(kind)),
+	}
+	if b.metrics != nil {
+		options = append(options, framework.ClientCallObserver(func(plugin string, duration time.Duration) {
+			b.metrics.ObservePluginCallDuration(kind.String(), plugin, duration.Seconds())
+		}))
+	}
+	return options
+}
+
 func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 	return &hcplugin.ClientConfig{
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindItemSnapshotter):   framework.NewItemSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupItemAction):   framework.NewBackupItemActionPlugin(b.pluginOptions(framework.PluginKindBackupItemAction)...),
+			string(framework.PluginKindBackupItemActionV2): framework.NewBackupItemActionV2Plugin(b.pluginOptions(framework.PluginKindBackupItemActionV2)...),
+			string(framework.PluginKindVolumeSnapshotter):  framework.NewVolumeSnapshotterPlugin(b.pluginOptions(framework.PluginKindVolumeSnapshotter)...),
+			string(framework.PluginKindObjectStore):        framework.NewObjectStorePlugin(b.pluginOptions(framework.PluginKindObjectStore)...),
+			string(framework.PluginKindPluginLister):       &framework.PluginListerPlugin{},
+			string(framework.PluginKindRestoreItemAction):  framework.NewRestoreItemActionPlugin(b.pluginOptions(framework.PluginKindRestoreItemAction)...),
+			string(framework.PluginKindDeleteItemAction):   framework.NewDeleteItemActionPlugin(b.pluginOptions(framework.PluginKindDeleteItemAction)...),
+			string(framework.PluginKindPreBackupAction):    framework.NewPreBackupActionPlugin(b.pluginOptions(framework.PluginKindPreBackupAction)...),
+			string(framework.PluginKindPostBackupAction):   framework.NewPostBackupActionPlugin(b.pluginOptions(framework.PluginKindPostBackupAction)...),
+			string(framework.PluginKindPreRestoreAction):   framework.NewPreRestoreActionPlugin(b.pluginOptions(framework.PluginKindPreRestoreAction)...),
+			string(framework.PluginKindPostRestoreAction):  framework.NewPostRestoreActionPlugin(b.pluginOptions(framework.PluginKindPostRestoreAction)...),
+			string(framework.PluginKindItemSnapshotter):    framework.NewItemSnapshotterPlugin(b.pluginOptions(framework.PluginKindItemSnapshotter)...),
 		},
 		Logger: b.pluginLogger,
-		Cmd:    exec.Command(b.commandName, b.commandArgs...),
+		Cmd:    b.command(),
+	}
+}
+
+// command returns the command that runs the plugin process. The process is passed the tracing
+// configuration of the server in its environment, so that it can trace the calls to the plugins.
+func (b *clientBuilder) command() *exec.Cmd {
+	cmd := exec.Command(b.commandName, b.commandArgs...)
+	if env := tracing.Current().Env(); len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
 	}
+	return cmd
 }
 
 // client creates a new go-plugin Client with support for all of Velero's plugin kinds (BackupItemAction, VolumeSnapshotter,
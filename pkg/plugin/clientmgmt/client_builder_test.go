@@ -60,13 +60,18 @@ func TestClientConfig(t *testing.T) {
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindItemSnapshotter):   framework.NewItemSnapshotterPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupItemAction):   framework.NewBackupItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupItemActionV2): framework.NewBackupItemActionV2Plugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindVolumeSnapshotter):  framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindObjectStore):        framework.NewObjectStorePlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindPluginLister):       &framework.PluginListerPlugin{},
+			string(framework.PluginKindRestoreItemAction):  framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindDeleteItemAction):   framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindPreBackupAction):    framework.NewPreBackupActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindPostBackupAction):   framework.NewPostBackupActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindPreRestoreAction):   framework.NewPreRestoreActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindPostRestoreAction):  framework.NewPostRestoreActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindItemSnapshotter):    framework.NewItemSnapshotterPlugin(framework.ClientLogger(logger)),
 		},
 		Logger: cb.pluginLogger,
 		Cmd:    exec.Command(cb.commandName, cb.commandArgs...),
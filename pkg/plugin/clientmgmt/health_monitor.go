@@ -0,0 +1,164 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+)
+
+// DefaultHealthCheckInterval is how often running plugin processes are pinged by default.
+const DefaultHealthCheckInterval = 30 * time.Second
+
+// HealthMonitor has running plugin processes check their health periodically, and records the
+// status of each plugin executable across all of the Managers that run it. A nil HealthMonitor
+// disables health checks.
+type HealthMonitor struct {
+	checkInterval time.Duration
+	clock         clock.Clock
+	metrics       *metrics.ServerMetrics
+
+	// lock guards statuses
+	lock     sync.Mutex
+	statuses map[string]*processStatus
+}
+
+// processStatus is the status of a plugin executable, along with the number of its processes
+// that are currently running.
+type processStatus struct {
+	status  velerov1api.PluginStatus
+	running int
+}
+
+// NewHealthMonitor returns a HealthMonitor that pings running plugin processes every checkInterval.
+// If checkInterval is 0, processes are not pinged, but their restarts are still recorded.
+func NewHealthMonitor(checkInterval time.Duration, clock clock.Clock, metrics *metrics.ServerMetrics) *HealthMonitor {
+	return &HealthMonitor{
+		checkInterval: checkInterval,
+		clock:         clock,
+		metrics:       metrics,
+		statuses:      make(map[string]*processStatus),
+	}
+}
+
+// Status returns the status of the plugin executable command. It returns false if no process has
+// been run for command since the HealthMonitor was created.
+func (m *HealthMonitor) Status(command string) (velerov1api.PluginStatus, bool) {
+	if m == nil {
+		return velerov1api.PluginStatus{}, false
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	s, found := m.statuses[command]
+	if !found {
+		return velerov1api.PluginStatus{}, false
+	}
+	return *s.status.DeepCopy(), true
+}
+
+// update calls fn with the status of command while holding the lock.
+func (m *HealthMonitor) update(command string, fn func(s *processStatus)) {
+	if m == nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	s, found := m.statuses[command]
+	if !found {
+		s = new(processStatus)
+		m.statuses[command] = s
+	}
+	fn(s)
+}
+
+// started records that a process for command was started.
+func (m *HealthMonitor) started(command string) {
+	m.update(command, func(s *processStatus) {
+		s.running++
+		s.status.Phase = velerov1api.PluginPhaseUp
+	})
+}
+
+// startFailed records that a process for command could not be started.
+func (m *HealthMonitor) startFailed(command string, err error) {
+	m.update(command, func(s *processStatus) {
+		s.status.Phase = velerov1api.PluginPhaseFailed
+		s.status.LastError = err.Error()
+	})
+}
+
+// healthy records that a process for command passed a health check.
+func (m *HealthMonitor) healthy(command string) {
+	m.update(command, func(s *processStatus) {
+		s.status.LastHealthCheckTimestamp = &metav1.Time{Time: m.clock.Now()}
+	})
+}
+
+// restarting records that a process for command is being restarted because of err.
+func (m *HealthMonitor) restarting(command string, err error) {
+	m.update(command, func(s *processStatus) {
+		s.status.Phase = velerov1api.PluginPhaseRestarting
+		s.status.LastError = err.Error()
+	})
+}
+
+// restarted records that a process for command was restarted.
+func (m *HealthMonitor) restarted(command string) {
+	m.update(command, func(s *processStatus) {
+		s.status.Phase = velerov1api.PluginPhaseUp
+		s.status.RestartCount++
+	})
+	if m != nil && m.metrics != nil {
+		m.metrics.RegisterPluginRestart(command)
+	}
+}
+
+// restartFailed records that restarting a process for command failed with err. If final is true,
+// the process will not be restarted again.
+func (m *HealthMonitor) restartFailed(command string, err error, final bool) {
+	m.update(command, func(s *processStatus) {
+		if final {
+			s.status.Phase = velerov1api.PluginPhaseFailed
+		}
+		s.status.LastError = err.Error()
+	})
+	if m != nil && m.metrics != nil {
+		m.metrics.RegisterPluginRestartFailure(command)
+	}
+}
+
+// stopped records that a process for command was stopped.
+func (m *HealthMonitor) stopped(command string) {
+	m.update(command, func(s *processStatus) {
+		if s.running > 0 {
+			s.running--
+		}
+		if s.running == 0 && s.status.Phase != velerov1api.PluginPhaseFailed {
+			s.status.Phase = velerov1api.PluginPhaseStopped
+		}
+	})
+}
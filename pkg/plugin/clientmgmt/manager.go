@@ -17,15 +17,19 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"strings"
 	"sync"
+	"time"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
 )
 
 // Manager manages the lifecycles of plugins.
@@ -37,10 +41,10 @@ type Manager interface {
 	GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error)
 
 	// GetBackupItemActions returns all backup item action plugins.
-	GetBackupItemActions() ([]velero.BackupItemAction, error)
+	GetBackupItemActions() ([]biav2.BackupItemAction, error)
 
 	// GetBackupItemAction returns the backup item action plugin for name.
-	GetBackupItemAction(name string) (velero.BackupItemAction, error)
+	GetBackupItemAction(name string) (biav2.BackupItemAction, error)
 
 	// GetRestoreItemActions returns all restore item action plugins.
 	GetRestoreItemActions() ([]velero.RestoreItemAction, error)
@@ -60,6 +64,30 @@ type Manager interface {
 	// GetItemSnapshotters returns all item snapshotter plugins
 	GetItemSnapshotters() ([]v1.ItemSnapshotter, error)
 
+	// GetPreBackupActions returns all pre-backup action plugins, keyed by name.
+	GetPreBackupActions() (map[string]velero.PreBackupAction, error)
+
+	// GetPreBackupAction returns the pre-backup action plugin for name.
+	GetPreBackupAction(name string) (velero.PreBackupAction, error)
+
+	// GetPostBackupActions returns all post-backup action plugins, keyed by name.
+	GetPostBackupActions() (map[string]velero.PostBackupAction, error)
+
+	// GetPostBackupAction returns the post-backup action plugin for name.
+	GetPostBackupAction(name string) (velero.PostBackupAction, error)
+
+	// GetPreRestoreActions returns all pre-restore action plugins, keyed by name.
+	GetPreRestoreActions() (map[string]velero.PreRestoreAction, error)
+
+	// GetPreRestoreAction returns the pre-restore action plugin for name.
+	GetPreRestoreAction(name string) (velero.PreRestoreAction, error)
+
+	// GetPostRestoreActions returns all post-restore action plugins, keyed by name.
+	GetPostRestoreActions() (map[string]velero.PostRestoreAction, error)
+
+	// GetPostRestoreAction returns the post-restore action plugin for name.
+	GetPostRestoreAction(name string) (velero.PostRestoreAction, error)
+
 	// CleanupClients terminates all of the Manager's running plugin processes.
 	CleanupClients()
 }
@@ -77,14 +105,29 @@ type manager struct {
 	restartableProcesses map[string]RestartableProcess
 }
 
-// NewManager constructs a manager for getting plugins.
-func NewManager(logger logrus.FieldLogger, level logrus.Level, registry Registry) Manager {
+// Timeouts are how long calls to plugins of each kind may take before they're cancelled. The
+// timeout for a kind applies to all of its versions, unless a version has its own timeout.
+type Timeouts map[framework.PluginKind]time.Duration
+
+// forKind returns the timeout for calls to plugins of kind, or 0 if they don't time out.
+func (t Timeouts) forKind(kind framework.PluginKind) time.Duration {
+	if timeout, found := t[kind]; found {
+		return timeout
+	}
+	return t[kind.Base()]
+}
+
+// NewManager constructs a manager for getting plugins. Calls to the plugins are cancelled when
+// ctx is done, or when they take longer than the timeout for their kind in timeouts. The health
+// of the plugin processes it runs is checked and recorded by healthMonitor, which may be nil. The
+// duration of the calls is recorded by metrics, which may also be nil.
+func NewManager(ctx context.Context, logger logrus.FieldLogger, level logrus.Level, registry Registry, healthMonitor *HealthMonitor, timeouts Timeouts, metrics *metrics.ServerMetrics) Manager {
 	return &manager{
 		logger:   logger,
 		logLevel: level,
 		registry: registry,
 
-		restartableProcessFactory: newRestartableProcessFactory(),
+		restartableProcessFactory: newRestartableProcessFactory(healthMonitor, &processFactory{ctx: ctx, timeouts: timeouts, metrics: metrics}),
 
 		restartableProcesses: make(map[string]RestartableProcess),
 	}
@@ -103,21 +146,51 @@ func (m *manager) CleanupClients() {
 // getRestartableProcess returns a restartableProcess for a plugin identified by kind and name, creating a
 // restartableProcess if it is the first time it has been requested.
 func (m *manager) getRestartableProcess(kind framework.PluginKind, name string) (RestartableProcess, error) {
-	m.lock.Lock()
-	defer m.lock.Unlock()
-
-	logger := m.logger.WithFields(logrus.Fields{
-		"kind": framework.PluginKindObjectStore.String(),
+	m.logger.WithFields(logrus.Fields{
+		"kind": kind.String(),
 		"name": name,
-	})
-	logger.Debug("looking for plugin in registry")
+	}).Debug("looking for plugin in registry")
 
 	info, err := m.registry.Get(kind, name)
 	if err != nil {
 		return nil, err
 	}
 
-	logger = logger.WithField("command", info.Command)
+	return m.getRestartableProcessForPlugin(info)
+}
+
+// getLatestRestartableProcess returns a restartableProcess for the newest version of the plugin kind that's
+// registered for name, along with the plugin's identifier so that callers can tell which version it is.
+func (m *manager) getLatestRestartableProcess(kind framework.PluginKind, name string) (RestartableProcess, framework.PluginIdentifier, error) {
+	m.logger.WithFields(logrus.Fields{
+		"kind": kind.String(),
+		"name": name,
+	}).Debug("looking for newest version of plugin in registry")
+
+	info, err := m.registry.GetLatest(kind, name)
+	if err != nil {
+		return nil, framework.PluginIdentifier{}, err
+	}
+
+	restartableProcess, err := m.getRestartableProcessForPlugin(info)
+	if err != nil {
+		return nil, framework.PluginIdentifier{}, err
+	}
+
+	return restartableProcess, info, nil
+}
+
+// getRestartableProcessForPlugin returns a restartableProcess for the plugin's command, creating a
+// restartableProcess if it is the first time it has been requested.
+func (m *manager) getRestartableProcessForPlugin(info framework.PluginIdentifier) (RestartableProcess, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	logger := m.logger.WithFields(logrus.Fields{
+		"kind":    info.Kind.String(),
+		"name":    info.Name,
+		"command": info.Command,
+	})
 
 	restartableProcess, found := m.restartableProcesses[info.Command]
 	if found {
@@ -127,7 +200,7 @@ func (m *manager) getRestartableProcess(kind framework.PluginKind, name string)
 
 	logger.Debug("creating new restartable plugin process")
 
-	restartableProcess, err = m.restartableProcessFactory.newRestartableProcess(info.Command, m.logger, m.logLevel)
+	restartableProcess, err := m.restartableProcessFactory.newRestartableProcess(info.Command, m.logger, m.logLevel)
 	if err != nil {
 		return nil, err
 	}
@@ -165,16 +238,23 @@ func (m *manager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, e
 	return r, nil
 }
 
-// GetBackupItemActions returns all backup item actions as restartableBackupItemActions.
-func (m *manager) GetBackupItemActions() ([]velero.BackupItemAction, error) {
-	list := m.registry.List(framework.PluginKindBackupItemAction)
+// GetBackupItemActions returns all backup item actions, using the newest version of each that's available.
+func (m *manager) GetBackupItemActions() ([]biav2.BackupItemAction, error) {
+	var names []string
+	seen := make(map[string]bool)
+	for _, kind := range framework.PluginKindBackupItemAction.Versions() {
+		for _, id := range m.registry.List(kind) {
+			if !seen[id.Name] {
+				seen[id.Name] = true
+				names = append(names, id.Name)
+			}
+		}
+	}
 
-	actions := make([]velero.BackupItemAction, 0, len(list))
+	actions := make([]biav2.BackupItemAction, 0, len(names))
 
-	for i := range list {
-		id := list[i]
-
-		r, err := m.GetBackupItemAction(id.Name)
+	for _, name := range names {
+		r, err := m.GetBackupItemAction(name)
 		if err != nil {
 			return nil, err
 		}
@@ -185,17 +265,21 @@ func (m *manager) GetBackupItemActions() ([]velero.BackupItemAction, error) {
 	return actions, nil
 }
 
-// GetBackupItemAction returns a restartableBackupItemAction for name.
-func (m *manager) GetBackupItemAction(name string) (velero.BackupItemAction, error) {
+// GetBackupItemAction returns a restartable backup item action for name. If the plugin only implements
+// version 1 of the BackupItemAction interface, it's adapted to version 2.
+func (m *manager) GetBackupItemAction(name string) (biav2.BackupItemAction, error) {
 	name = sanitizeName(name)
 
-	restartableProcess, err := m.getRestartableProcess(framework.PluginKindBackupItemAction, name)
+	restartableProcess, id, err := m.getLatestRestartableProcess(framework.PluginKindBackupItemAction, name)
 	if err != nil {
 		return nil, err
 	}
 
-	r := newRestartableBackupItemAction(name, restartableProcess)
-	return r, nil
+	if id.Kind == framework.PluginKindBackupItemActionV2 {
+		return newRestartableBackupItemActionV2(name, restartableProcess), nil
+	}
+
+	return biav2.NewAdaptedV1BackupItemAction(newRestartableBackupItemAction(name, restartableProcess)), nil
 }
 
 // GetRestoreItemActions returns all restore item actions as restartableRestoreItemActions.
@@ -296,6 +380,138 @@ func (m *manager) GetItemSnapshotters() ([]v1.ItemSnapshotter, error) {
 }
 
 // sanitizeName adds "velero.io" to legacy plugins that weren't namespaced.
+// GetPreBackupActions returns all pre-backup actions as restartablePreBackupActions, keyed by name.
+func (m *manager) GetPreBackupActions() (map[string]velero.PreBackupAction, error) {
+	list := m.registry.List(framework.PluginKindPreBackupAction)
+
+	actions := make(map[string]velero.PreBackupAction, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetPreBackupAction(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions[id.Name] = r
+	}
+
+	return actions, nil
+}
+
+// GetPreBackupAction returns a restartablePreBackupAction for name.
+func (m *manager) GetPreBackupAction(name string) (velero.PreBackupAction, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindPreBackupAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartablePreBackupAction(name, restartableProcess)
+	return r, nil
+}
+
+// GetPostBackupActions returns all post-backup actions as restartablePostBackupActions, keyed by name.
+func (m *manager) GetPostBackupActions() (map[string]velero.PostBackupAction, error) {
+	list := m.registry.List(framework.PluginKindPostBackupAction)
+
+	actions := make(map[string]velero.PostBackupAction, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetPostBackupAction(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions[id.Name] = r
+	}
+
+	return actions, nil
+}
+
+// GetPostBackupAction returns a restartablePostBackupAction for name.
+func (m *manager) GetPostBackupAction(name string) (velero.PostBackupAction, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindPostBackupAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartablePostBackupAction(name, restartableProcess)
+	return r, nil
+}
+
+// GetPreRestoreActions returns all pre-restore actions as restartablePreRestoreActions, keyed by name.
+func (m *manager) GetPreRestoreActions() (map[string]velero.PreRestoreAction, error) {
+	list := m.registry.List(framework.PluginKindPreRestoreAction)
+
+	actions := make(map[string]velero.PreRestoreAction, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetPreRestoreAction(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions[id.Name] = r
+	}
+
+	return actions, nil
+}
+
+// GetPreRestoreAction returns a restartablePreRestoreAction for name.
+func (m *manager) GetPreRestoreAction(name string) (velero.PreRestoreAction, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindPreRestoreAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartablePreRestoreAction(name, restartableProcess)
+	return r, nil
+}
+
+// GetPostRestoreActions returns all post-restore actions as restartablePostRestoreActions, keyed by name.
+func (m *manager) GetPostRestoreActions() (map[string]velero.PostRestoreAction, error) {
+	list := m.registry.List(framework.PluginKindPostRestoreAction)
+
+	actions := make(map[string]velero.PostRestoreAction, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetPostRestoreAction(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions[id.Name] = r
+	}
+
+	return actions, nil
+}
+
+// GetPostRestoreAction returns a restartablePostRestoreAction for name.
+func (m *manager) GetPostRestoreAction(name string) (velero.PostRestoreAction, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindPostRestoreAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartablePostRestoreAction(name, restartableProcess)
+	return r, nil
+}
+
 func sanitizeName(name string) string {
 	// Backwards compatibility with non-namespaced Velero plugins, following principle of least surprise
 	// since DeleteItemActions were not bundled with Velero when plugins were non-namespaced.
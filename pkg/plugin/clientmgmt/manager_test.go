@@ -17,8 +17,10 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -27,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -53,6 +56,15 @@ func (r *mockRegistry) Get(kind framework.PluginKind, name string) (framework.Pl
 	return id, args.Error(1)
 }
 
+func (r *mockRegistry) GetLatest(kind framework.PluginKind, name string) (framework.PluginIdentifier, error) {
+	args := r.Called(kind, name)
+	var id framework.PluginIdentifier
+	if args.Get(0) != nil {
+		id = args.Get(0).(framework.PluginIdentifier)
+	}
+	return id, args.Error(1)
+}
+
 func TestNewManager(t *testing.T) {
 	logger := test.NewLogger()
 	logLevel := logrus.InfoLevel
@@ -60,7 +72,7 @@ func TestNewManager(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(context.Background(), logger, logLevel, registry, nil, nil, nil).(*manager)
 	assert.Equal(t, logger, m.logger)
 	assert.Equal(t, logLevel, m.logLevel)
 	assert.Equal(t, registry, m.registry)
@@ -115,7 +127,7 @@ func TestGetRestartableProcess(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(context.Background(), logger, logLevel, registry, nil, nil, nil).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -161,7 +173,7 @@ func TestCleanupClients(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(context.Background(), logger, logLevel, registry, nil, nil, nil).(*manager)
 
 	for i := 0; i < 5; i++ {
 		rp := &mockRestartableProcess{}
@@ -208,15 +220,89 @@ func TestGetVolumeSnapshotter(t *testing.T) {
 }
 
 func TestGetBackupItemAction(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     framework.PluginKind
+		expected func(name string, sharedPluginProcess RestartableProcess) biav2.BackupItemAction
+	}{
+		{
+			name: "version 1 plugins are adapted to version 2",
+			kind: framework.PluginKindBackupItemAction,
+			expected: func(name string, sharedPluginProcess RestartableProcess) biav2.BackupItemAction {
+				return biav2.NewAdaptedV1BackupItemAction(&restartableBackupItemAction{
+					key:                 kindAndName{kind: framework.PluginKindBackupItemAction, name: name},
+					sharedPluginProcess: sharedPluginProcess,
+				})
+			},
+		},
+		{
+			name: "version 2 plugins are used as-is",
+			kind: framework.PluginKindBackupItemActionV2,
+			expected: func(name string, sharedPluginProcess RestartableProcess) biav2.BackupItemAction {
+				return &restartableBackupItemActionV2{
+					key:                 kindAndName{kind: framework.PluginKindBackupItemActionV2, name: name},
+					sharedPluginProcess: sharedPluginProcess,
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := test.NewLogger()
+			logLevel := logrus.InfoLevel
+
+			registry := &mockRegistry{}
+			defer registry.AssertExpectations(t)
+
+			m := NewManager(context.Background(), logger, logLevel, registry, nil, nil, nil).(*manager)
+			factory := &mockRestartableProcessFactory{}
+			defer factory.AssertExpectations(t)
+			m.restartableProcessFactory = factory
+
+			pluginName := "velero.io/pod"
+			pluginID := framework.PluginIdentifier{
+				Command: "/command",
+				Kind:    tc.kind,
+				Name:    pluginName,
+			}
+
+			// Test 1: registry error
+			registry.On("GetLatest", framework.PluginKindBackupItemAction, pluginName).Return(nil, errors.Errorf("registry")).Once()
+			actual, err := m.GetBackupItemAction(pluginName)
+			assert.Nil(t, actual)
+			assert.EqualError(t, err, "registry")
+
+			registry.On("GetLatest", framework.PluginKindBackupItemAction, pluginName).Return(pluginID, nil)
+
+			// Test 2: error getting restartable process
+			factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+			actual, err = m.GetBackupItemAction(pluginName)
+			assert.Nil(t, actual)
+			assert.EqualError(t, err, "newRestartableProcess")
+
+			// Test 3: happy path
+			restartableProcess := &mockRestartableProcess{}
+			defer restartableProcess.AssertExpectations(t)
+			factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+
+			actual, err = m.GetBackupItemAction(pluginName)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected(pluginName, restartableProcess), actual)
+		})
+	}
+}
+
+func TestGetRestoreItemAction(t *testing.T) {
 	getPluginTest(t,
-		framework.PluginKindBackupItemAction,
+		framework.PluginKindRestoreItemAction,
 		"velero.io/pod",
 		func(m Manager, name string) (interface{}, error) {
-			return m.GetBackupItemAction(name)
+			return m.GetRestoreItemAction(name)
 		},
 		func(name string, sharedPluginProcess RestartableProcess) interface{} {
-			return &restartableBackupItemAction{
-				key:                 kindAndName{kind: framework.PluginKindBackupItemAction, name: name},
+			return &restartableRestoreItemAction{
+				key:                 kindAndName{kind: framework.PluginKindRestoreItemAction, name: name},
 				sharedPluginProcess: sharedPluginProcess,
 			}
 		},
@@ -224,16 +310,67 @@ func TestGetBackupItemAction(t *testing.T) {
 	)
 }
 
-func TestGetRestoreItemAction(t *testing.T) {
+func TestGetPreBackupAction(t *testing.T) {
 	getPluginTest(t,
-		framework.PluginKindRestoreItemAction,
-		"velero.io/pod",
+		framework.PluginKindPreBackupAction,
+		"velero.io/hook",
 		func(m Manager, name string) (interface{}, error) {
-			return m.GetRestoreItemAction(name)
+			return m.GetPreBackupAction(name)
 		},
 		func(name string, sharedPluginProcess RestartableProcess) interface{} {
-			return &restartableRestoreItemAction{
-				key:                 kindAndName{kind: framework.PluginKindRestoreItemAction, name: name},
+			return &restartablePreBackupAction{
+				key:                 kindAndName{kind: framework.PluginKindPreBackupAction, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetPostBackupAction(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindPostBackupAction,
+		"velero.io/hook",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetPostBackupAction(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartablePostBackupAction{
+				key:                 kindAndName{kind: framework.PluginKindPostBackupAction, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetPreRestoreAction(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindPreRestoreAction,
+		"velero.io/hook",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetPreRestoreAction(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartablePreRestoreAction{
+				key:                 kindAndName{kind: framework.PluginKindPreRestoreAction, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetPostRestoreAction(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindPostRestoreAction,
+		"velero.io/hook",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetPostRestoreAction(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartablePostRestoreAction{
+				key:                 kindAndName{kind: framework.PluginKindPostRestoreAction, name: name},
 				sharedPluginProcess: sharedPluginProcess,
 			}
 		},
@@ -255,7 +392,7 @@ func getPluginTest(
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(context.Background(), logger, logLevel, registry, nil, nil, nil).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -322,7 +459,7 @@ func TestGetBackupItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(context.Background(), logger, logLevel, registry, nil, nil, nil).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -337,6 +474,7 @@ func TestGetBackupItemActions(t *testing.T) {
 				}
 				pluginIDs = append(pluginIDs, pluginID)
 			}
+			registry.On("List", framework.PluginKindBackupItemActionV2).Return([]framework.PluginIdentifier{})
 			registry.On("List", pluginKind).Return(pluginIDs)
 
 			var expectedActions []interface{}
@@ -344,15 +482,15 @@ func TestGetBackupItemActions(t *testing.T) {
 				pluginID := pluginIDs[i]
 				pluginName := pluginID.Name
 
-				registry.On("Get", pluginKind, pluginName).Return(pluginID, nil)
+				registry.On("GetLatest", pluginKind, pluginName).Return(pluginID, nil)
 
 				restartableProcess := &mockRestartableProcess{}
 				defer restartableProcess.AssertExpectations(t)
 
-				expected := &restartableBackupItemAction{
+				expected := biav2.NewAdaptedV1BackupItemAction(&restartableBackupItemAction{
 					key:                 kindAndName{kind: pluginKind, name: pluginName},
 					sharedPluginProcess: restartableProcess,
-				}
+				})
 
 				if tc.newRestartableProcessError != nil {
 					// Test 1: error getting restartable process
@@ -414,7 +552,7 @@ func TestGetRestoreItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(context.Background(), logger, logLevel, registry, nil, nil, nil).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -513,7 +651,7 @@ func TestGetDeleteItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(context.Background(), logger, logLevel, registry, nil, nil, nil).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -608,3 +746,52 @@ func TestSanitizeName(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeoutsForKind(t *testing.T) {
+	timeouts := Timeouts{
+		framework.PluginKindBackupItemAction:    10 * time.Minute,
+		framework.PluginKindObjectStore:         time.Minute,
+		framework.PluginKindObjectStore + "/v2": 2 * time.Minute,
+	}
+
+	tests := []struct {
+		name     string
+		timeouts Timeouts
+		kind     framework.PluginKind
+		want     time.Duration
+	}{
+		{
+			name:     "kind with a timeout",
+			timeouts: timeouts,
+			kind:     framework.PluginKindBackupItemAction,
+			want:     10 * time.Minute,
+		},
+		{
+			name:     "versioned kind uses the timeout for its base kind",
+			timeouts: timeouts,
+			kind:     framework.PluginKindBackupItemActionV2,
+			want:     10 * time.Minute,
+		},
+		{
+			name:     "versioned kind with its own timeout",
+			timeouts: timeouts,
+			kind:     framework.PluginKindObjectStore + "/v2",
+			want:     2 * time.Minute,
+		},
+		{
+			name:     "kind without a timeout",
+			timeouts: timeouts,
+			kind:     framework.PluginKindVolumeSnapshotter,
+		},
+		{
+			name: "nil timeouts",
+			kind: framework.PluginKindVolumeSnapshotter,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.timeouts.forKind(tc.kind))
+		})
+	}
+}
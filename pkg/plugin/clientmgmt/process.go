@@ -17,12 +17,14 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"strings"
 
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 )
 
@@ -31,6 +33,11 @@ type ProcessFactory interface {
 }
 
 type processFactory struct {
+	// ctx is the context for calls to the plugins in the processes, and timeouts
+	// are how long the calls may take. metrics, if set, records the duration of the calls.
+	ctx      context.Context
+	timeouts Timeouts
+	metrics  *metrics.ServerMetrics
 }
 
 func newProcessFactory() ProcessFactory {
@@ -38,11 +45,12 @@ func newProcessFactory() ProcessFactory {
 }
 
 func (pf *processFactory) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
-	return newProcess(command, logger, logLevel)
+	return newProcess(pf.ctx, command, logger, logLevel, pf.timeouts, pf.metrics)
 }
 
 type Process interface {
 	dispense(key kindAndName) (interface{}, error)
+	ping() error
 	exited() bool
 	kill()
 }
@@ -52,8 +60,11 @@ type process struct {
 	protocolClient plugin.ClientProtocol
 }
 
-func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
+func newProcess(ctx context.Context, command string, logger logrus.FieldLogger, logLevel logrus.Level, timeouts Timeouts, metrics *metrics.ServerMetrics) (Process, error) {
 	builder := newClientBuilder(command, logger.WithField("cmd", command), logLevel)
+	builder.ctx = ctx
+	builder.timeouts = timeouts
+	builder.metrics = metrics
 
 	// This creates a new go-plugin Client that has its own unique exec.Cmd for launching the plugin process.
 	client := builder.client()
@@ -144,6 +155,11 @@ func (r *process) dispense(key kindAndName) (interface{}, error) {
 	return dispensed, nil
 }
 
+// ping checks that the plugin process is responding to requests over its gRPC connection.
+func (r *process) ping() error {
+	return errors.WithStack(r.protocolClient.Ping())
+}
+
 func (r *process) exited() bool {
 	return r.client.Exited()
 }
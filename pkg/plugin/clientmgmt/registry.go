@@ -20,9 +20,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -30,12 +32,17 @@ import (
 
 // Registry manages information about available plugins.
 type Registry interface {
-	// DiscoverPlugins discovers all available plugins.
+	// DiscoverPlugins discovers all available plugins. It can be called again to discover
+	// the plugins in executables that have been added since, and is safe to call while the
+	// registry is in use.
 	DiscoverPlugins() error
 	// List returns all PluginIdentifiers for kind.
 	List(kind framework.PluginKind) []framework.PluginIdentifier
 	// Get returns the PluginIdentifier for kind and name.
 	Get(kind framework.PluginKind, name string) (framework.PluginIdentifier, error)
+	// GetLatest returns the PluginIdentifier for the newest version of kind that's
+	// registered for name.
+	GetLatest(kind framework.PluginKind, name string) (framework.PluginIdentifier, error)
 }
 
 // kindAndName is a convenience struct that combines a PluginKind and a name.
@@ -47,14 +54,22 @@ type kindAndName struct {
 // registry implements Registry.
 type registry struct {
 	// dir is the directory to search for plugins.
-	dir      string
+	dir string
+	// commands, if set, are the plugin executables to discover plugins in
+	// instead of those in dir and the Velero executable.
+	commands []string
 	logger   logrus.FieldLogger
 	logLevel logrus.Level
 
 	processFactory ProcessFactory
 	fs             filesystem.Interface
-	pluginsByID    map[kindAndName]framework.PluginIdentifier
-	pluginsByKind  map[framework.PluginKind][]framework.PluginIdentifier
+
+	// lock guards discovered, pluginsByID and pluginsByKind.
+	lock sync.RWMutex
+	// discovered are the commands whose plugins have been registered.
+	discovered    sets.String
+	pluginsByID   map[kindAndName]framework.PluginIdentifier
+	pluginsByKind map[framework.PluginKind][]framework.PluginIdentifier
 }
 
 // NewRegistry returns a new registry.
@@ -66,12 +81,25 @@ func NewRegistry(dir string, logger logrus.FieldLogger, logLevel logrus.Level) R
 
 		processFactory: newProcessFactory(),
 		fs:             filesystem.NewFileSystem(),
+		discovered:     sets.NewString(),
 		pluginsByID:    make(map[kindAndName]framework.PluginIdentifier),
 		pluginsByKind:  make(map[framework.PluginKind][]framework.PluginIdentifier),
 	}
 }
 
+// NewCommandRegistry returns a new registry for the plugins in the executables commands, rather
+// than those in a plugin directory and the Velero executable.
+func NewCommandRegistry(commands []string, logger logrus.FieldLogger, logLevel logrus.Level) Registry {
+	r := NewRegistry("", logger, logLevel).(*registry)
+	r.commands = commands
+	return r
+}
+
 func (r *registry) DiscoverPlugins() error {
+	if r.commands != nil {
+		return r.discoverPlugins(r.commands)
+	}
+
 	plugins, err := r.readPluginsDir(r.dir)
 	if err != nil {
 		return err
@@ -87,23 +115,44 @@ func (r *registry) DiscoverPlugins() error {
 
 func (r *registry) discoverPlugins(commands []string) error {
 	for _, command := range commands {
+		r.lock.RLock()
+		discovered := r.discovered.Has(command)
+		r.lock.RUnlock()
+		if discovered {
+			continue
+		}
+
+		// the registry is usable while the command is queried for its plugins.
 		plugins, err := r.listPlugins(command)
 		if err != nil {
 			return err
 		}
 
-		for _, plugin := range plugins {
-			r.logger.WithFields(logrus.Fields{
-				"kind":    plugin.Kind,
-				"name":    plugin.Name,
-				"command": command,
-			}).Info("registering plugin")
+		if err := r.registerCommand(command, plugins); err != nil {
+			return err
+		}
+	}
 
-			if err := r.register(plugin); err != nil {
-				return err
-			}
+	return nil
+}
+
+// registerCommand registers the plugins in command.
+func (r *registry) registerCommand(command string, plugins []framework.PluginIdentifier) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, plugin := range plugins {
+		r.logger.WithFields(logrus.Fields{
+			"kind":    plugin.Kind,
+			"name":    plugin.Name,
+			"command": command,
+		}).Info("registering plugin")
+
+		if err := r.register(plugin); err != nil {
+			return err
 		}
 	}
+	r.discovered.Insert(command)
 
 	return nil
 }
@@ -111,12 +160,18 @@ func (r *registry) discoverPlugins(commands []string) error {
 // List returns info about all plugin binaries that implement the given
 // PluginKind.
 func (r *registry) List(kind framework.PluginKind) []framework.PluginIdentifier {
-	return r.pluginsByKind[kind]
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return append([]framework.PluginIdentifier(nil), r.pluginsByKind[kind]...)
 }
 
 // Get returns info about a plugin with the given name and kind, or an
 // error if one cannot be found.
 func (r *registry) Get(kind framework.PluginKind, name string) (framework.PluginIdentifier, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	p, found := r.pluginsByID[kindAndName{kind: kind, name: name}]
 	if !found {
 		return framework.PluginIdentifier{}, newPluginNotFoundError(kind, name)
@@ -124,6 +179,21 @@ func (r *registry) Get(kind framework.PluginKind, name string) (framework.Plugin
 	return p, nil
 }
 
+// GetLatest returns info about the newest version of the given PluginKind that a plugin
+// with the given name implements, or an error if no version of it can be found. Plugins
+// advertise the versions that they implement through their PluginLister.
+func (r *registry) GetLatest(kind framework.PluginKind, name string) (framework.PluginIdentifier, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for _, version := range kind.Versions() {
+		if p, found := r.pluginsByID[kindAndName{kind: version, name: name}]; found {
+			return p, nil
+		}
+	}
+	return framework.PluginIdentifier{}, newPluginNotFoundError(kind, name)
+}
+
 // readPluginsDir recursively reads dir looking for plugins.
 func (r *registry) readPluginsDir(dir string) ([]string, error) {
 	if _, err := r.fs.Stat(dir); err != nil {
@@ -195,13 +265,21 @@ func (r *registry) listPlugins(command string) ([]framework.PluginIdentifier, er
 	return lister.ListPlugins()
 }
 
-// register registers a PluginIdentifier with the registry.
+// register registers a PluginIdentifier with the registry. The caller must hold the lock.
 func (r *registry) register(id framework.PluginIdentifier) error {
 	key := kindAndName{kind: id.Kind, name: id.Name}
 	if existing, found := r.pluginsByID[key]; found {
 		return newDuplicatePluginRegistrationError(existing, id)
 	}
 
+	// all versions of a plugin must be implemented by the same binary, since
+	// Velero only uses the newest one.
+	for _, version := range id.Kind.Versions() {
+		if existing, found := r.pluginsByID[kindAndName{kind: version, name: id.Name}]; found && existing.Command != id.Command {
+			return newDuplicatePluginRegistrationError(existing, id)
+		}
+	}
+
 	// no need to pass list of existing plugins since the check if this exists was done above
 	if err := framework.ValidatePluginName(id.Name, nil); err != nil {
 		return errors.Errorf("invalid plugin name %q: %s", id.Name, err)
@@ -5,7 +5,7 @@ Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
-    http://www.apache.org/licenses/LICENSE-2.0
+	http://www.apache.org/licenses/LICENSE-2.0
 
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -42,6 +43,17 @@ func TestNewRegistry(t *testing.T) {
 	assert.Empty(t, r.pluginsByKind)
 }
 
+func TestNewCommandRegistry(t *testing.T) {
+	logger := test.NewLogger()
+	commands := []string{"/plugins/a", "/plugins/b"}
+
+	r := NewCommandRegistry(commands, logger, logrus.InfoLevel).(*registry)
+	assert.Equal(t, commands, r.commands)
+	assert.Empty(t, r.dir)
+	assert.Equal(t, logger, r.logger)
+	assert.NotNil(t, r.pluginsByID)
+}
+
 type fakeFileInfo struct {
 	os.FileInfo
 	mode os.FileMode
@@ -128,3 +140,107 @@ func TestReadPluginsDir(t *testing.T) {
 	sort.Strings(expected)
 	assert.Equal(t, expected, plugins)
 }
+
+func TestGetLatest(t *testing.T) {
+	r := NewRegistry("/plugins", test.NewLogger(), logrus.InfoLevel).(*registry)
+
+	v1Only := framework.PluginIdentifier{Command: "/plugins/a", Kind: framework.PluginKindBackupItemAction, Name: "velero.io/v1-only"}
+	bothV1 := framework.PluginIdentifier{Command: "/plugins/a", Kind: framework.PluginKindBackupItemAction, Name: "velero.io/both"}
+	bothV2 := framework.PluginIdentifier{Command: "/plugins/a", Kind: framework.PluginKindBackupItemActionV2, Name: "velero.io/both"}
+	v2Only := framework.PluginIdentifier{Command: "/plugins/b", Kind: framework.PluginKindBackupItemActionV2, Name: "velero.io/v2-only"}
+
+	for _, id := range []framework.PluginIdentifier{v1Only, bothV1, bothV2, v2Only} {
+		require.NoError(t, r.register(id))
+	}
+
+	tests := []struct {
+		name          string
+		pluginName    string
+		expected      framework.PluginIdentifier
+		expectedError string
+	}{
+		{
+			name:       "plugin that only implements version 1",
+			pluginName: "velero.io/v1-only",
+			expected:   v1Only,
+		},
+		{
+			name:       "plugin that implements both versions",
+			pluginName: "velero.io/both",
+			expected:   bothV2,
+		},
+		{
+			name:       "plugin that only implements version 2",
+			pluginName: "velero.io/v2-only",
+			expected:   v2Only,
+		},
+		{
+			name:          "plugin that doesn't exist",
+			pluginName:    "velero.io/missing",
+			expectedError: "unable to locate BackupItemAction plugin named velero.io/missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := r.GetLatest(framework.PluginKindBackupItemAction, tc.pluginName)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestRegisterVersionsFromDifferentCommands(t *testing.T) {
+	r := NewRegistry("/plugins", test.NewLogger(), logrus.InfoLevel).(*registry)
+
+	require.NoError(t, r.register(framework.PluginIdentifier{Command: "/plugins/a", Kind: framework.PluginKindBackupItemAction, Name: "velero.io/pod"}))
+
+	err := r.register(framework.PluginIdentifier{Command: "/plugins/b", Kind: framework.PluginKindBackupItemActionV2, Name: "velero.io/pod"})
+	assert.Error(t, err)
+}
+
+// fakePluginLister is a PluginLister process that lists plugins, counting how often it's started.
+type fakePluginLister struct {
+	plugins map[string][]framework.PluginIdentifier
+	started map[string]int
+	command string
+}
+
+func (f *fakePluginLister) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
+	f.started[command]++
+	return &fakePluginLister{plugins: f.plugins, command: command}, nil
+}
+
+func (f *fakePluginLister) dispense(key kindAndName) (interface{}, error) { return f, nil }
+func (f *fakePluginLister) ping() error                                   { return nil }
+func (f *fakePluginLister) exited() bool                                  { return false }
+func (f *fakePluginLister) kill()                                         {}
+
+func (f *fakePluginLister) ListPlugins() ([]framework.PluginIdentifier, error) {
+	return f.plugins[f.command], nil
+}
+
+func TestDiscoverPluginsAgain(t *testing.T) {
+	a := framework.PluginIdentifier{Command: "/plugins/a", Kind: framework.PluginKindObjectStore, Name: "velero.io/a"}
+	b := framework.PluginIdentifier{Command: "/plugins/b", Kind: framework.PluginKindObjectStore, Name: "velero.io/b"}
+
+	lister := &fakePluginLister{
+		plugins: map[string][]framework.PluginIdentifier{"/plugins/a": {a}, "/plugins/b": {b}},
+		started: make(map[string]int),
+	}
+	r := NewCommandRegistry([]string{"/plugins/a"}, test.NewLogger(), logrus.InfoLevel).(*registry)
+	r.processFactory = lister
+
+	require.NoError(t, r.DiscoverPlugins())
+	assert.Equal(t, []framework.PluginIdentifier{a}, r.List(framework.PluginKindObjectStore))
+
+	// discovering plugins again only queries the commands that have been added.
+	r.commands = append(r.commands, "/plugins/b")
+	require.NoError(t, r.DiscoverPlugins())
+	assert.Equal(t, []framework.PluginIdentifier{a, b}, r.List(framework.PluginKindObjectStore))
+	assert.Equal(t, map[string]int{"/plugins/a": 1, "/plugins/b": 1}, lister.started)
+}
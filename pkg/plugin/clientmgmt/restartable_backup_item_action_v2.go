@@ -0,0 +1,87 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
+)
+
+// restartableBackupItemActionV2 is a version 2 backup item action for a given implementation (such as "pod"). It is
+// associated with a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each
+// method call, the restartableBackupItemActionV2 asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartableBackupItemActionV2 struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+}
+
+// newRestartableBackupItemActionV2 returns a new restartableBackupItemActionV2.
+func newRestartableBackupItemActionV2(name string, sharedPluginProcess RestartableProcess) *restartableBackupItemActionV2 {
+	r := &restartableBackupItemActionV2{
+		key:                 kindAndName{kind: framework.PluginKindBackupItemActionV2, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getBackupItemAction returns the backup item action for this restartableBackupItemActionV2. It does *not* restart the
+// plugin process.
+func (r *restartableBackupItemActionV2) getBackupItemAction() (biav2.BackupItemAction, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	backupItemAction, ok := plugin.(biav2.BackupItemAction)
+	if !ok {
+		return nil, errors.Errorf("%T is not a BackupItemAction (v2)!", plugin)
+	}
+
+	return backupItemAction, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the backup item action for this restartableBackupItemActionV2.
+func (r *restartableBackupItemActionV2) getDelegate() (biav2.BackupItemAction, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getBackupItemAction()
+}
+
+// AppliesTo restarts the plugin's process if needed, then delegates the call.
+func (r *restartableBackupItemActionV2) AppliesTo() (velero.ResourceSelector, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.ResourceSelector{}, err
+	}
+
+	return delegate.AppliesTo()
+}
+
+// Execute restarts the plugin's process if needed, then delegates the call.
+func (r *restartableBackupItemActionV2) Execute(input *biav2.ExecuteInput) (*biav2.ExecuteOutput, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	return delegate.Execute(input)
+}
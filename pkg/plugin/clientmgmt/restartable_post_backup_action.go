@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartablePostBackupAction is a post-backup action for a given implementation. It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the restartablePostBackupAction asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartablePostBackupAction struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+}
+
+// newRestartablePostBackupAction returns a new restartablePostBackupAction.
+func newRestartablePostBackupAction(name string, sharedPluginProcess RestartableProcess) *restartablePostBackupAction {
+	r := &restartablePostBackupAction{
+		key:                 kindAndName{kind: framework.PluginKindPostBackupAction, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getPostBackupAction returns the post-backup action for this restartablePostBackupAction. It does *not* restart the
+// plugin process.
+func (r *restartablePostBackupAction) getPostBackupAction() (velero.PostBackupAction, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	action, ok := plugin.(velero.PostBackupAction)
+	if !ok {
+		return nil, errors.Errorf("%T is not a PostBackupAction!", plugin)
+	}
+
+	return action, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the post-backup action for this restartablePostBackupAction.
+func (r *restartablePostBackupAction) getDelegate() (velero.PostBackupAction, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getPostBackupAction()
+}
+
+// Execute restarts the plugin's process if needed, then delegates the call.
+func (r *restartablePostBackupAction) Execute(backup *api.Backup) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+
+	return delegate.Execute(backup)
+}
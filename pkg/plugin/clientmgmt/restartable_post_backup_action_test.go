@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+)
+
+func TestRestartableGetPostBackupAction(t *testing.T) {
+	tests := []struct {
+		name          string
+		plugin        interface{}
+		getError      error
+		expectedError string
+	}{
+		{
+			name:          "error getting by kind and name",
+			getError:      errors.Errorf("get error"),
+			expectedError: "get error",
+		},
+		{
+			name:          "wrong type",
+			plugin:        3,
+			expectedError: "int is not a PostBackupAction!",
+		},
+		{
+			name:   "happy path",
+			plugin: new(mocks.PostBackupAction),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := new(mockRestartableProcess)
+			defer p.AssertExpectations(t)
+
+			name := "velero.io/hook"
+			key := kindAndName{kind: framework.PluginKindPostBackupAction, name: name}
+			p.On("getByKindAndName", key).Return(tc.plugin, tc.getError)
+
+			r := newRestartablePostBackupAction(name, p)
+			a, err := r.getPostBackupAction()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.plugin, a)
+		})
+	}
+}
+
+func TestRestartablePostBackupActionDelegatedFunctions(t *testing.T) {
+	runRestartableDelegateTests(
+		t,
+		framework.PluginKindPostBackupAction,
+		func(key kindAndName, p RestartableProcess) interface{} {
+			return &restartablePostBackupAction{
+				key:                 key,
+				sharedPluginProcess: p,
+			}
+		},
+		func() mockable {
+			return new(mocks.PostBackupAction)
+		},
+		restartableDelegateTest{
+			function:                "Execute",
+			inputs:                  []interface{}{new(api.Backup)},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+	)
+}
@@ -18,9 +18,23 @@ package clientmgmt
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// maxResetFailures is the number of consecutive times a plugin process can fail to restart
+	// before Velero gives up on it.
+	maxResetFailures = 10
+
+	// resetInitialBackoff and resetMaxBackoff bound how long Velero waits after a failed restart of
+	// a plugin process before trying again. The wait doubles after each consecutive failure.
+	resetInitialBackoff = time.Second
+	resetMaxBackoff     = 2 * time.Minute
 )
 
 type RestartableProcessFactory interface {
@@ -28,14 +42,19 @@ type RestartableProcessFactory interface {
 }
 
 type restartableProcessFactory struct {
+	healthMonitor  *HealthMonitor
+	processFactory ProcessFactory
 }
 
-func newRestartableProcessFactory() RestartableProcessFactory {
-	return &restartableProcessFactory{}
+func newRestartableProcessFactory(healthMonitor *HealthMonitor, processFactory ProcessFactory) RestartableProcessFactory {
+	return &restartableProcessFactory{
+		healthMonitor:  healthMonitor,
+		processFactory: processFactory,
+	}
 }
 
 func (rpf *restartableProcessFactory) newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	return newRestartableProcess(command, logger, logLevel)
+	return newRestartableProcess(command, logger, logLevel, rpf.healthMonitor, rpf.processFactory)
 }
 
 type RestartableProcess interface {
@@ -47,12 +66,16 @@ type RestartableProcess interface {
 }
 
 // restartableProcess encapsulates the lifecycle for all plugins contained in a single executable file. It is able
-// to restart a plugin process if it is terminated for any reason. If this happens, all plugins are reinitialized using
-// the original configuration data.
+// to restart a plugin process if it is terminated or stops responding to health checks. If this happens, all plugins
+// are reinitialized using the original configuration data. Failed restarts are retried with exponential backoff.
 type restartableProcess struct {
-	command  string
-	logger   logrus.FieldLogger
-	logLevel logrus.Level
+	command        string
+	logger         logrus.FieldLogger
+	logLevel       logrus.Level
+	processFactory ProcessFactory
+	healthMonitor  *HealthMonitor
+	clock          clock.Clock
+	stopCh         chan struct{}
 
 	// lock guards all of the fields below
 	lock           sync.RWMutex
@@ -60,6 +83,7 @@ type restartableProcess struct {
 	plugins        map[kindAndName]interface{}
 	reinitializers map[kindAndName]reinitializer
 	resetFailures  int
+	nextResetTime  time.Time
 }
 
 // reinitializer is capable of reinitializing a restartable plugin instance using the newly dispensed plugin.
@@ -68,20 +92,34 @@ type reinitializer interface {
 	reinitialize(dispensed interface{}) error
 }
 
-// newRestartableProcess creates a new restartableProcess for the given command and options.
-func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+// newRestartableProcess creates a new restartableProcess for the given command and options, using processFactory to
+// launch the plugin process. If healthMonitor is not nil, the process's health is checked periodically until it is
+// stopped.
+func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, healthMonitor *HealthMonitor, processFactory ProcessFactory) (RestartableProcess, error) {
 	p := &restartableProcess{
 		command:        command,
 		logger:         logger,
 		logLevel:       logLevel,
+		processFactory: processFactory,
+		healthMonitor:  healthMonitor,
+		clock:          clock.RealClock{},
+		stopCh:         make(chan struct{}),
 		plugins:        make(map[kindAndName]interface{}),
 		reinitializers: make(map[kindAndName]reinitializer),
 	}
 
 	// This launches the process
-	err := p.reset()
+	if err := p.reset(); err != nil {
+		healthMonitor.startFailed(command, err)
+		return p, err
+	}
+	healthMonitor.started(command)
 
-	return p, err
+	if healthMonitor != nil && healthMonitor.checkInterval > 0 {
+		go wait.Until(p.checkHealth, healthMonitor.checkInterval, p.stopCh)
+	}
+
+	return p, nil
 }
 
 // addReinitializer registers the reinitializer r for key.
@@ -105,14 +143,17 @@ func (p *restartableProcess) reset() error {
 //
 // Callers of resetLH *must* acquire the lock before calling it.
 func (p *restartableProcess) resetLH() error {
-	if p.resetFailures > 10 {
+	if p.resetFailures > maxResetFailures {
 		return errors.Errorf("unable to restart plugin process: exceeded maximum number of reset failures")
 	}
+	if p.backingOffLH() {
+		return errors.Errorf("unable to restart plugin process: backing off until %s after %d failed attempts",
+			p.nextResetTime.Format(time.RFC3339), p.resetFailures)
+	}
 
-	process, err := newProcess(p.command, p.logger, p.logLevel)
+	process, err := p.processFactory.newProcess(p.command, p.logger, p.logLevel)
 	if err != nil {
-		p.resetFailures++
-		return err
+		return p.resetFailedLH(err)
 	}
 	p.process = process
 
@@ -123,8 +164,7 @@ func (p *restartableProcess) resetLH() error {
 		// Re-dispense
 		dispensed, err := p.process.dispense(key)
 		if err != nil {
-			p.resetFailures++
-			return err
+			return p.resetFailedLH(err)
 		}
 		// Store in the new map
 		newPlugins[key] = dispensed
@@ -132,8 +172,7 @@ func (p *restartableProcess) resetLH() error {
 		// Reinitialize
 		if r, found := p.reinitializers[key]; found {
 			if err := r.reinitialize(dispensed); err != nil {
-				p.resetFailures++
-				return err
+				return p.resetFailedLH(err)
 			}
 		}
 	}
@@ -142,6 +181,51 @@ func (p *restartableProcess) resetLH() error {
 	p.plugins = newPlugins
 
 	p.resetFailures = 0
+	p.nextResetTime = time.Time{}
+
+	return nil
+}
+
+// resetFailedLH records a failed reset, so that the next one is delayed by an exponentially increasing backoff,
+// and returns err.
+//
+// Callers of resetFailedLH *must* acquire the lock before calling it.
+func (p *restartableProcess) resetFailedLH(err error) error {
+	p.resetFailures++
+
+	backoff := resetMaxBackoff
+	if p.resetFailures <= maxResetFailures {
+		if b := resetInitialBackoff << uint(p.resetFailures-1); b < resetMaxBackoff {
+			backoff = b
+		}
+	}
+	p.nextResetTime = p.clock.Now().Add(backoff)
+
+	return err
+}
+
+// backingOffLH returns true if the last reset failed too recently to try again.
+//
+// Callers of backingOffLH *must* acquire the lock before calling it.
+func (p *restartableProcess) backingOffLH() bool {
+	return p.resetFailures > 0 && p.clock.Now().Before(p.nextResetTime)
+}
+
+// restartLH restarts the plugin process because of reason, recording the outcome with the health monitor.
+// Restarts that are not attempted because of backoff, or because p has failed too many times, are not recorded.
+//
+// Callers of restartLH *must* acquire the lock before calling it.
+func (p *restartableProcess) restartLH(reason error) error {
+	if p.resetFailures > maxResetFailures || p.backingOffLH() {
+		return p.resetLH()
+	}
+
+	p.healthMonitor.restarting(p.command, reason)
+	if err := p.resetLH(); err != nil {
+		p.healthMonitor.restartFailed(p.command, err, p.resetFailures > maxResetFailures)
+		return err
+	}
+	p.healthMonitor.restarted(p.command)
 
 	return nil
 }
@@ -153,12 +237,46 @@ func (p *restartableProcess) resetIfNeeded() error {
 
 	if p.process.exited() {
 		p.logger.Info("Plugin process exited - restarting.")
-		return p.resetLH()
+		return p.restartLH(errors.New("plugin process exited"))
 	}
 
 	return nil
 }
 
+// checkHealth pings the plugin process and restarts it if it has exited or doesn't respond.
+func (p *restartableProcess) checkHealth() {
+	p.lock.RLock()
+	process := p.process
+	failed := p.resetFailures > maxResetFailures
+	p.lock.RUnlock()
+
+	if failed {
+		return
+	}
+
+	var err error
+	if process.exited() {
+		err = errors.New("plugin process exited")
+	} else if err = process.ping(); err == nil {
+		p.healthMonitor.healthy(p.command)
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// The process may have been restarted by resetIfNeeded while it was being pinged.
+	if p.process != process {
+		return
+	}
+
+	p.logger.WithError(err).Warn("Plugin process failed health check - restarting.")
+	process.kill()
+	if err := p.restartLH(err); err != nil {
+		p.logger.WithError(err).Error("Error restarting plugin process")
+	}
+}
+
 // getByKindAndName acquires the lock and calls getByKindAndNameLH.
 func (p *restartableProcess) getByKindAndName(key kindAndName) (interface{}, error) {
 	p.lock.Lock()
@@ -183,9 +301,16 @@ func (p *restartableProcess) getByKindAndNameLH(key kindAndName) (interface{}, e
 	return p.plugins[key], nil
 }
 
-// stop terminates the plugin process.
+// stop terminates the plugin process and stops checking its health.
 func (p *restartableProcess) stop() {
 	p.lock.Lock()
+	select {
+	case <-p.stopCh:
+	default:
+		close(p.stopCh)
+	}
 	p.process.kill()
 	p.lock.Unlock()
+
+	p.healthMonitor.stopped(p.command)
 }
@@ -0,0 +1,216 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type mockProcess struct {
+	mock.Mock
+}
+
+func (p *mockProcess) dispense(key kindAndName) (interface{}, error) {
+	args := p.Called(key)
+	return args.Get(0), args.Error(1)
+}
+
+func (p *mockProcess) ping() error {
+	args := p.Called()
+	return args.Error(0)
+}
+
+func (p *mockProcess) exited() bool {
+	args := p.Called()
+	return args.Bool(0)
+}
+
+func (p *mockProcess) kill() {
+	p.Called()
+}
+
+type mockProcessFactory struct {
+	mock.Mock
+}
+
+func (f *mockProcessFactory) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
+	args := f.Called(command, logger, logLevel)
+	var p Process
+	if args.Get(0) != nil {
+		p = args.Get(0).(Process)
+	}
+	return p, args.Error(1)
+}
+
+func newTestRestartableProcess(t *testing.T, processFactory ProcessFactory, fakeClock *clock.FakeClock) (*restartableProcess, *HealthMonitor) {
+	healthMonitor := NewHealthMonitor(0, fakeClock, nil)
+
+	p := &restartableProcess{
+		command:        "/plugins/my-plugin",
+		logger:         test.NewLogger(),
+		logLevel:       logrus.InfoLevel,
+		processFactory: processFactory,
+		healthMonitor:  healthMonitor,
+		clock:          fakeClock,
+		stopCh:         make(chan struct{}),
+		plugins:        make(map[kindAndName]interface{}),
+		reinitializers: make(map[kindAndName]reinitializer),
+	}
+	require.NoError(t, p.reset())
+	healthMonitor.started(p.command)
+
+	return p, healthMonitor
+}
+
+func TestRestartableProcessCheckHealth(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	now := metav1.NewTime(fakeClock.Now())
+
+	firstProcess := new(mockProcess)
+	defer firstProcess.AssertExpectations(t)
+	processFactory := new(mockProcessFactory)
+	defer processFactory.AssertExpectations(t)
+
+	processFactory.On("newProcess", "/plugins/my-plugin", mock.Anything, logrus.InfoLevel).Return(firstProcess, nil).Once()
+	p, healthMonitor := newTestRestartableProcess(t, processFactory, fakeClock)
+
+	// A healthy process records the time of the health check.
+	firstProcess.On("exited").Return(false)
+	firstProcess.On("ping").Return(nil).Once()
+	p.checkHealth()
+
+	status, found := healthMonitor.Status(p.command)
+	require.True(t, found)
+	assert.Equal(t, velerov1api.PluginStatus{Phase: velerov1api.PluginPhaseUp, LastHealthCheckTimestamp: &now}, status)
+
+	// A process that doesn't respond to a ping is killed and restarted.
+	secondProcess := new(mockProcess)
+	defer secondProcess.AssertExpectations(t)
+
+	firstProcess.On("ping").Return(errors.New("connection refused")).Once()
+	firstProcess.On("kill").Once()
+	processFactory.On("newProcess", "/plugins/my-plugin", mock.Anything, logrus.InfoLevel).Return(secondProcess, nil).Once()
+	p.checkHealth()
+
+	assert.Equal(t, secondProcess, p.process)
+	status, _ = healthMonitor.Status(p.command)
+	assert.Equal(t, velerov1api.PluginPhaseUp, status.Phase)
+	assert.Equal(t, 1, status.RestartCount)
+	assert.Equal(t, "connection refused", status.LastError)
+
+	// Stopping the process records that it stopped.
+	secondProcess.On("kill").Once()
+	p.stop()
+
+	status, _ = healthMonitor.Status(p.command)
+	assert.Equal(t, velerov1api.PluginPhaseStopped, status.Phase)
+}
+
+func TestRestartableProcessResetBackoff(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+
+	firstProcess := new(mockProcess)
+	defer firstProcess.AssertExpectations(t)
+	processFactory := new(mockProcessFactory)
+	defer processFactory.AssertExpectations(t)
+
+	processFactory.On("newProcess", "/plugins/my-plugin", mock.Anything, logrus.InfoLevel).Return(firstProcess, nil).Once()
+	p, healthMonitor := newTestRestartableProcess(t, processFactory, fakeClock)
+
+	// The first restart fails, which delays the next attempt by resetInitialBackoff.
+	firstProcess.On("exited").Return(true)
+	processFactory.On("newProcess", "/plugins/my-plugin", mock.Anything, logrus.InfoLevel).Return(nil, errors.New("exec format error")).Once()
+	assert.EqualError(t, p.resetIfNeeded(), "exec format error")
+
+	status, _ := healthMonitor.Status(p.command)
+	assert.Equal(t, velerov1api.PluginPhaseRestarting, status.Phase)
+	assert.Equal(t, "exec format error", status.LastError)
+
+	// Restarts aren't attempted until the backoff has passed.
+	err := p.resetIfNeeded()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backing off")
+
+	// The second restart fails, which doubles the backoff.
+	fakeClock.Step(resetInitialBackoff)
+	processFactory.On("newProcess", "/plugins/my-plugin", mock.Anything, logrus.InfoLevel).Return(nil, errors.New("exec format error")).Once()
+	assert.EqualError(t, p.resetIfNeeded(), "exec format error")
+
+	fakeClock.Step(resetInitialBackoff)
+	err = p.resetIfNeeded()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backing off")
+
+	// Once the backoff has passed, the restart succeeds and the failures are reset.
+	secondProcess := new(mockProcess)
+	defer secondProcess.AssertExpectations(t)
+
+	fakeClock.Step(resetInitialBackoff)
+	processFactory.On("newProcess", "/plugins/my-plugin", mock.Anything, logrus.InfoLevel).Return(secondProcess, nil).Once()
+	require.NoError(t, p.resetIfNeeded())
+
+	assert.Equal(t, 0, p.resetFailures)
+	status, _ = healthMonitor.Status(p.command)
+	assert.Equal(t, velerov1api.PluginPhaseUp, status.Phase)
+	assert.Equal(t, 1, status.RestartCount)
+}
+
+func TestRestartableProcessResetFailuresExceeded(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+
+	firstProcess := new(mockProcess)
+	defer firstProcess.AssertExpectations(t)
+	processFactory := new(mockProcessFactory)
+	defer processFactory.AssertExpectations(t)
+
+	processFactory.On("newProcess", "/plugins/my-plugin", mock.Anything, logrus.InfoLevel).Return(firstProcess, nil).Once()
+	p, healthMonitor := newTestRestartableProcess(t, processFactory, fakeClock)
+
+	firstProcess.On("exited").Return(true)
+	processFactory.On("newProcess", "/plugins/my-plugin", mock.Anything, logrus.InfoLevel).Return(nil, errors.New("exec format error")).Times(maxResetFailures + 1)
+	for i := 0; i <= maxResetFailures; i++ {
+		fakeClock.Step(resetMaxBackoff)
+		assert.EqualError(t, p.resetIfNeeded(), "exec format error")
+	}
+
+	status, _ := healthMonitor.Status(p.command)
+	assert.Equal(t, velerov1api.PluginPhaseFailed, status.Phase)
+
+	// No more restarts are attempted, and health checks are skipped.
+	fakeClock.Step(resetMaxBackoff)
+	assert.EqualError(t, p.resetIfNeeded(), "unable to restart plugin process: exceeded maximum number of reset failures")
+	p.checkHealth()
+
+	// Stopping a failed process leaves it failed.
+	firstProcess.On("kill").Once()
+	p.stop()
+
+	status, _ = healthMonitor.Status(p.command)
+	assert.Equal(t, velerov1api.PluginPhaseFailed, status.Phase)
+}
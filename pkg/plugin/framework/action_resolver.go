@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
 	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
 
 	"github.com/vmware-tanzu/velero/pkg/discovery"
@@ -98,11 +99,11 @@ func resolveAction(helper discovery.Helper, action velero.Applicable) (resources
 }
 
 type BackupItemResolvedAction struct {
-	velero.BackupItemAction
+	biav2.BackupItemAction
 	resolvedAction
 }
 
-func NewBackupItemActionResolver(actions []velero.BackupItemAction) BackupItemActionResolver {
+func NewBackupItemActionResolver(actions []biav2.BackupItemAction) BackupItemActionResolver {
 	return BackupItemActionResolver{
 		actions: actions,
 	}
@@ -131,7 +132,7 @@ type ActionResolver interface {
 }
 
 type BackupItemActionResolver struct {
-	actions []velero.BackupItemAction
+	actions []biav2.BackupItemAction
 }
 
 func (recv BackupItemActionResolver) ResolveActions(helper discovery.Helper) ([]BackupItemResolvedAction, error) {
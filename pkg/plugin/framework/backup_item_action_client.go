@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -57,9 +56,12 @@ func (c *BackupItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error
 		Plugin: c.plugin,
 	}
 
-	res, err := c.grpcClient.AppliesTo(context.Background(), req)
+	ctx, cancel := c.callContext("BackupItemAction/AppliesTo")
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, req)
 	if err != nil {
-		return velero.ResourceSelector{}, fromGRPCError(err)
+		return velero.ResourceSelector{}, c.callError(ctx, "AppliesTo", err)
 	}
 
 	if res.ResourceSelector == nil {
@@ -92,9 +94,12 @@ func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *
 		Backup: backupJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, cancel := c.callContext("BackupItemAction/Execute")
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
-		return nil, nil, fromGRPCError(err)
+		return nil, nil, c.callError(ctx, "Execute", err)
 	}
 
 	var updatedItem unstructured.Unstructured
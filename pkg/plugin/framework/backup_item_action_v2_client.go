@@ -0,0 +1,128 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	protobiav2 "github.com/vmware-tanzu/velero/pkg/plugin/generated/backupitemaction/v2"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
+)
+
+// NewBackupItemActionV2Plugin constructs a BackupItemActionV2Plugin.
+func NewBackupItemActionV2Plugin(options ...PluginOption) *BackupItemActionV2Plugin {
+	return &BackupItemActionV2Plugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// BackupItemActionV2GRPCClient implements version 2 of the backup/ItemAction interface
+// and uses a gRPC client to make calls to the plugin server.
+type BackupItemActionV2GRPCClient struct {
+	*clientBase
+	grpcClient protobiav2.BackupItemActionClient
+}
+
+func newBackupItemActionV2GRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &BackupItemActionV2GRPCClient{
+		clientBase: base,
+		grpcClient: protobiav2.NewBackupItemActionClient(clientConn),
+	}
+}
+
+func (c *BackupItemActionV2GRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	req := &protobiav2.BackupItemActionAppliesToRequest{
+		Plugin: c.plugin,
+	}
+
+	ctx, cancel := c.callContext("BackupItemActionV2/AppliesTo")
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, req)
+	if err != nil {
+		return velero.ResourceSelector{}, c.callError(ctx, "AppliesTo", err)
+	}
+
+	if res.ResourceSelector == nil {
+		return velero.ResourceSelector{}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedNamespaces: res.ResourceSelector.IncludedNamespaces,
+		ExcludedNamespaces: res.ResourceSelector.ExcludedNamespaces,
+		IncludedResources:  res.ResourceSelector.IncludedResources,
+		ExcludedResources:  res.ResourceSelector.ExcludedResources,
+		LabelSelector:      res.ResourceSelector.Selector,
+	}, nil
+}
+
+func (c *BackupItemActionV2GRPCClient) Execute(input *biav2.ExecuteInput) (*biav2.ExecuteOutput, error) {
+	itemJSON, err := json.Marshal(input.Item.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	backupJSON, err := json.Marshal(input.Backup)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req := &protobiav2.ExecuteRequest{
+		Plugin: c.plugin,
+		Item:   itemJSON,
+		Backup: backupJSON,
+	}
+
+	ctx, cancel := c.itemCallContext(input.Context, "BackupItemActionV2/Execute")
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
+	if err != nil {
+		return nil, c.callError(ctx, "Execute", err)
+	}
+
+	var updatedItem unstructured.Unstructured
+	if err := json.Unmarshal(res.Item, &updatedItem); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var additionalItems []velero.ResourceIdentifier
+
+	for _, itm := range res.AdditionalItems {
+		newItem := velero.ResourceIdentifier{
+			GroupResource: schema.GroupResource{
+				Group:    itm.Group,
+				Resource: itm.Resource,
+			},
+			Namespace: itm.Namespace,
+			Name:      itm.Name,
+		}
+
+		additionalItems = append(additionalItems, newItem)
+	}
+
+	return &biav2.ExecuteOutput{
+		UpdatedItem:     &updatedItem,
+		AdditionalItems: additionalItems,
+		SkipBackup:      res.SkipBackup,
+	}, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	protobiav2 "github.com/vmware-tanzu/velero/pkg/plugin/generated/backupitemaction/v2"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
+)
+
+// BackupItemActionV2GRPCServer implements the proto-generated version 2 BackupItemAction interface, and
+// accepts gRPC calls and forwards them to an implementation of the pluggable interface.
+type BackupItemActionV2GRPCServer struct {
+	mux *serverMux
+}
+
+func (s *BackupItemActionV2GRPCServer) getImpl(name string) (biav2.BackupItemAction, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	itemAction, ok := impl.(biav2.BackupItemAction)
+	if !ok {
+		return nil, errors.Errorf("%T is not a backup item action (v2)", impl)
+	}
+
+	return itemAction, nil
+}
+
+func (s *BackupItemActionV2GRPCServer) AppliesTo(ctx context.Context, req *protobiav2.BackupItemActionAppliesToRequest) (response *protobiav2.BackupItemActionAppliesToResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	resourceSelector, err := impl.AppliesTo()
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &protobiav2.BackupItemActionAppliesToResponse{
+		ResourceSelector: &proto.ResourceSelector{
+			IncludedNamespaces: resourceSelector.IncludedNamespaces,
+			ExcludedNamespaces: resourceSelector.ExcludedNamespaces,
+			IncludedResources:  resourceSelector.IncludedResources,
+			ExcludedResources:  resourceSelector.ExcludedResources,
+			Selector:           resourceSelector.LabelSelector,
+		},
+	}, nil
+}
+
+func (s *BackupItemActionV2GRPCServer) Execute(ctx context.Context, req *protobiav2.ExecuteRequest) (response *protobiav2.ExecuteResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var item unstructured.Unstructured
+	var backup api.Backup
+
+	if err := json.Unmarshal(req.Item, &item); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	output, err := impl.Execute(&biav2.ExecuteInput{
+		Item:   &item,
+		Backup: &backup,
+	})
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	// If the plugin implementation returned a nil UpdatedItem (meaning no modifications), reset updatedItem to the
+	// original item.
+	var updatedItemJSON []byte
+	if output.UpdatedItem == nil {
+		updatedItemJSON = req.Item
+	} else {
+		updatedItemJSON, err = json.Marshal(output.UpdatedItem.UnstructuredContent())
+		if err != nil {
+			return nil, newGRPCError(errors.WithStack(err))
+		}
+	}
+
+	res := &protobiav2.ExecuteResponse{
+		Item:       updatedItemJSON,
+		SkipBackup: output.SkipBackup,
+	}
+
+	for _, item := range output.AdditionalItems {
+		res.AdditionalItems = append(res.AdditionalItems, backupResourceIdentifierToProto(item))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	protobiav2 "github.com/vmware-tanzu/velero/pkg/plugin/generated/backupitemaction/v2"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupItemActionV2GRPCServerExecute(t *testing.T) {
+	invalidItem := []byte("this is gibberish json")
+	validItem := []byte(`
+	{
+		"apiVersion": "v1",
+		"kind": "ConfigMap",
+		"metadata": {
+			"namespace": "myns",
+			"name": "myconfigmap"
+		},
+		"data": {
+			"key": "value"
+		}
+	}`)
+	var validItemObject unstructured.Unstructured
+	err := json.Unmarshal(validItem, &validItemObject)
+	require.NoError(t, err)
+
+	updatedItem := []byte(`
+		{
+			"apiVersion": "v1",
+			"kind": "ConfigMap",
+			"metadata": {
+				"namespace": "myns",
+				"name": "myconfigmap"
+			},
+			"data": {
+				"key": "changed!"
+			}
+		}`)
+	var updatedItemObject unstructured.Unstructured
+	err = json.Unmarshal(updatedItem, &updatedItemObject)
+	require.NoError(t, err)
+
+	validBackup := []byte(`
+	{
+		"apiVersion": "velero.io/v1",
+		"kind": "Backup",
+		"metadata": {
+			"namespace": "myns",
+			"name": "mybackup"
+		}
+	}`)
+	var validBackupObject v1.Backup
+	err = json.Unmarshal(validBackup, &validBackupObject)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		item        []byte
+		implOutput  *biav2.ExecuteOutput
+		implError   error
+		expectError bool
+		skipMock    bool
+	}{
+		{
+			name:        "error unmarshaling item",
+			item:        invalidItem,
+			expectError: true,
+			skipMock:    true,
+		},
+		{
+			name:        "error running impl",
+			item:        validItem,
+			implError:   errors.New("impl error"),
+			expectError: true,
+		},
+		{
+			name:       "nil updatedItem / no additionalItems",
+			item:       validItem,
+			implOutput: &biav2.ExecuteOutput{},
+		},
+		{
+			name: "different updatedItem / some additionalItems / skipBackup",
+			item: validItem,
+			implOutput: &biav2.ExecuteOutput{
+				UpdatedItem: &updatedItemObject,
+				AdditionalItems: []velero.ResourceIdentifier{
+					{
+						GroupResource: schema.GroupResource{Group: "v1", Resource: "pods"},
+						Namespace:     "myns",
+						Name:          "mypod",
+					},
+				},
+				SkipBackup: true,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			itemAction := &mocks.BackupItemAction{}
+			defer itemAction.AssertExpectations(t)
+
+			if !test.skipMock {
+				input := &biav2.ExecuteInput{Item: &validItemObject, Backup: &validBackupObject}
+				itemAction.On("Execute", input).Return(test.implOutput, test.implError)
+			}
+
+			s := &BackupItemActionV2GRPCServer{mux: &serverMux{
+				serverLog: velerotest.NewLogger(),
+				handlers: map[string]interface{}{
+					"xyz": itemAction,
+				},
+			}}
+
+			req := &protobiav2.ExecuteRequest{
+				Plugin: "xyz",
+				Item:   test.item,
+				Backup: validBackup,
+			}
+
+			resp, err := s.Execute(context.Background(), req)
+
+			// Verify error
+			assert.Equal(t, test.expectError, err != nil)
+			if err != nil {
+				return
+			}
+			require.NotNil(t, resp)
+
+			// Verify updated item
+			var updatedItem runtime.Unstructured = &validItemObject
+			if test.implOutput.UpdatedItem != nil {
+				updatedItem = test.implOutput.UpdatedItem
+			}
+
+			var respItem unstructured.Unstructured
+			err = json.Unmarshal(resp.Item, &respItem)
+			require.NoError(t, err)
+
+			assert.Equal(t, updatedItem, &respItem)
+
+			// Verify additional items and skipBackup
+			var expectedAdditionalItems []*proto.ResourceIdentifier
+			for _, item := range test.implOutput.AdditionalItems {
+				expectedAdditionalItems = append(expectedAdditionalItems, backupResourceIdentifierToProto(item))
+			}
+			assert.Equal(t, expectedAdditionalItems, resp.AdditionalItems)
+			assert.Equal(t, test.implOutput.SkipBackup, resp.SkipBackup)
+		})
+	}
+}
@@ -17,14 +17,93 @@ limitations under the License.
 package framework
 
 import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 )
 
 // clientBase implements client and contains shared fields common to all clients.
 type clientBase struct {
-	plugin string
-	logger logrus.FieldLogger
+	plugin       string
+	logger       logrus.FieldLogger
+	ctx          context.Context
+	timeout      time.Duration
+	callObserver CallObserver
+}
+
+// callContext returns the context for a call to method on the plugin. It's cancelled when the client's
+// context is done and, if the client has a timeout, when the timeout expires. The call is traced in a
+// span that's a child of any span in the client's context, and whose context is propagated to the
+// plugin process in the call's gRPC metadata. The span is ended, and, if the client has a CallObserver,
+// the duration of the call is reported to it, when the returned CancelFunc is first called.
+func (c *clientBase) callContext(method string) (context.Context, context.CancelFunc) {
+	return c.itemCallContext(nil, method)
+}
+
+// itemCallContext is callContext for a call that's made for an item processed in the span in itemCtx.
+// The call's span is a child of that span rather than of any span in the client's context, which is
+// still the context that the call is cancelled with. itemCtx may be nil.
+func (c *clientBase) itemCallContext(itemCtx context.Context, method string) (context.Context, context.CancelFunc) {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var cancel context.CancelFunc
+	if c.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	if itemCtx != nil {
+		ctx = trace.ContextWithSpan(ctx, trace.SpanFromContext(itemCtx))
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(pluginNameAttribute.String(c.plugin)),
+	)
+	ctx = injectTraceContext(ctx)
+
+	start := time.Now()
+	var once sync.Once
+	return ctx, func() {
+		cancel()
+		once.Do(func() {
+			span.End()
+			if c.callObserver != nil {
+				c.callObserver(c.plugin, time.Since(start))
+			}
+		})
+	}
+}
+
+// callError records err, returned by a call to method on the plugin with ctx, on the call's span and
+// converts it into a TimeoutError if the call timed out, or an error wrapping context.Canceled if the
+// call was cancelled. Otherwise it returns fromGRPCError(err).
+func (c *clientBase) callError(ctx context.Context, method string, err error) error {
+	if err != nil {
+		span := trace.SpanFromContext(ctx)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return &TimeoutError{Plugin: c.plugin, Method: method, Timeout: c.timeout}
+	case context.Canceled:
+		return errors.Wrapf(context.Canceled, "call to %s on plugin %s was cancelled", method, c.plugin)
+	}
+	return fromGRPCError(err)
 }
 
 type ClientDispenser interface {
@@ -36,6 +115,12 @@ type ClientDispenser interface {
 type clientDispenser struct {
 	// logger is the log the plugin should use.
 	logger logrus.FieldLogger
+	// ctx is the context for calls to the plugin.
+	ctx context.Context
+	// timeout is how long each call to the plugin may take.
+	timeout time.Duration
+	// callObserver is reported the duration of each call to the plugin.
+	callObserver CallObserver
 	// clienConn is shared among all implementations for this client.
 	clientConn *grpc.ClientConn
 	// initFunc returns a client that implements a plugin interface, such as ObjectStore.
@@ -46,13 +131,16 @@ type clientDispenser struct {
 
 type clientInitFunc func(base *clientBase, clientConn *grpc.ClientConn) interface{}
 
-// newClientDispenser creates a new clientDispenser.
-func newClientDispenser(logger logrus.FieldLogger, clientConn *grpc.ClientConn, initFunc clientInitFunc) *clientDispenser {
+// newClientDispenser creates a new clientDispenser using the client options in base.
+func newClientDispenser(base *pluginBase, clientConn *grpc.ClientConn, initFunc clientInitFunc) *clientDispenser {
 	return &clientDispenser{
-		clientConn: clientConn,
-		logger:     logger,
-		initFunc:   initFunc,
-		clients:    make(map[string]interface{}),
+		clientConn:   clientConn,
+		logger:       base.clientLogger,
+		ctx:          base.clientContext,
+		timeout:      base.clientTimeout,
+		callObserver: base.callObserver,
+		initFunc:     initFunc,
+		clients:      make(map[string]interface{}),
 	}
 }
 
@@ -64,8 +152,11 @@ func (cd *clientDispenser) ClientFor(name string) interface{} {
 	}
 
 	base := &clientBase{
-		plugin: name,
-		logger: cd.logger,
+		plugin:       name,
+		logger:       cd.logger,
+		ctx:          cd.ctx,
+		timeout:      cd.timeout,
+		callObserver: cd.callObserver,
 	}
 	// Initialize the plugin (e.g. newBackupItemActionGRPCClient())
 	client := cd.initFunc(base, cd.clientConn)
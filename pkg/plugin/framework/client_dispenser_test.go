@@ -16,8 +16,11 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -40,7 +43,7 @@ func TestNewClientDispenser(t *testing.T) {
 		return c
 	}
 
-	cd := newClientDispenser(logger, clientConn, initFunc)
+	cd := newClientDispenser(&pluginBase{clientLogger: logger}, clientConn, initFunc)
 	assert.Equal(t, clientConn, cd.clientConn)
 	assert.NotNil(t, cd.clients)
 	assert.Empty(t, cd.clients)
@@ -59,7 +62,8 @@ func TestClientFor(t *testing.T) {
 		return c
 	}
 
-	cd := newClientDispenser(logger, clientConn, initFunc)
+	ctx := context.Background()
+	cd := newClientDispenser(&pluginBase{clientLogger: logger, clientContext: ctx, clientTimeout: time.Minute}, clientConn, initFunc)
 
 	actual := cd.ClientFor("pod")
 	require.IsType(t, &fakeClient{}, actual)
@@ -67,8 +71,10 @@ func TestClientFor(t *testing.T) {
 	assert.Equal(t, 1, count)
 	assert.Equal(t, &typed, &c)
 	expectedBase := &clientBase{
-		plugin: "pod",
-		logger: logger,
+		plugin:  "pod",
+		logger:  logger,
+		ctx:     ctx,
+		timeout: time.Minute,
 	}
 	assert.Equal(t, expectedBase, typed.base)
 	assert.Equal(t, clientConn, typed.clientConn)
@@ -79,3 +85,61 @@ func TestClientFor(t *testing.T) {
 	typed = actual.(*fakeClient)
 	assert.Equal(t, 1, count)
 }
+
+func TestClientBaseCallError(t *testing.T) {
+	t.Run("a call that takes longer than the timeout returns a TimeoutError", func(t *testing.T) {
+		c := &clientBase{plugin: "velero.io/aws", ctx: context.Background(), timeout: time.Millisecond}
+
+		ctx, cancel := c.callContext("ObjectStore/PutObject")
+		defer cancel()
+		<-ctx.Done()
+
+		err := c.callError(ctx, "PutObject", ctx.Err())
+		require.IsType(t, &TimeoutError{}, err)
+		assert.EqualError(t, err, "plugin velero.io/aws timed out after 1ms waiting for PutObject to complete")
+	})
+
+	t.Run("a call whose client context is cancelled returns an error wrapping context.Canceled", func(t *testing.T) {
+		clientCtx, clientCancel := context.WithCancel(context.Background())
+		c := &clientBase{plugin: "velero.io/aws", ctx: clientCtx}
+
+		ctx, cancel := c.callContext("ObjectStore/PutObject")
+		defer cancel()
+		clientCancel()
+
+		err := c.callError(ctx, "PutObject", ctx.Err())
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.EqualError(t, err, "call to PutObject on plugin velero.io/aws was cancelled: context canceled")
+	})
+
+	t.Run("a call without a client context or timeout isn't cancelled", func(t *testing.T) {
+		c := &clientBase{plugin: "velero.io/aws"}
+
+		ctx, cancel := c.callContext("ObjectStore/PutObject")
+		defer cancel()
+
+		assert.NoError(t, ctx.Err())
+		_, hasDeadline := ctx.Deadline()
+		assert.False(t, hasDeadline)
+		assert.NoError(t, c.callError(ctx, "PutObject", nil))
+	})
+}
+
+func TestClientBaseCallContextObservesCallDuration(t *testing.T) {
+	var observed []string
+	c := &clientBase{
+		plugin: "velero.io/aws",
+		callObserver: func(plugin string, duration time.Duration) {
+			assert.True(t, duration >= time.Millisecond)
+			observed = append(observed, plugin)
+		},
+	}
+
+	_, cancel := c.callContext("ObjectStore/PutObject")
+	time.Sleep(time.Millisecond)
+	assert.Empty(t, observed)
+
+	cancel()
+	cancel()
+	assert.Equal(t, []string{"velero.io/aws"}, observed)
+}
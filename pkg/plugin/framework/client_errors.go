@@ -17,6 +17,9 @@ limitations under the License.
 package framework
 
 import (
+	"fmt"
+	"time"
+
 	"google.golang.org/grpc/status"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -49,6 +52,21 @@ func fromGRPCError(err error) error {
 	return err
 }
 
+// TimeoutError is returned by a plugin client when a call to the plugin doesn't complete within
+// the timeout for the plugin's kind.
+type TimeoutError struct {
+	// Plugin is the name of the plugin.
+	Plugin string
+	// Method is the plugin method that was called, e.g. Execute.
+	Method string
+	// Timeout is how long the call was allowed to take.
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("plugin %s timed out after %s waiting for %s to complete", e.Plugin, e.Timeout, e.Method)
+}
+
 type protoStackError struct {
 	error
 	stack *proto.Stack
@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -51,9 +50,12 @@ func newDeleteItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn
 }
 
 func (c *DeleteItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin})
+	ctx, cancel := c.callContext("DeleteItemAction/AppliesTo")
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin})
 	if err != nil {
-		return velero.ResourceSelector{}, fromGRPCError(err)
+		return velero.ResourceSelector{}, c.callError(ctx, "AppliesTo", err)
 	}
 
 	if res.ResourceSelector == nil {
@@ -87,8 +89,11 @@ func (c *DeleteItemActionGRPCClient) Execute(input *velero.DeleteItemActionExecu
 	}
 
 	// First return item is just an empty struct no matter what.
-	if _, err = c.grpcClient.Execute(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	ctx, cancel := c.itemCallContext(input.Context, "DeleteItemAction/Execute")
+	defer cancel()
+
+	if _, err = c.grpcClient.Execute(ctx, req); err != nil {
+		return c.callError(ctx, "Execute", err)
 	}
 
 	return nil
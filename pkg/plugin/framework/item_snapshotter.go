@@ -34,7 +34,7 @@ type ItemSnapshotterPlugin struct {
 
 // GRPCClient returns a clientDispenser for ItemSnapshotter gRPC clients.
 func (p *ItemSnapshotterPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, clientConn *grpc.ClientConn) (interface{}, error) {
-	return newClientDispenser(p.clientLogger, clientConn, newItemSnapshotterGRPCClient), nil
+	return newClientDispenser(p.pluginBase, clientConn, newItemSnapshotterGRPCClient), nil
 }
 
 // GRPCServer registers an ItemSnapshotter gRPC server.
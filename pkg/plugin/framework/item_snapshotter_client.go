@@ -58,8 +58,13 @@ func (recv ItemSnapshotterGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	_, err := recv.grpcClient.Init(context.Background(), req)
-	return err
+	ctx, cancel := recv.callContext("ItemSnapshotter/Init")
+	defer cancel()
+
+	if _, err := recv.grpcClient.Init(ctx, req); err != nil {
+		return recv.callError(ctx, "Init", err)
+	}
+	return nil
 }
 
 func (recv ItemSnapshotterGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
@@ -67,9 +72,12 @@ func (recv ItemSnapshotterGRPCClient) AppliesTo() (velero.ResourceSelector, erro
 		Plugin: recv.plugin,
 	}
 
-	res, err := recv.grpcClient.AppliesTo(context.Background(), req)
+	ctx, cancel := recv.callContext("ItemSnapshotter/AppliesTo")
+	defer cancel()
+
+	res, err := recv.grpcClient.AppliesTo(ctx, req)
 	if err != nil {
-		return velero.ResourceSelector{}, fromGRPCError(err)
+		return velero.ResourceSelector{}, recv.callError(ctx, "AppliesTo", err)
 	}
 
 	if res.ResourceSelector == nil {
@@ -100,9 +108,12 @@ func (recv ItemSnapshotterGRPCClient) AlsoHandles(input *isv1.AlsoHandlesInput)
 		Item:   itemJSON,
 		Backup: backupJSON,
 	}
-	res, err := recv.grpcClient.AlsoHandles(context.Background(), req)
+	ctx, cancel := recv.callContext("ItemSnapshotter/AlsoHandles")
+	defer cancel()
+
+	res, err := recv.grpcClient.AlsoHandles(ctx, req)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, recv.callError(ctx, "AlsoHandles", err)
 	}
 
 	handledItems := unpackResourceIdentifiers(res.HandledItems)
@@ -159,10 +170,13 @@ func (recv ItemSnapshotterGRPCClient) Progress(input *isv1.ProgressInput) (*isv1
 		Backup:     backupJSON,
 	}
 
-	res, err := recv.grpcClient.Progress(context.Background(), req)
+	ctx, cancel := recv.callContext("ItemSnapshotter/Progress")
+	defer cancel()
+
+	res, err := recv.grpcClient.Progress(ctx, req)
 
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, recv.callError(ctx, "Progress", err)
 	}
 	// Validate phase
 
@@ -21,7 +21,6 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -59,8 +58,11 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	ctx, cancel := c.callContext("ObjectStore/Init")
+	defer cancel()
+
+	if _, err := c.grpcClient.Init(ctx, req); err != nil {
+		return c.callError(ctx, "Init", err)
 	}
 
 	return nil
@@ -69,9 +71,12 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 // PutObject creates a new object using the data in body within the specified
 // object storage bucket with the given key.
 func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) error {
-	stream, err := c.grpcClient.PutObject(context.Background())
+	ctx, cancel := c.callContext("ObjectStore/PutObject")
+	defer cancel()
+
+	stream, err := c.grpcClient.PutObject(ctx)
 	if err != nil {
-		return fromGRPCError(err)
+		return c.callError(ctx, "PutObject", err)
 	}
 
 	// read from the provider io.Reader into chunks, and send each one over
@@ -81,7 +86,7 @@ func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) er
 		n, err := body.Read(chunk)
 		if err == io.EOF {
 			if _, resErr := stream.CloseAndRecv(); resErr != nil {
-				return fromGRPCError(resErr)
+				return c.callError(ctx, "PutObject", resErr)
 			}
 			return nil
 		}
@@ -91,7 +96,7 @@ func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) er
 		}
 
 		if err := stream.Send(&proto.PutObjectRequest{Plugin: c.plugin, Bucket: bucket, Key: key, Body: chunk[0:n]}); err != nil {
-			return fromGRPCError(err)
+			return c.callError(ctx, "PutObject", err)
 		}
 	}
 }
@@ -104,9 +109,12 @@ func (c *ObjectStoreGRPCClient) ObjectExists(bucket, key string) (bool, error) {
 		Key:    key,
 	}
 
-	res, err := c.grpcClient.ObjectExists(context.Background(), req)
+	ctx, cancel := c.callContext("ObjectStore/ObjectExists")
+	defer cancel()
+
+	res, err := c.grpcClient.ObjectExists(ctx, req)
 	if err != nil {
-		return false, err
+		return false, c.callError(ctx, "ObjectExists", err)
 	}
 
 	return res.Exists, nil
@@ -121,9 +129,14 @@ func (c *ObjectStoreGRPCClient) GetObject(bucket, key string) (io.ReadCloser, er
 		Key:    key,
 	}
 
-	stream, err := c.grpcClient.GetObject(context.Background(), req)
+	// The call's context is cancelled when the returned reader is closed, since the object is
+	// streamed from the plugin as it's read.
+	ctx, cancel := c.callContext("ObjectStore/GetObject")
+
+	stream, err := c.grpcClient.GetObject(ctx, req)
 	if err != nil {
-		return nil, fromGRPCError(err)
+		cancel()
+		return nil, c.callError(ctx, "GetObject", err)
 	}
 
 	receive := func() ([]byte, error) {
@@ -135,13 +148,14 @@ func (c *ObjectStoreGRPCClient) GetObject(bucket, key string) (io.ReadCloser, er
 			return nil, err
 		}
 		if err != nil {
-			return nil, fromGRPCError(err)
+			return nil, c.callError(ctx, "GetObject", err)
 		}
 
 		return data.Data, nil
 	}
 
 	close := func() error {
+		defer cancel()
 		if err := stream.CloseSend(); err != nil {
 			return fromGRPCError(err)
 		}
@@ -162,9 +176,12 @@ func (c *ObjectStoreGRPCClient) ListCommonPrefixes(bucket, prefix, delimiter str
 		Delimiter: delimiter,
 	}
 
-	res, err := c.grpcClient.ListCommonPrefixes(context.Background(), req)
+	ctx, cancel := c.callContext("ObjectStore/ListCommonPrefixes")
+	defer cancel()
+
+	res, err := c.grpcClient.ListCommonPrefixes(ctx, req)
 	if err != nil {
-		return nil, fromGRPCError(err)
+		return nil, c.callError(ctx, "ListCommonPrefixes", err)
 	}
 
 	return res.Prefixes, nil
@@ -178,9 +195,12 @@ func (c *ObjectStoreGRPCClient) ListObjects(bucket, prefix string) ([]string, er
 		Prefix: prefix,
 	}
 
-	res, err := c.grpcClient.ListObjects(context.Background(), req)
+	ctx, cancel := c.callContext("ObjectStore/ListObjects")
+	defer cancel()
+
+	res, err := c.grpcClient.ListObjects(ctx, req)
 	if err != nil {
-		return nil, fromGRPCError(err)
+		return nil, c.callError(ctx, "ListObjects", err)
 	}
 
 	return res.Keys, nil
@@ -195,8 +215,11 @@ func (c *ObjectStoreGRPCClient) DeleteObject(bucket, key string) error {
 		Key:    key,
 	}
 
-	if _, err := c.grpcClient.DeleteObject(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	ctx, cancel := c.callContext("ObjectStore/DeleteObject")
+	defer cancel()
+
+	if _, err := c.grpcClient.DeleteObject(ctx, req); err != nil {
+		return c.callError(ctx, "DeleteObject", err)
 	}
 
 	return nil
@@ -211,9 +234,12 @@ func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Dur
 		Ttl:    int64(ttl),
 	}
 
-	res, err := c.grpcClient.CreateSignedURL(context.Background(), req)
+	ctx, cancel := c.callContext("ObjectStore/CreateSignedURL")
+	defer cancel()
+
+	res, err := c.grpcClient.CreateSignedURL(ctx, req)
 	if err != nil {
-		return "", fromGRPCError(err)
+		return "", c.callError(ctx, "CreateSignedURL", err)
 	}
 
 	return res.Url, nil
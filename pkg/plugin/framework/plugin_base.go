@@ -17,11 +17,17 @@ limitations under the License.
 package framework
 
 import (
+	"context"
+	"time"
+
 	"github.com/sirupsen/logrus"
 )
 
 type pluginBase struct {
-	clientLogger logrus.FieldLogger
+	clientLogger  logrus.FieldLogger
+	clientContext context.Context
+	clientTimeout time.Duration
+	callObserver  CallObserver
 	*serverMux
 }
 
@@ -41,6 +47,32 @@ func ClientLogger(logger logrus.FieldLogger) PluginOption {
 	}
 }
 
+// ClientContext sets the context for calls to the plugin. Calls are cancelled when ctx is done.
+func ClientContext(ctx context.Context) PluginOption {
+	return func(base *pluginBase) {
+		base.clientContext = ctx
+	}
+}
+
+// ClientTimeout sets how long each call to the plugin may take before it's cancelled. A timeout
+// of 0 means that calls don't time out.
+func ClientTimeout(timeout time.Duration) PluginOption {
+	return func(base *pluginBase) {
+		base.clientTimeout = timeout
+	}
+}
+
+// CallObserver is called with the name of the plugin and the duration of each call to it, once the
+// call is done.
+type CallObserver func(plugin string, duration time.Duration)
+
+// ClientCallObserver sets the CallObserver that the duration of calls to the plugin are reported to.
+func ClientCallObserver(observer CallObserver) PluginOption {
+	return func(base *pluginBase) {
+		base.callObserver = observer
+	}
+}
+
 func serverLogger(logger logrus.FieldLogger) PluginOption {
 	return func(base *pluginBase) {
 		base.serverMux = newServerMux(logger)
@@ -16,6 +16,8 @@ limitations under the License.
 
 package framework
 
+import "strings"
+
 // PluginKind is a type alias for a string that describes
 // the kind of a Velero-supported plugin.
 type PluginKind string
@@ -25,6 +27,30 @@ func (k PluginKind) String() string {
 	return string(k)
 }
 
+// Base returns the unversioned kind that k is a version of, e.g. BackupItemAction
+// for BackupItemAction/v2. Unversioned kinds are their own base, and are version 1
+// of the kind.
+func (k PluginKind) Base() PluginKind {
+	return PluginKind(strings.SplitN(string(k), "/", 2)[0])
+}
+
+// Version returns the version of k, e.g. v2 for BackupItemAction/v2. Unversioned kinds
+// are v1.
+func (k PluginKind) Version() string {
+	if parts := strings.SplitN(string(k), "/", 2); len(parts) == 2 {
+		return parts[1]
+	}
+	return "v1"
+}
+
+// Versions returns all of the versions of k's base kind, newest first.
+func (k PluginKind) Versions() []PluginKind {
+	if versions, ok := pluginKindVersions[k.Base()]; ok {
+		return versions
+	}
+	return []PluginKind{k.Base()}
+}
+
 const (
 	// PluginKindObjectStore represents an object store plugin.
 	PluginKindObjectStore PluginKind = "ObjectStore"
@@ -35,12 +61,27 @@ const (
 	// PluginKindBackupItemAction represents a backup item action plugin.
 	PluginKindBackupItemAction PluginKind = "BackupItemAction"
 
+	// PluginKindBackupItemActionV2 represents version 2 of the backup item action plugin.
+	PluginKindBackupItemActionV2 PluginKind = "BackupItemAction/v2"
+
 	// PluginKindRestoreItemAction represents a restore item action plugin.
 	PluginKindRestoreItemAction PluginKind = "RestoreItemAction"
 
 	// PluginKindDeleteItemAction represents a delete item action plugin.
 	PluginKindDeleteItemAction PluginKind = "DeleteItemAction"
 
+	// PluginKindPreBackupAction represents a pre-backup action plugin.
+	PluginKindPreBackupAction PluginKind = "PreBackupAction"
+
+	// PluginKindPostBackupAction represents a post-backup action plugin.
+	PluginKindPostBackupAction PluginKind = "PostBackupAction"
+
+	// PluginKindPreRestoreAction represents a pre-restore action plugin.
+	PluginKindPreRestoreAction PluginKind = "PreRestoreAction"
+
+	// PluginKindPostRestoreAction represents a post-restore action plugin.
+	PluginKindPostRestoreAction PluginKind = "PostRestoreAction"
+
 	// PluginKindItemSnapshotter represents an item snapshotter plugin
 	PluginKindItemSnapshotter PluginKind = "ItemSnapshotter"
 
@@ -48,6 +89,13 @@ const (
 	PluginKindPluginLister PluginKind = "PluginLister"
 )
 
+// pluginKindVersions contains the versions of each plugin kind that has more than one,
+// newest first. Velero uses the newest version that a plugin implements, adapting it
+// to the newest version of the kind's interface.
+var pluginKindVersions = map[PluginKind][]PluginKind{
+	PluginKindBackupItemAction: {PluginKindBackupItemActionV2, PluginKindBackupItemAction},
+}
+
 // AllPluginKinds contains all the valid plugin kinds that Velero supports, excluding PluginLister because that is not a
 // kind that a developer would ever need to implement (it's handled by Velero and the Velero plugin library code).
 func AllPluginKinds() map[string]PluginKind {
@@ -55,8 +103,13 @@ func AllPluginKinds() map[string]PluginKind {
 	allPluginKinds[PluginKindObjectStore.String()] = PluginKindObjectStore
 	allPluginKinds[PluginKindVolumeSnapshotter.String()] = PluginKindVolumeSnapshotter
 	allPluginKinds[PluginKindBackupItemAction.String()] = PluginKindBackupItemAction
+	allPluginKinds[PluginKindBackupItemActionV2.String()] = PluginKindBackupItemActionV2
 	allPluginKinds[PluginKindRestoreItemAction.String()] = PluginKindRestoreItemAction
 	allPluginKinds[PluginKindDeleteItemAction.String()] = PluginKindDeleteItemAction
+	allPluginKinds[PluginKindPreBackupAction.String()] = PluginKindPreBackupAction
+	allPluginKinds[PluginKindPostBackupAction.String()] = PluginKindPostBackupAction
+	allPluginKinds[PluginKindPreRestoreAction.String()] = PluginKindPreRestoreAction
+	allPluginKinds[PluginKindPostRestoreAction.String()] = PluginKindPostRestoreAction
 	allPluginKinds[PluginKindItemSnapshotter.String()] = PluginKindItemSnapshotter
 	return allPluginKinds
 }
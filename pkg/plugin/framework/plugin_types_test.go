@@ -27,10 +27,15 @@ func TestPluginImplementationsAreGRPCPlugins(t *testing.T) {
 	pluginImpls := []interface{}{
 		new(VolumeSnapshotterPlugin),
 		new(BackupItemActionPlugin),
+		new(BackupItemActionV2Plugin),
 		new(ObjectStorePlugin),
 		new(PluginListerPlugin),
 		new(RestoreItemActionPlugin),
 		new(ItemSnapshotterPlugin),
+		new(PreBackupActionPlugin),
+		new(PostBackupActionPlugin),
+		new(PreRestoreActionPlugin),
+		new(PostRestoreActionPlugin),
 	}
 
 	for _, impl := range pluginImpls {
@@ -0,0 +1,73 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.PostBackupAction = &PostBackupActionGRPCClient{}
+
+// NewPostBackupActionPlugin constructs a PostBackupActionPlugin.
+func NewPostBackupActionPlugin(options ...PluginOption) *PostBackupActionPlugin {
+	return &PostBackupActionPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// PostBackupActionGRPCClient implements the PostBackupAction interface and uses a
+// gRPC client to make calls to the plugin server.
+type PostBackupActionGRPCClient struct {
+	*clientBase
+	grpcClient proto.PostBackupActionClient
+}
+
+func newPostBackupActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &PostBackupActionGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewPostBackupActionClient(clientConn),
+	}
+}
+
+func (c *PostBackupActionGRPCClient) Execute(backup *api.Backup) error {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req := &proto.PostBackupActionExecuteRequest{
+		Plugin: c.plugin,
+		Backup: backupJSON,
+	}
+
+	// First return item is just an empty struct no matter what.
+	ctx, cancel := c.callContext("PostBackupAction/Execute")
+	defer cancel()
+
+	if _, err := c.grpcClient.Execute(ctx, req); err != nil {
+		return c.callError(ctx, "Execute", err)
+	}
+
+	return nil
+}
@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -53,9 +52,12 @@ func newRestoreItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientCon
 }
 
 func (c *RestoreItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
+	ctx, cancel := c.callContext("RestoreItemAction/AppliesTo")
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
 	if err != nil {
-		return velero.ResourceSelector{}, fromGRPCError(err)
+		return velero.ResourceSelector{}, c.callError(ctx, "AppliesTo", err)
 	}
 
 	if res.ResourceSelector == nil {
@@ -94,9 +96,12 @@ func (c *RestoreItemActionGRPCClient) Execute(input *velero.RestoreItemActionExe
 		Restore:        restoreJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, cancel := c.itemCallContext(input.Context, "RestoreItemAction/Execute")
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
-		return nil, fromGRPCError(err)
+		return nil, c.callError(ctx, "Execute", err)
 	}
 
 	var updatedItem unstructured.Unstructured
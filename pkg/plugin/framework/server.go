@@ -17,8 +17,10 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	plugin "github.com/hashicorp/go-plugin"
@@ -26,6 +28,7 @@ import (
 	"github.com/spf13/pflag"
 
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
@@ -46,6 +49,13 @@ type Server interface {
 	// RegisterBackupItemActions registers multiple backup item actions.
 	RegisterBackupItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterBackupItemActionV2 registers a version 2 backup item action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterBackupItemActionV2(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterBackupItemActionsV2 registers multiple version 2 backup item actions.
+	RegisterBackupItemActionsV2(map[string]HandlerInitializer) Server
+
 	// RegisterVolumeSnapshotter registers a volume snapshotter. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterVolumeSnapshotter(pluginName string, initializer HandlerInitializer) Server
@@ -78,22 +88,56 @@ type Server interface {
 
 	// RegisterItemSnapshotters registers multiple Item Snapshotters
 	RegisterItemSnapshotters(map[string]HandlerInitializer) Server
+
+	// RegisterPreBackupAction registers a pre-backup action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterPreBackupAction(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterPreBackupActions registers multiple pre-backup actions.
+	RegisterPreBackupActions(map[string]HandlerInitializer) Server
+
+	// RegisterPostBackupAction registers a post-backup action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterPostBackupAction(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterPostBackupActions registers multiple post-backup actions.
+	RegisterPostBackupActions(map[string]HandlerInitializer) Server
+
+	// RegisterPreRestoreAction registers a pre-restore action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterPreRestoreAction(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterPreRestoreActions registers multiple pre-restore actions.
+	RegisterPreRestoreActions(map[string]HandlerInitializer) Server
+
+	// RegisterPostRestoreAction registers a post-restore action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterPostRestoreAction(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterPostRestoreActions registers multiple post-restore actions.
+	RegisterPostRestoreActions(map[string]HandlerInitializer) Server
+
 	// Server runs the plugin server.
 	Serve()
 }
 
 // server implements Server.
 type server struct {
-	log               *logrus.Logger
-	logLevelFlag      *logging.LevelFlag
-	flagSet           *pflag.FlagSet
-	featureSet        *veleroflag.StringArray
-	backupItemAction  *BackupItemActionPlugin
-	volumeSnapshotter *VolumeSnapshotterPlugin
-	objectStore       *ObjectStorePlugin
-	restoreItemAction *RestoreItemActionPlugin
-	deleteItemAction  *DeleteItemActionPlugin
-	itemSnapshotter   *ItemSnapshotterPlugin
+	log                *logrus.Logger
+	logLevelFlag       *logging.LevelFlag
+	flagSet            *pflag.FlagSet
+	featureSet         *veleroflag.StringArray
+	backupItemAction   *BackupItemActionPlugin
+	backupItemActionV2 *BackupItemActionV2Plugin
+	volumeSnapshotter  *VolumeSnapshotterPlugin
+	objectStore        *ObjectStorePlugin
+	restoreItemAction  *RestoreItemActionPlugin
+	deleteItemAction   *DeleteItemActionPlugin
+	itemSnapshotter    *ItemSnapshotterPlugin
+	preBackupAction    *PreBackupActionPlugin
+	postBackupAction   *PostBackupActionPlugin
+	preRestoreAction   *PreRestoreActionPlugin
+	postRestoreAction  *PostRestoreActionPlugin
 }
 
 // NewServer returns a new Server
@@ -102,15 +146,20 @@ func NewServer() Server {
 	features := veleroflag.NewStringArray()
 
 	return &server{
-		log:               log,
-		logLevelFlag:      logging.LogLevelFlag(log.Level),
-		featureSet:        &features,
-		backupItemAction:  NewBackupItemActionPlugin(serverLogger(log)),
-		volumeSnapshotter: NewVolumeSnapshotterPlugin(serverLogger(log)),
-		objectStore:       NewObjectStorePlugin(serverLogger(log)),
-		restoreItemAction: NewRestoreItemActionPlugin(serverLogger(log)),
-		deleteItemAction:  NewDeleteItemActionPlugin(serverLogger(log)),
-		itemSnapshotter:   NewItemSnapshotterPlugin(serverLogger(log)),
+		log:                log,
+		logLevelFlag:       logging.LogLevelFlag(log.Level),
+		featureSet:         &features,
+		backupItemAction:   NewBackupItemActionPlugin(serverLogger(log)),
+		backupItemActionV2: NewBackupItemActionV2Plugin(serverLogger(log)),
+		volumeSnapshotter:  NewVolumeSnapshotterPlugin(serverLogger(log)),
+		objectStore:        NewObjectStorePlugin(serverLogger(log)),
+		restoreItemAction:  NewRestoreItemActionPlugin(serverLogger(log)),
+		deleteItemAction:   NewDeleteItemActionPlugin(serverLogger(log)),
+		itemSnapshotter:    NewItemSnapshotterPlugin(serverLogger(log)),
+		preBackupAction:    NewPreBackupActionPlugin(serverLogger(log)),
+		postBackupAction:   NewPostBackupActionPlugin(serverLogger(log)),
+		preRestoreAction:   NewPreRestoreActionPlugin(serverLogger(log)),
+		postRestoreAction:  NewPostRestoreActionPlugin(serverLogger(log)),
 	}
 }
 
@@ -135,6 +184,18 @@ func (s *server) RegisterBackupItemActions(m map[string]HandlerInitializer) Serv
 	return s
 }
 
+func (s *server) RegisterBackupItemActionV2(name string, initializer HandlerInitializer) Server {
+	s.backupItemActionV2.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterBackupItemActionsV2(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterBackupItemActionV2(name, m[name])
+	}
+	return s
+}
+
 func (s *server) RegisterVolumeSnapshotter(name string, initializer HandlerInitializer) Server {
 	s.volumeSnapshotter.register(name, initializer)
 	return s
@@ -194,6 +255,54 @@ func (s *server) RegisterItemSnapshotters(m map[string]HandlerInitializer) Serve
 	return s
 }
 
+func (s *server) RegisterPreBackupAction(name string, initializer HandlerInitializer) Server {
+	s.preBackupAction.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterPreBackupActions(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterPreBackupAction(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterPostBackupAction(name string, initializer HandlerInitializer) Server {
+	s.postBackupAction.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterPostBackupActions(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterPostBackupAction(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterPreRestoreAction(name string, initializer HandlerInitializer) Server {
+	s.preRestoreAction.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterPreRestoreActions(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterPreRestoreAction(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterPostRestoreAction(name string, initializer HandlerInitializer) Server {
+	s.postRestoreAction.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterPostRestoreActions(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterPostRestoreAction(name, m[name])
+	}
+	return s
+}
+
 // getNames returns a list of PluginIdentifiers registered with plugin.
 func getNames(command string, kind PluginKind, plugin Interface) []PluginIdentifier {
 	var pluginIdentifiers []PluginIdentifier
@@ -217,27 +326,45 @@ func (s *server) Serve() {
 
 	command := os.Args[0]
 
+	// Trace the calls to the plugins if the Velero server that runs this process has tracing enabled.
+	shutdownTracing, err := tracing.SetupPlugin(context.Background(), filepath.Base(command), tracing.ConfigFromEnv())
+	if err != nil {
+		s.log.WithError(err).Warn("Error setting up tracing, calls to the plugins will not be traced")
+	} else {
+		defer shutdownTracing(context.Background())
+	}
+
 	var pluginIdentifiers []PluginIdentifier
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupItemAction, s.backupItemAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupItemActionV2, s.backupItemActionV2)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindVolumeSnapshotter, s.volumeSnapshotter)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindObjectStore, s.objectStore)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindRestoreItemAction, s.restoreItemAction)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindDeleteItemAction, s.deleteItemAction)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindItemSnapshotter, s.itemSnapshotter)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindPreBackupAction, s.preBackupAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindPostBackupAction, s.postBackupAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindPreRestoreAction, s.preRestoreAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindPostRestoreAction, s.postRestoreAction)...)
 
 	pluginLister := NewPluginLister(pluginIdentifiers...)
 
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: Handshake(),
 		Plugins: map[string]plugin.Plugin{
-			string(PluginKindBackupItemAction):  s.backupItemAction,
-			string(PluginKindVolumeSnapshotter): s.volumeSnapshotter,
-			string(PluginKindObjectStore):       s.objectStore,
-			string(PluginKindPluginLister):      NewPluginListerPlugin(pluginLister),
-			string(PluginKindRestoreItemAction): s.restoreItemAction,
-			string(PluginKindDeleteItemAction):  s.deleteItemAction,
-			string(PluginKindItemSnapshotter):   s.itemSnapshotter,
+			string(PluginKindBackupItemAction):   s.backupItemAction,
+			string(PluginKindBackupItemActionV2): s.backupItemActionV2,
+			string(PluginKindVolumeSnapshotter):  s.volumeSnapshotter,
+			string(PluginKindObjectStore):        s.objectStore,
+			string(PluginKindPluginLister):       NewPluginListerPlugin(pluginLister),
+			string(PluginKindRestoreItemAction):  s.restoreItemAction,
+			string(PluginKindDeleteItemAction):   s.deleteItemAction,
+			string(PluginKindItemSnapshotter):    s.itemSnapshotter,
+			string(PluginKindPreBackupAction):    s.preBackupAction,
+			string(PluginKindPostBackupAction):   s.postBackupAction,
+			string(PluginKindPreRestoreAction):   s.preRestoreAction,
+			string(PluginKindPostRestoreAction):  s.postRestoreAction,
 		},
-		GRPCServer: plugin.DefaultGRPCServer,
+		GRPCServer: newGRPCServer,
 	})
 }
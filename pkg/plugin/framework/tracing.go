@@ -0,0 +1,116 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/vmware-tanzu/velero/pkg/tracing"
+)
+
+// pluginNameAttribute is the attribute of the spans of plugin calls that holds the name of the plugin.
+const pluginNameAttribute = attribute.Key("velero.plugin.name")
+
+// metadataCarrier adapts gRPC metadata to a propagation.TextMapCarrier so that trace context can be
+// passed between the Velero server and plugin processes.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// injectTraceContext returns a copy of ctx whose outgoing gRPC metadata carries the trace context
+// of the span in ctx.
+func injectTraceContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// extractTraceContext returns a copy of ctx that holds the remote span whose trace context was
+// propagated in ctx's incoming gRPC metadata.
+func extractTraceContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// tracingUnaryServerInterceptor traces each unary call to the plugin in a span that's a child of the
+// span that made the call in the Velero server.
+func tracingUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span := tracing.Tracer().Start(extractTraceContext(ctx), info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
+	res, err := handler(ctx, req)
+	tracing.EndSpan(span, err)
+	return res, err
+}
+
+// tracingStreamServerInterceptor traces each streaming call to the plugin in a span that's a child
+// of the span that made the call in the Velero server.
+func tracingStreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span := tracing.Tracer().Start(extractTraceContext(stream.Context()), info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
+	err := handler(srv, &tracedServerStream{ServerStream: stream, ctx: ctx})
+	tracing.EndSpan(span, err)
+	return err
+}
+
+// tracedServerStream is a grpc.ServerStream whose context holds the span of the call.
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// newGRPCServer returns the gRPC server that plugins are served with, which traces the calls to them.
+func newGRPCServer(opts []grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.UnaryInterceptor(tracingUnaryServerInterceptor),
+		grpc.StreamInterceptor(tracingStreamServerInterceptor),
+	)
+	return grpc.NewServer(opts...)
+}
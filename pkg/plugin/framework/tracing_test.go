@@ -0,0 +1,99 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCallContextPropagatesTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	}()
+
+	backupCtx, backupSpan := provider.Tracer("test").Start(context.Background(), "Backup")
+	defer backupSpan.End()
+
+	c := &clientBase{plugin: "velero.io/aws", ctx: backupCtx}
+	ctx, cancel := c.callContext("ObjectStore/PutObject")
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	require.Len(t, md.Get("traceparent"), 1)
+
+	// the plugin process sees the client span of the call as the parent of its own spans
+	remote := trace.SpanContextFromContext(extractTraceContext(metadata.NewIncomingContext(context.Background(), md)))
+	assert.True(t, remote.IsRemote())
+	assert.Equal(t, backupSpan.SpanContext().TraceID(), remote.TraceID())
+	assert.Equal(t, trace.SpanContextFromContext(ctx).SpanID(), remote.SpanID())
+
+	assert.Empty(t, recorder.Ended())
+	cancel()
+	cancel()
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, "ObjectStore/PutObject", ended[0].Name())
+	assert.Equal(t, trace.SpanKindClient, ended[0].SpanKind())
+	assert.Equal(t, backupSpan.SpanContext().SpanID(), ended[0].Parent().SpanID())
+	assert.Contains(t, ended[0].Attributes(), pluginNameAttribute.String("velero.io/aws"))
+}
+
+func TestItemCallContextIsChildOfItemSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	backupCtx, cancelBackup := context.WithCancel(context.Background())
+	backupCtx, backupSpan := provider.Tracer("test").Start(backupCtx, "Backup")
+	defer backupSpan.End()
+	itemCtx, itemSpan := provider.Tracer("test").Start(context.Background(), "BackupItem")
+	defer itemSpan.End()
+
+	c := &clientBase{plugin: "velero.io/pod", ctx: backupCtx}
+	ctx, cancel := c.itemCallContext(itemCtx, "BackupItemActionV2/Execute")
+
+	// the call is still cancelled with the client's context
+	cancelBackup()
+	assert.Error(t, ctx.Err())
+
+	cancel()
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, itemSpan.SpanContext().SpanID(), ended[0].Parent().SpanID())
+}
+
+func TestExtractTraceContextWithoutMetadata(t *testing.T) {
+	ctx := extractTraceContext(context.Background())
+	assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+}
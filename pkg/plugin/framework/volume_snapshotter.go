@@ -34,7 +34,7 @@ type VolumeSnapshotterPlugin struct {
 
 // GRPCClient returns a VolumeSnapshotter gRPC client.
 func (p *VolumeSnapshotterPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, clientConn *grpc.ClientConn) (interface{}, error) {
-	return newClientDispenser(p.clientLogger, clientConn, newVolumeSnapshotterGRPCClient), nil
+	return newClientDispenser(p.pluginBase, clientConn, newVolumeSnapshotterGRPCClient), nil
 }
 
 // GRPCServer registers a VolumeSnapshotter gRPC server.
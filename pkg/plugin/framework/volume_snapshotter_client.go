@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -58,8 +57,11 @@ func (c *VolumeSnapshotterGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	ctx, cancel := c.callContext("VolumeSnapshotter/Init")
+	defer cancel()
+
+	if _, err := c.grpcClient.Init(ctx, req); err != nil {
+		return c.callError(ctx, "Init", err)
 	}
 
 	return nil
@@ -81,9 +83,12 @@ func (c *VolumeSnapshotterGRPCClient) CreateVolumeFromSnapshot(snapshotID, volum
 		req.Iops = *iops
 	}
 
-	res, err := c.grpcClient.CreateVolumeFromSnapshot(context.Background(), req)
+	ctx, cancel := c.callContext("VolumeSnapshotter/CreateVolumeFromSnapshot")
+	defer cancel()
+
+	res, err := c.grpcClient.CreateVolumeFromSnapshot(ctx, req)
 	if err != nil {
-		return "", fromGRPCError(err)
+		return "", c.callError(ctx, "CreateVolumeFromSnapshot", err)
 	}
 
 	return res.VolumeID, nil
@@ -98,9 +103,12 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeInfo(volumeID, volumeAZ string) (
 		VolumeAZ: volumeAZ,
 	}
 
-	res, err := c.grpcClient.GetVolumeInfo(context.Background(), req)
+	ctx, cancel := c.callContext("VolumeSnapshotter/GetVolumeInfo")
+	defer cancel()
+
+	res, err := c.grpcClient.GetVolumeInfo(ctx, req)
 	if err != nil {
-		return "", nil, fromGRPCError(err)
+		return "", nil, c.callError(ctx, "GetVolumeInfo", err)
 	}
 
 	var iops *int64
@@ -121,9 +129,12 @@ func (c *VolumeSnapshotterGRPCClient) CreateSnapshot(volumeID, volumeAZ string,
 		Tags:     tags,
 	}
 
-	res, err := c.grpcClient.CreateSnapshot(context.Background(), req)
+	ctx, cancel := c.callContext("VolumeSnapshotter/CreateSnapshot")
+	defer cancel()
+
+	res, err := c.grpcClient.CreateSnapshot(ctx, req)
 	if err != nil {
-		return "", fromGRPCError(err)
+		return "", c.callError(ctx, "CreateSnapshot", err)
 	}
 
 	return res.SnapshotID, nil
@@ -136,8 +147,11 @@ func (c *VolumeSnapshotterGRPCClient) DeleteSnapshot(snapshotID string) error {
 		SnapshotID: snapshotID,
 	}
 
-	if _, err := c.grpcClient.DeleteSnapshot(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	ctx, cancel := c.callContext("VolumeSnapshotter/DeleteSnapshot")
+	defer cancel()
+
+	if _, err := c.grpcClient.DeleteSnapshot(ctx, req); err != nil {
+		return c.callError(ctx, "DeleteSnapshot", err)
 	}
 
 	return nil
@@ -154,9 +168,12 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeID(pv runtime.Unstructured) (stri
 		PersistentVolume: encodedPV,
 	}
 
-	resp, err := c.grpcClient.GetVolumeID(context.Background(), req)
+	ctx, cancel := c.callContext("VolumeSnapshotter/GetVolumeID")
+	defer cancel()
+
+	resp, err := c.grpcClient.GetVolumeID(ctx, req)
 	if err != nil {
-		return "", fromGRPCError(err)
+		return "", c.callError(ctx, "GetVolumeID", err)
 	}
 
 	return resp.VolumeID, nil
@@ -174,9 +191,12 @@ func (c *VolumeSnapshotterGRPCClient) SetVolumeID(pv runtime.Unstructured, volum
 		VolumeID:         volumeID,
 	}
 
-	resp, err := c.grpcClient.SetVolumeID(context.Background(), req)
+	ctx, cancel := c.callContext("VolumeSnapshotter/SetVolumeID")
+	defer cancel()
+
+	resp, err := c.grpcClient.SetVolumeID(ctx, req)
 	if err != nil {
-		return nil, fromGRPCError(err)
+		return nil, c.callError(ctx, "SetVolumeID", err)
 	}
 
 	var updatedPV unstructured.Unstructured
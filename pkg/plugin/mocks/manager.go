@@ -5,6 +5,7 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
 	isv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/item_snapshotter/v1"
 )
 
@@ -19,15 +20,15 @@ func (_m *Manager) CleanupClients() {
 }
 
 // GetBackupItemAction provides a mock function with given fields: name
-func (_m *Manager) GetBackupItemAction(name string) (velero.BackupItemAction, error) {
+func (_m *Manager) GetBackupItemAction(name string) (biav2.BackupItemAction, error) {
 	ret := _m.Called(name)
 
-	var r0 velero.BackupItemAction
-	if rf, ok := ret.Get(0).(func(string) velero.BackupItemAction); ok {
+	var r0 biav2.BackupItemAction
+	if rf, ok := ret.Get(0).(func(string) biav2.BackupItemAction); ok {
 		r0 = rf(name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(velero.BackupItemAction)
+			r0 = ret.Get(0).(biav2.BackupItemAction)
 		}
 	}
 
@@ -42,15 +43,15 @@ func (_m *Manager) GetBackupItemAction(name string) (velero.BackupItemAction, er
 }
 
 // GetBackupItemActions provides a mock function with given fields:
-func (_m *Manager) GetBackupItemActions() ([]velero.BackupItemAction, error) {
+func (_m *Manager) GetBackupItemActions() ([]biav2.BackupItemAction, error) {
 	ret := _m.Called()
 
-	var r0 []velero.BackupItemAction
-	if rf, ok := ret.Get(0).(func() []velero.BackupItemAction); ok {
+	var r0 []biav2.BackupItemAction
+	if rf, ok := ret.Get(0).(func() []biav2.BackupItemAction); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]velero.BackupItemAction)
+			r0 = ret.Get(0).([]biav2.BackupItemAction)
 		}
 	}
 
@@ -133,6 +134,190 @@ func (_m *Manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	return r0, r1
 }
 
+// GetPostBackupAction provides a mock function with given fields: name
+func (_m *Manager) GetPostBackupAction(name string) (velero.PostBackupAction, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.PostBackupAction
+	if rf, ok := ret.Get(0).(func(string) velero.PostBackupAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.PostBackupAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostBackupActions provides a mock function with given fields:
+func (_m *Manager) GetPostBackupActions() (map[string]velero.PostBackupAction, error) {
+	ret := _m.Called()
+
+	var r0 map[string]velero.PostBackupAction
+	if rf, ok := ret.Get(0).(func() map[string]velero.PostBackupAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]velero.PostBackupAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostRestoreAction provides a mock function with given fields: name
+func (_m *Manager) GetPostRestoreAction(name string) (velero.PostRestoreAction, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.PostRestoreAction
+	if rf, ok := ret.Get(0).(func(string) velero.PostRestoreAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.PostRestoreAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostRestoreActions provides a mock function with given fields:
+func (_m *Manager) GetPostRestoreActions() (map[string]velero.PostRestoreAction, error) {
+	ret := _m.Called()
+
+	var r0 map[string]velero.PostRestoreAction
+	if rf, ok := ret.Get(0).(func() map[string]velero.PostRestoreAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]velero.PostRestoreAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPreBackupAction provides a mock function with given fields: name
+func (_m *Manager) GetPreBackupAction(name string) (velero.PreBackupAction, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.PreBackupAction
+	if rf, ok := ret.Get(0).(func(string) velero.PreBackupAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.PreBackupAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPreBackupActions provides a mock function with given fields:
+func (_m *Manager) GetPreBackupActions() (map[string]velero.PreBackupAction, error) {
+	ret := _m.Called()
+
+	var r0 map[string]velero.PreBackupAction
+	if rf, ok := ret.Get(0).(func() map[string]velero.PreBackupAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]velero.PreBackupAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPreRestoreAction provides a mock function with given fields: name
+func (_m *Manager) GetPreRestoreAction(name string) (velero.PreRestoreAction, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.PreRestoreAction
+	if rf, ok := ret.Get(0).(func(string) velero.PreRestoreAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.PreRestoreAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPreRestoreActions provides a mock function with given fields:
+func (_m *Manager) GetPreRestoreActions() (map[string]velero.PreRestoreAction, error) {
+	ret := _m.Called()
+
+	var r0 map[string]velero.PreRestoreAction
+	if rf, ok := ret.Get(0).(func() map[string]velero.PreRestoreAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]velero.PreRestoreAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoreItemAction provides a mock function with given fields: name
 func (_m *Manager) GetRestoreItemAction(name string) (velero.RestoreItemAction, error) {
 	ret := _m.Called(name)
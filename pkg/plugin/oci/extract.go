@@ -0,0 +1,118 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// imagePluginDir is the directory in a plugin image that contains its plugin executables.
+	imagePluginDir = "plugins"
+
+	whiteoutPrefix = ".wh."
+	opaqueWhiteout = ".wh..wh..opq"
+)
+
+// extractPlugins extracts the plugin executables in the layer at layerPath into dir. Layers must be
+// extracted in order, so that files deleted by later layers are removed from dir.
+func extractPlugins(layerPath, dir string) error {
+	file, err := os.Open(layerPath)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var layer io.Reader = reader
+	if magic, err := reader.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return errors.Wrap(err, "error decompressing layer")
+		}
+		defer gzipReader.Close()
+		layer = gzipReader
+	}
+
+	tarReader := tar.NewReader(layer)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error reading layer")
+		}
+
+		name := strings.TrimPrefix(path.Clean("/"+header.Name), "/")
+		dirName, baseName := path.Split(name)
+
+		switch {
+		case name == whiteoutPrefix+imagePluginDir, dirName == imagePluginDir+"/" && baseName == opaqueWhiteout:
+			// The layer deletes the plugins directory, or everything that earlier layers put in it.
+			if err := removeAll(dir); err != nil {
+				return err
+			}
+		case dirName != imagePluginDir+"/":
+			// Only the executables directly in the plugins directory are plugins.
+		case strings.HasPrefix(baseName, whiteoutPrefix):
+			if err := os.Remove(filepath.Join(dir, strings.TrimPrefix(baseName, whiteoutPrefix))); err != nil && !os.IsNotExist(err) {
+				return errors.WithStack(err)
+			}
+		case header.Typeflag == tar.TypeReg && header.Mode&0111 != 0:
+			if err := writeExecutable(filepath.Join(dir, baseName), tarReader); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// writeExecutable writes the content of r to an executable file at path.
+func writeExecutable(path string, r io.Reader) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return errors.Wrapf(err, "error extracting %s", filepath.Base(path))
+	}
+	return errors.WithStack(file.Close())
+}
+
+// removeAll removes everything in dir.
+func removeAll(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
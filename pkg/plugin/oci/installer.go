@@ -0,0 +1,313 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// Installer installs the plugins in the images of VeleroPlugins into the plugin directory.
+type Installer struct {
+	client     kbclient.Client
+	namespace  string
+	pluginDir  string
+	httpClient *http.Client
+	clock      clock.Clock
+	logger     logrus.FieldLogger
+}
+
+// NewInstaller returns an Installer for the VeleroPlugins in namespace that installs plugins into
+// pluginDir, pulling images with httpClient.
+func NewInstaller(client kbclient.Client, namespace, pluginDir string, httpClient *http.Client, clock clock.Clock, logger logrus.FieldLogger) *Installer {
+	return &Installer{
+		client:     client,
+		namespace:  namespace,
+		pluginDir:  pluginDir,
+		httpClient: httpClient,
+		clock:      clock,
+		logger:     logger,
+	}
+}
+
+// Install installs the plugins in the images of all of the VeleroPlugins in the Installer's
+// namespace, and records the result in each VeleroPlugin's status. Images that can't be pulled or
+// verified are skipped, so an error is only returned if the VeleroPlugins can't be listed.
+func (i *Installer) Install(ctx context.Context) error {
+	plugins := new(velerov1api.VeleroPluginList)
+	if err := i.client.List(ctx, plugins, kbclient.InNamespace(i.namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			i.logger.Info("VeleroPlugin custom resource definition not found, not installing plugins from images")
+			return nil
+		}
+		return errors.Wrap(err, "error listing VeleroPlugins")
+	}
+
+	for idx := range plugins.Items {
+		plugin := &plugins.Items[idx]
+		log := i.logger.WithField("veleroPlugin", plugin.Name)
+
+		original := plugin.DeepCopy()
+		plugin.Status = i.installPlugin(ctx, plugin, log)
+
+		if err := i.client.Status().Patch(ctx, plugin, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(err).Error("Error updating VeleroPlugin status")
+		}
+	}
+
+	return nil
+}
+
+// installPlugin installs the plugins in the images of plugin, returning its new status.
+func (i *Installer) installPlugin(ctx context.Context, plugin *velerov1api.VeleroPlugin, log logrus.FieldLogger) velerov1api.VeleroPluginStatus {
+	status := velerov1api.VeleroPluginStatus{
+		LastInstallTimestamp: &metav1.Time{Time: i.clock.Now()},
+	}
+
+	var keychain Keychain
+	var keychainErr error
+	if plugin.Spec.ImagePullSecret != nil {
+		keychain, keychainErr = i.keychain(ctx, plugin.Spec.ImagePullSecret.Name)
+	}
+	client := NewClient(i.httpClient, keychain)
+
+	installed := 0
+	for _, image := range plugin.Spec.Images {
+		imageLog := log.WithField("image", image.Image)
+
+		imageStatus := velerov1api.PluginImageStatus{
+			Image:        image.Image,
+			Verification: velerov1api.SignatureVerificationPhaseUnverified,
+		}
+
+		var err error
+		if keychainErr != nil {
+			err = keychainErr
+		} else {
+			err = i.installImage(ctx, client, image, &imageStatus, imageLog)
+		}
+
+		if err != nil {
+			imageLog.WithError(err).Error("Error installing plugins from image")
+			imageStatus.Phase = velerov1api.PluginImagePhaseFailed
+			imageStatus.Message = err.Error()
+		} else {
+			imageLog.WithField("executables", imageStatus.Executables).Info("Installed plugins from image")
+			imageStatus.Phase = velerov1api.PluginImagePhaseInstalled
+			installed++
+		}
+		status.Images = append(status.Images, imageStatus)
+	}
+
+	switch installed {
+	case len(plugin.Spec.Images):
+		status.Phase = velerov1api.VeleroPluginPhaseInstalled
+	case 0:
+		status.Phase = velerov1api.VeleroPluginPhaseFailed
+	default:
+		status.Phase = velerov1api.VeleroPluginPhasePartiallyFailed
+	}
+
+	return status
+}
+
+// installImage verifies image's signature, if it has a verification policy, and installs its plugins,
+// recording the result in status.
+func (i *Installer) installImage(ctx context.Context, client *Client, image velerov1api.PluginImage, status *velerov1api.PluginImageStatus, log logrus.FieldLogger) error {
+	ref, err := ParseReference(image.Image)
+	if err != nil {
+		return err
+	}
+
+	if image.Verification != nil {
+		verifier, err := i.verifier(ctx, image.Verification)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Verifying image signature")
+		if err := verifier.Verify(ctx, client, ref); err != nil {
+			status.Verification = velerov1api.SignatureVerificationPhaseFailed
+			return errors.Wrap(err, "error verifying image signature")
+		}
+		status.Verification = velerov1api.SignatureVerificationPhaseVerified
+	}
+
+	log.Debug("Pulling image")
+	manifest, err := client.GetImageManifest(ctx, ref)
+	if err != nil {
+		return err
+	}
+
+	// The plugins are extracted into a directory inside the plugin directory so that they can
+	// be moved into it once all of the layers have been extracted.
+	stagingDir, err := os.MkdirTemp(i.pluginDir, ".install-")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.RemoveAll(stagingDir)
+
+	executablesDir := filepath.Join(stagingDir, imagePluginDir)
+	if err := os.Mkdir(executablesDir, 0755); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, layer := range manifest.Layers {
+		layerPath, err := client.DownloadBlob(ctx, ref, layer, stagingDir)
+		if err != nil {
+			return err
+		}
+		err = extractPlugins(layerPath, executablesDir)
+		os.Remove(layerPath)
+		if err != nil {
+			return errors.Wrapf(err, "error extracting layer %s", layer.Digest)
+		}
+	}
+
+	entries, err := os.ReadDir(executablesDir)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(entries) == 0 {
+		return errors.Errorf("image has no executables in its /%s directory", imagePluginDir)
+	}
+
+	// Executables that are already in the plugin directory, such as the ones copied there by
+	// init containers or installed from another image, aren't replaced, since they may be
+	// running. Nothing from the image is installed if any of its executables collide.
+	var collisions []string
+	for _, entry := range entries {
+		collision, err := collides(filepath.Join(executablesDir, entry.Name()), filepath.Join(i.pluginDir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if collision {
+			collisions = append(collisions, entry.Name())
+		}
+	}
+	if len(collisions) > 0 {
+		return errors.Errorf("executables %s already exist in the plugin directory, not replacing them", strings.Join(collisions, ", "))
+	}
+
+	for _, entry := range entries {
+		if err := os.Rename(filepath.Join(executablesDir, entry.Name()), filepath.Join(i.pluginDir, entry.Name())); err != nil {
+			return errors.WithStack(err)
+		}
+		status.Executables = append(status.Executables, entry.Name())
+	}
+	sort.Strings(status.Executables)
+
+	return nil
+}
+
+// collides returns true if there's already a file at path that doesn't have the same content as
+// the file at newPath. Installing a file with the same content again, for example when the Velero
+// server restarts, doesn't replace anything.
+func collides(newPath, path string) (bool, error) {
+	existing, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	if !existing.Mode().IsRegular() {
+		return true, nil
+	}
+
+	newHash, err := fileHash(newPath)
+	if err != nil {
+		return false, err
+	}
+	hash, err := fileHash(path)
+	if err != nil {
+		return false, err
+	}
+	return !bytes.Equal(newHash, hash), nil
+}
+
+// fileHash returns the SHA-256 hash of the content of the file at path.
+func fileHash(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return h.Sum(nil), nil
+}
+
+// verifier returns the Verifier for policy.
+func (i *Installer) verifier(ctx context.Context, policy *velerov1api.SignaturePolicy) (Verifier, error) {
+	key, err := i.secretKey(ctx, policy.Key.Name, policy.Key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	switch policy.Type {
+	case velerov1api.SignatureTypeCosign:
+		return NewCosignVerifier(key)
+	case velerov1api.SignatureTypeNotation:
+		return NewNotationVerifier(key, policy.TrustedIdentities, i.clock)
+	default:
+		return nil, errors.Errorf("unsupported signature type %q", policy.Type)
+	}
+}
+
+// keychain returns a Keychain with the credentials in the image pull secret with name.
+func (i *Installer) keychain(ctx context.Context, name string) (Keychain, error) {
+	data, err := i.secretKey(ctx, name, corev1api.DockerConfigJsonKey)
+	if err != nil {
+		return nil, err
+	}
+	return KeychainFromDockerConfig(data)
+}
+
+// secretKey returns the value of key in the secret with name.
+func (i *Installer) secretKey(ctx context.Context, name, key string) ([]byte, error) {
+	secret := new(corev1api.Secret)
+	if err := i.client.Get(ctx, kbclient.ObjectKey{Namespace: i.namespace, Name: name}, secret); err != nil {
+		return nil, errors.Wrapf(err, "error getting secret %s", name)
+	}
+
+	value, found := secret.Data[key]
+	if !found {
+		return nil, errors.Errorf("secret %s has no key %s", name, key)
+	}
+	return value, nil
+}
@@ -0,0 +1,236 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestInstallerInstall(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	key := newTestKey(t)
+	otherKey := newTestKey(t)
+
+	registry := newFakeRegistry(t)
+	registry.credentials = &Credentials{Username: "user", Password: "pass"}
+
+	// The second layer of the signed image deletes a plugin added by the first, and only executables
+	// directly in the plugins directory are installed.
+	signed := registry.addImage(
+		[]testFile{
+			{name: "plugins/velero-plugin-deleted", content: "deleted", mode: 0755},
+			{name: "usr/bin/not-a-plugin", content: "not a plugin", mode: 0755},
+		},
+		[]testFile{
+			{name: "./plugins/velero-plugin-signed", content: "signed", mode: 0755},
+			{name: "plugins/.wh.velero-plugin-deleted"},
+			{name: "plugins/README.md", content: "not executable", mode: 0644},
+			{name: "plugins/nested/velero-plugin-nested", content: "nested", mode: 0755},
+		},
+	)
+	signCosign(t, registry, signed.Digest, key)
+
+	unverified := registry.addImage([]testFile{{name: "plugins/velero-plugin-unverified", content: "unverified", mode: 0755}})
+
+	signedByOtherKey := registry.addImage([]testFile{{name: "plugins/velero-plugin-untrusted", content: "untrusted", mode: 0755}})
+	signCosign(t, registry, signedByOtherKey.Digest, otherKey)
+
+	noPlugins := registry.addImage([]testFile{{name: "usr/bin/not-a-plugin", content: "not a plugin", mode: 0755}})
+
+	policy := &velerov1api.SignaturePolicy{
+		Type: velerov1api.SignatureTypeCosign,
+		Key: corev1api.SecretKeySelector{
+			LocalObjectReference: corev1api.LocalObjectReference{Name: "cosign"},
+			Key:                  "cosign.pub",
+		},
+	}
+	auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForSecret("velero", "cosign").Data(map[string][]byte{"cosign.pub": publicKeyPEM(t, key)}).Result(),
+		builder.ForSecret("velero", "registry").Data(map[string][]byte{
+			corev1api.DockerConfigJsonKey: []byte(fmt.Sprintf(`{"auths":{"%s":{"auth":"%s"}}}`, signed.Registry, auth)),
+		}).Result(),
+		builder.ForVeleroPlugin("velero", "plugins").
+			ImagePullSecret("registry").
+			Image(signed.String(), policy).
+			Image(unverified.String(), nil).
+			Image(signedByOtherKey.String(), policy).
+			Image(noPlugins.String(), nil).
+			Result(),
+		builder.ForVeleroPlugin("velero", "no-credentials").
+			Image(unverified.String(), nil).
+			Result(),
+		builder.ForVeleroPlugin("velero", "missing-secret").
+			ImagePullSecret("missing").
+			Image(unverified.String(), nil).
+			Result(),
+	)
+
+	pluginDir, err := ioutil.TempDir("", "plugins")
+	require.NoError(t, err)
+	defer os.RemoveAll(pluginDir)
+
+	installer := NewInstaller(client, "velero", pluginDir, registry.server.Client(), clock.NewFakeClock(now), velerotest.NewLogger())
+	require.NoError(t, installer.Install(context.Background()))
+
+	// Only the plugins from the images that were pulled and verified are installed, and
+	// nothing else is left in the plugin directory.
+	entries, err := os.ReadDir(pluginDir)
+	require.NoError(t, err)
+	var installed []string
+	for _, entry := range entries {
+		installed = append(installed, entry.Name())
+	}
+	assert.Equal(t, []string{"velero-plugin-signed", "velero-plugin-unverified"}, installed)
+
+	info, err := os.Stat(filepath.Join(pluginDir, "velero-plugin-signed"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	content, err := ioutil.ReadFile(filepath.Join(pluginDir, "velero-plugin-signed"))
+	require.NoError(t, err)
+	assert.Equal(t, "signed", string(content))
+
+	getStatus := func(name string) velerov1api.VeleroPluginStatus {
+		plugin := new(velerov1api.VeleroPlugin)
+		require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: "velero", Name: name}, plugin))
+		return plugin.Status
+	}
+
+	status := getStatus("plugins")
+	assert.Equal(t, velerov1api.VeleroPluginPhasePartiallyFailed, status.Phase)
+	require.NotNil(t, status.LastInstallTimestamp)
+	assert.True(t, now.Equal(status.LastInstallTimestamp.Time))
+	require.Len(t, status.Images, 4)
+
+	assert.Equal(t, velerov1api.PluginImageStatus{
+		Image:        signed.String(),
+		Phase:        velerov1api.PluginImagePhaseInstalled,
+		Verification: velerov1api.SignatureVerificationPhaseVerified,
+		Executables:  []string{"velero-plugin-signed"},
+	}, status.Images[0])
+
+	assert.Equal(t, velerov1api.PluginImageStatus{
+		Image:        unverified.String(),
+		Phase:        velerov1api.PluginImagePhaseInstalled,
+		Verification: velerov1api.SignatureVerificationPhaseUnverified,
+		Executables:  []string{"velero-plugin-unverified"},
+	}, status.Images[1])
+
+	assert.Equal(t, velerov1api.PluginImagePhaseFailed, status.Images[2].Phase)
+	assert.Equal(t, velerov1api.SignatureVerificationPhaseFailed, status.Images[2].Verification)
+	assert.Contains(t, status.Images[2].Message, "error verifying image signature")
+	assert.Empty(t, status.Images[2].Executables)
+
+	assert.Equal(t, velerov1api.PluginImagePhaseFailed, status.Images[3].Phase)
+	assert.Contains(t, status.Images[3].Message, "image has no executables in its /plugins directory")
+
+	status = getStatus("no-credentials")
+	assert.Equal(t, velerov1api.VeleroPluginPhaseFailed, status.Phase)
+	require.Len(t, status.Images, 1)
+	assert.Contains(t, status.Images[0].Message, "error getting token")
+
+	status = getStatus("missing-secret")
+	assert.Equal(t, velerov1api.VeleroPluginPhaseFailed, status.Phase)
+	require.Len(t, status.Images, 1)
+	assert.Contains(t, status.Images[0].Message, "error getting secret missing")
+}
+
+func TestInstallerInstallNameCollisions(t *testing.T) {
+	registry := newFakeRegistry(t)
+	image := registry.addImage([]testFile{
+		{name: "plugins/velero-plugin-a", content: "a", mode: 0755},
+		{name: "plugins/velero-plugin-b", content: "b", mode: 0755},
+	})
+
+	tests := []struct {
+		name        string
+		existing    map[string]string
+		wantErr     string
+		wantContent map[string]string
+	}{
+		{
+			name:        "executables that aren't in the plugin directory are installed",
+			wantContent: map[string]string{"velero-plugin-a": "a", "velero-plugin-b": "b"},
+		},
+		{
+			name:        "executables that are already installed with the same content are installed again",
+			existing:    map[string]string{"velero-plugin-a": "a", "velero-plugin-b": "b"},
+			wantContent: map[string]string{"velero-plugin-a": "a", "velero-plugin-b": "b"},
+		},
+		{
+			name:        "nothing is installed if an executable with the same name and different content exists",
+			existing:    map[string]string{"velero-plugin-b": "other"},
+			wantErr:     "executables velero-plugin-b already exist in the plugin directory, not replacing them",
+			wantContent: map[string]string{"velero-plugin-b": "other"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginDir, err := ioutil.TempDir("", "plugins")
+			require.NoError(t, err)
+			defer os.RemoveAll(pluginDir)
+
+			for name, content := range tc.existing {
+				require.NoError(t, ioutil.WriteFile(filepath.Join(pluginDir, name), []byte(content), 0755))
+			}
+
+			client := velerotest.NewFakeControllerRuntimeClient(t, builder.ForVeleroPlugin("velero", "plugins").Image(image.String(), nil).Result())
+			installer := NewInstaller(client, "velero", pluginDir, registry.server.Client(), clock.NewFakeClock(time.Now()), velerotest.NewLogger())
+			require.NoError(t, installer.Install(context.Background()))
+
+			plugin := new(velerov1api.VeleroPlugin)
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: "velero", Name: "plugins"}, plugin))
+			require.Len(t, plugin.Status.Images, 1)
+			if tc.wantErr != "" {
+				assert.Equal(t, velerov1api.PluginImagePhaseFailed, plugin.Status.Images[0].Phase)
+				assert.Contains(t, plugin.Status.Images[0].Message, tc.wantErr)
+			} else {
+				assert.Equal(t, velerov1api.PluginImagePhaseInstalled, plugin.Status.Images[0].Phase)
+			}
+
+			entries, err := os.ReadDir(pluginDir)
+			require.NoError(t, err)
+			content := make(map[string]string)
+			for _, entry := range entries {
+				data, err := ioutil.ReadFile(filepath.Join(pluginDir, entry.Name()))
+				require.NoError(t, err)
+				content[entry.Name()] = string(data)
+			}
+			assert.Equal(t, tc.wantContent, content)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// dockerConfig is the content of a secret of type kubernetes.io/dockerconfigjson.
+type dockerConfig struct {
+	Auths map[string]struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Auth     string `json:"auth"`
+	} `json:"auths"`
+}
+
+// KeychainFromDockerConfig returns a Keychain with the credentials in the .dockerconfigjson key of
+// a secret of type kubernetes.io/dockerconfigjson.
+func KeychainFromDockerConfig(data []byte) (Keychain, error) {
+	var config dockerConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, errors.Wrap(err, "error decoding docker config")
+	}
+
+	credentials := make(map[string]Credentials, len(config.Auths))
+	for server, auth := range config.Auths {
+		creds := Credentials{Username: auth.Username, Password: auth.Password}
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error decoding docker config credentials for %s", server)
+			}
+			username, password, found := cut(string(decoded), ":")
+			if !found {
+				return nil, errors.Errorf("docker config credentials for %s are not of the form username:password", server)
+			}
+			creds = Credentials{Username: username, Password: password}
+		}
+		credentials[registryHost(server)] = creds
+	}
+
+	return func(registry string) (Credentials, bool) {
+		creds, found := credentials[registry]
+		return creds, found
+	}, nil
+}
+
+// registryHost returns the host of a registry server in a docker config, which may be a URL.
+func registryHost(server string) string {
+	host := server
+	if _, rest, found := cut(host, "://"); found {
+		host = rest
+	}
+	host, _, _ = cut(host, "/")
+
+	switch strings.ToLower(host) {
+	case dockerHubDomain, "index." + dockerHubDomain:
+		return dockerHubRegistry
+	}
+	return host
+}
@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	dockerHubDomain   = "docker.io"
+	dockerHubRegistry = "registry-1.docker.io"
+)
+
+var digestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// Reference is an image reference that's pinned to a digest.
+type Reference struct {
+	// Registry is the host (and optional port) of the image's registry.
+	Registry string
+	// Repository is the image's repository within the registry.
+	Repository string
+	// Digest is the digest of the image's manifest or index.
+	Digest string
+}
+
+// ParseReference parses an image reference of the form [registry/]repository[:tag]@sha256:<digest>.
+// References without a registry refer to Docker Hub. References without a digest are rejected,
+// since they don't identify the image's content.
+func ParseReference(image string) (Reference, error) {
+	name, digest, found := cut(image, "@")
+	if !found {
+		return Reference{}, errors.Errorf("image reference %q must include a digest", image)
+	}
+	if !digestRegexp.MatchString(digest) {
+		return Reference{}, errors.Errorf("image reference %q has an invalid sha256 digest", image)
+	}
+
+	// The tag is ignored, since the digest identifies the image.
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	if name == "" {
+		return Reference{}, errors.Errorf("image reference %q has no repository", image)
+	}
+
+	ref := Reference{Registry: dockerHubRegistry, Repository: name, Digest: digest}
+
+	// The first component of the name is a registry if it looks like a host name.
+	if first, rest, found := cut(name, "/"); found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		ref.Registry = first
+		ref.Repository = rest
+		if first == dockerHubDomain || first == "index."+dockerHubDomain {
+			ref.Registry = dockerHubRegistry
+		}
+	}
+
+	if ref.Registry == dockerHubRegistry && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = "library/" + ref.Repository
+	}
+
+	if ref.Repository == "" || ref.Repository != strings.ToLower(ref.Repository) {
+		return Reference{}, errors.Errorf("image reference %q has an invalid repository", image)
+	}
+
+	return ref, nil
+}
+
+// String returns the reference in the form registry/repository@digest.
+func (r Reference) String() string {
+	return r.Registry + "/" + r.Repository + "@" + r.Digest
+}
+
+// cut slices s around the first instance of sep.
+func cut(s, sep string) (before, after string, found bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
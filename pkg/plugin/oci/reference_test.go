@@ -0,0 +1,90 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	tests := []struct {
+		name    string
+		image   string
+		want    Reference
+		wantErr string
+	}{
+		{
+			name:  "official Docker Hub image",
+			image: "velero@" + digest,
+			want:  Reference{Registry: "registry-1.docker.io", Repository: "library/velero", Digest: digest},
+		},
+		{
+			name:  "Docker Hub image with a tag",
+			image: "velero/velero-plugin-for-aws:v1.4.0@" + digest,
+			want:  Reference{Registry: "registry-1.docker.io", Repository: "velero/velero-plugin-for-aws", Digest: digest},
+		},
+		{
+			name:  "Docker Hub image with the docker.io registry",
+			image: "docker.io/velero/velero-plugin-for-aws@" + digest,
+			want:  Reference{Registry: "registry-1.docker.io", Repository: "velero/velero-plugin-for-aws", Digest: digest},
+		},
+		{
+			name:  "image in a registry with a port",
+			image: "registry.example.com:5000/team/plugins/aws:latest@" + digest,
+			want:  Reference{Registry: "registry.example.com:5000", Repository: "team/plugins/aws", Digest: digest},
+		},
+		{
+			name:  "image in a localhost registry",
+			image: "localhost/plugins@" + digest,
+			want:  Reference{Registry: "localhost", Repository: "plugins", Digest: digest},
+		},
+		{
+			name:    "image without a digest",
+			image:   "velero/velero-plugin-for-aws:v1.4.0",
+			wantErr: "must include a digest",
+		},
+		{
+			name:    "image with an invalid digest",
+			image:   "velero/velero-plugin-for-aws@sha256:abc",
+			wantErr: "invalid sha256 digest",
+		},
+		{
+			name:    "image with an uppercase repository",
+			image:   "velero/Velero@" + digest,
+			wantErr: "invalid repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, err := ParseReference(tc.image)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ref)
+		})
+	}
+}
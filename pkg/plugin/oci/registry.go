@@ -0,0 +1,412 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Media types of the manifests, indexes and layers that can be pulled.
+const (
+	MediaTypeImageManifest       = "application/vnd.oci.image.manifest.v1+json"
+	MediaTypeImageIndex          = "application/vnd.oci.image.index.v1+json"
+	MediaTypeDockerManifest      = "application/vnd.docker.distribution.manifest.v2+json"
+	MediaTypeDockerManifestList  = "application/vnd.docker.distribution.manifest.list.v2+json"
+	MediaTypeImageLayer          = "application/vnd.oci.image.layer.v1.tar"
+	MediaTypeImageLayerGzip      = "application/vnd.oci.image.layer.v1.tar+gzip"
+	MediaTypeDockerLayerGzip     = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+	mediaTypeManifestAcceptValue = MediaTypeImageManifest + "," + MediaTypeImageIndex + "," + MediaTypeDockerManifest + "," + MediaTypeDockerManifestList
+)
+
+// maxManifestSize is the largest manifest or signature that will be read into memory.
+const maxManifestSize = 4 << 20
+
+// Descriptor describes content in a registry.
+type Descriptor struct {
+	MediaType    string            `json:"mediaType,omitempty"`
+	ArtifactType string            `json:"artifactType,omitempty"`
+	Digest       string            `json:"digest"`
+	Size         int64             `json:"size"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	Platform     *Platform         `json:"platform,omitempty"`
+}
+
+// Platform is the platform an image in an index is built for.
+type Platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// Manifest is an image manifest or an image index.
+type Manifest struct {
+	MediaType    string       `json:"mediaType,omitempty"`
+	ArtifactType string       `json:"artifactType,omitempty"`
+	Config       Descriptor   `json:"config"`
+	Layers       []Descriptor `json:"layers,omitempty"`
+	Manifests    []Descriptor `json:"manifests,omitempty"`
+}
+
+// isIndex returns true if the manifest is an index of the manifests of an image for several platforms.
+func (m *Manifest) isIndex() bool {
+	return m.MediaType == MediaTypeImageIndex || m.MediaType == MediaTypeDockerManifestList || (m.MediaType == "" && len(m.Manifests) > 0)
+}
+
+// Credentials are the credentials for a registry.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Keychain returns the credentials for a registry, if it has any.
+type Keychain func(registry string) (Credentials, bool)
+
+// Client pulls content from OCI registries.
+type Client struct {
+	httpClient *http.Client
+	keychain   Keychain
+
+	// lock guards tokens
+	lock sync.Mutex
+	// tokens are the bearer tokens for each registry and repository.
+	tokens map[string]string
+}
+
+// NewClient returns a Client that makes requests with httpClient, authenticating with the
+// credentials in keychain, which may be nil.
+func NewClient(httpClient *http.Client, keychain Keychain) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		httpClient: httpClient,
+		keychain:   keychain,
+		tokens:     make(map[string]string),
+	}
+}
+
+// GetManifest gets the manifest or index with digest from ref's repository, verifying that its
+// content matches digest.
+func (c *Client) GetManifest(ctx context.Context, ref Reference, digest string) (*Manifest, []byte, error) {
+	body, err := c.getManifestBytes(ctx, ref, digest)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := verifyDigest(body, digest); err != nil {
+		return nil, nil, errors.Wrapf(err, "error verifying manifest %s", digest)
+	}
+
+	manifest := new(Manifest)
+	if err := json.Unmarshal(body, manifest); err != nil {
+		return nil, nil, errors.Wrapf(err, "error decoding manifest %s", digest)
+	}
+	return manifest, body, nil
+}
+
+// GetTaggedManifest gets the manifest tagged tag in ref's repository. It returns nil if there's
+// no manifest with tag.
+func (c *Client) GetTaggedManifest(ctx context.Context, ref Reference, tag string) (*Manifest, error) {
+	body, err := c.getManifestBytes(ctx, ref, tag)
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := new(Manifest)
+	if err := json.Unmarshal(body, manifest); err != nil {
+		return nil, errors.Wrapf(err, "error decoding manifest %s", tag)
+	}
+	return manifest, nil
+}
+
+// GetImageManifest gets the image manifest for ref. If ref is an index, the manifest for the
+// current platform is returned.
+func (c *Client) GetImageManifest(ctx context.Context, ref Reference) (*Manifest, error) {
+	manifest, _, err := c.GetManifest(ctx, ref, ref.Digest)
+	if err != nil {
+		return nil, err
+	}
+	if !manifest.isIndex() {
+		return manifest, nil
+	}
+
+	for _, desc := range manifest.Manifests {
+		if desc.Platform != nil && desc.Platform.OS == runtime.GOOS && desc.Platform.Architecture == runtime.GOARCH {
+			platformManifest, _, err := c.GetManifest(ctx, ref, desc.Digest)
+			return platformManifest, err
+		}
+	}
+	return nil, errors.Errorf("image %s has no manifest for platform %s/%s", ref, runtime.GOOS, runtime.GOARCH)
+}
+
+// Referrers lists the manifests in ref's repository that refer to the content with digest and
+// have artifactType.
+func (c *Client) Referrers(ctx context.Context, ref Reference, digest, artifactType string) ([]Descriptor, error) {
+	path := fmt.Sprintf("referrers/%s?artifactType=%s", digest, url.QueryEscape(artifactType))
+	res, err := c.do(ctx, ref, path, MediaTypeImageIndex)
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	index := new(Manifest)
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxManifestSize)).Decode(index); err != nil {
+		return nil, errors.Wrapf(err, "error decoding referrers of %s", digest)
+	}
+
+	// Registries may ignore the artifactType filter.
+	var referrers []Descriptor
+	for _, desc := range index.Manifests {
+		if desc.ArtifactType == artifactType {
+			referrers = append(referrers, desc)
+		}
+	}
+	return referrers, nil
+}
+
+// GetBlob gets the blob described by desc into memory, verifying that its content matches its digest.
+// It's meant for small blobs such as signatures.
+func (c *Client) GetBlob(ctx context.Context, ref Reference, desc Descriptor) ([]byte, error) {
+	if desc.Size > maxManifestSize {
+		return nil, errors.Errorf("blob %s is too large", desc.Digest)
+	}
+
+	res, err := c.do(ctx, ref, "blobs/"+desc.Digest, "")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxManifestSize))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading blob %s", desc.Digest)
+	}
+	if err := verifyDigest(body, desc.Digest); err != nil {
+		return nil, errors.Wrapf(err, "error verifying blob %s", desc.Digest)
+	}
+	return body, nil
+}
+
+// DownloadBlob downloads the blob described by desc into a temporary file in dir, verifying that
+// its content matches its digest. The caller must remove the file.
+func (c *Client) DownloadBlob(ctx context.Context, ref Reference, desc Descriptor, dir string) (string, error) {
+	res, err := c.do(ctx, ref, "blobs/"+desc.Digest, "")
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	file, err := ioutil.TempFile(dir, "blob-")
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, hash), res.Body); err != nil {
+		os.Remove(file.Name())
+		return "", errors.Wrapf(err, "error downloading blob %s", desc.Digest)
+	}
+	if actual := "sha256:" + hex.EncodeToString(hash.Sum(nil)); actual != desc.Digest {
+		os.Remove(file.Name())
+		return "", errors.Errorf("error verifying blob %s: content has digest %s", desc.Digest, actual)
+	}
+
+	return file.Name(), nil
+}
+
+var errNotFound = errors.New("not found")
+
+func (c *Client) getManifestBytes(ctx context.Context, ref Reference, reference string) ([]byte, error) {
+	res, err := c.do(ctx, ref, "manifests/"+reference, mediaTypeManifestAcceptValue)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxManifestSize))
+	return body, errors.Wrapf(err, "error reading manifest %s", reference)
+}
+
+// do makes a GET request for path in ref's repository, authenticating if the registry requires it.
+func (c *Client) do(ctx context.Context, ref Reference, path, accept string) (*http.Response, error) {
+	u := fmt.Sprintf("https://%s/v2/%s/%s", ref.Registry, ref.Repository, path)
+
+	tokenKey := ref.Registry + "/" + ref.Repository
+	c.lock.Lock()
+	token := c.tokens[tokenKey]
+	c.lock.Unlock()
+
+	res, err := c.get(ctx, u, accept, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusUnauthorized {
+		challenge := res.Header.Get("WWW-Authenticate")
+		res.Body.Close()
+
+		token, err = c.authenticate(ctx, ref, challenge)
+		if err != nil {
+			return nil, err
+		}
+
+		c.lock.Lock()
+		c.tokens[tokenKey] = token
+		c.lock.Unlock()
+
+		if res, err = c.get(ctx, u, accept, token); err != nil {
+			return nil, err
+		}
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res, nil
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, errors.Wrapf(errNotFound, "%s not found in %s/%s", path, ref.Registry, ref.Repository)
+	default:
+		res.Body.Close()
+		return nil, errors.Errorf("error getting %s from %s/%s: %s", path, ref.Registry, ref.Repository, res.Status)
+	}
+}
+
+// get makes a GET request for u with authorization, which is either a bearer token or, if it starts
+// with "Basic ", a basic authorization header.
+func (c *Client) get(ctx context.Context, u, accept, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	switch {
+	case strings.HasPrefix(authorization, "Basic "):
+		req.Header.Set("Authorization", authorization)
+	case authorization != "":
+		req.Header.Set("Authorization", "Bearer "+authorization)
+	}
+
+	res, err := c.httpClient.Do(req)
+	return res, errors.Wrapf(err, "error getting %s", u)
+}
+
+// authenticate returns the authorization for ref's repository given the registry's challenge.
+func (c *Client) authenticate(ctx context.Context, ref Reference, challenge string) (string, error) {
+	var creds Credentials
+	var hasCreds bool
+	if c.keychain != nil {
+		creds, hasCreds = c.keychain(ref.Registry)
+	}
+
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !hasCreds {
+			return "", errors.Errorf("registry %s requires credentials", ref.Registry)
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(creds.Username+":"+creds.Password)), nil
+	case "bearer":
+	default:
+		return "", errors.Errorf("registry %s requires unsupported authentication %q", ref.Registry, challenge)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", errors.Errorf("registry %s returned an invalid authentication realm %q", ref.Registry, params["realm"])
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", ref.Repository))
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if hasCreds {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting token for %s", ref.Registry)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", errors.Errorf("error getting token for %s: %s", ref.Registry, res.Status)
+	}
+
+	var tokenResponse struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxManifestSize)).Decode(&tokenResponse); err != nil {
+		return "", errors.Wrapf(err, "error decoding token for %s", ref.Registry)
+	}
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+	return tokenResponse.AccessToken, nil
+}
+
+// parseChallenge parses a WWW-Authenticate header of the form: Scheme key="value",key="value".
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+	for _, param := range strings.Split(rest, ",") {
+		key, value, found := cut(strings.TrimSpace(param), "=")
+		if found {
+			params[strings.ToLower(key)] = strings.Trim(value, `"`)
+		}
+	}
+	return scheme, params
+}
+
+// verifyDigest returns an error if the sha256 digest of content isn't digest.
+func verifyDigest(content []byte, digest string) error {
+	sum := sha256.Sum256(content)
+	if actual := "sha256:" + hex.EncodeToString(sum[:]); actual != digest {
+		return errors.Errorf("content has digest %s", actual)
+	}
+	return nil
+}
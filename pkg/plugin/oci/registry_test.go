@@ -0,0 +1,313 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRepository = "velero/plugins"
+	testToken      = "test-token"
+)
+
+// fakeRegistry is an OCI registry with a single repository.
+type fakeRegistry struct {
+	t      *testing.T
+	server *httptest.Server
+
+	// credentials, if set, are required to get a token to pull from the registry.
+	credentials *Credentials
+
+	lock      sync.Mutex
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	referrers map[string][]Descriptor
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	r := &fakeRegistry{
+		t:         t,
+		manifests: make(map[string][]byte),
+		blobs:     make(map[string][]byte),
+		referrers: make(map[string][]Descriptor),
+	}
+	r.server = httptest.NewTLSServer(r)
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+// client returns a Client that trusts the registry's certificate.
+func (r *fakeRegistry) client(keychain Keychain) *Client {
+	return NewClient(r.server.Client(), keychain)
+}
+
+// reference returns a reference to the content with digest in the registry.
+func (r *fakeRegistry) reference(digest string) Reference {
+	return Reference{Registry: r.server.Listener.Addr().String(), Repository: testRepository, Digest: digest}
+}
+
+func (r *fakeRegistry) addBlob(mediaType string, content []byte) Descriptor {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	digest := testDigest(content)
+	r.blobs[digest] = content
+	return Descriptor{MediaType: mediaType, Digest: digest, Size: int64(len(content))}
+}
+
+func (r *fakeRegistry) addManifest(manifest *Manifest, tags ...string) Descriptor {
+	content, err := json.Marshal(manifest)
+	require.NoError(r.t, err)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	digest := testDigest(content)
+	r.manifests[digest] = content
+	for _, tag := range tags {
+		r.manifests[tag] = content
+	}
+	return Descriptor{MediaType: manifest.MediaType, ArtifactType: manifest.ArtifactType, Digest: digest, Size: int64(len(content))}
+}
+
+func (r *fakeRegistry) addReferrer(subject string, desc Descriptor) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.referrers[subject] = append(r.referrers[subject], desc)
+}
+
+// addImage adds an image whose layers are tar.gz archives of the files in each of layers.
+func (r *fakeRegistry) addImage(layers ...[]testFile) Reference {
+	manifest := &Manifest{
+		MediaType: MediaTypeImageManifest,
+		Config:    r.addBlob("application/vnd.oci.image.config.v1+json", []byte("{}")),
+	}
+	for _, files := range layers {
+		manifest.Layers = append(manifest.Layers, r.addBlob(MediaTypeImageLayerGzip, testLayer(r.t, files)))
+	}
+	return r.reference(r.addManifest(manifest).Digest)
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if req.URL.Path == "/token" {
+		username, password, _ := req.BasicAuth()
+		if r.credentials == nil || username != r.credentials.Username || password != r.credentials.Password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"token": testToken})
+		return
+	}
+
+	if r.credentials != nil && req.Header.Get("Authorization") != "Bearer "+testToken {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake"`, r.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	prefix := "/v2/" + testRepository + "/"
+	if !strings.HasPrefix(req.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	kind, reference, _ := cut(strings.TrimPrefix(req.URL.Path, prefix), "/")
+
+	var content []byte
+	switch kind {
+	case "manifests":
+		content = r.manifests[reference]
+	case "blobs":
+		content = r.blobs[reference]
+	case "referrers":
+		index := Manifest{MediaType: MediaTypeImageIndex, Manifests: r.referrers[reference]}
+		content, _ = json.Marshal(index)
+	}
+	if content == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Write(content)
+}
+
+type testFile struct {
+	name    string
+	content string
+	mode    int64
+}
+
+// testLayer returns a tar.gz archive of files.
+func testLayer(t *testing.T, files []testFile) []byte {
+	buf := new(bytes.Buffer)
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, file := range files {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     file.name,
+			Mode:     file.mode,
+			Size:     int64(len(file.content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tarWriter.Write([]byte(file.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func testDigest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func TestClientAuthentication(t *testing.T) {
+	registry := newFakeRegistry(t)
+	registry.credentials = &Credentials{Username: "user", Password: "pass"}
+	ref := registry.addImage()
+
+	tests := []struct {
+		name     string
+		keychain Keychain
+		wantErr  string
+	}{
+		{
+			name: "credentials for the registry are used to get a token",
+			keychain: func(host string) (Credentials, bool) {
+				return Credentials{Username: "user", Password: "pass"}, host == ref.Registry
+			},
+		},
+		{
+			name:    "without credentials, the registry can't be pulled from",
+			wantErr: "error getting token",
+		},
+		{
+			name: "with the wrong credentials, the registry can't be pulled from",
+			keychain: func(string) (Credentials, bool) {
+				return Credentials{Username: "user", Password: "wrong"}, true
+			},
+			wantErr: "error getting token",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest, err := registry.client(tc.keychain).GetImageManifest(context.Background(), ref)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, MediaTypeImageManifest, manifest.MediaType)
+		})
+	}
+}
+
+func TestGetImageManifest(t *testing.T) {
+	registry := newFakeRegistry(t)
+	image := registry.addImage([]testFile{{name: "plugins/velero-plugin-example", content: "#!/bin/sh", mode: 0755}})
+	imageManifest, _, err := registry.client(nil).GetManifest(context.Background(), image, image.Digest)
+	require.NoError(t, err)
+
+	t.Run("an image index returns the manifest for the current platform", func(t *testing.T) {
+		other := registry.addImage()
+		index := registry.addManifest(&Manifest{
+			MediaType: MediaTypeImageIndex,
+			Manifests: []Descriptor{
+				{MediaType: MediaTypeImageManifest, Digest: other.Digest, Platform: &Platform{OS: "plan9", Architecture: runtime.GOARCH}},
+				{MediaType: MediaTypeImageManifest, Digest: image.Digest, Platform: &Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH}},
+			},
+		})
+
+		manifest, err := registry.client(nil).GetImageManifest(context.Background(), registry.reference(index.Digest))
+		require.NoError(t, err)
+		assert.Equal(t, imageManifest, manifest)
+	})
+
+	t.Run("an image index without a manifest for the current platform returns an error", func(t *testing.T) {
+		index := registry.addManifest(&Manifest{
+			MediaType: MediaTypeImageIndex,
+			Manifests: []Descriptor{
+				{MediaType: MediaTypeImageManifest, Digest: image.Digest, Platform: &Platform{OS: "plan9", Architecture: runtime.GOARCH}},
+			},
+		})
+
+		_, err := registry.client(nil).GetImageManifest(context.Background(), registry.reference(index.Digest))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has no manifest for platform")
+	})
+
+	t.Run("a manifest whose content doesn't match its digest returns an error", func(t *testing.T) {
+		tampered := registry.reference("sha256:" + strings.Repeat("0", 64))
+		registry.manifests[tampered.Digest] = registry.manifests[image.Digest]
+
+		_, err := registry.client(nil).GetImageManifest(context.Background(), tampered)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error verifying manifest")
+	})
+
+	t.Run("a blob whose content doesn't match its digest returns an error", func(t *testing.T) {
+		layer := imageManifest.Layers[0]
+		registry.blobs[layer.Digest] = []byte("tampered")
+
+		_, err := registry.client(nil).DownloadBlob(context.Background(), image, layer, t.TempDir())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error verifying blob")
+	})
+}
+
+func TestKeychainFromDockerConfig(t *testing.T) {
+	keychain, err := KeychainFromDockerConfig([]byte(`{
+		"auths": {
+			"https://index.docker.io/v1/": {"auth": "dXNlcjpwYXNz"},
+			"registry.example.com:5000": {"username": "other", "password": "secret"}
+		}
+	}`))
+	require.NoError(t, err)
+
+	creds, found := keychain(dockerHubRegistry)
+	assert.True(t, found)
+	assert.Equal(t, Credentials{Username: "user", Password: "pass"}, creds)
+
+	creds, found = keychain("registry.example.com:5000")
+	assert.True(t, found)
+	assert.Equal(t, Credentials{Username: "other", Password: "secret"}, creds)
+
+	_, found = keychain("quay.io")
+	assert.False(t, found)
+}
@@ -0,0 +1,500 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+const (
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	cosignPayloadMediaType    = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+	notationArtifactType     = "application/vnd.cncf.notary.signature"
+	notationJWSMediaType     = "application/jose+json"
+	notationPayloadMediaType = "application/vnd.cncf.notary.payload.v1+json"
+)
+
+// Verifier verifies that an image is signed.
+type Verifier interface {
+	// Verify returns an error if the image with ref has no valid signature.
+	Verify(ctx context.Context, client *Client, ref Reference) error
+}
+
+// cosignVerifier verifies cosign signatures made with a key.
+type cosignVerifier struct {
+	publicKey crypto.PublicKey
+}
+
+// NewCosignVerifier returns a Verifier for cosign signatures made with the private key of the
+// PEM-encoded publicKey.
+func NewCosignVerifier(publicKey []byte) (Verifier, error) {
+	block, _ := pem.Decode(publicKey)
+	if block == nil {
+		return nil, errors.New("cosign public key is not PEM-encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing cosign public key")
+	}
+	return &cosignVerifier{publicKey: key}, nil
+}
+
+// cosignPayload is the simple signing payload that cosign signs.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+func (v *cosignVerifier) Verify(ctx context.Context, client *Client, ref Reference) error {
+	// cosign stores the signatures of an image as the layers of a manifest tagged with the image's digest.
+	tag := strings.Replace(ref.Digest, ":", "-", 1) + ".sig"
+	manifest, err := client.GetTaggedManifest(ctx, ref, tag)
+	if err != nil {
+		return errors.Wrap(err, "error getting cosign signatures")
+	}
+	if manifest == nil {
+		return errors.Errorf("image %s has no cosign signatures", ref)
+	}
+
+	for _, layer := range manifest.Layers {
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[cosignSignatureAnnotation])
+		if layer.MediaType != cosignPayloadMediaType || err != nil || len(signature) == 0 {
+			continue
+		}
+
+		payload, err := client.GetBlob(ctx, ref, layer)
+		if err != nil {
+			return errors.Wrap(err, "error getting cosign signature payload")
+		}
+
+		if err := verifySignature(v.publicKey, crypto.SHA256, payload, signature, false); err != nil {
+			continue
+		}
+
+		var p cosignPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			continue
+		}
+		if p.Critical.Image.DockerManifestDigest == ref.Digest {
+			return nil
+		}
+	}
+
+	return errors.Errorf("image %s has no cosign signature made with the key", ref)
+}
+
+// notationVerifier verifies Notation signatures made with certificates issued by trusted roots.
+type notationVerifier struct {
+	roots             *x509.CertPool
+	trustedIdentities []pkix.Name
+	clock             clock.Clock
+}
+
+// NewNotationVerifier returns a Verifier for Notation signatures made with certificates issued by
+// one of the PEM-encoded rootCertificates. If trustedIdentities isn't empty, the signing certificate's
+// subject must match one of them, as in a Notation trust policy. Each identity is a distinguished
+// name such as "x509.subject: C=US, O=Example, CN=signer", optionally without the "x509.subject:"
+// prefix, and matches subjects that have all of its attributes.
+func NewNotationVerifier(rootCertificates []byte, trustedIdentities []string, clock clock.Clock) (Verifier, error) {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(rootCertificates) {
+		return nil, errors.New("no PEM-encoded certificates found in Notation root certificates")
+	}
+
+	v := &notationVerifier{roots: roots, clock: clock}
+	for _, identity := range trustedIdentities {
+		name, err := parseDistinguishedName(strings.TrimPrefix(strings.TrimSpace(identity), notationIdentityPrefix))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid Notation trusted identity %q", identity)
+		}
+		v.trustedIdentities = append(v.trustedIdentities, name)
+	}
+	return v, nil
+}
+
+// jwsEnvelope is a Notation signature in the JWS JSON serialization.
+type jwsEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		CertificateChain [][]byte `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+// jwsProtectedHeader is the part of a Notation signature's protected header that's verified.
+type jwsProtectedHeader struct {
+	Algorithm     string     `json:"alg"`
+	ContentType   string     `json:"cty"`
+	Critical      []string   `json:"crit"`
+	SigningScheme string     `json:"io.cncf.notary.signingScheme"`
+	SigningTime   *time.Time `json:"io.cncf.notary.signingTime"`
+	Expiry        *time.Time `json:"io.cncf.notary.expiry"`
+}
+
+const (
+	notationSigningSchemeHeader = "io.cncf.notary.signingScheme"
+	notationExpiryHeader        = "io.cncf.notary.expiry"
+
+	// notationSigningSchemeX509 is the signing scheme in which the signing time is asserted by
+	// the signer. The notary.x509.signingAuthority scheme, which needs a trusted timestamping
+	// authority, isn't supported.
+	notationSigningSchemeX509 = "notary.x509"
+
+	notationIdentityPrefix = "x509.subject:"
+)
+
+// notationCriticalHeaders are the protected headers that a Notation signature may mark as critical.
+var notationCriticalHeaders = map[string]bool{
+	notationSigningSchemeHeader: true,
+	notationExpiryHeader:        true,
+}
+
+// notationPayload is the payload that Notation signs.
+type notationPayload struct {
+	TargetArtifact Descriptor `json:"targetArtifact"`
+}
+
+func (v *notationVerifier) Verify(ctx context.Context, client *Client, ref Reference) error {
+	referrers, err := client.Referrers(ctx, ref, ref.Digest, notationArtifactType)
+	if err != nil {
+		return errors.Wrap(err, "error getting Notation signatures")
+	}
+	if len(referrers) == 0 {
+		return errors.Errorf("image %s has no Notation signatures", ref)
+	}
+
+	var lastErr error
+	for _, desc := range referrers {
+		manifest, _, err := client.GetManifest(ctx, ref, desc.Digest)
+		if err != nil {
+			return errors.Wrap(err, "error getting Notation signature")
+		}
+
+		for _, layer := range manifest.Layers {
+			if layer.MediaType != notationJWSMediaType {
+				continue
+			}
+
+			envelope, err := client.GetBlob(ctx, ref, layer)
+			if err != nil {
+				return errors.Wrap(err, "error getting Notation signature envelope")
+			}
+
+			if lastErr = v.verifyEnvelope(envelope, ref.Digest); lastErr == nil {
+				return nil
+			}
+		}
+	}
+
+	if lastErr != nil {
+		return errors.Wrapf(lastErr, "image %s has no valid Notation signature", ref)
+	}
+	return errors.Errorf("image %s has no Notation signatures in a supported format", ref)
+}
+
+// verifyEnvelope verifies that the JWS envelope is a signature of digest that hasn't expired,
+// made with a certificate that was valid when it was signed, is still valid, is issued by one of
+// the verifier's roots and has one of the verifier's trusted identities. Like Notation, it rejects
+// signatures whose protected header marks headers it doesn't understand as critical.
+func (v *notationVerifier) verifyEnvelope(envelopeBytes []byte, digest string) error {
+	var envelope jwsEnvelope
+	if err := json.Unmarshal(envelopeBytes, &envelope); err != nil {
+		return errors.Wrap(err, "error decoding signature envelope")
+	}
+
+	if len(envelope.Header.CertificateChain) == 0 {
+		return errors.New("signature has no certificate chain")
+	}
+	var certs []*x509.Certificate
+	for _, der := range envelope.Header.CertificateChain {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return errors.Wrap(err, "error parsing signature certificate")
+		}
+		certs = append(certs, cert)
+	}
+
+	header, err := decodeNotationHeader(envelope.Protected)
+	if err != nil {
+		return err
+	}
+
+	// the signature must be valid before anything in its header can be trusted.
+	hash, err := jwsAlgorithm(header.Algorithm, certs[0].PublicKey)
+	if err != nil {
+		return err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(envelope.Signature)
+	if err != nil {
+		return errors.Wrap(err, "error decoding signature")
+	}
+	if err := verifySignature(certs[0].PublicKey, hash, []byte(envelope.Protected+"."+envelope.Payload), signature, true); err != nil {
+		return err
+	}
+
+	now := v.clock.Now()
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return errors.Wrap(err, "signature certificate is not trusted")
+	}
+
+	if header.SigningTime.After(now) {
+		return errors.Errorf("signature has a signing time in the future, %s", header.SigningTime.Format(time.RFC3339))
+	}
+	for _, cert := range certs {
+		if header.SigningTime.Before(cert.NotBefore) || header.SigningTime.After(cert.NotAfter) {
+			return errors.Errorf("certificate %q wasn't valid at the signature's signing time, %s", cert.Subject, header.SigningTime.Format(time.RFC3339))
+		}
+	}
+	if header.Expiry != nil && !now.Before(*header.Expiry) {
+		return errors.Errorf("signature expired at %s", header.Expiry.Format(time.RFC3339))
+	}
+
+	if !v.trusted(certs[0].Subject) {
+		return errors.Errorf("signature certificate subject %q is not a trusted identity", certs[0].Subject)
+	}
+
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return errors.Wrap(err, "error decoding signature payload")
+	}
+	var payload notationPayload
+	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+		return errors.Wrap(err, "error decoding signature payload")
+	}
+	if payload.TargetArtifact.Digest != digest {
+		return errors.Errorf("signature is for %s", payload.TargetArtifact.Digest)
+	}
+
+	return nil
+}
+
+// decodeNotationHeader decodes the base64url-encoded protected header of a Notation signature,
+// and checks that it has the headers that the notary.x509 signing scheme requires, and that its
+// critical headers are present and understood.
+func decodeNotationHeader(protected string) (*jwsProtectedHeader, error) {
+	headerBytes, err := base64.RawURLEncoding.DecodeString(protected)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding signature header")
+	}
+	var header jwsProtectedHeader
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, errors.Wrap(err, "error decoding signature header")
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(headerBytes, &fields); err != nil {
+		return nil, errors.Wrap(err, "error decoding signature header")
+	}
+
+	critical := make(map[string]bool)
+	for _, name := range header.Critical {
+		if !notationCriticalHeaders[name] {
+			return nil, errors.Errorf("signature has unsupported critical header %q", name)
+		}
+		if _, found := fields[name]; !found {
+			return nil, errors.Errorf("signature is missing critical header %q", name)
+		}
+		critical[name] = true
+	}
+	if !critical[notationSigningSchemeHeader] {
+		return nil, errors.Errorf("signature doesn't mark header %q as critical", notationSigningSchemeHeader)
+	}
+	if header.Expiry != nil && !critical[notationExpiryHeader] {
+		return nil, errors.Errorf("signature doesn't mark header %q as critical", notationExpiryHeader)
+	}
+
+	if header.ContentType != notationPayloadMediaType {
+		return nil, errors.Errorf("signature has unsupported content type %q", header.ContentType)
+	}
+	if header.SigningScheme != notationSigningSchemeX509 {
+		return nil, errors.Errorf("signature has unsupported signing scheme %q", header.SigningScheme)
+	}
+	if header.SigningTime == nil {
+		return nil, errors.New("signature has no signing time")
+	}
+
+	return &header, nil
+}
+
+// trusted returns true if subject matches one of the verifier's trusted identities, or if it
+// has none.
+func (v *notationVerifier) trusted(subject pkix.Name) bool {
+	if len(v.trustedIdentities) == 0 {
+		return true
+	}
+	for _, identity := range v.trustedIdentities {
+		if subjectMatches(subject, identity) {
+			return true
+		}
+	}
+	return false
+}
+
+// subjectMatches returns true if subject has all of the attributes of identity.
+func subjectMatches(subject, identity pkix.Name) bool {
+	contains := func(values []string, wanted []string) bool {
+		for _, w := range wanted {
+			found := false
+			for _, value := range values {
+				if value == w {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+
+	return contains(subject.Country, identity.Country) &&
+		contains(subject.Province, identity.Province) &&
+		contains(subject.Locality, identity.Locality) &&
+		contains(subject.Organization, identity.Organization) &&
+		contains(subject.OrganizationalUnit, identity.OrganizationalUnit) &&
+		(identity.CommonName == "" || subject.CommonName == identity.CommonName)
+}
+
+// parseDistinguishedName parses a distinguished name made of comma-separated C, ST, L, O, OU and
+// CN attributes, such as "C=US, O=Example, CN=signer".
+func parseDistinguishedName(dn string) (pkix.Name, error) {
+	var name pkix.Name
+	for _, attribute := range strings.Split(dn, ",") {
+		parts := strings.SplitN(attribute, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+			return pkix.Name{}, errors.Errorf("attribute %q is not of the form TYPE=VALUE", strings.TrimSpace(attribute))
+		}
+		value := strings.TrimSpace(parts[1])
+
+		switch strings.ToUpper(strings.TrimSpace(parts[0])) {
+		case "C":
+			name.Country = append(name.Country, value)
+		case "ST":
+			name.Province = append(name.Province, value)
+		case "L":
+			name.Locality = append(name.Locality, value)
+		case "O":
+			name.Organization = append(name.Organization, value)
+		case "OU":
+			name.OrganizationalUnit = append(name.OrganizationalUnit, value)
+		case "CN":
+			if name.CommonName != "" {
+				return pkix.Name{}, errors.New("more than one CN attribute")
+			}
+			name.CommonName = value
+		default:
+			return pkix.Name{}, errors.Errorf("unsupported attribute type %q", strings.TrimSpace(parts[0]))
+		}
+	}
+	return name, nil
+}
+
+var jwsHashes = map[string]crypto.Hash{
+	"PS256": crypto.SHA256,
+	"PS384": crypto.SHA384,
+	"PS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+	"ES512": crypto.SHA512,
+}
+
+// jwsAlgorithm returns the hash for the JWS algorithm alg, checking that alg can be used with
+// publicKey. Notation only allows RSASSA-PSS and ECDSA signatures.
+func jwsAlgorithm(alg string, publicKey crypto.PublicKey) (crypto.Hash, error) {
+	hash, found := jwsHashes[alg]
+
+	_, isRSA := publicKey.(*rsa.PublicKey)
+	_, isECDSA := publicKey.(*ecdsa.PublicKey)
+	if !found || !(strings.HasPrefix(alg, "PS") && isRSA || strings.HasPrefix(alg, "ES") && isECDSA) {
+		return 0, errors.Errorf("signature has unsupported algorithm %q for a %T", alg, publicKey)
+	}
+	return hash, nil
+}
+
+// verifySignature verifies that signature is a signature of message made with the private key of
+// publicKey. If jws is true, RSA signatures use PSS and ECDSA signatures are the concatenation of r
+// and s, as in JWS. Otherwise RSA signatures use PKCS #1 v1.5 and ECDSA signatures are ASN.1-encoded.
+func verifySignature(publicKey crypto.PublicKey, hash crypto.Hash, message, signature []byte, jws bool) error {
+	if key, ok := publicKey.(ed25519.PublicKey); ok {
+		if !ed25519.Verify(key, message, signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+
+	h := hash.New()
+	h.Write(message)
+	digest := h.Sum(nil)
+
+	var valid bool
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if jws {
+			valid = rsa.VerifyPSS(key, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+		} else {
+			valid = rsa.VerifyPKCS1v15(key, hash, digest, signature) == nil
+		}
+	case *ecdsa.PublicKey:
+		if jws {
+			if len(signature)%2 != 0 {
+				return errors.New("invalid signature")
+			}
+			r := new(big.Int).SetBytes(signature[:len(signature)/2])
+			s := new(big.Int).SetBytes(signature[len(signature)/2:])
+			valid = ecdsa.Verify(key, digest, r, s)
+		} else {
+			valid = ecdsa.VerifyASN1(key, digest, signature)
+		}
+	default:
+		return errors.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	if !valid {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
@@ -0,0 +1,423 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+// publicKeyPEM returns the PEM-encoded public key of key.
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+// signCosign adds a cosign signature of the image with digest, made with key, to the registry.
+func signCosign(t *testing.T, registry *fakeRegistry, digest string, key *ecdsa.PrivateKey) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s/%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`,
+		registry.server.Listener.Addr(), testRepository, digest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+
+	layer := registry.addBlob(cosignPayloadMediaType, payload)
+	layer.Annotations = map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature)}
+
+	registry.addManifest(&Manifest{
+		MediaType: MediaTypeImageManifest,
+		Config:    registry.addBlob("application/vnd.oci.image.config.v1+json", []byte("{}")),
+		Layers:    []Descriptor{layer},
+	}, strings.Replace(digest, ":", "-", 1)+".sig")
+}
+
+// newTestCertificate returns a certificate for key issued by parent, or a self-signed one if
+// parent is nil, that's valid from an hour ago until an hour from now.
+func newTestCertificate(t *testing.T, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) *x509.Certificate {
+	return newTestCertificateValidBetween(t, key, parent, parentKey, pkix.Name{CommonName: "velero-test"}, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+}
+
+// newTestCertificateValidBetween returns a certificate for key with subject issued by parent, or
+// a self-signed one if parent is nil, that's valid between notBefore and notAfter.
+func newTestCertificateValidBetween(t *testing.T, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, subject pkix.Name, notBefore, notAfter time.Time) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      subject,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+// notationHeader returns the protected header of a valid Notation signature made with the
+// notary.x509 signing scheme at signingTime.
+func notationHeader(signingTime time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"alg":                        "ES256",
+		"cty":                        notationPayloadMediaType,
+		"crit":                       []string{notationSigningSchemeHeader},
+		notationSigningSchemeHeader:  notationSigningSchemeX509,
+		"io.cncf.notary.signingTime": signingTime.Format(time.RFC3339),
+	}
+}
+
+// notationEnvelope returns a Notation signature envelope of the image with digest, with the
+// protected header, made with key and the certificate chain.
+func notationEnvelope(t *testing.T, digest string, header map[string]interface{}, key *ecdsa.PrivateKey, chain ...*x509.Certificate) []byte {
+	payload, err := json.Marshal(notationPayload{TargetArtifact: Descriptor{MediaType: MediaTypeImageManifest, Digest: digest}})
+	require.NoError(t, err)
+	protected, err := json.Marshal(header)
+	require.NoError(t, err)
+
+	encodedProtected := base64.RawURLEncoding.EncodeToString(protected)
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	hash := sha256.Sum256([]byte(encodedProtected + "." + encodedPayload))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	envelope := jwsEnvelope{Payload: encodedPayload, Protected: encodedProtected, Signature: base64.RawURLEncoding.EncodeToString(signature)}
+	for _, cert := range chain {
+		envelope.Header.CertificateChain = append(envelope.Header.CertificateChain, cert.Raw)
+	}
+	envelopeBytes, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	return envelopeBytes
+}
+
+// signNotation adds a Notation signature of the image with digest, made with key and the
+// certificate chain, to the registry.
+func signNotation(t *testing.T, registry *fakeRegistry, digest string, key *ecdsa.PrivateKey, chain ...*x509.Certificate) {
+	envelope := notationEnvelope(t, digest, notationHeader(time.Now()), key, chain...)
+
+	manifest := registry.addManifest(&Manifest{
+		MediaType:    MediaTypeImageManifest,
+		ArtifactType: notationArtifactType,
+		Config:       registry.addBlob("application/vnd.oci.empty.v1+json", []byte("{}")),
+		Layers:       []Descriptor{registry.addBlob(notationJWSMediaType, envelope)},
+	})
+	registry.addReferrer(digest, manifest)
+}
+
+func TestCosignVerifier(t *testing.T) {
+	key := newTestKey(t)
+	otherKey := newTestKey(t)
+
+	registry := newFakeRegistry(t)
+	signed := registry.addImage()
+	signCosign(t, registry, signed.Digest, key)
+	unsigned := registry.addImage([]testFile{{name: "plugins/unsigned", mode: 0755}})
+	signedByOtherKey := registry.addImage([]testFile{{name: "plugins/other", mode: 0755}})
+	signCosign(t, registry, signedByOtherKey.Digest, otherKey)
+
+	verifier, err := NewCosignVerifier(publicKeyPEM(t, key))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ref     Reference
+		wantErr string
+	}{
+		{
+			name: "image signed with the key is verified",
+			ref:  signed,
+		},
+		{
+			name:    "image without signatures is not verified",
+			ref:     unsigned,
+			wantErr: "has no cosign signatures",
+		},
+		{
+			name:    "image signed with another key is not verified",
+			ref:     signedByOtherKey,
+			wantErr: "has no cosign signature made with the key",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifier.Verify(context.Background(), registry.client(nil), tc.ref)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("a signature of another image is not accepted", func(t *testing.T) {
+		// Copy the signed image's signature to the unsigned image.
+		tag := strings.Replace(unsigned.Digest, ":", "-", 1) + ".sig"
+		registry.manifests[tag] = registry.manifests[strings.Replace(signed.Digest, ":", "-", 1)+".sig"]
+
+		err := verifier.Verify(context.Background(), registry.client(nil), unsigned)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has no cosign signature made with the key")
+	})
+}
+
+func TestNotationVerifier(t *testing.T) {
+	rootKey := newTestKey(t)
+	root := newTestCertificate(t, rootKey, nil, nil)
+	leafKey := newTestKey(t)
+	leaf := newTestCertificate(t, leafKey, root, rootKey)
+
+	untrustedKey := newTestKey(t)
+	untrusted := newTestCertificate(t, untrustedKey, nil, nil)
+
+	registry := newFakeRegistry(t)
+	signed := registry.addImage()
+	signNotation(t, registry, signed.Digest, leafKey, leaf, root)
+	unsigned := registry.addImage([]testFile{{name: "plugins/unsigned", mode: 0755}})
+	signedByUntrusted := registry.addImage([]testFile{{name: "plugins/untrusted", mode: 0755}})
+	signNotation(t, registry, signedByUntrusted.Digest, untrustedKey, untrusted)
+	signedWithWrongKey := registry.addImage([]testFile{{name: "plugins/wrong-key", mode: 0755}})
+	signNotation(t, registry, signedWithWrongKey.Digest, untrustedKey, leaf, root)
+
+	verifier, err := NewNotationVerifier(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw}), nil, clock.RealClock{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ref     Reference
+		wantErr string
+	}{
+		{
+			name: "image signed with a certificate issued by a trusted root is verified",
+			ref:  signed,
+		},
+		{
+			name:    "image without signatures is not verified",
+			ref:     unsigned,
+			wantErr: "has no Notation signatures",
+		},
+		{
+			name:    "image signed with an untrusted certificate is not verified",
+			ref:     signedByUntrusted,
+			wantErr: "signature certificate is not trusted",
+		},
+		{
+			name:    "image signed with a key that doesn't match the certificate is not verified",
+			ref:     signedWithWrongKey,
+			wantErr: "invalid signature",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifier.Verify(context.Background(), registry.client(nil), tc.ref)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("a signature of another image is not accepted", func(t *testing.T) {
+		registry.addReferrer(unsigned.Digest, registry.referrers[signed.Digest][0])
+
+		err := verifier.Verify(context.Background(), registry.client(nil), unsigned)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "signature is for "+signed.Digest)
+	})
+}
+
+func TestNotationVerifierChecks(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	rootKey := newTestKey(t)
+	root := newTestCertificateValidBetween(t, rootKey, nil, nil, pkix.Name{CommonName: "root"}, now.Add(-48*time.Hour), now.Add(48*time.Hour))
+	leafKey := newTestKey(t)
+	signer := pkix.Name{Country: []string{"US"}, Organization: []string{"Example"}, CommonName: "signer"}
+	leaf := newTestCertificateValidBetween(t, leafKey, root, rootKey, signer, now.Add(-time.Hour), now.Add(time.Hour))
+	expiredLeaf := newTestCertificateValidBetween(t, leafKey, root, rootKey, signer, now.Add(-2*time.Hour), now.Add(-time.Hour))
+
+	withHeader := func(mutate func(header map[string]interface{})) map[string]interface{} {
+		header := notationHeader(now.Add(-time.Minute))
+		mutate(header)
+		return header
+	}
+
+	tests := []struct {
+		name              string
+		header            map[string]interface{}
+		leaf              *x509.Certificate
+		trustedIdentities []string
+		wantErr           string
+	}{
+		{
+			name:   "valid signature is verified",
+			header: notationHeader(now.Add(-time.Minute)),
+		},
+		{
+			name: "signature with an unsupported critical header is rejected",
+			header: withHeader(func(header map[string]interface{}) {
+				header["crit"] = []string{notationSigningSchemeHeader, "io.cncf.notary.unknown"}
+				header["io.cncf.notary.unknown"] = "value"
+			}),
+			wantErr: `unsupported critical header "io.cncf.notary.unknown"`,
+		},
+		{
+			name: "signature with a critical header that's missing is rejected",
+			header: withHeader(func(header map[string]interface{}) {
+				header["crit"] = []string{notationSigningSchemeHeader, notationExpiryHeader}
+			}),
+			wantErr: `missing critical header "io.cncf.notary.expiry"`,
+		},
+		{
+			name: "signature that doesn't mark the signing scheme as critical is rejected",
+			header: withHeader(func(header map[string]interface{}) {
+				delete(header, "crit")
+			}),
+			wantErr: `doesn't mark header "io.cncf.notary.signingScheme" as critical`,
+		},
+		{
+			name: "signature that doesn't mark its expiry as critical is rejected",
+			header: withHeader(func(header map[string]interface{}) {
+				header[notationExpiryHeader] = now.Add(time.Hour).Format(time.RFC3339)
+			}),
+			wantErr: `doesn't mark header "io.cncf.notary.expiry" as critical`,
+		},
+		{
+			name: "signature with an unsupported signing scheme is rejected",
+			header: withHeader(func(header map[string]interface{}) {
+				header[notationSigningSchemeHeader] = "notary.x509.signingAuthority"
+			}),
+			wantErr: `unsupported signing scheme "notary.x509.signingAuthority"`,
+		},
+		{
+			name: "signature without a signing time is rejected",
+			header: withHeader(func(header map[string]interface{}) {
+				delete(header, "io.cncf.notary.signingTime")
+			}),
+			wantErr: "signature has no signing time",
+		},
+		{
+			name:    "signature with a signing time in the future is rejected",
+			header:  notationHeader(now.Add(time.Minute)),
+			wantErr: "signing time in the future",
+		},
+		{
+			name:    "signature made before the certificate was valid is rejected",
+			header:  notationHeader(now.Add(-2 * time.Hour)),
+			wantErr: "wasn't valid at the signature's signing time",
+		},
+		{
+			name: "expired signature is rejected",
+			header: withHeader(func(header map[string]interface{}) {
+				header["crit"] = []string{notationSigningSchemeHeader, notationExpiryHeader}
+				header[notationExpiryHeader] = now.Add(-time.Second).Format(time.RFC3339)
+			}),
+			wantErr: "signature expired at",
+		},
+		{
+			name: "signature that hasn't expired is verified",
+			header: withHeader(func(header map[string]interface{}) {
+				header["crit"] = []string{notationSigningSchemeHeader, notationExpiryHeader}
+				header[notationExpiryHeader] = now.Add(time.Hour).Format(time.RFC3339)
+			}),
+		},
+		{
+			name:    "signature made with an expired certificate is rejected",
+			header:  notationHeader(now.Add(-90 * time.Minute)),
+			leaf:    expiredLeaf,
+			wantErr: "signature certificate is not trusted",
+		},
+		{
+			name:              "signature made with a certificate with a trusted identity is verified",
+			header:            notationHeader(now.Add(-time.Minute)),
+			trustedIdentities: []string{"x509.subject: C=US, O=Other", "x509.subject: O=Example, CN=signer"},
+		},
+		{
+			name:              "signature made with a certificate without a trusted identity is rejected",
+			header:            notationHeader(now.Add(-time.Minute)),
+			trustedIdentities: []string{"C=US, O=Example, CN=other"},
+			wantErr:           "is not a trusted identity",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier, err := NewNotationVerifier(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw}), tc.trustedIdentities, clock.NewFakeClock(now))
+			require.NoError(t, err)
+
+			signingCert := tc.leaf
+			if signingCert == nil {
+				signingCert = leaf
+			}
+
+			err = verifier.(*notationVerifier).verifyEnvelope(notationEnvelope(t, digest, tc.header, leafKey, signingCert, root), digest)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewNotationVerifierInvalidTrustedIdentity(t *testing.T) {
+	root := newTestCertificate(t, newTestKey(t), nil, nil)
+
+	_, err := NewNotationVerifier(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw}), []string{"x509.subject: SERIALNUMBER=1"}, clock.RealClock{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported attribute type "SERIALNUMBER"`)
+}
@@ -0,0 +1,27 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testing helps plugin authors test their plugins the way Velero runs them.
+//
+// A Harness starts plugin executables through the same go-plugin handshake, and calls them
+// through the same gRPC clients, as the Velero server. BackupItemActions and RestoreItemActions
+// can be run against items, including items recorded in a backup tarball, and the items,
+// additional items and errors that they return can be checked.
+//
+// The conformance suites check that an ObjectStore, VolumeSnapshotter, BackupItemAction or
+// RestoreItemAction behaves the way Velero expects plugins of its kind to behave. They can be
+// run against plugins started by a Harness, or against plugin implementations directly.
+package testing
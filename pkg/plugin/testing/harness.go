@@ -0,0 +1,129 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
+)
+
+// Harness runs the plugins in plugin executables the way the Velero server does.
+type Harness struct {
+	registry clientmgmt.Registry
+	manager  clientmgmt.Manager
+	logger   logrus.FieldLogger
+}
+
+// Start discovers the plugins in the executables commands and returns a Harness for them.
+// The plugins' processes are started when they're first used, and are stopped when the
+// test finishes. The test fails if the plugins can't be discovered.
+func Start(t testing.TB, commands ...string) *Harness {
+	t.Helper()
+
+	logger := logrus.New()
+	logger.SetOutput(os.Stderr)
+	logger.SetLevel(logrus.InfoLevel)
+
+	registry := clientmgmt.NewCommandRegistry(commands, logger, logger.Level)
+	if err := registry.DiscoverPlugins(); err != nil {
+		t.Fatalf("error discovering plugins in %v: %v", commands, err)
+	}
+
+	manager := clientmgmt.NewManager(context.Background(), logger, logger.Level, registry, nil, nil, nil)
+	t.Cleanup(manager.CleanupClients)
+
+	return &Harness{
+		registry: registry,
+		manager:  manager,
+		logger:   logger,
+	}
+}
+
+// Plugins returns the names of the plugins of kind that the executables contain.
+func (h *Harness) Plugins(kind framework.PluginKind) []string {
+	var names []string
+	for _, id := range h.registry.List(kind) {
+		names = append(names, id.Name)
+	}
+	return names
+}
+
+// BackupItemAction returns the BackupItemAction plugin with name. Plugins that implement
+// version 1 of the BackupItemAction kind are adapted to version 2, as they are by Velero.
+func (h *Harness) BackupItemAction(t testing.TB, name string) biav2.BackupItemAction {
+	t.Helper()
+
+	action, err := h.manager.GetBackupItemAction(name)
+	if err != nil {
+		t.Fatalf("error getting BackupItemAction %s: %v", name, err)
+	}
+	return action
+}
+
+// RestoreItemAction returns the RestoreItemAction plugin with name.
+func (h *Harness) RestoreItemAction(t testing.TB, name string) velero.RestoreItemAction {
+	t.Helper()
+
+	action, err := h.manager.GetRestoreItemAction(name)
+	if err != nil {
+		t.Fatalf("error getting RestoreItemAction %s: %v", name, err)
+	}
+	return action
+}
+
+// DeleteItemAction returns the DeleteItemAction plugin with name.
+func (h *Harness) DeleteItemAction(t testing.TB, name string) velero.DeleteItemAction {
+	t.Helper()
+
+	action, err := h.manager.GetDeleteItemAction(name)
+	if err != nil {
+		t.Fatalf("error getting DeleteItemAction %s: %v", name, err)
+	}
+	return action
+}
+
+// ObjectStore returns the ObjectStore plugin with name. It must be initialized with Init
+// before it's used.
+func (h *Harness) ObjectStore(t testing.TB, name string) velero.ObjectStore {
+	t.Helper()
+
+	store, err := h.manager.GetObjectStore(name)
+	if err != nil {
+		t.Fatalf("error getting ObjectStore %s: %v", name, err)
+	}
+	return store
+}
+
+// VolumeSnapshotter returns the VolumeSnapshotter plugin with name. It must be initialized
+// with Init before it's used.
+func (h *Harness) VolumeSnapshotter(t testing.TB, name string) velero.VolumeSnapshotter {
+	t.Helper()
+
+	snapshotter, err := h.manager.GetVolumeSnapshotter(name)
+	if err != nil {
+		t.Fatalf("error getting VolumeSnapshotter %s: %v", name, err)
+	}
+	return snapshotter
+}
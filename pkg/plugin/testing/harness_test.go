@@ -0,0 +1,428 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// servePluginsEnv is set in the environment of the test binary when it's started as a plugin.
+const servePluginsEnv = "VELERO_PLUGIN_TESTING_SERVE_PLUGINS"
+
+const (
+	objectStoreName       = "example.velero.io/memory"
+	volumeSnapshotterName = "example.velero.io/memory"
+	backupItemActionName  = "example.velero.io/pod"
+	restoreItemActionName = "example.velero.io/pod"
+	exampleCSIDriver      = "example.velero.io"
+)
+
+// TestMain serves the example plugins below when the test binary is started as a plugin by a
+// Harness, so that the tests can run them through the go-plugin handshake like any other
+// plugin executable.
+func TestMain(m *testing.M) {
+	if os.Getenv(servePluginsEnv) == "true" {
+		framework.NewServer().
+			RegisterObjectStore(objectStoreName, func(logrus.FieldLogger) (interface{}, error) {
+				return newMemoryObjectStore(), nil
+			}).
+			RegisterVolumeSnapshotter(volumeSnapshotterName, func(logrus.FieldLogger) (interface{}, error) {
+				return newMemoryVolumeSnapshotter(), nil
+			}).
+			RegisterBackupItemAction(backupItemActionName, func(logrus.FieldLogger) (interface{}, error) {
+				return &podBackupItemAction{}, nil
+			}).
+			RegisterRestoreItemAction(restoreItemActionName, func(logrus.FieldLogger) (interface{}, error) {
+				return &podRestoreItemAction{}, nil
+			}).
+			Serve()
+		return
+	}
+
+	os.Setenv(servePluginsEnv, "true")
+	os.Exit(m.Run())
+}
+
+func TestHarnessPlugins(t *testing.T) {
+	h := Start(t, os.Args[0])
+
+	assert.Equal(t, []string{objectStoreName}, h.Plugins(framework.PluginKindObjectStore))
+	assert.Equal(t, []string{volumeSnapshotterName}, h.Plugins(framework.PluginKindVolumeSnapshotter))
+	assert.Equal(t, []string{backupItemActionName}, h.Plugins(framework.PluginKindBackupItemAction))
+	assert.Equal(t, []string{restoreItemActionName}, h.Plugins(framework.PluginKindRestoreItemAction))
+	assert.Empty(t, h.Plugins(framework.PluginKindDeleteItemAction))
+}
+
+func TestObjectStoreConformance(t *testing.T) {
+	h := Start(t, os.Args[0])
+
+	RunObjectStoreConformance(t, h.ObjectStore(t, objectStoreName), ObjectStoreConformanceConfig{
+		Bucket: "bucket",
+		Prefix: "velero",
+	})
+}
+
+func TestVolumeSnapshotterConformance(t *testing.T) {
+	h := Start(t, os.Args[0])
+
+	pv := builder.ForPersistentVolume("pv-1").Result()
+	pv.Spec.CSI = &corev1api.CSIPersistentVolumeSource{Driver: exampleCSIDriver, VolumeHandle: "volume-1"}
+
+	RunVolumeSnapshotterConformance(t, h.VolumeSnapshotter(t, volumeSnapshotterName), VolumeSnapshotterConformanceConfig{
+		PersistentVolume: pv,
+		VolumeAZ:         "zone-1",
+		Tags:             map[string]string{"velero.io/backup": "backup"},
+	})
+}
+
+func TestRunBackupItemActionTests(t *testing.T) {
+	h := Start(t, os.Args[0])
+
+	RunBackupItemActionTests(t, h.BackupItemAction(t, backupItemActionName), []BackupItemActionTest{
+		{
+			Name:     "pod is annotated and its claims are additional items",
+			Resource: "pods",
+			Item:     newPod(t, builder.ForPod("ns-1", "pod-1").Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result())),
+			WantItem: newPod(t, builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithAnnotations(backedUpByAnnotation, "backup")).
+				Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result())),
+			WantAdditionalItems: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-1"},
+			},
+		},
+		{
+			Name:              "deployment is not applicable",
+			Resource:          "deployments.apps",
+			Item:              newPod(t, builder.ForPod("ns-1", "pod-1")),
+			WantNotApplicable: true,
+		},
+		{
+			Name:     "pod with the fail annotation fails",
+			Resource: "pods",
+			Item:     newPod(t, builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithAnnotations(failAnnotation, "true"))),
+			WantErr:  "pod ns-1/pod-1 is marked to fail",
+		},
+	})
+}
+
+func TestRunRestoreItemActionTests(t *testing.T) {
+	h := Start(t, os.Args[0])
+
+	RunRestoreItemActionTests(t, h.RestoreItemAction(t, restoreItemActionName), []RestoreItemActionTest{
+		{
+			Name:     "pod's node name is removed",
+			Resource: "pods",
+			Item:     newPod(t, builder.ForPod("ns-1", "pod-1").NodeName("node-1")),
+			WantItem: newPod(t, builder.ForPod("ns-1", "pod-1")),
+		},
+		{
+			Name:            "pod with the skip label is skipped",
+			Resource:        "pods",
+			Item:            newPod(t, builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels(skipRestoreLabel, "true"))),
+			WantSkipRestore: true,
+		},
+		{
+			Name:              "service is not applicable",
+			Resource:          "services",
+			Item:              newPod(t, builder.ForPod("ns-1", "pod-1")),
+			WantNotApplicable: true,
+		},
+	})
+}
+
+func TestItemActionConformance(t *testing.T) {
+	h := Start(t, os.Args[0])
+
+	items := ItemsFromBackup(t, writeBackupTarball(t, map[string]string{
+		"resources/pods/namespaces/ns-1/pod-1.json":                         `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"},"spec":{"nodeName":"node-1"}}`,
+		"resources/pods/namespaces/ns-2/pod-2.json":                         `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-2","name":"pod-2","labels":{"example.velero.io/skip-restore":"true"}}}`,
+		"resources/deployments.apps/namespaces/ns-1/deploy-1.json":          `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1"}}`,
+		"resources/persistentvolumes/cluster/pv-1.json":                     `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1"}}`,
+		"resources/persistentvolumes/v1-preferredversion/cluster/pv-1.json": `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1"}}`,
+	}))
+
+	t.Run("BackupItemAction", func(t *testing.T) {
+		RunBackupItemActionConformance(t, h.BackupItemAction(t, backupItemActionName), items, nil)
+	})
+	t.Run("RestoreItemAction", func(t *testing.T) {
+		RunRestoreItemActionConformance(t, h.RestoreItemAction(t, restoreItemActionName), items, nil)
+	})
+}
+
+func newPod(t *testing.T, b *builder.PodBuilder) *unstructured.Unstructured {
+	return toUnstructured(t, b.Result())
+}
+
+// memoryObjectStore is an example ObjectStore that stores objects in memory.
+type memoryObjectStore struct {
+	lock    sync.Mutex
+	objects map[string][]byte
+}
+
+func newMemoryObjectStore() *memoryObjectStore {
+	return &memoryObjectStore{objects: make(map[string][]byte)}
+}
+
+func (s *memoryObjectStore) Init(config map[string]string) error {
+	return nil
+}
+
+func (s *memoryObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.objects[bucket+"/"+key] = data
+	return nil
+}
+
+func (s *memoryObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, exists := s.objects[bucket+"/"+key]
+	return exists, nil
+}
+
+func (s *memoryObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	data, exists := s.objects[bucket+"/"+key]
+	if !exists {
+		return nil, errors.Errorf("object %s not found in bucket %s", key, bucket)
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *memoryObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	keys, err := s.ListObjects(bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool)
+	var prefixes []string
+	for _, key := range keys {
+		i := strings.Index(key[len(prefix):], delimiter)
+		if i < 0 {
+			continue
+		}
+		commonPrefix := key[:len(prefix)+i+len(delimiter)]
+		if !found[commonPrefix] {
+			found[commonPrefix] = true
+			prefixes = append(prefixes, commonPrefix)
+		}
+	}
+	return prefixes, nil
+}
+
+func (s *memoryObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var keys []string
+	for bucketKey := range s.objects {
+		if strings.HasPrefix(bucketKey, bucket+"/"+prefix) {
+			keys = append(keys, strings.TrimPrefix(bucketKey, bucket+"/"))
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *memoryObjectStore) DeleteObject(bucket, key string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.objects, bucket+"/"+key)
+	return nil
+}
+
+func (s *memoryObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	return fmt.Sprintf("https://objects.example.com/%s/%s?expires=%d", bucket, key, int(ttl.Seconds())), nil
+}
+
+// memoryVolumeSnapshotter is an example VolumeSnapshotter for volumes of the example CSI
+// driver, that keeps its snapshots in memory.
+type memoryVolumeSnapshotter struct {
+	lock      sync.Mutex
+	snapshots map[string]string
+	count     int
+}
+
+func newMemoryVolumeSnapshotter() *memoryVolumeSnapshotter {
+	return &memoryVolumeSnapshotter{snapshots: make(map[string]string)}
+}
+
+func (s *memoryVolumeSnapshotter) Init(config map[string]string) error {
+	return nil
+}
+
+func (s *memoryVolumeSnapshotter) CreateVolumeFromSnapshot(snapshotID, volumeType, volumeAZ string, iops *int64) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, exists := s.snapshots[snapshotID]; !exists {
+		return "", errors.Errorf("snapshot %s not found", snapshotID)
+	}
+	s.count++
+	return fmt.Sprintf("volume-from-%s-%d", snapshotID, s.count), nil
+}
+
+func (s *memoryVolumeSnapshotter) GetVolumeID(unstructuredPV runtime.Unstructured) (string, error) {
+	pv := new(corev1api.PersistentVolume)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPV.UnstructuredContent(), pv); err != nil {
+		return "", errors.WithStack(err)
+	}
+	if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != exampleCSIDriver {
+		return "", nil
+	}
+	return pv.Spec.CSI.VolumeHandle, nil
+}
+
+func (s *memoryVolumeSnapshotter) SetVolumeID(unstructuredPV runtime.Unstructured, volumeID string) (runtime.Unstructured, error) {
+	pv := new(corev1api.PersistentVolume)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPV.UnstructuredContent(), pv); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if pv.Spec.CSI == nil {
+		return nil, errors.New("persistent volume is not a CSI volume")
+	}
+	pv.Spec.CSI.VolumeHandle = volumeID
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pv)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+func (s *memoryVolumeSnapshotter) GetVolumeInfo(volumeID, volumeAZ string) (string, *int64, error) {
+	return "standard", nil, nil
+}
+
+func (s *memoryVolumeSnapshotter) CreateSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.count++
+	snapshotID := fmt.Sprintf("snapshot-%d", s.count)
+	s.snapshots[snapshotID] = volumeID
+	return snapshotID, nil
+}
+
+func (s *memoryVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.snapshots, snapshotID)
+	return nil
+}
+
+const (
+	backedUpByAnnotation = "example.velero.io/backed-up-by"
+	failAnnotation       = "example.velero.io/fail"
+	skipRestoreLabel     = "example.velero.io/skip-restore"
+)
+
+// podBackupItemAction is an example BackupItemAction that annotates pods with the name of the
+// backup, and backs up the persistent volume claims that they use.
+type podBackupItemAction struct{}
+
+func (a *podBackupItemAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{IncludedResources: []string{"pods"}}, nil
+}
+
+func (a *podBackupItemAction) Execute(item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	pod := new(corev1api.Pod)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pod); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	if pod.Annotations[failAnnotation] == "true" {
+		return nil, nil, errors.Errorf("pod %s/%s is marked to fail", pod.Namespace, pod.Name)
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+	pod.Annotations[backedUpByAnnotation] = backup.Name
+
+	var additionalItems []velero.ResourceIdentifier
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			additionalItems = append(additionalItems, velero.ResourceIdentifier{
+				GroupResource: kuberesource.PersistentVolumeClaims,
+				Namespace:     pod.Namespace,
+				Name:          volume.PersistentVolumeClaim.ClaimName,
+			})
+		}
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	return &unstructured.Unstructured{Object: content}, additionalItems, nil
+}
+
+// podRestoreItemAction is an example RestoreItemAction that removes the node names of pods,
+// and skips pods with the skip label.
+type podRestoreItemAction struct{}
+
+func (a *podRestoreItemAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{IncludedResources: []string{"pods"}}, nil
+}
+
+func (a *podRestoreItemAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	item := &unstructured.Unstructured{Object: input.Item.UnstructuredContent()}
+	if item.GetLabels()[skipRestoreLabel] == "true" {
+		return velero.NewRestoreItemActionExecuteOutput(item).WithoutRestore(), nil
+	}
+
+	unstructured.RemoveNestedField(item.Object, "spec", "nodeName")
+	return velero.NewRestoreItemActionExecuteOutput(item), nil
+}
+
+// check that the example plugins implement the plugin interfaces.
+var (
+	_ velero.ObjectStore       = &memoryObjectStore{}
+	_ velero.VolumeSnapshotter = &memoryVolumeSnapshotter{}
+	_ velero.BackupItemAction  = &podBackupItemAction{}
+	_ velero.RestoreItemAction = &podRestoreItemAction{}
+)
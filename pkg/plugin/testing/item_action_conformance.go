@@ -0,0 +1,177 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// RunBackupItemActionConformance checks that action behaves the way Velero expects a
+// BackupItemAction to behave when it's executed for each of items that it applies to, such
+// as the items returned by ItemsFromBackup. If backup is nil, a backup named "backup" in the
+// "velero" namespace is used.
+//
+// The action must have a valid AppliesTo selector, and for each item it must succeed, return
+// the item without changing its identity, only return additional items with a resource and a
+// name, and return the same output when it's executed again.
+func RunBackupItemActionConformance(t *testing.T, action biav2.BackupItemAction, items []RecordedItem, backup *velerov1api.Backup) {
+	if backup == nil {
+		backup = defaultBackup()
+	}
+
+	checkAppliesTo(t, action)
+	resolved, err := framework.NewBackupItemActionResolver([]biav2.BackupItemAction{action}).ResolveActions(newDiscoveryHelper())
+	require.NoError(t, err, "error resolving the action's AppliesTo selector")
+
+	for _, item := range items {
+		item := item
+		if !resolved[0].ShouldUse(item.Resource, item.Item.GetNamespace(), item.Item, velerotest.NewLogger()) {
+			continue
+		}
+
+		t.Run(itemTestName(item), func(t *testing.T) {
+			execute := func() *biav2.ExecuteOutput {
+				output, err := action.Execute(&biav2.ExecuteInput{Item: item.Item.DeepCopy(), Backup: backup})
+				require.NoError(t, err)
+				require.NotNil(t, output)
+				return output
+			}
+
+			output := execute()
+			if output.UpdatedItem == nil {
+				assert.True(t, output.SkipBackup, "action returned no item but didn't skip the backup of the item")
+			} else {
+				assertIdentityUnchanged(t, item.Item, output.UpdatedItem)
+			}
+			assertAdditionalItemsValid(t, output.AdditionalItems)
+
+			again := execute()
+			assert.Equal(t, output.SkipBackup, again.SkipBackup, "action isn't deterministic")
+			assert.ElementsMatch(t, output.AdditionalItems, again.AdditionalItems, "action isn't deterministic")
+			if output.UpdatedItem != nil {
+				assertItemsEqual(t, &unstructured.Unstructured{Object: output.UpdatedItem.UnstructuredContent()}, again.UpdatedItem)
+			}
+		})
+	}
+}
+
+// RunRestoreItemActionConformance checks that action behaves the way Velero expects a
+// RestoreItemAction to behave when it's executed for each of items that it applies to, such
+// as the items returned by ItemsFromBackup. If restore is nil, a restore named "restore" in
+// the "velero" namespace is used.
+//
+// The action must have a valid AppliesTo selector, and for each item it must succeed, return
+// an item unless it skips the item's restore, keep the item's kind, only return additional
+// items with a resource and a name, and return the same output when it's executed again.
+func RunRestoreItemActionConformance(t *testing.T, action velero.RestoreItemAction, items []RecordedItem, restore *velerov1api.Restore) {
+	if restore == nil {
+		restore = defaultRestore()
+	}
+
+	checkAppliesTo(t, action)
+	resolved, err := framework.NewRestoreItemActionResolver([]velero.RestoreItemAction{action}).ResolveActions(newDiscoveryHelper())
+	require.NoError(t, err, "error resolving the action's AppliesTo selector")
+
+	for _, item := range items {
+		item := item
+		if !resolved[0].ShouldUse(item.Resource, item.Item.GetNamespace(), item.Item, velerotest.NewLogger()) {
+			continue
+		}
+
+		t.Run(itemTestName(item), func(t *testing.T) {
+			execute := func() *velero.RestoreItemActionExecuteOutput {
+				output, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+					Item:           item.Item.DeepCopy(),
+					ItemFromBackup: item.Item.DeepCopy(),
+					Restore:        restore,
+				})
+				require.NoError(t, err)
+				require.NotNil(t, output)
+				return output
+			}
+
+			output := execute()
+			if output.UpdatedItem == nil {
+				assert.True(t, output.SkipRestore, "action returned no item but didn't skip the restore of the item")
+			} else {
+				// Restore item actions may rename items, e.g. to remap namespaces, but they
+				// can't change what kind of item is restored.
+				assert.Equal(t, item.Item.GetObjectKind().GroupVersionKind().GroupKind(), output.UpdatedItem.GetObjectKind().GroupVersionKind().GroupKind(),
+					"action changed the item's kind")
+			}
+			assertAdditionalItemsValid(t, output.AdditionalItems)
+
+			again := execute()
+			assert.Equal(t, output.SkipRestore, again.SkipRestore, "action isn't deterministic")
+			assert.ElementsMatch(t, output.AdditionalItems, again.AdditionalItems, "action isn't deterministic")
+			if output.UpdatedItem != nil {
+				assertItemsEqual(t, &unstructured.Unstructured{Object: output.UpdatedItem.UnstructuredContent()}, again.UpdatedItem)
+			}
+		})
+	}
+}
+
+// checkAppliesTo checks that the action's AppliesTo selector is valid.
+func checkAppliesTo(t *testing.T, action velero.Applicable) {
+	t.Helper()
+
+	selector, err := action.AppliesTo()
+	require.NoError(t, err, "AppliesTo returned an error")
+	if selector.LabelSelector != "" {
+		_, err := labels.Parse(selector.LabelSelector)
+		require.NoError(t, err, "AppliesTo returned an invalid label selector")
+	}
+}
+
+func itemTestName(item RecordedItem) string {
+	if item.Item.GetNamespace() == "" {
+		return fmt.Sprintf("%s/%s", item.Resource, item.Item.GetName())
+	}
+	return fmt.Sprintf("%s/%s/%s", item.Resource, item.Item.GetNamespace(), item.Item.GetName())
+}
+
+// assertIdentityUnchanged asserts that got has the kind, namespace and name of want.
+func assertIdentityUnchanged(t *testing.T, want *unstructured.Unstructured, got runtime.Unstructured) {
+	t.Helper()
+
+	gotItem := &unstructured.Unstructured{Object: got.UnstructuredContent()}
+	assert.Equal(t, want.GroupVersionKind().GroupKind(), gotItem.GroupVersionKind().GroupKind(), "action changed the item's kind")
+	assert.Equal(t, want.GetNamespace(), gotItem.GetNamespace(), "action changed the item's namespace")
+	assert.Equal(t, want.GetName(), gotItem.GetName(), "action changed the item's name")
+}
+
+func assertAdditionalItemsValid(t *testing.T, items []velero.ResourceIdentifier) {
+	t.Helper()
+
+	for _, item := range items {
+		assert.NotEmpty(t, item.Resource, "additional item %v has no resource", item)
+		assert.NotEmpty(t, item.Name, "additional item %v has no name", item)
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backup_item_action/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// BackupItemActionTest is a test case for a BackupItemAction.
+type BackupItemActionTest struct {
+	// Name is the name of the test case.
+	Name string
+
+	// Resource is the item's resource, e.g. "pods" or "deployments.apps".
+	Resource string
+
+	// Item is the item that the action is executed for.
+	Item *unstructured.Unstructured
+
+	// Backup is the backup that the item is backed up in. If it's nil, a backup named
+	// "backup" in the "velero" namespace is used.
+	Backup *velerov1api.Backup
+
+	// WantNotApplicable means that the action's AppliesTo selector shouldn't select the
+	// item. If it's true, the action isn't executed and nothing else is checked.
+	WantNotApplicable bool
+
+	// WantItem is the item that the action should return. If it's nil, the returned item
+	// isn't checked.
+	WantItem *unstructured.Unstructured
+
+	// WantAdditionalItems are the additional items that the action should return, in any
+	// order.
+	WantAdditionalItems []velero.ResourceIdentifier
+
+	// WantSkipBackup means that the action should tell Velero not to back up the item.
+	WantSkipBackup bool
+
+	// WantErr is a substring of the error that the action should return. If it's empty,
+	// the action should succeed.
+	WantErr string
+}
+
+// RestoreItemActionTest is a test case for a RestoreItemAction.
+type RestoreItemActionTest struct {
+	// Name is the name of the test case.
+	Name string
+
+	// Resource is the item's resource, e.g. "pods" or "deployments.apps".
+	Resource string
+
+	// Item is the item that the action is executed for.
+	Item *unstructured.Unstructured
+
+	// ItemFromBackup is the item as it was backed up. If it's nil, Item is used.
+	ItemFromBackup *unstructured.Unstructured
+
+	// Restore is the restore that the item is restored in. If it's nil, a restore named
+	// "restore" in the "velero" namespace, of a backup named "backup", is used.
+	Restore *velerov1api.Restore
+
+	// WantNotApplicable means that the action's AppliesTo selector shouldn't select the
+	// item. If it's true, the action isn't executed and nothing else is checked.
+	WantNotApplicable bool
+
+	// WantItem is the item that the action should return. If it's nil, the returned item
+	// isn't checked.
+	WantItem *unstructured.Unstructured
+
+	// WantAdditionalItems are the additional items that the action should return, in any
+	// order.
+	WantAdditionalItems []velero.ResourceIdentifier
+
+	// WantSkipRestore means that the action should tell Velero not to restore the item.
+	WantSkipRestore bool
+
+	// WantErr is a substring of the error that the action should return. If it's empty,
+	// the action should succeed.
+	WantErr string
+}
+
+// RunBackupItemActionTests runs each test case as a subtest of t.
+func RunBackupItemActionTests(t *testing.T, action biav2.BackupItemAction, tests []BackupItemActionTest) {
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			require.NotNil(t, tc.Item, "test case has no item")
+
+			resolved, err := framework.NewBackupItemActionResolver([]biav2.BackupItemAction{action}).ResolveActions(newDiscoveryHelper())
+			require.NoError(t, err, "error resolving the action's AppliesTo selector")
+			applies := resolved[0].ShouldUse(schema.ParseGroupResource(tc.Resource), tc.Item.GetNamespace(), tc.Item, velerotest.NewLogger())
+			if tc.WantNotApplicable {
+				assert.False(t, applies, "action applies to the item")
+				return
+			}
+			require.True(t, applies, "action doesn't apply to the item")
+
+			backup := tc.Backup
+			if backup == nil {
+				backup = defaultBackup()
+			}
+
+			output, err := action.Execute(&biav2.ExecuteInput{Item: tc.Item.DeepCopy(), Backup: backup})
+			if tc.WantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.WantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, output)
+
+			if tc.WantItem != nil {
+				assertItemsEqual(t, tc.WantItem, output.UpdatedItem)
+			}
+			assert.ElementsMatch(t, tc.WantAdditionalItems, output.AdditionalItems)
+			assert.Equal(t, tc.WantSkipBackup, output.SkipBackup)
+		})
+	}
+}
+
+// RunRestoreItemActionTests runs each test case as a subtest of t.
+func RunRestoreItemActionTests(t *testing.T, action velero.RestoreItemAction, tests []RestoreItemActionTest) {
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			require.NotNil(t, tc.Item, "test case has no item")
+
+			resolved, err := framework.NewRestoreItemActionResolver([]velero.RestoreItemAction{action}).ResolveActions(newDiscoveryHelper())
+			require.NoError(t, err, "error resolving the action's AppliesTo selector")
+			applies := resolved[0].ShouldUse(schema.ParseGroupResource(tc.Resource), tc.Item.GetNamespace(), tc.Item, velerotest.NewLogger())
+			if tc.WantNotApplicable {
+				assert.False(t, applies, "action applies to the item")
+				return
+			}
+			require.True(t, applies, "action doesn't apply to the item")
+
+			itemFromBackup := tc.ItemFromBackup
+			if itemFromBackup == nil {
+				itemFromBackup = tc.Item
+			}
+			restore := tc.Restore
+			if restore == nil {
+				restore = defaultRestore()
+			}
+
+			output, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           tc.Item.DeepCopy(),
+				ItemFromBackup: itemFromBackup.DeepCopy(),
+				Restore:        restore,
+			})
+			if tc.WantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.WantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, output)
+
+			if tc.WantItem != nil {
+				assertItemsEqual(t, tc.WantItem, output.UpdatedItem)
+			}
+			assert.ElementsMatch(t, tc.WantAdditionalItems, output.AdditionalItems)
+			assert.Equal(t, tc.WantSkipRestore, output.SkipRestore)
+		})
+	}
+}
+
+// newDiscoveryHelper returns a discovery helper that resolves resources to themselves, so
+// actions' AppliesTo selectors must name resources the way test cases do.
+func newDiscoveryHelper() *velerotest.FakeDiscoveryHelper {
+	return velerotest.NewFakeDiscoveryHelper(true, nil)
+}
+
+func defaultBackup() *velerov1api.Backup {
+	return builder.ForBackup(velerov1api.DefaultNamespace, "backup").Result()
+}
+
+func defaultRestore() *velerov1api.Restore {
+	return builder.ForRestore(velerov1api.DefaultNamespace, "restore").Backup("backup").Result()
+}
+
+// assertItemsEqual asserts that the JSON encodings of want and got are equal, so that items
+// that were decoded from a plugin's response compare equal to items built by a test.
+func assertItemsEqual(t *testing.T, want *unstructured.Unstructured, got runtime.Unstructured) bool {
+	t.Helper()
+
+	if !assert.NotNil(t, got, "action returned no item") {
+		return false
+	}
+	wantJSON, err := json.Marshal(want.Object)
+	require.NoError(t, err)
+	gotJSON, err := json.Marshal(got.UnstructuredContent())
+	require.NoError(t, err)
+	return assert.JSONEq(t, string(wantJSON), string(gotJSON))
+}
@@ -0,0 +1,188 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// RecordedItem is an item recorded in a backup tarball.
+type RecordedItem struct {
+	// Resource is the item's resource, e.g. "pods" or "deployments.apps".
+	Resource schema.GroupResource
+
+	// Item is the item as it was backed up.
+	Item *unstructured.Unstructured
+}
+
+// LoadItem reads an item from a YAML or JSON file. The test fails if the file can't be read.
+func LoadItem(t testing.TB, filename string) *unstructured.Unstructured {
+	t.Helper()
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("error reading item: %v", err)
+	}
+
+	item, err := decodeItem(data)
+	if err != nil {
+		t.Fatalf("error decoding item in %s: %v", filename, err)
+	}
+	return item
+}
+
+// ItemsFromBackup reads the items recorded in a backup tarball, such as one downloaded with
+// "velero backup download". Items are returned in the order of their resource, namespace and
+// name. The test fails if the tarball can't be read.
+func ItemsFromBackup(t testing.TB, tarball string) []RecordedItem {
+	t.Helper()
+
+	file, err := os.Open(tarball)
+	if err != nil {
+		t.Fatalf("error opening backup tarball: %v", err)
+	}
+	defer file.Close()
+
+	items, err := readBackupItems(file)
+	if err != nil {
+		t.Fatalf("error reading backup tarball %s: %v", tarball, err)
+	}
+	return items
+}
+
+func decodeItem(data []byte) (*unstructured.Unstructured, error) {
+	obj := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+// readBackupItems reads the items in a gzipped backup tarball. Backups made with the
+// EnableAPIGroupVersions feature record items once per API version; only the items of the
+// preferred version are returned.
+func readBackupItems(r io.Reader) ([]RecordedItem, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating gzip reader")
+	}
+	defer gzr.Close()
+
+	type recorded struct {
+		RecordedItem
+		versioned bool
+	}
+	itemsByKey := make(map[string]recorded)
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading tarball")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		resource, key, versioned, ok := parseItemPath(header.Name)
+		if !ok {
+			continue
+		}
+		// Items in the unversioned directories take precedence over the same items in the
+		// preferred version's directory.
+		if existing, found := itemsByKey[key]; found && !existing.versioned {
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading %s", header.Name)
+		}
+		item, err := decodeItem(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decoding %s", header.Name)
+		}
+
+		itemsByKey[key] = recorded{
+			RecordedItem: RecordedItem{Resource: resource, Item: item},
+			versioned:    versioned,
+		}
+	}
+
+	keys := make([]string, 0, len(itemsByKey))
+	for key := range itemsByKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	items := make([]RecordedItem, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, itemsByKey[key].RecordedItem)
+	}
+	return items, nil
+}
+
+// parseItemPath parses the path of an item in a backup tarball, which is one of
+//
+//	resources/<resource>/[<version>-preferredversion/]namespaces/<namespace>/<name>.json
+//	resources/<resource>/[<version>-preferredversion/]cluster/<name>.json
+//
+// It returns the item's resource, a key that identifies the item, and whether the path is
+// in a preferred version's directory. ok is false for paths that aren't items.
+func parseItemPath(itemPath string) (resource schema.GroupResource, key string, versioned bool, ok bool) {
+	parts := strings.Split(path.Clean(strings.TrimPrefix(itemPath, "./")), "/")
+	if len(parts) < 4 || parts[0] != velerov1api.ResourcesDir || !strings.HasSuffix(parts[len(parts)-1], ".json") {
+		return schema.GroupResource{}, "", false, false
+	}
+
+	resource = schema.ParseGroupResource(parts[1])
+	rest := parts[2:]
+	if strings.HasSuffix(rest[0], velerov1api.PreferredVersionDir) {
+		versioned = true
+		rest = rest[1:]
+	}
+
+	var namespace, name string
+	switch {
+	case len(rest) == 3 && rest[0] == velerov1api.NamespaceScopedDir:
+		namespace, name = rest[1], rest[2]
+	case len(rest) == 2 && rest[0] == velerov1api.ClusterScopedDir:
+		name = rest[1]
+	default:
+		return schema.GroupResource{}, "", false, false
+	}
+
+	return resource, strings.Join([]string{resource.String(), namespace, strings.TrimSuffix(name, ".json")}, "/"), versioned, true
+}
@@ -0,0 +1,107 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// writeBackupTarball writes a gzipped tarball with files to a temporary file, and returns its
+// path.
+func writeBackupTarball(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	file, err := ioutil.TempFile(t.TempDir(), "backup-*.tar.gz")
+	require.NoError(t, err)
+	defer file.Close()
+
+	gzw := gzip.NewWriter(file)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(content)), Mode: 0644, Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	return file.Name()
+}
+
+func TestItemsFromBackup(t *testing.T) {
+	tarball := writeBackupTarball(t, map[string]string{
+		"metadata/version":                                                                  "1",
+		"resources/pods/namespaces/ns-1/pod-1.json":                                         `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"}}`,
+		"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json":                     `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1","labels":{"versioned":"true"}}}`,
+		"resources/horizontalpodautoscalers.autoscaling/namespaces/ns-1/hpa-1.json":         `{"apiVersion":"autoscaling/v1","kind":"HorizontalPodAutoscaler","metadata":{"namespace":"ns-1","name":"hpa-1"}}`,
+		"resources/horizontalpodautoscalers.autoscaling/v2beta2/namespaces/ns-1/hpa-1.json": `{"apiVersion":"autoscaling/v2beta2","kind":"HorizontalPodAutoscaler","metadata":{"namespace":"ns-1","name":"hpa-1"}}`,
+		"resources/widgets.example.com/v1-preferredversion/namespaces/ns-2/widget-1.json":   `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"namespace":"ns-2","name":"widget-1"}}`,
+		"resources/persistentvolumes/cluster/pv-1.json":                                     `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1"}}`,
+		"resources/persistentvolumes/cluster/not-an-item.txt":                               "not an item",
+	})
+
+	items := ItemsFromBackup(t, tarball)
+
+	type summary struct {
+		resource  schema.GroupResource
+		namespace string
+		name      string
+		version   string
+		labels    map[string]string
+	}
+	var got []summary
+	for _, item := range items {
+		got = append(got, summary{
+			resource:  item.Resource,
+			namespace: item.Item.GetNamespace(),
+			name:      item.Item.GetName(),
+			version:   item.Item.GetAPIVersion(),
+			labels:    item.Item.GetLabels(),
+		})
+	}
+
+	assert.Equal(t, []summary{
+		{resource: schema.GroupResource{Group: "autoscaling", Resource: "horizontalpodautoscalers"}, namespace: "ns-1", name: "hpa-1", version: "autoscaling/v1"},
+		{resource: schema.GroupResource{Resource: "persistentvolumes"}, name: "pv-1", version: "v1"},
+		{resource: schema.GroupResource{Resource: "pods"}, namespace: "ns-1", name: "pod-1", version: "v1"},
+		{resource: schema.GroupResource{Group: "example.com", Resource: "widgets"}, namespace: "ns-2", name: "widget-1", version: "example.com/v1"},
+	}, got)
+}
+
+func TestLoadItem(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlFile := filepath.Join(dir, "pod.yaml")
+	require.NoError(t, ioutil.WriteFile(yamlFile, []byte("apiVersion: v1\nkind: Pod\nmetadata:\n  namespace: ns-1\n  name: pod-1\n"), 0644))
+	jsonFile := filepath.Join(dir, "pod.json")
+	require.NoError(t, ioutil.WriteFile(jsonFile, []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"}}`), 0644))
+
+	for _, file := range []string{yamlFile, jsonFile} {
+		item := LoadItem(t, file)
+		assert.Equal(t, "Pod", item.GetKind())
+		assert.Equal(t, "ns-1", item.GetNamespace())
+		assert.Equal(t, "pod-1", item.GetName())
+	}
+}
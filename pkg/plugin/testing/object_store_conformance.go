@@ -0,0 +1,191 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ObjectStoreConformanceConfig configures RunObjectStoreConformance.
+type ObjectStoreConformanceConfig struct {
+	// Config is the configuration that the object store is initialized with, as it would be
+	// set in a BackupStorageLocation's spec.config.
+	Config map[string]string
+
+	// Bucket is the bucket that objects are written to. It must exist.
+	Bucket string
+
+	// Prefix is the prefix, within the bucket, of the keys of the objects that are written.
+	// Each run writes its objects under a new random prefix below it, and deletes them when
+	// it's done.
+	Prefix string
+
+	// SkipSignedURL skips the check of CreateSignedURL, for object stores that don't
+	// support signed URLs.
+	SkipSignedURL bool
+}
+
+// largeObjectSize is larger than the chunks that objects are streamed to and from plugins in,
+// so that objects of this size are streamed in several chunks.
+const largeObjectSize = 100 * 1024
+
+// RunObjectStoreConformance checks that store behaves the way Velero expects an ObjectStore to
+// behave. It initializes store with config.Config, and writes, reads, lists and deletes objects
+// in config.Bucket.
+func RunObjectStoreConformance(t *testing.T, store velero.ObjectStore, config ObjectStoreConformanceConfig) {
+	require.NoError(t, store.Init(config.Config), "error initializing object store")
+
+	prefix := path.Join(config.Prefix, "velero-conformance-"+rand.String(8)) + "/"
+	key := func(name string) string {
+		return prefix + name
+	}
+
+	objects := map[string][]byte{
+		key("backups/backup-1/velero-backup.json"):  []byte(`{"kind":"Backup"}`),
+		key("backups/backup-1/backup-1.tar.gz"):     bytes.Repeat([]byte("0123456789"), largeObjectSize/10),
+		key("backups/backup-2/velero-backup.json"):  []byte(`{"kind":"Backup"}`),
+		key("restores/restore-1/restore-1-logs.gz"): []byte("logs"),
+		key("metadata/revision"):                    []byte("revision"),
+	}
+
+	t.Cleanup(func() {
+		keys, err := store.ListObjects(config.Bucket, prefix)
+		if err != nil {
+			t.Logf("error listing objects to clean up: %v", err)
+			return
+		}
+		for _, key := range keys {
+			if err := store.DeleteObject(config.Bucket, key); err != nil {
+				t.Logf("error deleting object %s: %v", key, err)
+			}
+		}
+	})
+
+	getObject := func(key string) ([]byte, error) {
+		reader, err := store.GetObject(config.Bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	}
+
+	t.Run("PutObject writes objects", func(t *testing.T) {
+		for key, data := range objects {
+			require.NoError(t, store.PutObject(config.Bucket, key, bytes.NewReader(data)), "error putting object %s", key)
+		}
+	})
+
+	t.Run("ObjectExists finds objects that exist", func(t *testing.T) {
+		for key := range objects {
+			exists, err := store.ObjectExists(config.Bucket, key)
+			require.NoError(t, err)
+			assert.True(t, exists, "object %s doesn't exist", key)
+		}
+	})
+
+	t.Run("ObjectExists doesn't return an error for objects that don't exist", func(t *testing.T) {
+		exists, err := store.ObjectExists(config.Bucket, key("missing"))
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("GetObject reads objects", func(t *testing.T) {
+		for key, want := range objects {
+			data, err := getObject(key)
+			require.NoError(t, err, "error getting object %s", key)
+			assert.True(t, bytes.Equal(want, data), "object %s has %d bytes, want %d", key, len(data), len(want))
+		}
+	})
+
+	t.Run("GetObject returns an error for objects that don't exist", func(t *testing.T) {
+		_, err := getObject(key("missing"))
+		assert.Error(t, err)
+	})
+
+	t.Run("PutObject overwrites objects", func(t *testing.T) {
+		revision := key("metadata/revision")
+		require.NoError(t, store.PutObject(config.Bucket, revision, bytes.NewReader([]byte("new revision"))))
+
+		data, err := getObject(revision)
+		require.NoError(t, err)
+		assert.Equal(t, "new revision", string(data))
+	})
+
+	t.Run("ListObjects lists the objects with a prefix", func(t *testing.T) {
+		keys, err := store.ListObjects(config.Bucket, key("backups/"))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{
+			key("backups/backup-1/velero-backup.json"),
+			key("backups/backup-1/backup-1.tar.gz"),
+			key("backups/backup-2/velero-backup.json"),
+		}, keys)
+
+		keys, err = store.ListObjects(config.Bucket, key("none/"))
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("ListCommonPrefixes lists the prefixes up to the delimiter", func(t *testing.T) {
+		prefixes, err := store.ListCommonPrefixes(config.Bucket, prefix, "/")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{key("backups/"), key("restores/"), key("metadata/")}, prefixes)
+
+		prefixes, err = store.ListCommonPrefixes(config.Bucket, key("backups/"), "/")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{key("backups/backup-1/"), key("backups/backup-2/")}, prefixes)
+
+		prefixes, err = store.ListCommonPrefixes(config.Bucket, key("metadata/"), "/")
+		require.NoError(t, err)
+		assert.Empty(t, prefixes, "objects directly under the prefix aren't common prefixes")
+	})
+
+	if !config.SkipSignedURL {
+		t.Run("CreateSignedURL returns an absolute URL", func(t *testing.T) {
+			signedURL, err := store.CreateSignedURL(config.Bucket, key("backups/backup-1/backup-1.tar.gz"), 10*time.Minute)
+			require.NoError(t, err)
+
+			parsed, err := url.Parse(signedURL)
+			require.NoError(t, err)
+			assert.True(t, parsed.IsAbs(), "signed URL %q isn't absolute", signedURL)
+		})
+	}
+
+	t.Run("DeleteObject deletes objects", func(t *testing.T) {
+		deleted := key("backups/backup-2/velero-backup.json")
+		require.NoError(t, store.DeleteObject(config.Bucket, deleted))
+
+		exists, err := store.ObjectExists(config.Bucket, deleted)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		keys, err := store.ListObjects(config.Bucket, key("backups/"))
+		require.NoError(t, err)
+		assert.NotContains(t, keys, deleted)
+	})
+}
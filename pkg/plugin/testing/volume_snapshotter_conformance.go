@@ -0,0 +1,140 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// VolumeSnapshotterConformanceConfig configures RunVolumeSnapshotterConformance.
+type VolumeSnapshotterConformanceConfig struct {
+	// Config is the configuration that the volume snapshotter is initialized with, as it
+	// would be set in a VolumeSnapshotLocation's spec.config.
+	Config map[string]string
+
+	// PersistentVolume is a persistent volume, backed by a volume that the volume snapshotter
+	// can snapshot. The volume is snapshotted, and a new volume is created from the snapshot.
+	PersistentVolume *corev1api.PersistentVolume
+
+	// VolumeAZ is the availability zone of the persistent volume's volume.
+	VolumeAZ string
+
+	// Tags are the tags that the snapshot is created with.
+	Tags map[string]string
+}
+
+// RunVolumeSnapshotterConformance checks that snapshotter behaves the way Velero expects a
+// VolumeSnapshotter to behave. It initializes snapshotter with config.Config, snapshots the
+// volume of config.PersistentVolume, creates a volume from the snapshot, and deletes the
+// snapshot, which must succeed again once the snapshot has been deleted. The created volume
+// isn't deleted, since VolumeSnapshotters can't delete volumes.
+func RunVolumeSnapshotterConformance(t *testing.T, snapshotter velero.VolumeSnapshotter, config VolumeSnapshotterConformanceConfig) {
+	require.NotNil(t, config.PersistentVolume, "config has no persistent volume")
+	require.NoError(t, snapshotter.Init(config.Config), "error initializing volume snapshotter")
+
+	pv := toUnstructured(t, config.PersistentVolume)
+
+	var volumeID, snapshotID, restoredVolumeID string
+
+	t.Run("GetVolumeID returns the persistent volume's volume ID", func(t *testing.T) {
+		var err error
+		volumeID, err = snapshotter.GetVolumeID(pv.DeepCopy())
+		require.NoError(t, err)
+		require.NotEmpty(t, volumeID)
+	})
+
+	t.Run("GetVolumeID returns an empty volume ID for unsupported persistent volumes", func(t *testing.T) {
+		unsupported := builder.ForPersistentVolume("unsupported").Result()
+		unsupported.Spec.HostPath = &corev1api.HostPathVolumeSource{Path: "/data"}
+
+		id, err := snapshotter.GetVolumeID(toUnstructured(t, unsupported))
+		require.NoError(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("GetVolumeInfo returns the volume's info", func(t *testing.T) {
+		require.NotEmpty(t, volumeID, "no volume ID")
+
+		_, iops, err := snapshotter.GetVolumeInfo(volumeID, config.VolumeAZ)
+		require.NoError(t, err)
+		if iops != nil {
+			assert.GreaterOrEqual(t, *iops, int64(0))
+		}
+	})
+
+	t.Run("CreateSnapshot snapshots the volume", func(t *testing.T) {
+		require.NotEmpty(t, volumeID, "no volume ID")
+
+		var err error
+		snapshotID, err = snapshotter.CreateSnapshot(volumeID, config.VolumeAZ, config.Tags)
+		require.NoError(t, err)
+		require.NotEmpty(t, snapshotID)
+	})
+
+	t.Run("CreateVolumeFromSnapshot creates a volume from the snapshot", func(t *testing.T) {
+		require.NotEmpty(t, snapshotID, "no snapshot ID")
+
+		volumeType, iops, err := snapshotter.GetVolumeInfo(volumeID, config.VolumeAZ)
+		require.NoError(t, err)
+
+		restoredVolumeID, err = snapshotter.CreateVolumeFromSnapshot(snapshotID, volumeType, config.VolumeAZ, iops)
+		require.NoError(t, err)
+		require.NotEmpty(t, restoredVolumeID)
+	})
+
+	t.Run("SetVolumeID sets the persistent volume's volume ID", func(t *testing.T) {
+		require.NotEmpty(t, restoredVolumeID, "no restored volume ID")
+
+		updated, err := snapshotter.SetVolumeID(pv.DeepCopy(), restoredVolumeID)
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+
+		id, err := snapshotter.GetVolumeID(updated)
+		require.NoError(t, err)
+		assert.Equal(t, restoredVolumeID, id)
+
+		updatedPV := &unstructured.Unstructured{Object: updated.UnstructuredContent()}
+		assert.Equal(t, pv.GetName(), updatedPV.GetName(), "SetVolumeID changed the persistent volume's name")
+	})
+
+	t.Run("DeleteSnapshot deletes the snapshot", func(t *testing.T) {
+		require.NotEmpty(t, snapshotID, "no snapshot ID")
+		require.NoError(t, snapshotter.DeleteSnapshot(snapshotID))
+	})
+
+	t.Run("DeleteSnapshot doesn't return an error for snapshots that don't exist", func(t *testing.T) {
+		require.NotEmpty(t, snapshotID, "no snapshot ID")
+		assert.NoError(t, snapshotter.DeleteSnapshot(snapshotID))
+	})
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	t.Helper()
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: content}
+}
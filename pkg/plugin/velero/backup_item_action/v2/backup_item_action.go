@@ -0,0 +1,92 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ExecuteInput contains the input parameters for the BackupItemAction's Execute function.
+type ExecuteInput struct {
+	// Item is the item being backed up.
+	Item runtime.Unstructured
+	// Backup is the representation of the backup resource being processed by Velero.
+	Backup *api.Backup
+	// Context is the context of the span that the item is processed in by Velero. The call to
+	// the plugin is traced as a child of that span. It may be nil, and isn't passed to the plugin.
+	Context context.Context
+}
+
+// ExecuteOutput contains the output variables for the BackupItemAction's Execute function.
+type ExecuteOutput struct {
+	// UpdatedItem is the item, unmodified or modified, that should be included in the backup.
+	UpdatedItem runtime.Unstructured
+	// AdditionalItems is a list of related items that should be backed up. They're backed up
+	// even if SkipBackup is true.
+	AdditionalItems []velero.ResourceIdentifier
+	// SkipBackup tells Velero not to include the item in the backup.
+	SkipBackup bool
+}
+
+// BackupItemAction is an actor that performs an operation on an individual item being backed up.
+// It's version 2 of the BackupItemAction plugin kind. Plugins that implement version 1 are adapted
+// to this interface with NewAdaptedV1BackupItemAction.
+type BackupItemAction interface {
+	// AppliesTo returns information about which resources this action should be invoked for.
+	// A BackupItemAction's Execute function will only be invoked on items that match the returned
+	// selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (velero.ResourceSelector, error)
+
+	// Execute allows the ItemAction to perform arbitrary logic with the item being backed up,
+	// including mutating the item itself prior to backup, adding related items to the backup,
+	// or excluding the item from the backup.
+	Execute(input *ExecuteInput) (*ExecuteOutput, error)
+}
+
+// adaptedV1BackupItemAction is a version 1 BackupItemAction adapted to the version 2 interface.
+type adaptedV1BackupItemAction struct {
+	v1 velero.BackupItemAction
+}
+
+// NewAdaptedV1BackupItemAction returns a BackupItemAction that delegates to a version 1
+// BackupItemAction. The adapted action never skips items.
+func NewAdaptedV1BackupItemAction(v1 velero.BackupItemAction) BackupItemAction {
+	return &adaptedV1BackupItemAction{v1: v1}
+}
+
+// AppliesTo delegates to the version 1 action.
+func (a *adaptedV1BackupItemAction) AppliesTo() (velero.ResourceSelector, error) {
+	return a.v1.AppliesTo()
+}
+
+// Execute delegates to the version 1 action, and wraps its results in an ExecuteOutput.
+func (a *adaptedV1BackupItemAction) Execute(input *ExecuteInput) (*ExecuteOutput, error) {
+	updatedItem, additionalItems, err := a.v1.Execute(input.Item, input.Backup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExecuteOutput{
+		UpdatedItem:     updatedItem,
+		AdditionalItems: additionalItems,
+	}, nil
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package velero
 
 import (
+	"context"
+
 	"k8s.io/apimachinery/pkg/runtime"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -42,4 +44,7 @@ type DeleteItemActionExecuteInput struct {
 	Item runtime.Unstructured
 	// Backup is the representation of the restore resource processed by Velero.
 	Backup *velerov1api.Backup
+	// Context is the context of the span that the item is processed in by Velero. The call to
+	// the plugin is traced as a child of that span. It may be nil, and isn't passed to the plugin.
+	Context context.Context
 }
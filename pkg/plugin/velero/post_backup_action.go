@@ -0,0 +1,30 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// PostBackupAction provides a hook into the backup process after it completes.
+type PostBackupAction interface {
+	// Execute allows the PostBackupAction to perform arbitrary logic after the
+	// backup has been run and persisted to object storage, e.g. notifying an
+	// external system. Returned errors are logged, and make a completed backup
+	// partially failed.
+	Execute(backup *velerov1api.Backup) error
+}
@@ -0,0 +1,29 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// PreRestoreAction provides a hook into the restore process before it begins.
+type PreRestoreAction interface {
+	// Execute allows the PreRestoreAction to perform arbitrary logic before the
+	// restore is run, e.g. validating the destination cluster. If an error is
+	// returned, the restore isn't run and its phase is set to FailedPreRestoreActions.
+	Execute(restore *velerov1api.Restore) error
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package velero
 
 import (
+	"context"
+
 	"k8s.io/apimachinery/pkg/runtime"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -47,6 +49,9 @@ type RestoreItemActionExecuteInput struct {
 	ItemFromBackup runtime.Unstructured
 	// Restore is the representation of the restore resource processed by Velero.
 	Restore *api.Restore
+	// Context is the context of the span that the item is processed in by Velero. The call to
+	// the plugin is traced as a child of that span. It may be nil, and isn't passed to the plugin.
+	Context context.Context
 }
 
 // RestoreItemActionExecuteOutput contains the output variables for the ItemAction's Execution function.
@@ -38,17 +38,33 @@ import (
 type Backupper interface {
 	// BackupPodVolumes backs up all specified volumes in a pod.
 	BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, volumesToBackup []string, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error)
+
+	// BackupUnmountedPVC backs up the persistent volume claim if it isn't mounted by any
+	// running pod, by temporarily mounting it in a helper pod. It returns no PodVolumeBackups
+	// if the claim is mounted by a running pod.
+	BackupUnmountedPVC(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error)
+
+	// BackupPVC backs up the persistent volume claim by mounting it in a helper pod,
+	// regardless of whether it's bound or mounted by other pods.
+	BackupPVC(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error)
 }
 
 type backupper struct {
 	ctx         context.Context
 	repoManager *repositoryManager
 	repoEnsurer *repositoryEnsurer
+	podClient   corev1client.PodsGetter
 	pvcClient   corev1client.PersistentVolumeClaimsGetter
 	pvClient    corev1client.PersistentVolumesGetter
 
 	results     map[string]chan *velerov1api.PodVolumeBackup
 	resultsLock sync.Mutex
+
+	// mountedPVCs caches, by namespace, the names of the persistent volume claims
+	// mounted by running pods, so that each namespace's pods are only listed once
+	// per backup.
+	mountedPVCs     map[string]sets.String
+	mountedPVCsLock sync.Mutex
 }
 
 func newBackupper(
@@ -56,6 +72,7 @@ func newBackupper(
 	repoManager *repositoryManager,
 	repoEnsurer *repositoryEnsurer,
 	podVolumeBackupInformer cache.SharedIndexInformer,
+	podClient corev1client.PodsGetter,
 	pvcClient corev1client.PersistentVolumeClaimsGetter,
 	pvClient corev1client.PersistentVolumesGetter,
 	log logrus.FieldLogger,
@@ -64,10 +81,12 @@ func newBackupper(
 		ctx:         ctx,
 		repoManager: repoManager,
 		repoEnsurer: repoEnsurer,
+		podClient:   podClient,
 		pvcClient:   pvcClient,
 		pvClient:    pvClient,
 
-		results: make(map[string]chan *velerov1api.PodVolumeBackup),
+		results:     make(map[string]chan *velerov1api.PodVolumeBackup),
+		mountedPVCs: make(map[string]sets.String),
 	}
 
 	podVolumeBackupInformer.AddEventHandler(
@@ -209,6 +228,78 @@ ForEachVolume:
 	return podVolumeBackups, errs
 }
 
+func (b *backupper) BackupUnmountedPVC(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error) {
+	log = log.WithField("persistentVolumeClaim", fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name))
+
+	if pvc.Status.Phase != corev1api.ClaimBound {
+		log.Info("Persistent volume claim is not bound, skipping restic backup")
+		return nil, nil
+	}
+
+	mountedPVCs, err := b.getMountedPVCs(pvc.Namespace)
+	if err != nil {
+		return nil, []error{err}
+	}
+	if mountedPVCs.Has(pvc.Name) {
+		log.Debug("Persistent volume claim is mounted by a running pod, skipping helper pod")
+		return nil, nil
+	}
+
+	return b.BackupPVC(backup, pvc, log)
+}
+
+// getMountedPVCs returns the names of the persistent volume claims in the namespace that are
+// mounted by running pods, listing the namespace's pods the first time it's called for it.
+func (b *backupper) getMountedPVCs(namespace string) (sets.String, error) {
+	b.mountedPVCsLock.Lock()
+	defer b.mountedPVCsLock.Unlock()
+
+	if pvcs, ok := b.mountedPVCs[namespace]; ok {
+		return pvcs, nil
+	}
+
+	pods, err := b.podClient.Pods(namespace).List(b.ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing pods")
+	}
+
+	pvcs := pvcsMountedByRunningPods(pods.Items)
+	b.mountedPVCs[namespace] = pvcs
+	return pvcs, nil
+}
+
+func (b *backupper) BackupPVC(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error) {
+	log = log.WithField("persistentVolumeClaim", fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name))
+
+	helper, err := b.repoManager.restoreHelperContainer(log)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	helperPod, err := b.podClient.Pods(pvc.Namespace).Create(b.ctx, newHelperPod(backup, pvc, helper), metav1.CreateOptions{})
+	if err != nil {
+		return nil, []error{errors.Wrap(err, "error creating restic helper pod")}
+	}
+	log = log.WithField("helperPod", helperPod.Name)
+	log.Info("Created restic helper pod to mount persistent volume claim")
+
+	defer func() {
+		if err := b.podClient.Pods(helperPod.Namespace).Delete(context.TODO(), helperPod.Name, metav1.DeleteOptions{}); err != nil {
+			log.WithError(errors.WithStack(err)).Warn("Error deleting restic helper pod")
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(b.ctx, helperPodReadyTimeout)
+	defer cancel()
+
+	helperPod, err = WaitForHelperPod(ctx, b.podClient, helperPod)
+	if err != nil {
+		return nil, []error{errors.Wrap(err, "error waiting for restic helper pod to be running")}
+	}
+
+	return b.BackupPodVolumes(backup, helperPod, []string{HelperPodVolume}, log)
+}
+
 type pvcGetter interface {
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1api.PersistentVolumeClaim, error)
 }
@@ -274,6 +365,7 @@ func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume c
 			},
 			BackupStorageLocation: backup.Spec.StorageLocation,
 			RepoIdentifier:        repoIdentifier,
+			RateLimit:             backup.Spec.ResticRateLimit,
 		},
 	}
 
@@ -292,6 +384,12 @@ func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume c
 		pvb.Spec.Tags["pvc-uid"] = string(pvc.UID)
 	}
 
+	if pod.Labels[velerov1api.ResticHelperPodLabel] == "true" {
+		// this label is used in pkg/restore to restore the PVC's data through a helper pod,
+		// since the helper pod that it was backed up through isn't restored.
+		pvb.Labels[velerov1api.ResticHelperPodLabel] = "true"
+	}
+
 	return pvb
 }
 
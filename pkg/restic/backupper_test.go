@@ -24,6 +24,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func TestIsHostPathVolume(t *testing.T) {
@@ -139,3 +144,53 @@ func (g *fakePVGetter) Get(ctx context.Context, name string, opts metav1.GetOpti
 
 	return nil, errors.New("item not found")
 }
+
+func TestBackupUnmountedPVCListsPodsOncePerNamespace(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset(
+		builder.ForPod("ns-1", "pod-1").
+			Phase(corev1api.PodRunning).
+			Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+			Result(),
+		builder.ForPod("ns-1", "pod-2").
+			Phase(corev1api.PodRunning).
+			Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-2").Result()).
+			Result(),
+	)
+
+	b := &backupper{
+		ctx:         context.Background(),
+		podClient:   kubeClient.CoreV1(),
+		mountedPVCs: make(map[string]sets.String),
+	}
+
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	for _, name := range []string{"pvc-1", "pvc-2"} {
+		pvc := builder.ForPersistentVolumeClaim("ns-1", name).Result()
+		pvc.Status.Phase = corev1api.ClaimBound
+
+		pvbs, errs := b.BackupUnmountedPVC(backup, pvc, velerotest.NewLogger())
+		assert.Empty(t, pvbs)
+		assert.Empty(t, errs)
+	}
+
+	var lists int
+	for _, action := range kubeClient.Actions() {
+		if action.Matches("list", "pods") {
+			lists++
+		}
+	}
+	assert.Equal(t, 1, lists)
+}
+
+func TestNewPodVolumeBackupForHelperPod(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result()
+	volume := corev1api.Volume{Name: HelperPodVolume}
+
+	pvb := newPodVolumeBackup(backup, builder.ForPod("ns-1", "pod-1").Result(), volume, "repo", pvc)
+	assert.False(t, IsHelperPodVolumeBackup(pvb))
+
+	pvb = newPodVolumeBackup(backup, newHelperPod(backup, pvc, corev1api.Container{}), volume, "repo", pvc)
+	assert.True(t, IsHelperPodVolumeBackup(pvb))
+	assert.Equal(t, "pvc-1", pvb.Annotations[PVCNameAnnotation])
+}
@@ -58,6 +58,16 @@ func RestoreCommand(repoIdentifier, passwordFile, snapshotID, target string) *Co
 	}
 }
 
+// RestoreFilesCommand returns a Command for running a restic restore of only
+// the given paths within the snapshot.
+func RestoreFilesCommand(repoIdentifier, passwordFile, snapshotID, target string, includePaths []string) *Command {
+	cmd := RestoreCommand(repoIdentifier, passwordFile, snapshotID, target)
+	for _, path := range includePaths {
+		cmd.ExtraFlags = append(cmd.ExtraFlags, fmt.Sprintf("--include=%s", path))
+	}
+	return cmd
+}
+
 // GetSnapshotCommand returns a Command for running a restic (get) snapshots.
 func GetSnapshotCommand(repoIdentifier, passwordFile string, tags map[string]string) *Command {
 	return &Command{
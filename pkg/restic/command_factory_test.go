@@ -49,6 +49,17 @@ func TestRestoreCommand(t *testing.T) {
 	assert.Equal(t, []string{"--target=."}, c.ExtraFlags)
 }
 
+func TestRestoreFilesCommand(t *testing.T) {
+	c := RestoreFilesCommand("repo-id", "password-file", "snapshot-id", "target", []string{"/var/lib/app/file", "/etc/app"})
+
+	assert.Equal(t, "restore", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, "password-file", c.PasswordFile)
+	assert.Equal(t, "target", c.Dir)
+	assert.Equal(t, []string{"snapshot-id"}, c.Args)
+	assert.Equal(t, []string{"--target=.", "--include=/var/lib/app/file", "--include=/etc/app"}, c.ExtraFlags)
+}
+
 func TestGetSnapshotCommand(t *testing.T) {
 	expectedTags := map[string]string{"foo": "bar", "c": "d"}
 	c := GetSnapshotCommand("repo-id", "password-file", expectedTags)
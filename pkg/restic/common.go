@@ -147,6 +147,32 @@ func GetVolumeBackupsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod
 	return getPodSnapshotAnnotations(pod)
 }
 
+// GetPodVolumeBackupForPVC returns the PodVolumeBackup with a snapshot of the persistent
+// volume claim with the provided name in the source namespace, or nil if there isn't one.
+func GetPodVolumeBackupForPVC(podVolumeBackups []*velerov1api.PodVolumeBackup, pvcName, sourceNamespace string) *velerov1api.PodVolumeBackup {
+	for _, pvb := range podVolumeBackups {
+		if pvb.Spec.Pod.Namespace != sourceNamespace || pvb.GetAnnotations()[PVCNameAnnotation] != pvcName {
+			continue
+		}
+
+		// skip PVBs without a snapshot ID since there's nothing
+		// to restore (they could be failed, or for empty volumes).
+		if pvb.Status.SnapshotID == "" {
+			continue
+		}
+
+		return pvb
+	}
+
+	return nil
+}
+
+// IsHelperPodVolumeBackup returns whether the PodVolumeBackup was taken through a helper pod,
+// for a persistent volume claim that wasn't mounted by any running pod.
+func IsHelperPodVolumeBackup(pvb *velerov1api.PodVolumeBackup) bool {
+	return pvb.Labels[velerov1api.ResticHelperPodLabel] == "true"
+}
+
 // GetVolumesToBackup returns a list of volume names to backup for
 // the provided pod.
 // Deprecated: Use GetPodVolumesUsingRestic instead.
@@ -183,46 +209,84 @@ func contains(list []string, k string) bool {
 }
 
 // GetPodVolumesUsingRestic returns a list of volume names to backup for the provided pod.
-func GetPodVolumesUsingRestic(pod *corev1api.Pod, defaultVolumesToRestic bool) []string {
-	if !defaultVolumesToRestic {
-		return GetVolumesToBackup(pod)
+// Volumes selected or excluded by the pod's annotations are always backed up or skipped
+// respectively. The rest are evaluated against the rules, and if no rule matches, they're
+// only backed up when using restic by default. The PVC getter is used for rules that
+// need a volume's persistent volume claim.
+func GetPodVolumesUsingRestic(pod *corev1api.Pod, defaultVolumesToRestic bool, rules []velerov1api.ResticVolumeRule, pvcGetter PVCGetter) ([]string, error) {
+	volsToBackup := GetVolumesToBackup(pod)
+	if !defaultVolumesToRestic && len(rules) == 0 {
+		return volsToBackup, nil
 	}
 
 	volsToExclude := getVolumesToExclude(pod)
 	podVolumes := []string{}
+
+	// when not using restic by default, the volumes in the pod's annotation
+	// are backed up regardless of the rules.
+	if !defaultVolumesToRestic {
+		podVolumes = append(podVolumes, volsToBackup...)
+	}
+
 	for _, pv := range pod.Spec.Volumes {
-		// cannot backup hostpath volumes as they are not mounted into /var/lib/kubelet/pods
-		// and therefore not accessible to the restic daemon set.
-		if pv.HostPath != nil {
+		if !defaultVolumesToRestic && contains(volsToBackup, pv.Name) {
 			continue
 		}
-		// don't backup volumes mounting secrets. Secrets will be backed up separately.
-		if pv.Secret != nil {
+		// don't backup volumes that are included in the exclude list.
+		if contains(volsToExclude, pv.Name) {
 			continue
 		}
-		// don't backup volumes mounting config maps. Config maps will be backed up separately.
-		if pv.ConfigMap != nil {
+		// cannot backup hostpath volumes as they are not mounted into /var/lib/kubelet/pods
+		// and therefore not accessible to the restic daemon set.
+		if pv.HostPath != nil {
 			continue
 		}
-		// don't backup volumes mounted as projected volumes, all data in those come from kube state.
-		if pv.Projected != nil {
-			continue
+
+		action, err := evaluateVolumeRules(rules, pod.Namespace, pv, pvcGetter)
+		if err != nil {
+			return nil, err
 		}
-		// don't backup DownwardAPI volumes, all data in those come from kube state.
-		if pv.DownwardAPI != nil {
+
+		switch action {
+		case velerov1api.ResticVolumeRuleActionInclude:
+			podVolumes = append(podVolumes, pv.Name)
 			continue
-		}
-		// don't backup volumes that are included in the exclude list.
-		if contains(volsToExclude, pv.Name) {
+		case velerov1api.ResticVolumeRuleActionExclude:
 			continue
 		}
-		// don't include volumes that mount the default service account token.
-		if strings.HasPrefix(pv.Name, "default-token") {
+
+		if !defaultVolumesToRestic || isExcludedByDefault(pv) {
 			continue
 		}
 		podVolumes = append(podVolumes, pv.Name)
 	}
-	return podVolumes
+	return podVolumes, nil
+}
+
+// isExcludedByDefault returns true if the volume isn't backed up when using
+// restic by default, since its data can be recreated from the backed up resources.
+func isExcludedByDefault(pv corev1api.Volume) bool {
+	// don't backup volumes mounting secrets. Secrets will be backed up separately.
+	if pv.Secret != nil {
+		return true
+	}
+	// don't backup volumes mounting config maps. Config maps will be backed up separately.
+	if pv.ConfigMap != nil {
+		return true
+	}
+	// don't backup volumes mounted as projected volumes, all data in those come from kube state.
+	if pv.Projected != nil {
+		return true
+	}
+	// don't backup DownwardAPI volumes, all data in those come from kube state.
+	if pv.DownwardAPI != nil {
+		return true
+	}
+	// don't include volumes that mount the default service account token.
+	if strings.HasPrefix(pv.Name, "default-token") {
+		return true
+	}
+	return false
 }
 
 // SnapshotIdentifier uniquely identifies a restic snapshot
@@ -292,6 +356,40 @@ func TempCACertFile(caCert []byte, bsl string, fs filesystem.Interface) (string,
 	return name, nil
 }
 
+// UploadLimitFlags returns the restic flags that limit the upload rate of a pod
+// volume backup. The pod volume backup's own rate limit is used if it's set,
+// otherwise the backup storage location's.
+func UploadLimitFlags(rateLimit *velerov1api.ResticRateLimit, backupLocation *velerov1api.BackupStorageLocation) []string {
+	if limit := getRateLimit(rateLimit, backupLocation); limit != nil && limit.UploadKiBps > 0 {
+		return []string{fmt.Sprintf("--limit-upload=%d", limit.UploadKiBps)}
+	}
+
+	return nil
+}
+
+// DownloadLimitFlags returns the restic flags that limit the download rate of a pod
+// volume restore. The pod volume restore's own rate limit is used if it's set,
+// otherwise the backup storage location's.
+func DownloadLimitFlags(rateLimit *velerov1api.ResticRateLimit, backupLocation *velerov1api.BackupStorageLocation) []string {
+	if limit := getRateLimit(rateLimit, backupLocation); limit != nil && limit.DownloadKiBps > 0 {
+		return []string{fmt.Sprintf("--limit-download=%d", limit.DownloadKiBps)}
+	}
+
+	return nil
+}
+
+func getRateLimit(rateLimit *velerov1api.ResticRateLimit, backupLocation *velerov1api.BackupStorageLocation) *velerov1api.ResticRateLimit {
+	if rateLimit != nil {
+		return rateLimit
+	}
+
+	if backupLocation != nil {
+		return backupLocation.Spec.ResticRateLimit
+	}
+
+	return nil
+}
+
 // NewPodVolumeRestoreListOptions creates a ListOptions with a label selector configured to
 // find PodVolumeRestores for the restore identified by name.
 func NewPodVolumeRestoreListOptions(name string) metav1.ListOptions {
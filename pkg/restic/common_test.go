@@ -629,7 +629,8 @@ func TestGetPodVolumesUsingRestic(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			actual := GetPodVolumesUsingRestic(tc.pod, tc.defaultVolumesToRestic)
+			actual, err := GetPodVolumesUsingRestic(tc.pod, tc.defaultVolumesToRestic, nil, nil)
+			require.NoError(t, err)
 
 			sort.Strings(tc.expected)
 			sort.Strings(actual)
@@ -830,3 +831,40 @@ func TestVolumeHasNonRestorableSource(t *testing.T) {
 
 	}
 }
+
+func TestRateLimitFlags(t *testing.T) {
+	location := builder.ForBackupStorageLocation("velero", "default").Result()
+	location.Spec.ResticRateLimit = &velerov1api.ResticRateLimit{UploadKiBps: 1024, DownloadKiBps: 2048}
+
+	tests := []struct {
+		name         string
+		rateLimit    *velerov1api.ResticRateLimit
+		location     *velerov1api.BackupStorageLocation
+		wantUpload   []string
+		wantDownload []string
+	}{
+		{
+			name:     "no rate limits",
+			location: builder.ForBackupStorageLocation("velero", "default").Result(),
+		},
+		{
+			name:         "location's rate limits are used when the operation doesn't have any",
+			location:     location,
+			wantUpload:   []string{"--limit-upload=1024"},
+			wantDownload: []string{"--limit-download=2048"},
+		},
+		{
+			name:       "operation's rate limits override the location's",
+			rateLimit:  &velerov1api.ResticRateLimit{UploadKiBps: 512},
+			location:   location,
+			wantUpload: []string{"--limit-upload=512"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantUpload, UploadLimitFlags(tc.rateLimit, tc.location))
+			assert.Equal(t, tc.wantDownload, DownloadLimitFlags(tc.rateLimit, tc.location))
+		})
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+const (
+	// HelperPodVolume is the name of the volume in a helper pod that
+	// mounts the persistent volume claim being backed up or restored.
+	HelperPodVolume = "data"
+
+	// helperPodReadyTimeout is how long to wait for a helper pod to
+	// be scheduled and start running before giving up.
+	helperPodReadyTimeout = 5 * time.Minute
+)
+
+// pvcsMountedByRunningPods returns the names of the persistent volume claims
+// used by the volumes of the running pods in the list.
+func pvcsMountedByRunningPods(pods []corev1api.Pod) sets.String {
+	pvcs := sets.NewString()
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1api.PodRunning {
+			continue
+		}
+
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				pvcs.Insert(volume.PersistentVolumeClaim.ClaimName)
+			}
+		}
+	}
+
+	return pvcs
+}
+
+// newHelperPod returns a pod that mounts the persistent volume claim so that
+// the restic daemonset can back up its data. The pod runs the restic restore
+// helper, which waits for a done file that is never written, so the pod keeps
+// running until it's deleted. The scheduler places it on a node that satisfies
+// the claimed volume's node affinity. helper is the restore helper container
+// returned by RestoreHelperContainer.
+func newHelperPod(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, helper corev1api.Container) *corev1api.Pod {
+	helper.Args = []string{string(backup.UID)}
+	helper.VolumeMounts = []corev1api.VolumeMount{
+		{
+			Name:      HelperPodVolume,
+			MountPath: "/restores/" + HelperPodVolume,
+			ReadOnly:  true,
+		},
+	}
+
+	return &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    pvc.Namespace,
+			GenerateName: "velero-restic-helper-",
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel:      label.GetValidName(backup.Name),
+				velerov1api.BackupUIDLabel:       string(backup.UID),
+				velerov1api.ResticHelperPodLabel: "true",
+				// the helper pod is only needed while the backup is in progress,
+				// so make sure it doesn't end up in the backup itself.
+				velerov1api.ExcludeFromBackupLabel: "true",
+			},
+		},
+		Spec: corev1api.PodSpec{
+			RestartPolicy: corev1api.RestartPolicyNever,
+			Containers:    []corev1api.Container{helper},
+			Volumes: []corev1api.Volume{
+				{
+					Name: HelperPodVolume,
+					VolumeSource: corev1api.VolumeSource{
+						PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvc.Name,
+							ReadOnly:  true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// newRestoreHelperPod returns a pod that mounts the persistent volume claim so that
+// the restic daemonset can restore its data. Like a restored pod, it runs the restic
+// init container, which waits until the volume has been restored. Its container then
+// exits, since the restore is done, and the claim can be used by the restored workload.
+// helper is the restore helper container returned by RestoreHelperContainer.
+func newRestoreHelperPod(restore *velerov1api.Restore, pvc *corev1api.PersistentVolumeClaim, helper corev1api.Container) *corev1api.Pod {
+	helper.Args = []string{string(restore.UID)}
+	helper.VolumeMounts = []corev1api.VolumeMount{
+		{
+			Name:      HelperPodVolume,
+			MountPath: "/restores/" + HelperPodVolume,
+		},
+	}
+
+	initContainer := helper
+	initContainer.Name = InitContainer
+
+	return &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    pvc.Namespace,
+			GenerateName: "velero-restic-helper-",
+			Labels: map[string]string{
+				velerov1api.RestoreNameLabel:     label.GetValidName(restore.Name),
+				velerov1api.RestoreUIDLabel:      string(restore.UID),
+				velerov1api.ResticHelperPodLabel: "true",
+			},
+		},
+		Spec: corev1api.PodSpec{
+			RestartPolicy:  corev1api.RestartPolicyNever,
+			InitContainers: []corev1api.Container{initContainer},
+			Containers:     []corev1api.Container{helper},
+			Volumes: []corev1api.Volume{
+				{
+					Name: HelperPodVolume,
+					VolumeSource: corev1api.VolumeSource{
+						PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvc.Name,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// NewFileRestoreHelperPod returns a pod that mounts the persistent volume claim
+// so that the restic daemonset can restore files into it. Like the backup helper
+// pod, it waits for a done file that is never written, so it keeps running until
+// it's deleted. helper is the restore helper container returned by
+// RestoreHelperContainer.
+func NewFileRestoreHelperPod(pvc *corev1api.PersistentVolumeClaim, helper corev1api.Container) *corev1api.Pod {
+	helper.Args = []string{string(pvc.UID)}
+	helper.VolumeMounts = []corev1api.VolumeMount{
+		{
+			Name:      HelperPodVolume,
+			MountPath: "/restores/" + HelperPodVolume,
+		},
+	}
+
+	return &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    pvc.Namespace,
+			GenerateName: "velero-restic-helper-",
+			Labels: map[string]string{
+				velerov1api.ResticHelperPodLabel:   "true",
+				velerov1api.ExcludeFromBackupLabel: "true",
+			},
+		},
+		Spec: corev1api.PodSpec{
+			RestartPolicy: corev1api.RestartPolicyNever,
+			Containers:    []corev1api.Container{helper},
+			Volumes: []corev1api.Volume{
+				{
+					Name: HelperPodVolume,
+					VolumeSource: corev1api.VolumeSource{
+						PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvc.Name,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// WaitForHelperPod waits for the helper pod to be running and returns the
+// latest version of it.
+func WaitForHelperPod(ctx context.Context, podGetter corev1client.PodsGetter, pod *corev1api.Pod) (*corev1api.Pod, error) {
+	var res *corev1api.Pod
+
+	err := wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		updated, err := podGetter.Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+
+		switch updated.Status.Phase {
+		case corev1api.PodRunning:
+			res = updated
+			return true, nil
+		case corev1api.PodFailed, corev1api.PodSucceeded:
+			return false, errors.Errorf("helper pod %s/%s exited unexpectedly with phase %s", pod.Namespace, pod.Name, updated.Status.Phase)
+		default:
+			return false, nil
+		}
+	}, ctx.Done())
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestPVCsMountedByRunningPods(t *testing.T) {
+	tests := []struct {
+		name string
+		pods []corev1api.Pod
+		want []string
+	}{
+		{
+			name: "no pods",
+			want: []string{},
+		},
+		{
+			name: "running pods mounting claims",
+			pods: []corev1api.Pod{
+				*builder.ForPod("ns-1", "pod-1").
+					Phase(corev1api.PodRunning).
+					Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+					Result(),
+				*builder.ForPod("ns-1", "pod-2").
+					Phase(corev1api.PodRunning).
+					Volumes(
+						builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-2").Result(),
+						builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-3").Result(),
+					).
+					Result(),
+			},
+			want: []string{"pvc-1", "pvc-2", "pvc-3"},
+		},
+		{
+			name: "non-running pod mounting a claim",
+			pods: []corev1api.Pod{
+				*builder.ForPod("ns-1", "pod-1").
+					Phase(corev1api.PodPending).
+					Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+					Result(),
+			},
+			want: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, pvcsMountedByRunningPods(tc.pods).List())
+		})
+	}
+}
+
+func TestNewHelperPod(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithUID("backup-uid")).Result()
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result()
+
+	pod := newHelperPod(backup, pvc, RestoreHelperContainer(velerotest.NewLogger(), nil))
+
+	assert.Equal(t, "ns-1", pod.Namespace)
+	assert.Equal(t, "backup-1", pod.Labels[velerov1api.BackupNameLabel])
+	assert.Equal(t, "backup-uid", pod.Labels[velerov1api.BackupUIDLabel])
+	assert.Equal(t, "true", pod.Labels[velerov1api.ResticHelperPodLabel])
+	assert.Equal(t, "true", pod.Labels[velerov1api.ExcludeFromBackupLabel])
+
+	require.Len(t, pod.Spec.Volumes, 1)
+	assert.Equal(t, HelperPodVolume, pod.Spec.Volumes[0].Name)
+	require.NotNil(t, pod.Spec.Volumes[0].PersistentVolumeClaim)
+	assert.Equal(t, "pvc-1", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+
+	require.Len(t, pod.Spec.Containers, 1)
+	require.Len(t, pod.Spec.Containers[0].VolumeMounts, 1)
+	assert.Equal(t, HelperPodVolume, pod.Spec.Containers[0].VolumeMounts[0].Name)
+}
+
+func TestNewRestoreHelperPod(t *testing.T) {
+	restore := builder.ForRestore("velero", "restore-1").ObjectMeta(builder.WithUID("restore-uid")).Result()
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result()
+	helper := RestoreHelperContainer(velerotest.NewLogger(), &corev1api.ConfigMap{
+		Data: map[string]string{
+			"image":   "myregistry.io/my-image:my-tag",
+			"command": "/my-helper",
+		},
+	})
+
+	pod := newRestoreHelperPod(restore, pvc, helper)
+
+	assert.Equal(t, "ns-1", pod.Namespace)
+	assert.Equal(t, "restore-1", pod.Labels[velerov1api.RestoreNameLabel])
+	assert.Equal(t, "restore-uid", pod.Labels[velerov1api.RestoreUIDLabel])
+
+	require.Len(t, pod.Spec.InitContainers, 1)
+	require.Len(t, pod.Spec.Containers, 1)
+	for _, container := range []corev1api.Container{pod.Spec.InitContainers[0], pod.Spec.Containers[0]} {
+		assert.Equal(t, "myregistry.io/my-image:my-tag", container.Image)
+		assert.Equal(t, []string{"/my-helper"}, container.Command)
+		assert.Equal(t, []string{"restore-uid"}, container.Args)
+		require.Len(t, container.VolumeMounts, 1)
+		assert.Equal(t, HelperPodVolume, container.VolumeMounts[0].Name)
+	}
+	assert.Equal(t, InitContainer, pod.Spec.InitContainers[0].Name)
+}
+
+func TestNewFileRestoreHelperPod(t *testing.T) {
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithUID("pvc-uid")).Result()
+
+	helper := RestoreHelperContainer(velerotest.NewLogger(), &corev1api.ConfigMap{
+		Data: map[string]string{"image": "myregistry.io/my-image:my-tag"},
+	})
+
+	pod := NewFileRestoreHelperPod(pvc, helper)
+
+	assert.Equal(t, "ns-1", pod.Namespace)
+	assert.Equal(t, "true", pod.Labels[velerov1api.ResticHelperPodLabel])
+	assert.Equal(t, "true", pod.Labels[velerov1api.ExcludeFromBackupLabel])
+	assert.Empty(t, pod.Spec.InitContainers)
+
+	require.Len(t, pod.Spec.Volumes, 1)
+	assert.Equal(t, HelperPodVolume, pod.Spec.Volumes[0].Name)
+	require.NotNil(t, pod.Spec.Volumes[0].PersistentVolumeClaim)
+	assert.Equal(t, "pvc-1", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.False(t, pod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly)
+
+	require.Len(t, pod.Spec.Containers, 1)
+	assert.Equal(t, "myregistry.io/my-image:my-tag", pod.Spec.Containers[0].Image)
+	assert.Equal(t, []string{"pvc-uid"}, pod.Spec.Containers[0].Args)
+	require.Len(t, pod.Spec.Containers[0].VolumeMounts, 1)
+	assert.Equal(t, HelperPodVolume, pod.Spec.Containers[0].VolumeMounts[0].Name)
+	assert.False(t, pod.Spec.Containers[0].VolumeMounts[0].ReadOnly)
+}
@@ -25,3 +25,19 @@ func (_m *Restorer) RestorePodVolumes(_a0 restic.RestoreData) []error {
 
 	return r0
 }
+
+// RestorePVC provides a mock function with given fields: _a0
+func (_m *Restorer) RestorePVC(_a0 restic.PVCRestoreData) []error {
+	ret := _m.Called(_a0)
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func(restic.PVCRestoreData) []error); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,53 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+// OperationLimiter caps the number of restic backups and restores that run
+// at the same time on a node. A nil OperationLimiter doesn't limit anything.
+type OperationLimiter struct {
+	slots chan struct{}
+}
+
+// NewOperationLimiter returns an OperationLimiter that allows up to max
+// operations at the same time, or nil if max isn't positive.
+func NewOperationLimiter(max int) *OperationLimiter {
+	if max <= 0 {
+		return nil
+	}
+
+	return &OperationLimiter{
+		slots: make(chan struct{}, max),
+	}
+}
+
+// Acquire blocks until an operation can start.
+func (l *OperationLimiter) Acquire() {
+	if l == nil {
+		return
+	}
+
+	l.slots <- struct{}{}
+}
+
+// Release marks an operation started with Acquire as done.
+func (l *OperationLimiter) Release() {
+	if l == nil {
+		return
+	}
+
+	<-l.slots
+}
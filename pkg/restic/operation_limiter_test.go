@@ -0,0 +1,56 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationLimiter(t *testing.T) {
+	// a nil limiter doesn't block
+	var unlimited *OperationLimiter
+	assert.Nil(t, NewOperationLimiter(0))
+	unlimited.Acquire()
+	unlimited.Release()
+
+	limiter := NewOperationLimiter(1)
+	limiter.Acquire()
+
+	acquired := make(chan struct{})
+	go func() {
+		limiter.Acquire()
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("second operation started while the first one was running")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	limiter.Release()
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("second operation didn't start after the first one was done")
+	}
+	limiter.Release()
+}
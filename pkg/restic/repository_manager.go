@@ -23,6 +23,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -90,8 +91,10 @@ type repositoryManager struct {
 	repoEnsurer          *repositoryEnsurer
 	fileSystem           filesystem.Interface
 	ctx                  context.Context
+	podClient            corev1client.PodsGetter
 	pvcClient            corev1client.PersistentVolumeClaimsGetter
 	pvClient             corev1client.PersistentVolumesGetter
+	configMapClient      corev1client.ConfigMapsGetter
 	credentialsFileStore credentials.FileStore
 }
 
@@ -103,8 +106,10 @@ func NewRepositoryManager(
 	repoInformer velerov1informers.ResticRepositoryInformer,
 	repoClient velerov1client.ResticRepositoriesGetter,
 	kbClient kbclient.Client,
+	podClient corev1client.PodsGetter,
 	pvcClient corev1client.PersistentVolumeClaimsGetter,
 	pvClient corev1client.PersistentVolumesGetter,
+	configMapClient corev1client.ConfigMapsGetter,
 	credentialFileStore credentials.FileStore,
 	log logrus.FieldLogger,
 ) (RepositoryManager, error) {
@@ -114,8 +119,10 @@ func NewRepositoryManager(
 		repoLister:           repoInformer.Lister(),
 		repoInformerSynced:   repoInformer.Informer().HasSynced,
 		kbClient:             kbClient,
+		podClient:            podClient,
 		pvcClient:            pvcClient,
 		pvClient:             pvClient,
+		configMapClient:      configMapClient,
 		credentialsFileStore: credentialFileStore,
 		log:                  log,
 		ctx:                  ctx,
@@ -139,7 +146,7 @@ func (rm *repositoryManager) NewBackupper(ctx context.Context, backup *velerov1a
 		},
 	)
 
-	b := newBackupper(ctx, rm, rm.repoEnsurer, informer, rm.pvcClient, rm.pvClient, rm.log)
+	b := newBackupper(ctx, rm, rm.repoEnsurer, informer, rm.podClient, rm.pvcClient, rm.pvClient, rm.log)
 
 	go informer.Run(ctx.Done())
 	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced, rm.repoInformerSynced) {
@@ -160,7 +167,7 @@ func (rm *repositoryManager) NewRestorer(ctx context.Context, restore *velerov1a
 		},
 	)
 
-	r := newRestorer(ctx, rm, rm.repoEnsurer, informer, rm.pvcClient, rm.log)
+	r := newRestorer(ctx, rm, rm.repoEnsurer, informer, rm.podClient, rm.pvcClient, rm.log)
 
 	go informer.Run(ctx.Done())
 	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced, rm.repoInformerSynced) {
@@ -229,6 +236,17 @@ func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdenti
 	return rm.exec(ForgetCommand(repo.Spec.ResticIdentifier, snapshot.SnapshotID), repo.Spec.BackupStorageLocation)
 }
 
+// restoreHelperContainer returns the restore helper container that helper pods run,
+// configured by the restic restore item action's plugin ConfigMap in the Velero namespace.
+func (rm *repositoryManager) restoreHelperContainer(log logrus.FieldLogger) (corev1api.Container, error) {
+	config, err := GetRestoreHelperConfig(rm.configMapClient.ConfigMaps(rm.namespace))
+	if err != nil {
+		return corev1api.Container{}, errors.Wrap(err, "error getting restore helper config")
+	}
+
+	return RestoreHelperContainer(log, config), nil
+}
+
 func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 	file, err := rm.credentialsFileStore.Path(RepoKeySelector())
 	if err != nil {
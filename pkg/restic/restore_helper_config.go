@@ -0,0 +1,191 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	veleroimage "github.com/vmware-tanzu/velero/internal/velero"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	defaultRestoreHelperCPURequestLimit = "100m"
+	defaultRestoreHelperMemRequestLimit = "128Mi"
+	defaultRestoreHelperCommand         = "/velero-restic-restore-helper"
+)
+
+// GetRestoreHelperConfig returns the restic restore item action's plugin ConfigMap,
+// which configures the restic restore helper container that is added to restored
+// pods and runs in restore helper pods, or nil if there isn't one.
+func GetRestoreHelperConfig(client corev1client.ConfigMapInterface) (*corev1api.ConfigMap, error) {
+	return framework.GetPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/restic", client)
+}
+
+// RestoreHelperContainer returns the restic restore helper container that helper pods run,
+// with the image, command, resources and security context from the restore helper config.
+func RestoreHelperContainer(log logrus.FieldLogger, config *corev1api.ConfigMap) corev1api.Container {
+	resources := RestoreHelperResources(log, config)
+	securityContext := RestoreHelperSecurityContext(log, config)
+
+	return corev1api.Container{
+		Name:            "restic-helper",
+		Image:           RestoreHelperImage(log, config),
+		Command:         RestoreHelperCommand(log, config),
+		Resources:       resources,
+		SecurityContext: &securityContext,
+	}
+}
+
+// RestoreHelperCommand returns the restic restore helper container's command from the
+// restore helper config, or the default one.
+func RestoreHelperCommand(log logrus.FieldLogger, config *corev1api.ConfigMap) []string {
+	if config == nil {
+		log.Debug("No config found for plugin")
+		return []string{defaultRestoreHelperCommand}
+	}
+
+	if config.Data["command"] == "" {
+		log.Debugf("No custom command configured")
+		return []string{defaultRestoreHelperCommand}
+	}
+
+	log.Debugf("Using custom command %s", config.Data["command"])
+	return []string{config.Data["command"]}
+}
+
+// RestoreHelperImage returns the restic restore helper container's image from the
+// restore helper config, or the default one.
+func RestoreHelperImage(log logrus.FieldLogger, config *corev1api.ConfigMap) string {
+	if config == nil {
+		log.Debug("No config found for plugin")
+		return veleroimage.DefaultResticRestoreHelperImage()
+	}
+
+	image := config.Data["image"]
+	if image == "" {
+		log.Debugf("No custom image configured")
+		return veleroimage.DefaultResticRestoreHelperImage()
+	}
+
+	log = log.WithField("image", image)
+
+	parts := strings.Split(image, "/")
+
+	if len(parts) == 1 {
+		defaultImage := veleroimage.DefaultResticRestoreHelperImage()
+		// Image supplied without registry part
+		log.Infof("Plugin config contains image name without registry name. Using default init container image: %q", defaultImage)
+		return defaultImage
+	}
+
+	if !(strings.Contains(parts[len(parts)-1], ":")) {
+		tag := veleroimage.ImageTag()
+		// tag-less image name: add default image tag for this version of Velero
+		log.Infof("Plugin config contains image name without tag. Adding tag: %q", tag)
+		return fmt.Sprintf("%s:%s", image, tag)
+	} else {
+		// tagged image name
+		log.Debugf("Plugin config contains image name with tag")
+		return image
+	}
+}
+
+// RestoreHelperResources returns the restic restore helper container's resource requirements
+// from the restore helper config. Requests and limits that aren't configured, or can't be
+// parsed, default to 100m CPU and 128Mi memory.
+func RestoreHelperResources(log logrus.FieldLogger, config *corev1api.ConfigMap) corev1api.ResourceRequirements {
+	cpuRequest, memRequest := getResourceRequests(log, config)
+	cpuLimit, memLimit := getResourceLimits(log, config)
+	if cpuRequest == "" {
+		cpuRequest = defaultRestoreHelperCPURequestLimit
+	}
+	if cpuLimit == "" {
+		cpuLimit = defaultRestoreHelperCPURequestLimit
+	}
+	if memRequest == "" {
+		memRequest = defaultRestoreHelperMemRequestLimit
+	}
+	if memLimit == "" {
+		memLimit = defaultRestoreHelperMemRequestLimit
+	}
+
+	resourceReqs, err := kube.ParseResourceRequirements(cpuRequest, memRequest, cpuLimit, memLimit)
+	if err != nil {
+		log.Errorf("Using default resource values, couldn't parse resource requirements: %s.", err)
+		resourceReqs, _ = kube.ParseResourceRequirements(
+			defaultRestoreHelperCPURequestLimit, defaultRestoreHelperMemRequestLimit, // requests
+			defaultRestoreHelperCPURequestLimit, defaultRestoreHelperMemRequestLimit, // limits
+		)
+	}
+
+	return resourceReqs
+}
+
+// RestoreHelperSecurityContext returns the restic restore helper container's security context
+// from the restore helper config.
+func RestoreHelperSecurityContext(log logrus.FieldLogger, config *corev1api.ConfigMap) corev1api.SecurityContext {
+	runAsUser, runAsGroup, allowPrivilegeEscalation, secCtx := getSecurityContext(log, config)
+
+	securityContext, err := kube.ParseSecurityContext(runAsUser, runAsGroup, allowPrivilegeEscalation, secCtx)
+	if err != nil {
+		log.Errorf("Using default securityContext values, couldn't parse securityContext requirements: %s.", err)
+	}
+
+	return securityContext
+}
+
+// getResourceRequests extracts the CPU and memory requests from a ConfigMap.
+// The 0 values are valid if the keys are not present
+func getResourceRequests(log logrus.FieldLogger, config *corev1api.ConfigMap) (string, string) {
+	if config == nil {
+		log.Debug("No config found for plugin")
+		return "", ""
+	}
+
+	return config.Data["cpuRequest"], config.Data["memRequest"]
+}
+
+// getResourceLimits extracts the CPU and memory limits from a ConfigMap.
+// The 0 values are valid if the keys are not present
+func getResourceLimits(log logrus.FieldLogger, config *corev1api.ConfigMap) (string, string) {
+	if config == nil {
+		log.Debug("No config found for plugin")
+		return "", ""
+	}
+
+	return config.Data["cpuLimit"], config.Data["memLimit"]
+}
+
+// getSecurityContext extracts securityContext runAsUser, runAsGroup, allowPrivilegeEscalation, and securityContext from a ConfigMap.
+func getSecurityContext(log logrus.FieldLogger, config *corev1api.ConfigMap) (string, string, string, string) {
+	if config == nil {
+		log.Debug("No config found for plugin")
+		return "", "", "", ""
+	}
+
+	return config.Data["secCtxRunAsUser"],
+		config.Data["secCtxRunAsGroup"],
+		config.Data["secCtxAllowPrivilegeEscalation"],
+		config.Data["secCtx"]
+}
@@ -0,0 +1,167 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	veleroimage "github.com/vmware-tanzu/velero/internal/velero"
+	"github.com/vmware-tanzu/velero/pkg/buildinfo"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetImage(t *testing.T) {
+	configMapWithData := func(key, val string) *corev1api.ConfigMap {
+		return &corev1api.ConfigMap{
+			Data: map[string]string{
+				key: val,
+			},
+		}
+	}
+
+	defaultImage := veleroimage.DefaultResticRestoreHelperImage()
+
+	tests := []struct {
+		name             string
+		configMap        *corev1api.ConfigMap
+		buildInfoVersion string
+		want             string
+	}{
+		{
+			name:      "nil config map returns default image",
+			configMap: nil,
+			want:      defaultImage,
+		},
+		{
+			name:      "config map without 'image' key returns default image",
+			configMap: configMapWithData("non-matching-key", "val"),
+			want:      defaultImage,
+		},
+		{
+			name:      "config map without '/' in image name returns default image",
+			configMap: configMapWithData("image", "my-image"),
+			want:      defaultImage,
+		},
+		{
+			name:             "config map with untagged image returns image with buildinfo.Version as tag",
+			configMap:        configMapWithData("image", "myregistry.io/my-image"),
+			buildInfoVersion: "buildinfo-version",
+			want:             "myregistry.io/my-image:buildinfo-version",
+		},
+		{
+			name:             "config map with untagged image and custom registry port with ':' returns image with buildinfo.Version as tag",
+			configMap:        configMapWithData("image", "myregistry.io:34567/my-image"),
+			buildInfoVersion: "buildinfo-version",
+			want:             "myregistry.io:34567/my-image:buildinfo-version",
+		},
+		{
+			name:      "config map with tagged image returns tagged image",
+			configMap: configMapWithData("image", "myregistry.io/my-image:my-tag"),
+			want:      "myregistry.io/my-image:my-tag",
+		},
+		{
+			name:      "config map with tagged image and custom registry port with ':' returns tagged image",
+			configMap: configMapWithData("image", "myregistry.io:34567/my-image:my-tag"),
+			want:      "myregistry.io:34567/my-image:my-tag",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.buildInfoVersion != "" {
+				originalVersion := buildinfo.Version
+				buildinfo.Version = test.buildInfoVersion
+				defer func() {
+					buildinfo.Version = originalVersion
+				}()
+			}
+			assert.Equal(t, test.want, RestoreHelperImage(velerotest.NewLogger(), test.configMap))
+		})
+	}
+}
+
+// TestResticRestoreActionExecute tests the restic restore item action plugin's Execute method.
+func TestGetCommand(t *testing.T) {
+	configMapWithData := func(key, val string) *corev1api.ConfigMap {
+		return &corev1api.ConfigMap{
+			Data: map[string]string{
+				key: val,
+			},
+		}
+	}
+	testCases := []struct {
+		name      string
+		configMap *corev1api.ConfigMap
+		expected  []string
+	}{
+		{
+			name:      "should get default command when config key is missing",
+			configMap: configMapWithData("non-matching-key", "val"),
+			expected:  []string{defaultRestoreHelperCommand},
+		},
+		{
+			name:      "should get default command when config key is empty",
+			configMap: configMapWithData("command", ""),
+			expected:  []string{defaultRestoreHelperCommand},
+		},
+		{
+			name:      "should get default command when config is nil",
+			configMap: nil,
+			expected:  []string{defaultRestoreHelperCommand},
+		},
+		{
+			name:      "should get command from config",
+			configMap: configMapWithData("command", "foobarbz"),
+			expected:  []string{"foobarbz"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := RestoreHelperCommand(velerotest.NewLogger(), tc.configMap)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestRestoreHelperContainer(t *testing.T) {
+	container := RestoreHelperContainer(velerotest.NewLogger(), &corev1api.ConfigMap{
+		Data: map[string]string{
+			"image":            "myregistry.io/my-image:my-tag",
+			"cpuRequest":       "50m",
+			"memLimit":         "256Mi",
+			"secCtxRunAsUser":  "1001",
+			"secCtxRunAsGroup": "999",
+		},
+	})
+
+	assert.Equal(t, "myregistry.io/my-image:my-tag", container.Image)
+	assert.Equal(t, []string{defaultRestoreHelperCommand}, container.Command)
+	assert.Equal(t, "50m", container.Resources.Requests.Cpu().String())
+	assert.Equal(t, "128Mi", container.Resources.Requests.Memory().String())
+	assert.Equal(t, "100m", container.Resources.Limits.Cpu().String())
+	assert.Equal(t, "256Mi", container.Resources.Limits.Memory().String())
+	require.NotNil(t, container.SecurityContext)
+	require.NotNil(t, container.SecurityContext.RunAsUser)
+	assert.Equal(t, int64(1001), *container.SecurityContext.RunAsUser)
+	require.NotNil(t, container.SecurityContext.RunAsGroup)
+	assert.Equal(t, int64(999), *container.SecurityContext.RunAsGroup)
+}
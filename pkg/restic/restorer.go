@@ -39,17 +39,32 @@ type RestoreData struct {
 	SourceNamespace, BackupLocation string
 }
 
+// PVCRestoreData is the data needed to restore a persistent volume claim
+// through a helper pod.
+type PVCRestoreData struct {
+	Restore                         *velerov1api.Restore
+	PVC                             *corev1api.PersistentVolumeClaim
+	PodVolumeBackup                 *velerov1api.PodVolumeBackup
+	SourceNamespace, BackupLocation string
+}
+
 // Restorer can execute restic restores of volumes in a pod.
 type Restorer interface {
 	// RestorePodVolumes restores all annotated volumes in a pod.
 	RestorePodVolumes(RestoreData) []error
+
+	// RestorePVC restores a persistent volume claim by mounting it in a
+	// helper pod, without modifying the pods that use it.
+	RestorePVC(PVCRestoreData) []error
 }
 
 type restorer struct {
 	ctx         context.Context
 	repoManager *repositoryManager
 	repoEnsurer *repositoryEnsurer
+	podClient   corev1client.PodsGetter
 	pvcClient   corev1client.PersistentVolumeClaimsGetter
+	log         logrus.FieldLogger
 
 	resultsLock sync.Mutex
 	results     map[string]chan *velerov1api.PodVolumeRestore
@@ -60,6 +75,7 @@ func newRestorer(
 	rm *repositoryManager,
 	repoEnsurer *repositoryEnsurer,
 	podVolumeRestoreInformer cache.SharedIndexInformer,
+	podClient corev1client.PodsGetter,
 	pvcClient corev1client.PersistentVolumeClaimsGetter,
 	log logrus.FieldLogger,
 ) *restorer {
@@ -67,7 +83,9 @@ func newRestorer(
 		ctx:         ctx,
 		repoManager: rm,
 		repoEnsurer: repoEnsurer,
+		podClient:   podClient,
 		pvcClient:   pvcClient,
+		log:         log,
 
 		results: make(map[string]chan *velerov1api.PodVolumeRestore),
 	}
@@ -169,6 +187,64 @@ ForEachVolume:
 	return errs
 }
 
+func (r *restorer) RestorePVC(data PVCRestoreData) []error {
+	repo, err := r.repoEnsurer.EnsureRepo(r.ctx, data.Restore.Namespace, data.SourceNamespace, data.BackupLocation)
+	if err != nil {
+		return []error{err}
+	}
+
+	// get a single non-exclusive lock since we'll wait for the
+	// restore to be complete before releasing it.
+	r.repoManager.repoLocker.Lock(repo.Name)
+	defer r.repoManager.repoLocker.Unlock(repo.Name)
+
+	helper, err := r.repoManager.restoreHelperContainer(r.log)
+	if err != nil {
+		return []error{err}
+	}
+
+	helperPod, err := r.podClient.Pods(data.PVC.Namespace).Create(r.ctx, newRestoreHelperPod(data.Restore, data.PVC, helper), metav1.CreateOptions{})
+	if err != nil {
+		return []error{errors.Wrap(err, "error creating restic helper pod")}
+	}
+
+	defer func() {
+		if err := r.podClient.Pods(helperPod.Namespace).Delete(context.TODO(), helperPod.Name, metav1.DeleteOptions{}); err != nil {
+			r.log.WithError(errors.WithStack(err)).Warnf("Error deleting restic helper pod %s/%s", helperPod.Namespace, helperPod.Name)
+		}
+	}()
+
+	resultsChan := make(chan *velerov1api.PodVolumeRestore)
+
+	r.resultsLock.Lock()
+	r.results[resultsKey(helperPod.Namespace, helperPod.Name)] = resultsChan
+	r.resultsLock.Unlock()
+
+	defer func() {
+		r.resultsLock.Lock()
+		delete(r.results, resultsKey(helperPod.Namespace, helperPod.Name))
+		r.resultsLock.Unlock()
+	}()
+
+	volumeRestore := newPodVolumeRestore(data.Restore, helperPod, data.BackupLocation, HelperPodVolume, data.PodVolumeBackup.Status.SnapshotID, repo.Spec.ResticIdentifier, data.PVC)
+	volumeRestore.Spec.PersistentVolumeClaim = data.PVC.Name
+
+	if err := errorOnly(r.repoManager.veleroClient.VeleroV1().PodVolumeRestores(volumeRestore.Namespace).Create(context.TODO(), volumeRestore, metav1.CreateOptions{})); err != nil {
+		return []error{errors.WithStack(err)}
+	}
+
+	select {
+	case <-r.ctx.Done():
+		return []error{errors.New("timed out waiting for PodVolumeRestore to complete")}
+	case res := <-resultsChan:
+		if res.Status.Phase == velerov1api.PodVolumeRestorePhaseFailed {
+			return []error{errors.Errorf("pod volume restore failed: %s", res.Status.Message)}
+		}
+	}
+
+	return nil
+}
+
 func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot, repoIdentifier string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeRestore {
 	pvr := &velerov1api.PodVolumeRestore{
 		ObjectMeta: metav1.ObjectMeta{
@@ -200,6 +276,7 @@ func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backu
 			SnapshotID:            snapshot,
 			BackupStorageLocation: backupLocation,
 			RepoIdentifier:        repoIdentifier,
+			RateLimit:             restore.Spec.ResticRateLimit,
 		},
 	}
 	if pvc != nil {
@@ -0,0 +1,183 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// legacyStorageClassAnnotation is the annotation used to set a persistent
+// volume claim's storage class before the storageClassName field existed.
+const legacyStorageClassAnnotation = "volume.beta.kubernetes.io/storage-class"
+
+// PVCGetter returns the persistent volume claim with the given namespace and name.
+type PVCGetter func(namespace, name string) (*corev1api.PersistentVolumeClaim, error)
+
+// evaluateVolumeRules returns the action of the first rule that matches the pod
+// volume, or an empty action if none of them do.
+func evaluateVolumeRules(rules []velerov1api.ResticVolumeRule, namespace string, volume corev1api.Volume, pvcGetter PVCGetter) (velerov1api.ResticVolumeRuleAction, error) {
+	if len(rules) == 0 {
+		return "", nil
+	}
+
+	// only get the volume's claim once, and only if a rule needs it.
+	var (
+		pvc        *corev1api.PersistentVolumeClaim
+		pvcFetched bool
+	)
+	getPVC := func() (*corev1api.PersistentVolumeClaim, error) {
+		if pvcFetched || volume.PersistentVolumeClaim == nil {
+			return pvc, nil
+		}
+		if pvcGetter == nil {
+			return nil, errors.New("unable to get persistent volume claims for evaluating restic volume rules")
+		}
+
+		res, err := pvcGetter(namespace, volume.PersistentVolumeClaim.ClaimName)
+		// a missing claim doesn't match any rule that needs it.
+		if apierrors.IsNotFound(err) {
+			pvcFetched = true
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting persistent volume claim %s/%s", namespace, volume.PersistentVolumeClaim.ClaimName)
+		}
+
+		pvc, pvcFetched = res, true
+		return pvc, nil
+	}
+
+	for _, rule := range rules {
+		matches, err := volumeRuleMatches(rule, volume, getPVC)
+		if err != nil {
+			return "", err
+		}
+
+		if matches {
+			return rule.Action, nil
+		}
+	}
+
+	return "", nil
+}
+
+// volumeRuleMatches returns true if the volume matches all of the rule's criteria.
+func volumeRuleMatches(rule velerov1api.ResticVolumeRule, volume corev1api.Volume, getPVC func() (*corev1api.PersistentVolumeClaim, error)) (bool, error) {
+	if len(rule.VolumeTypes) > 0 && !contains(rule.VolumeTypes, getVolumeType(volume)) {
+		return false, nil
+	}
+
+	needsPVC := rule.PVCSelector != nil || len(rule.StorageClasses) > 0
+	if needsPVC && volume.PersistentVolumeClaim == nil {
+		return false, nil
+	}
+
+	var pvc *corev1api.PersistentVolumeClaim
+	if needsPVC || rule.MinSize != nil || rule.MaxSize != nil {
+		var err error
+		if pvc, err = getPVC(); err != nil {
+			return false, err
+		}
+	}
+
+	if needsPVC && pvc == nil {
+		return false, nil
+	}
+
+	if rule.PVCSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(rule.PVCSelector)
+		if err != nil {
+			return false, errors.Wrap(err, "error parsing restic volume rule's persistent volume claim selector")
+		}
+
+		if !selector.Matches(labels.Set(pvc.Labels)) {
+			return false, nil
+		}
+	}
+
+	if len(rule.StorageClasses) > 0 && !contains(rule.StorageClasses, getStorageClass(pvc)) {
+		return false, nil
+	}
+
+	if rule.MinSize != nil || rule.MaxSize != nil {
+		size := getPodVolumeSize(volume, pvc)
+		if size == nil {
+			return false, nil
+		}
+		if rule.MinSize != nil && size.Cmp(*rule.MinSize) < 0 {
+			return false, nil
+		}
+		if rule.MaxSize != nil && size.Cmp(*rule.MaxSize) > 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// getVolumeType returns the name of the volume's source as it appears in the
+// pod spec, e.g. "persistentVolumeClaim" or "emptyDir".
+func getVolumeType(volume corev1api.Volume) string {
+	source, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&volume.VolumeSource)
+	if err != nil {
+		return ""
+	}
+
+	// a volume has exactly one source.
+	for volumeType := range source {
+		return volumeType
+	}
+
+	return ""
+}
+
+// getStorageClass returns the name of the claim's storage class.
+func getStorageClass(pvc *corev1api.PersistentVolumeClaim) string {
+	if pvc.Spec.StorageClassName != nil {
+		return *pvc.Spec.StorageClassName
+	}
+
+	return pvc.Annotations[legacyStorageClassAnnotation]
+}
+
+// getPodVolumeSize returns the size of the volume, or nil if it isn't known.
+// The size of a persistent volume claim volume is the claim's capacity, or
+// its requested storage if it isn't bound yet. The size of an emptyDir volume
+// is its size limit.
+func getPodVolumeSize(volume corev1api.Volume, pvc *corev1api.PersistentVolumeClaim) *resource.Quantity {
+	switch {
+	case pvc != nil:
+		if size, ok := pvc.Status.Capacity[corev1api.ResourceStorage]; ok {
+			return &size
+		}
+		if size, ok := pvc.Spec.Resources.Requests[corev1api.ResourceStorage]; ok {
+			return &size
+		}
+	case volume.EmptyDir != nil:
+		return volume.EmptyDir.SizeLimit
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetPodVolumesUsingResticWithRules(t *testing.T) {
+	quantity := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+
+	pvcs := map[string]*corev1api.PersistentVolumeClaim{
+		"ns-1/pvc-small": builder.ForPersistentVolumeClaim("ns-1", "pvc-small").
+			ObjectMeta(builder.WithLabels("app", "db")).
+			StorageClass("standard").
+			Result(),
+		"ns-1/pvc-large": builder.ForPersistentVolumeClaim("ns-1", "pvc-large").
+			ObjectMeta(builder.WithLabels("app", "cache")).
+			StorageClass("fast").
+			Result(),
+	}
+	pvcs["ns-1/pvc-small"].Status.Capacity = corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("1Gi")}
+	pvcs["ns-1/pvc-large"].Status.Capacity = corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("100Gi")}
+
+	pvcGetter := func(namespace, name string) (*corev1api.PersistentVolumeClaim, error) {
+		pvc, ok := pvcs[namespace+"/"+name]
+		if !ok {
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumeclaims"}, name)
+		}
+		return pvc, nil
+	}
+
+	newPod := func(annotations ...string) *corev1api.Pod {
+		return builder.ForPod("ns-1", "pod-1").
+			ObjectMeta(builder.WithAnnotations(annotations...)).
+			Volumes(
+				builder.ForVolume("small").PersistentVolumeClaimSource("pvc-small").Result(),
+				builder.ForVolume("large").PersistentVolumeClaimSource("pvc-large").Result(),
+				builder.ForVolume("missing").PersistentVolumeClaimSource("pvc-missing").Result(),
+				&corev1api.Volume{Name: "scratch", VolumeSource: corev1api.VolumeSource{EmptyDir: &corev1api.EmptyDirVolumeSource{SizeLimit: quantity("500Mi")}}},
+				&corev1api.Volume{Name: "host", VolumeSource: corev1api.VolumeSource{HostPath: &corev1api.HostPathVolumeSource{Path: "/tmp"}}},
+			).
+			Result()
+	}
+
+	tests := []struct {
+		name                   string
+		pod                    *corev1api.Pod
+		defaultVolumesToRestic bool
+		rules                  []velerov1api.ResticVolumeRule
+		expected               []string
+	}{
+		{
+			name: "include rule selects claims by label",
+			pod:  newPod(),
+			rules: []velerov1api.ResticVolumeRule{
+				{
+					Action:      velerov1api.ResticVolumeRuleActionInclude,
+					PVCSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				},
+			},
+			expected: []string{"small"},
+		},
+		{
+			name:                   "exclude rule removes claims by storage class when using restic by default",
+			pod:                    newPod(),
+			defaultVolumesToRestic: true,
+			rules: []velerov1api.ResticVolumeRule{
+				{
+					Action:         velerov1api.ResticVolumeRuleActionExclude,
+					StorageClasses: []string{"fast"},
+				},
+			},
+			expected: []string{"small", "missing", "scratch"},
+		},
+		{
+			name:                   "exclude rule removes volumes by type",
+			pod:                    newPod(),
+			defaultVolumesToRestic: true,
+			rules: []velerov1api.ResticVolumeRule{
+				{
+					Action:      velerov1api.ResticVolumeRuleActionExclude,
+					VolumeTypes: []string{"emptyDir"},
+				},
+			},
+			expected: []string{"small", "large", "missing"},
+		},
+		{
+			name: "include rule selects volumes by size, ignoring volumes without a known size",
+			pod:  newPod(),
+			rules: []velerov1api.ResticVolumeRule{
+				{
+					Action:  velerov1api.ResticVolumeRuleActionInclude,
+					MaxSize: quantity("10Gi"),
+				},
+			},
+			expected: []string{"small", "scratch"},
+		},
+		{
+			name:                   "first matching rule wins",
+			pod:                    newPod(),
+			defaultVolumesToRestic: true,
+			rules: []velerov1api.ResticVolumeRule{
+				{
+					Action:  velerov1api.ResticVolumeRuleActionInclude,
+					MinSize: quantity("50Gi"),
+				},
+				{
+					Action:      velerov1api.ResticVolumeRuleActionExclude,
+					VolumeTypes: []string{"persistentVolumeClaim"},
+				},
+			},
+			expected: []string{"large", "scratch"},
+		},
+		{
+			name: "pod annotations take precedence over rules",
+			pod:  newPod(VolumesToBackupAnnotation, "large", VolumesToExcludeAnnotation, "small"),
+			rules: []velerov1api.ResticVolumeRule{
+				{
+					Action:      velerov1api.ResticVolumeRuleActionInclude,
+					VolumeTypes: []string{"persistentVolumeClaim"},
+				},
+				{
+					Action:      velerov1api.ResticVolumeRuleActionExclude,
+					VolumeTypes: []string{"persistentVolumeClaim"},
+				},
+			},
+			expected: []string{"large", "missing"},
+		},
+		{
+			name: "host path volumes are never included by rules",
+			pod:  newPod(),
+			rules: []velerov1api.ResticVolumeRule{
+				{
+					Action:      velerov1api.ResticVolumeRuleActionInclude,
+					VolumeTypes: []string{"hostPath"},
+				},
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := GetPodVolumesUsingRestic(tc.pod, tc.defaultVolumesToRestic, tc.rules, pvcGetter)
+			require.NoError(t, err)
+
+			sort.Strings(tc.expected)
+			sort.Strings(actual)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// dataDownloadPollInterval is how often a restore checks whether the
+// data download of a persistent volume claim is done.
+const dataDownloadPollInterval = 5 * time.Second
+
+// getDataUploadForPVC returns the completed data upload of the CSI snapshot of
+// the named persistent volume claim, or nil if there isn't one.
+func getDataUploadForPVC(dataUploads []*velerov1api.DataUpload, pvcName, namespace string) *velerov1api.DataUpload {
+	for _, dataUpload := range dataUploads {
+		if dataUpload.Status.Phase != velerov1api.DataUploadPhaseCompleted {
+			continue
+		}
+
+		if dataUpload.Spec.SourceNamespace == namespace && dataUpload.Spec.SourcePVC == pvcName {
+			return dataUpload
+		}
+	}
+
+	return nil
+}
+
+// downloadPVCData creates a DataDownload to restore the data of the given restored
+// PVC from the backup storage location, and waits for it to be done.
+func downloadPVCData(ctx *restoreContext, createdObj *unstructured.Unstructured, dataUpload *velerov1api.DataUpload) {
+	if ctx.dataDownloadClient == nil {
+		ctx.log.Warn("No data download client, not restoring persistent volume claim's moved snapshot data")
+		return
+	}
+
+	done := make(chan struct{})
+	ctx.pvcRestores[kube.NamespaceAndName(createdObj)] = done
+
+	ctx.resticWaitGroup.Add(1)
+	go func() {
+		// Done() will only be called after all errors have been successfully
+		// sent on the ctx.resticErrs channel
+		defer ctx.resticWaitGroup.Done()
+
+		err := waitForDataDownload(ctx, newDataDownload(ctx.restore, createdObj, dataUpload))
+
+		// unblock the pods waiting for this PVC before sending errors, since
+		// the errors are only received once all items have been restored.
+		close(done)
+
+		if err != nil {
+			ctx.log.WithError(err).Errorf("unable to successfully download moved snapshot data of persistent volume claim %s", kube.NamespaceAndName(createdObj))
+			ctx.resticErrs <- err
+		}
+	}()
+}
+
+// waitForDataDownload creates the data download and waits until it's done.
+func waitForDataDownload(ctx *restoreContext, dataDownload *velerov1api.DataDownload) error {
+	client := ctx.dataDownloadClient.DataDownloads(dataDownload.Namespace)
+
+	// stop waiting if the restore is cancelled
+	waitCtx, cancel := go_context.WithTimeout(ctx.traceContext, ctx.dataDownloadTimeout)
+	defer cancel()
+
+	created, err := client.Create(waitCtx, dataDownload, metav1.CreateOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error creating data download")
+	}
+
+	var res *velerov1api.DataDownload
+	err = wait.PollImmediateUntil(dataDownloadPollInterval, func() (bool, error) {
+		if res, err = client.Get(waitCtx, created.Name, metav1.GetOptions{}); err != nil {
+			return false, errors.WithStack(err)
+		}
+
+		switch res.Status.Phase {
+		case velerov1api.DataDownloadPhaseCompleted, velerov1api.DataDownloadPhaseFailed, velerov1api.DataDownloadPhaseCanceled:
+			return true, nil
+		default:
+			return false, nil
+		}
+	}, waitCtx.Done())
+	if err != nil {
+		// the download is still running, so stop it. waitCtx is done, so don't use it.
+		if _, cancelErr := client.Patch(go_context.Background(), created.Name, types.MergePatchType, []byte(`{"spec":{"cancel":true}}`), metav1.PatchOptions{}); cancelErr != nil && !apierrors.IsNotFound(cancelErr) {
+			ctx.log.WithError(errors.WithStack(cancelErr)).Errorf("Error canceling data download %s", created.Name)
+		}
+		return errors.Wrapf(err, "error waiting for data download %s to complete", created.Name)
+	}
+
+	if res.Status.Phase != velerov1api.DataDownloadPhaseCompleted {
+		return errors.Errorf("data download %s didn't complete: phase %s, %s", created.Name, res.Status.Phase, res.Status.Message)
+	}
+
+	return nil
+}
+
+func newDataDownload(restore *velerov1api.Restore, pvc *unstructured.Unstructured, dataUpload *velerov1api.DataUpload) *velerov1api.DataDownload {
+	return &velerov1api.DataDownload{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    restore.Namespace,
+			GenerateName: restore.Name + "-",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: velerov1api.SchemeGroupVersion.String(),
+					Kind:       "Restore",
+					Name:       restore.Name,
+					UID:        restore.UID,
+					Controller: boolptr.True(),
+				},
+			},
+			Labels: map[string]string{
+				velerov1api.RestoreNameLabel: label.GetValidName(restore.Name),
+				velerov1api.RestoreUIDLabel:  string(restore.UID),
+			},
+		},
+		Spec: velerov1api.DataDownloadSpec{
+			TargetNamespace:       pvc.GetNamespace(),
+			TargetPVC:             pvc.GetName(),
+			SourceNamespace:       dataUpload.Spec.SourceNamespace,
+			SnapshotID:            dataUpload.Status.SnapshotID,
+			BackupStorageLocation: dataUpload.Spec.BackupStorageLocation,
+		},
+	}
+}
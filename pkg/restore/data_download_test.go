@@ -0,0 +1,134 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newTestDataUpload(name, namespace, pvc string, phase velerov1api.DataUploadPhase) *velerov1api.DataUpload {
+	dataUpload := &velerov1api.DataUpload{
+		Spec: velerov1api.DataUploadSpec{
+			SourceNamespace:       namespace,
+			SourcePVC:             pvc,
+			BackupStorageLocation: "default",
+		},
+		Status: velerov1api.DataUploadStatus{
+			Phase:      phase,
+			SnapshotID: "snapshot-" + name,
+		},
+	}
+	dataUpload.Name = name
+	return dataUpload
+}
+
+func TestGetDataUploadForPVC(t *testing.T) {
+	dataUploads := []*velerov1api.DataUpload{
+		newTestDataUpload("du-1", "ns-1", "pvc-1", velerov1api.DataUploadPhaseCompleted),
+		newTestDataUpload("du-2", "ns-2", "pvc-1", velerov1api.DataUploadPhaseCompleted),
+		newTestDataUpload("du-3", "ns-1", "pvc-2", velerov1api.DataUploadPhaseFailed),
+	}
+
+	tests := []struct {
+		name      string
+		pvc       string
+		namespace string
+		expected  *velerov1api.DataUpload
+	}{
+		{
+			name:      "matches the claim's name and namespace",
+			pvc:       "pvc-1",
+			namespace: "ns-2",
+			expected:  dataUploads[1],
+		},
+		{
+			name:      "ignores data uploads that didn't complete",
+			pvc:       "pvc-2",
+			namespace: "ns-1",
+		},
+		{
+			name:      "returns nil when there's no data upload for the claim",
+			pvc:       "pvc-3",
+			namespace: "ns-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getDataUploadForPVC(dataUploads, tc.pvc, tc.namespace))
+		})
+	}
+}
+
+func TestNewDataDownload(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
+	restore.UID = "restore-uid"
+
+	pvc := &unstructured.Unstructured{}
+	pvc.SetNamespace("ns-2")
+	pvc.SetName("pvc-1")
+
+	dataDownload := newDataDownload(restore, pvc, newTestDataUpload("du-1", "ns-1", "pvc-1", velerov1api.DataUploadPhaseCompleted))
+
+	assert.Equal(t, velerov1api.DefaultNamespace, dataDownload.Namespace)
+	assert.Equal(t, "restore-1", dataDownload.Labels[velerov1api.RestoreNameLabel])
+	require.Len(t, dataDownload.OwnerReferences, 1)
+	assert.Equal(t, "Restore", dataDownload.OwnerReferences[0].Kind)
+	assert.Equal(t, velerov1api.DataDownloadSpec{
+		TargetNamespace:       "ns-2",
+		TargetPVC:             "pvc-1",
+		SourceNamespace:       "ns-1",
+		SnapshotID:            "snapshot-du-1",
+		BackupStorageLocation: "default",
+	}, dataDownload.Spec)
+}
+
+func TestWaitForDataDownloadCancelsDownloadWhenRestoreIsCanceled(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	restoreCtx, cancel := go_context.WithCancel(go_context.Background())
+	cancel()
+
+	ctx := &restoreContext{
+		log:                 velerotest.NewLogger(),
+		traceContext:        restoreCtx,
+		dataDownloadClient:  client.VeleroV1(),
+		dataDownloadTimeout: time.Minute,
+	}
+
+	dataDownload := &velerov1api.DataDownload{}
+	dataDownload.Namespace = velerov1api.DefaultNamespace
+	dataDownload.Name = "dd-1"
+
+	require.Error(t, waitForDataDownload(ctx, dataDownload))
+
+	res, err := client.VeleroV1().DataDownloads(dataDownload.Namespace).Get(go_context.Background(), dataDownload.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, res.Spec.Cancel)
+}
@@ -18,34 +18,23 @@ package restore
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
-	veleroimage "github.com/vmware-tanzu/velero/internal/velero"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
-	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
-const (
-	defaultCPURequestLimit = "100m"
-	defaultMemRequestLimit = "128Mi"
-	defaultCommand         = "/velero-restic-restore-helper"
-)
-
 type ResticRestoreAction struct {
 	logger                logrus.FieldLogger
 	client                corev1client.ConfigMapInterface
@@ -70,6 +59,11 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	a.logger.Info("Executing ResticRestoreAction")
 	defer a.logger.Info("Done executing ResticRestoreAction")
 
+	if input.Restore.Spec.PodVolumeRestoreMode == velerov1api.PodVolumeRestoreModeHelperPod {
+		a.logger.Debug("Restore is using helper pods to restore pod volumes, not adding restic init container")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
 	var pod corev1.Pod
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(input.Item.UnstructuredContent(), &pod); err != nil {
 		return nil, errors.Wrap(err, "unable to convert pod from runtime.Unstructured")
@@ -107,44 +101,16 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	// TODO we might want/need to get plugin config at the top of this method at some point; for now, wait
 	// until we know we're doing a restore before getting config.
 	log.Debugf("Getting plugin config")
-	config, err := getPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/restic", a.client)
+	config, err := restic.GetRestoreHelperConfig(a.client)
 	if err != nil {
 		return nil, err
 	}
 
-	image := getImage(log, config)
+	image := restic.RestoreHelperImage(log, config)
 	log.Infof("Using image %q", image)
 
-	cpuRequest, memRequest := getResourceRequests(log, config)
-	cpuLimit, memLimit := getResourceLimits(log, config)
-	if cpuRequest == "" {
-		cpuRequest = defaultCPURequestLimit
-	}
-	if cpuLimit == "" {
-		cpuLimit = defaultCPURequestLimit
-	}
-	if memRequest == "" {
-		memRequest = defaultMemRequestLimit
-	}
-	if memLimit == "" {
-		memLimit = defaultMemRequestLimit
-	}
-
-	resourceReqs, err := kube.ParseResourceRequirements(cpuRequest, memRequest, cpuLimit, memLimit)
-	if err != nil {
-		log.Errorf("Using default resource values, couldn't parse resource requirements: %s.", err)
-		resourceReqs, _ = kube.ParseResourceRequirements(
-			defaultCPURequestLimit, defaultMemRequestLimit, // requests
-			defaultCPURequestLimit, defaultMemRequestLimit, // limits
-		)
-	}
-
-	runAsUser, runAsGroup, allowPrivilegeEscalation, secCtx := getSecurityContext(log, config)
-
-	securityContext, err := kube.ParseSecurityContext(runAsUser, runAsGroup, allowPrivilegeEscalation, secCtx)
-	if err != nil {
-		log.Errorf("Using default securityContext values, couldn't parse securityContext requirements: %s.", err)
-	}
+	resourceReqs := restic.RestoreHelperResources(log, config)
+	securityContext := restic.RestoreHelperSecurityContext(log, config)
 
 	initContainerBuilder := newResticInitContainerBuilder(image, string(input.Restore.UID))
 	initContainerBuilder.Resources(&resourceReqs)
@@ -157,7 +123,7 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 		}
 		initContainerBuilder.VolumeMounts(mount)
 	}
-	initContainerBuilder.Command(getCommand(log, config))
+	initContainerBuilder.Command(restic.RestoreHelperCommand(log, config))
 
 	initContainer := *initContainerBuilder.Result()
 	if len(pod.Spec.InitContainers) == 0 || pod.Spec.InitContainers[0].Name != restic.InitContainer {
@@ -174,120 +140,6 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
 }
 
-func getCommand(log logrus.FieldLogger, config *corev1.ConfigMap) []string {
-	if config == nil {
-		log.Debug("No config found for plugin")
-		return []string{defaultCommand}
-	}
-
-	if config.Data["command"] == "" {
-		log.Debugf("No custom command configured")
-		return []string{defaultCommand}
-	}
-
-	log.Debugf("Using custom command %s", config.Data["command"])
-	return []string{config.Data["command"]}
-}
-
-func getImage(log logrus.FieldLogger, config *corev1.ConfigMap) string {
-	if config == nil {
-		log.Debug("No config found for plugin")
-		return veleroimage.DefaultResticRestoreHelperImage()
-	}
-
-	image := config.Data["image"]
-	if image == "" {
-		log.Debugf("No custom image configured")
-		return veleroimage.DefaultResticRestoreHelperImage()
-	}
-
-	log = log.WithField("image", image)
-
-	parts := strings.Split(image, "/")
-
-	if len(parts) == 1 {
-		defaultImage := veleroimage.DefaultResticRestoreHelperImage()
-		// Image supplied without registry part
-		log.Infof("Plugin config contains image name without registry name. Using default init container image: %q", defaultImage)
-		return defaultImage
-	}
-
-	if !(strings.Contains(parts[len(parts)-1], ":")) {
-		tag := veleroimage.ImageTag()
-		// tag-less image name: add default image tag for this version of Velero
-		log.Infof("Plugin config contains image name without tag. Adding tag: %q", tag)
-		return fmt.Sprintf("%s:%s", image, tag)
-	} else {
-		// tagged image name
-		log.Debugf("Plugin config contains image name with tag")
-		return image
-	}
-}
-
-// getResourceRequests extracts the CPU and memory requests from a ConfigMap.
-// The 0 values are valid if the keys are not present
-func getResourceRequests(log logrus.FieldLogger, config *corev1.ConfigMap) (string, string) {
-	if config == nil {
-		log.Debug("No config found for plugin")
-		return "", ""
-	}
-
-	return config.Data["cpuRequest"], config.Data["memRequest"]
-}
-
-// getResourceLimits extracts the CPU and memory limits from a ConfigMap.
-// The 0 values are valid if the keys are not present
-func getResourceLimits(log logrus.FieldLogger, config *corev1.ConfigMap) (string, string) {
-	if config == nil {
-		log.Debug("No config found for plugin")
-		return "", ""
-	}
-
-	return config.Data["cpuLimit"], config.Data["memLimit"]
-}
-
-// getSecurityContext extracts securityContext runAsUser, runAsGroup, allowPrivilegeEscalation, and securityContext from a ConfigMap.
-func getSecurityContext(log logrus.FieldLogger, config *corev1.ConfigMap) (string, string, string, string) {
-	if config == nil {
-		log.Debug("No config found for plugin")
-		return "", "", "", ""
-	}
-
-	return config.Data["secCtxRunAsUser"],
-		config.Data["secCtxRunAsGroup"],
-		config.Data["secCtxAllowPrivilegeEscalation"],
-		config.Data["secCtx"]
-}
-
-// TODO eventually this can move to pkg/plugin/framework since it'll be used across multiple
-// plugins.
-func getPluginConfig(kind framework.PluginKind, name string, client corev1client.ConfigMapInterface) (*corev1.ConfigMap, error) {
-	opts := metav1.ListOptions{
-		// velero.io/plugin-config: true
-		// velero.io/restic: RestoreItemAction
-		LabelSelector: fmt.Sprintf("velero.io/plugin-config,%s=%s", name, kind),
-	}
-
-	list, err := client.List(context.TODO(), opts)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-
-	if len(list.Items) == 0 {
-		return nil, nil
-	}
-
-	if len(list.Items) > 1 {
-		var items []string
-		for _, item := range list.Items {
-			items = append(items, item.Name)
-		}
-		return nil, errors.Errorf("found more than one ConfigMap matching label selector %q: %v", opts.LabelSelector, items)
-	}
-
-	return &list.Items[0], nil
-}
-
 func newResticInitContainerBuilder(image, restoreUID string) *builder.ContainerBuilder {
 	return builder.ForContainer(restic.InitContainer, image).
 		Args(restoreUID).
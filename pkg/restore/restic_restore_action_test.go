@@ -33,89 +33,13 @@ import (
 	veleroimage "github.com/vmware-tanzu/velero/internal/velero"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
-	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	velerofake "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
-	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
-func TestGetImage(t *testing.T) {
-	configMapWithData := func(key, val string) *corev1api.ConfigMap {
-		return &corev1api.ConfigMap{
-			Data: map[string]string{
-				key: val,
-			},
-		}
-	}
-
-	defaultImage := veleroimage.DefaultResticRestoreHelperImage()
-
-	tests := []struct {
-		name             string
-		configMap        *corev1api.ConfigMap
-		buildInfoVersion string
-		want             string
-	}{
-		{
-			name:      "nil config map returns default image",
-			configMap: nil,
-			want:      defaultImage,
-		},
-		{
-			name:      "config map without 'image' key returns default image",
-			configMap: configMapWithData("non-matching-key", "val"),
-			want:      defaultImage,
-		},
-		{
-			name:      "config map without '/' in image name returns default image",
-			configMap: configMapWithData("image", "my-image"),
-			want:      defaultImage,
-		},
-		{
-			name:             "config map with untagged image returns image with buildinfo.Version as tag",
-			configMap:        configMapWithData("image", "myregistry.io/my-image"),
-			buildInfoVersion: "buildinfo-version",
-			want:             "myregistry.io/my-image:buildinfo-version",
-		},
-		{
-			name:             "config map with untagged image and custom registry port with ':' returns image with buildinfo.Version as tag",
-			configMap:        configMapWithData("image", "myregistry.io:34567/my-image"),
-			buildInfoVersion: "buildinfo-version",
-			want:             "myregistry.io:34567/my-image:buildinfo-version",
-		},
-		{
-			name:      "config map with tagged image returns tagged image",
-			configMap: configMapWithData("image", "myregistry.io/my-image:my-tag"),
-			want:      "myregistry.io/my-image:my-tag",
-		},
-		{
-			name:      "config map with tagged image and custom registry port with ':' returns tagged image",
-			configMap: configMapWithData("image", "myregistry.io:34567/my-image:my-tag"),
-			want:      "myregistry.io:34567/my-image:my-tag",
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			if test.buildInfoVersion != "" {
-				originalVersion := buildinfo.Version
-				buildinfo.Version = test.buildInfoVersion
-				defer func() {
-					buildinfo.Version = originalVersion
-				}()
-			}
-			assert.Equal(t, test.want, getImage(velerotest.NewLogger(), test.configMap))
-		})
-	}
-}
-
-// TestResticRestoreActionExecute tests the restic restore item action plugin's Execute method.
 func TestResticRestoreActionExecute(t *testing.T) {
-	resourceReqs, _ := kube.ParseResourceRequirements(
-		defaultCPURequestLimit, defaultMemRequestLimit, // requests
-		defaultCPURequestLimit, defaultMemRequestLimit, // limits
-	)
+	resourceReqs, _ := kube.ParseResourceRequirements("100m", "128Mi", "100m", "128Mi")
 
 	securityContext, _ := kube.ParseSecurityContext("", "", "", "")
 
@@ -132,6 +56,7 @@ func TestResticRestoreActionExecute(t *testing.T) {
 		pod              *corev1api.Pod
 		podFromBackup    *corev1api.Pod
 		podVolumeBackups []*velerov1api.PodVolumeBackup
+		restoreMode      velerov1api.PodVolumeRestoreMode
 		want             *corev1api.Pod
 	}{
 		{
@@ -254,6 +179,29 @@ func TestResticRestoreActionExecute(t *testing.T) {
 						Command([]string{"/velero-restic-restore-helper"}).Result()).
 				Result(),
 		},
+		{
+			name: "Restoring pod in helper pod mode doesn't add the restic initContainer",
+			pod: builder.ForPod("ns-1", "my-pod").
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+				).
+				Result(),
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup(veleroNs, "pvb-1").
+					PodName("my-pod").
+					PodNamespace("ns-1").
+					Volume("vol-1").
+					ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backupName)).
+					SnapshotID("foo").
+					Result(),
+			},
+			restoreMode: velerov1api.PodVolumeRestoreModeHelperPod,
+			want: builder.ForPod("ns-1", "my-pod").
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+				).
+				Result(),
+		},
 	}
 
 	for _, tc := range tests {
@@ -288,6 +236,7 @@ func TestResticRestoreActionExecute(t *testing.T) {
 				Restore: builder.ForRestore(veleroNs, restoreName).
 					Backup(backupName).
 					Phase(velerov1api.RestorePhaseInProgress).
+					PodVolumeRestoreMode(tc.restoreMode).
 					Result(),
 			}
 
@@ -319,46 +268,3 @@ func TestResticRestoreActionExecute(t *testing.T) {
 		})
 	}
 }
-
-func TestGetCommand(t *testing.T) {
-	configMapWithData := func(key, val string) *corev1api.ConfigMap {
-		return &corev1api.ConfigMap{
-			Data: map[string]string{
-				key: val,
-			},
-		}
-	}
-	testCases := []struct {
-		name      string
-		configMap *corev1api.ConfigMap
-		expected  []string
-	}{
-		{
-			name:      "should get default command when config key is missing",
-			configMap: configMapWithData("non-matching-key", "val"),
-			expected:  []string{defaultCommand},
-		},
-		{
-			name:      "should get default command when config key is empty",
-			configMap: configMapWithData("command", ""),
-			expected:  []string{defaultCommand},
-		},
-		{
-			name:      "should get default command when config is nil",
-			configMap: nil,
-			expected:  []string{defaultCommand},
-		},
-		{
-			name:      "should get command from config",
-			configMap: configMapWithData("command", "foobarbz"),
-			expected:  []string{"foobarbz"},
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			actual := getCommand(velerotest.NewLogger(), tc.configMap)
-			assert.Equal(t, tc.expected, actual)
-		})
-	}
-}
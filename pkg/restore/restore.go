@@ -31,6 +31,7 @@ import (
 	uuid "github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -45,6 +46,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -56,10 +58,12 @@ import (
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -78,6 +82,7 @@ type Request struct {
 	Backup           *velerov1api.Backup
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	VolumeSnapshots  []*volume.Snapshot
+	DataUploads      []*velerov1api.DataUpload
 	BackupReader     io.Reader
 }
 
@@ -90,6 +95,7 @@ type Restorer interface {
 		volumeSnapshotterGetter VolumeSnapshotterGetter,
 	) (Result, Result)
 	RestoreWithResolvers(
+		ctx go_context.Context,
 		req Request,
 		restoreItemActionResolver framework.RestoreItemActionResolver,
 		itemSnapshotterResolver framework.ItemSnapshotterResolver,
@@ -113,6 +119,9 @@ type kubernetesRestorer struct {
 	logger                     logrus.FieldLogger
 	podCommandExecutor         podexec.PodCommandExecutor
 	podGetter                  cache.Getter
+	dataDownloadClient         velerov1client.DataDownloadsGetter
+	eventRecorder              record.EventRecorder
+	metrics                    *metrics.ServerMetrics
 }
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
@@ -128,6 +137,9 @@ func NewKubernetesRestorer(
 	logger logrus.FieldLogger,
 	podCommandExecutor podexec.PodCommandExecutor,
 	podGetter cache.Getter,
+	dataDownloadClient velerov1client.DataDownloadsGetter,
+	eventRecorder record.EventRecorder,
+	metrics *metrics.ServerMetrics,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
 		restoreClient:              restoreClient,
@@ -150,6 +162,9 @@ func NewKubernetesRestorer(
 		fileSystem:         filesystem.NewFileSystem(),
 		podCommandExecutor: podCommandExecutor,
 		podGetter:          podGetter,
+		dataDownloadClient: dataDownloadClient,
+		eventRecorder:      eventRecorder,
+		metrics:            metrics,
 	}, nil
 }
 
@@ -164,16 +179,20 @@ func (kr *kubernetesRestorer) Restore(
 ) (Result, Result) {
 	resolver := framework.NewRestoreItemActionResolver(actions)
 	snapshotItemResolver := framework.NewItemSnapshotterResolver(nil)
-	return kr.RestoreWithResolvers(req, resolver, snapshotItemResolver, snapshotLocationLister, volumeSnapshotterGetter)
+	return kr.RestoreWithResolvers(go_context.Background(), req, resolver, snapshotItemResolver, snapshotLocationLister, volumeSnapshotterGetter)
 }
 
 func (kr *kubernetesRestorer) RestoreWithResolvers(
+	traceCtx go_context.Context,
 	req Request,
 	restoreItemActionResolver framework.RestoreItemActionResolver,
 	itemSnapshotterResolver framework.ItemSnapshotterResolver,
 	snapshotLocationLister listers.VolumeSnapshotLocationLister,
 	volumeSnapshotterGetter VolumeSnapshotterGetter,
 ) (Result, Result) {
+	traceCtx, span := tracing.Tracer().Start(traceCtx, "RestoreWithResolvers", trace.WithAttributes(tracing.RestoreNameKey.String(req.Restore.Name)))
+	defer span.End()
+
 	// metav1.LabelSelectorAsSelector converts a nil LabelSelector to a
 	// Nothing Selector, i.e. a selector that matches nothing. We want
 	// a selector that matches everything. This can be accomplished by
@@ -223,7 +242,9 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		}
 	}
 
-	ctx, cancelFunc := go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
+	// derive the pod volume timeout from traceCtx so that cancelling the restore also
+	// stops waiting for its pod volume restores.
+	ctx, cancelFunc := go_context.WithTimeout(traceCtx, podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticRestorer restic.Restorer
@@ -244,6 +265,9 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		ListWatchFactory: &hook.DefaultListWatchFactory{
 			PodsGetter: kr.podGetter,
 		},
+		EventRecorder: kr.eventRecorder,
+		Metrics:       kr.metrics,
+		RestoreName:   req.Restore.Name,
 	}
 
 	pvRestorer := &pvRestorer{
@@ -257,6 +281,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 	}
 
 	restoreCtx := &restoreContext{
+		traceContext:               traceCtx,
 		backup:                     req.Backup,
 		backupReader:               req.BackupReader,
 		restore:                    req.Restore,
@@ -273,6 +298,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		volumeSnapshotterGetter:    volumeSnapshotterGetter,
 		resticRestorer:             resticRestorer,
 		resticErrs:                 make(chan error),
+		pvcRestores:                make(map[string]chan struct{}),
 		pvsToProvision:             sets.NewString(),
 		pvRestorer:                 pvRestorer,
 		volumeSnapshots:            req.VolumeSnapshots,
@@ -290,12 +316,26 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		hooksContext:               hooksCtx,
 		hooksCancelFunc:            hooksCancelFunc,
 		restoreClient:              kr.restoreClient,
+		dataUploads:                req.DataUploads,
+		dataDownloadClient:         kr.dataDownloadClient,
+		dataDownloadTimeout:        podVolumeTimeout,
 	}
 
-	return restoreCtx.execute()
+	warnings, errs := restoreCtx.execute()
+
+	if kr.metrics != nil {
+		for item := range restoreCtx.restoredItems {
+			kr.metrics.RegisterRestoreItem(req.Restore.Spec.ScheduleName, item.GroupResource.String())
+		}
+	}
+
+	return warnings, errs
 }
 
 type restoreContext struct {
+	// traceContext holds the span of the restore, that the restore of each resource is traced in.
+	// It's cancelled when the restore is.
+	traceContext               go_context.Context
 	backup                     *velerov1api.Backup
 	backupReader               io.Reader
 	restore                    *velerov1api.Restore
@@ -315,10 +355,14 @@ type restoreContext struct {
 	resticRestorer             restic.Restorer
 	resticWaitGroup            sync.WaitGroup
 	resticErrs                 chan error
+	pvcRestores                map[string]chan struct{}
 	pvsToProvision             sets.String
 	pvRestorer                 PVRestorer
 	volumeSnapshots            []*volume.Snapshot
 	podVolumeBackups           []*velerov1api.PodVolumeBackup
+	dataUploads                []*velerov1api.DataUpload
+	dataDownloadClient         velerov1client.DataDownloadsGetter
+	dataDownloadTimeout        time.Duration
 	resourceTerminatingTimeout time.Duration
 	resourceClients            map[resourceClientKey]client.Dynamic
 	restoredItems              map[velero.ResourceIdentifier]struct{}
@@ -568,6 +612,9 @@ func (ctx *restoreContext) processSelectedResource(
 	warnings, errs := Result{}, Result{}
 	groupResource := schema.ParseGroupResource(selectedResource.resource)
 
+	traceCtx, span := tracing.Tracer().Start(ctx.traceContext, "RestoreResource", trace.WithAttributes(tracing.ResourceKey.String(groupResource.String())))
+	defer span.End()
+
 	for namespace, selectedItems := range selectedResource.selectedItemsByNamespace {
 		for _, selectedItem := range selectedItems {
 			// If we don't know whether this namespace exists yet, attempt to create
@@ -620,7 +667,7 @@ func (ctx *restoreContext) processSelectedResource(
 				continue
 			}
 
-			w, e := ctx.restoreItem(obj, groupResource, selectedItem.targetNamespace)
+			w, e := ctx.restoreItem(traceCtx, obj, groupResource, selectedItem.targetNamespace)
 			warnings.Merge(&w)
 			errs.Merge(&e)
 			processedItems++
@@ -877,7 +924,15 @@ func getResourceID(groupResource schema.GroupResource, namespace, name string) s
 	return fmt.Sprintf("%s/%s/%s", groupResource.String(), namespace, name)
 }
 
-func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (Result, Result) {
+// restoreItem restores obj. The item is traced in a span that's a child of the one in traceCtx, and
+// that the calls to the restore item actions for it are traced in.
+func (ctx *restoreContext) restoreItem(traceCtx go_context.Context, obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (Result, Result) {
+	traceCtx, span := tracing.Tracer().Start(traceCtx, "RestoreItem", trace.WithAttributes(
+		tracing.ResourceKey.String(groupResource.String()),
+		tracing.NamespaceKey.String(namespace),
+	))
+	defer span.End()
+
 	warnings, errs := Result{}, Result{}
 	resourceID := getResourceID(groupResource, namespace, obj.GetName())
 
@@ -1115,6 +1170,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			Item:           obj,
 			ItemFromBackup: itemFromBackup,
 			Restore:        ctx.restore,
+			Context:        traceCtx,
 		})
 		if err != nil {
 			errs.Add(namespace, fmt.Errorf("error preparing %s: %v", resourceID, err))
@@ -1160,7 +1216,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				}
 			}
 
-			w, e := ctx.restoreItem(additionalObj, additionalItem.GroupResource, additionalItemNamespace)
+			w, e := ctx.restoreItem(traceCtx, additionalObj, additionalItem.GroupResource, additionalItemNamespace)
 			warnings.Merge(&w)
 			errs.Merge(&e)
 		}
@@ -1181,6 +1237,17 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs
 		}
 
+		// The data of a persistent volume claim whose CSI snapshot was moved to the backup
+		// storage location is downloaded into a new, empty volume.
+		if getDataUploadForPVC(ctx.dataUploads, name, obj.GetNamespace()) != nil {
+			ctx.log.Infof("Resetting PersistentVolumeClaim %s/%s for dynamic provisioning to restore its moved snapshot data", namespace, name)
+			obj = resetVolumeBindingInfo(obj)
+			unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
+			unstructured.RemoveNestedField(obj.Object, "spec", "dataSource")
+			unstructured.RemoveNestedField(obj.Object, "spec", "dataSourceRef")
+			pvc.Spec.VolumeName = ""
+		}
+
 		if pvc.Spec.VolumeName != "" {
 			// This used to only happen with restic volumes, but now always remove this binding metadata
 			obj = resetVolumeBindingInfo(obj)
@@ -1214,6 +1281,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// and which backup they came from.
 	addRestoreLabels(obj, ctx.restore.Name, ctx.restore.Spec.BackupName)
 
+	// When restoring persistent volume claims' data through helper pods, don't create
+	// a pod until the data of all of its persistent volume claims has been restored.
+	if groupResource == kuberesource.Pods && len(ctx.pvcRestores) > 0 {
+		ctx.waitForPVCRestores(obj)
+	}
+
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
 	createdObj, restoreErr := resourceClient.Create(obj)
 	isAlreadyExistsError, err := isAlreadyExistsError(ctx, obj, restoreErr, resourceClient)
@@ -1296,11 +1369,35 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs
 		}
 
-		if len(restic.GetVolumeBackupsForPod(ctx.podVolumeBackups, pod, originalNamespace)) > 0 {
+		volumeBackups := restic.GetVolumeBackupsForPod(ctx.podVolumeBackups, pod, originalNamespace)
+		if ctx.restore.Spec.PodVolumeRestoreMode == velerov1api.PodVolumeRestoreModeHelperPod {
+			// persistent volume claims have already been restored through helper pods, and
+			// other kinds of volumes can't be restored without modifying the pod.
+			for _, volume := range pod.Spec.Volumes {
+				if _, ok := volumeBackups[volume.Name]; ok && volume.PersistentVolumeClaim == nil {
+					warnings.Add(namespace, errors.Errorf("not restoring restic backup of volume %s in pod %s/%s because it is not a persistent volume claim", volume.Name, namespace, name))
+				}
+			}
+		} else if len(volumeBackups) > 0 {
 			restorePodVolumeBackups(ctx, createdObj, originalNamespace)
 		}
 	}
 
+	if groupResource == kuberesource.PersistentVolumeClaims {
+		// claims that weren't mounted by any running pod were backed up through helper pods,
+		// which aren't restored, so their data is restored through helper pods in either mode.
+		pvb := restic.GetPodVolumeBackupForPVC(ctx.podVolumeBackups, name, originalNamespace)
+		if pvb != nil && (ctx.restore.Spec.PodVolumeRestoreMode == velerov1api.PodVolumeRestoreModeHelperPod || restic.IsHelperPodVolumeBackup(pvb)) {
+			restorePVCData(ctx, createdObj, originalNamespace, pvb)
+		}
+	}
+
+	if groupResource == kuberesource.PersistentVolumeClaims {
+		if dataUpload := getDataUploadForPVC(ctx.dataUploads, name, originalNamespace); dataUpload != nil {
+			downloadPVCData(ctx, createdObj, dataUpload)
+		}
+	}
+
 	if groupResource == kuberesource.Pods {
 		ctx.waitExec(createdObj)
 	}
@@ -1469,6 +1566,78 @@ func restorePodVolumeBackups(ctx *restoreContext, createdObj *unstructured.Unstr
 	}
 }
 
+// restorePVCData restores the data of the given restored PVC from its restic backup
+// through a helper pod.
+func restorePVCData(ctx *restoreContext, createdObj *unstructured.Unstructured, originalNamespace string, pvb *velerov1api.PodVolumeBackup) {
+	if ctx.resticRestorer == nil {
+		ctx.log.Warn("No restic restorer, not restoring persistent volume claim's data")
+		return
+	}
+
+	done := make(chan struct{})
+	ctx.pvcRestores[kube.NamespaceAndName(createdObj)] = done
+
+	ctx.resticWaitGroup.Add(1)
+	go func() {
+		// Done() will only be called after all errors have been successfully
+		// sent on the ctx.resticErrs channel
+		defer ctx.resticWaitGroup.Done()
+
+		pvc := new(v1.PersistentVolumeClaim)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(createdObj.UnstructuredContent(), pvc); err != nil {
+			close(done)
+			ctx.log.WithError(err).Error("error converting unstructured persistent volume claim")
+			ctx.resticErrs <- err
+			return
+		}
+
+		data := restic.PVCRestoreData{
+			Restore:         ctx.restore,
+			PVC:             pvc,
+			PodVolumeBackup: pvb,
+			SourceNamespace: originalNamespace,
+			BackupLocation:  ctx.backup.Spec.StorageLocation,
+		}
+		errs := ctx.resticRestorer.RestorePVC(data)
+
+		// unblock the pods waiting for this PVC before sending errors, since
+		// the errors are only received once all items have been restored.
+		close(done)
+
+		if errs != nil {
+			ctx.log.WithError(kubeerrs.NewAggregate(errs)).Errorf("unable to successfully complete restic restore of persistent volume claim %s/%s", pvc.Namespace, pvc.Name)
+
+			for _, err := range errs {
+				ctx.resticErrs <- err
+			}
+		}
+	}()
+}
+
+// waitForPVCRestores blocks until the restic restores of the persistent volume
+// claims used by the pod are done.
+func (ctx *restoreContext) waitForPVCRestores(obj *unstructured.Unstructured) {
+	pod := new(v1.Pod)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
+		ctx.log.WithError(err).Error("error converting unstructured pod")
+		return
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+
+		done, ok := ctx.pvcRestores[fmt.Sprintf("%s/%s", pod.Namespace, volume.PersistentVolumeClaim.ClaimName)]
+		if !ok {
+			continue
+		}
+
+		ctx.log.Infof("Waiting for restic restore of persistent volume claim %s/%s before restoring pod %s", pod.Namespace, volume.PersistentVolumeClaim.ClaimName, pod.Name)
+		<-done
+	}
+}
+
 // waitExec executes hooks in a restored pod's containers when they become ready.
 func (ctx *restoreContext) waitExec(createdObj *unstructured.Unstructured) {
 	ctx.hooksWaitGroup.Add(1)
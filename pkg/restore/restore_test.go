@@ -28,6 +28,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -2658,6 +2659,136 @@ func TestRestoreWithRestic(t *testing.T) {
 	}
 }
 
+// TestRestoreWithResticHelperPod verifies that when a restore uses helper pods to restore
+// pod volumes, RestorePVC is called for PVCs with restic backups and RestorePodVolumes is
+// not called for the pods that use them.
+func TestRestoreWithResticHelperPod(t *testing.T) {
+	h := newHarness(t)
+	h.restorer.resourcePriorities = []string{"persistentvolumeclaims", "pods"}
+
+	restorer := new(resticmocks.Restorer)
+	defer restorer.AssertExpectations(t)
+	h.restorer.resticRestorerFactory = &fakeResticRestorerFactory{
+		restorer: restorer,
+	}
+
+	for _, resource := range []*test.APIResource{test.PVCs(), test.Pods()} {
+		h.AddItems(t, resource)
+	}
+
+	restore := defaultRestore().PodVolumeRestoreMode(velerov1api.PodVolumeRestoreModeHelperPod).Result()
+	backup := defaultBackup().Result()
+	pvb := builder.ForPodVolumeBackup("velero", "pvb-1").
+		ObjectMeta(builder.WithAnnotations(restic.PVCNameAnnotation, "pvc-1")).
+		PodName("pod-1").
+		PodNamespace("ns-1").
+		Volume("vol-1").
+		SnapshotID("foo").
+		Result()
+
+	tarball := test.NewTarWriter(t).
+		AddItems("persistentvolumeclaims",
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result(),
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-2").Result(),
+		).
+		AddItems("pods",
+			builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "vol-1")).
+				Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+				Result(),
+		)
+
+	restorer.
+		On("RestorePVC", mock.MatchedBy(func(data restic.PVCRestoreData) bool {
+			return data.PVC.Namespace == "ns-1" && data.PVC.Name == "pvc-1" && data.PodVolumeBackup == pvb && data.SourceNamespace == "ns-1"
+		})).
+		Return(nil)
+
+	data := Request{
+		Log:              h.log,
+		Restore:          restore,
+		Backup:           backup,
+		PodVolumeBackups: []*velerov1api.PodVolumeBackup{pvb},
+		BackupReader:     tarball.Done(),
+	}
+
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	assertAPIContents(t, h, map[*test.APIResource][]string{
+		test.PVCs(): {"ns-1/pvc-1", "ns-1/pvc-2"},
+		test.Pods(): {"ns-1/pod-1"},
+	})
+}
+
+// TestRestoreWithResticHelperPodVolumeBackup verifies that when a restore uses the restic
+// init container, RestorePVC is still called for PVCs that were backed up through helper pods,
+// since those pods aren't in the backup.
+func TestRestoreWithResticHelperPodVolumeBackup(t *testing.T) {
+	h := newHarness(t)
+	h.restorer.resourcePriorities = []string{"persistentvolumeclaims", "pods"}
+
+	restorer := new(resticmocks.Restorer)
+	defer restorer.AssertExpectations(t)
+	h.restorer.resticRestorerFactory = &fakeResticRestorerFactory{
+		restorer: restorer,
+	}
+
+	for _, resource := range []*test.APIResource{test.PVCs(), test.Pods()} {
+		h.AddItems(t, resource)
+	}
+
+	restore := defaultRestore().Result()
+	backup := defaultBackup().Result()
+	pvb := builder.ForPodVolumeBackup("velero", "pvb-1").
+		ObjectMeta(
+			builder.WithAnnotations(restic.PVCNameAnnotation, "pvc-1"),
+			builder.WithLabels(velerov1api.ResticHelperPodLabel, "true"),
+		).
+		PodName("velero-restic-helper-abcde").
+		PodNamespace("ns-1").
+		Volume(restic.HelperPodVolume).
+		SnapshotID("foo").
+		Result()
+
+	tarball := test.NewTarWriter(t).
+		AddItems("persistentvolumeclaims",
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result(),
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-2").Result(),
+		)
+
+	restorer.
+		On("RestorePVC", mock.MatchedBy(func(data restic.PVCRestoreData) bool {
+			return data.PVC.Namespace == "ns-1" && data.PVC.Name == "pvc-1" && data.PodVolumeBackup == pvb && data.SourceNamespace == "ns-1"
+		})).
+		Return(nil)
+
+	data := Request{
+		Log:              h.log,
+		Restore:          restore,
+		Backup:           backup,
+		PodVolumeBackups: []*velerov1api.PodVolumeBackup{pvb},
+		BackupReader:     tarball.Done(),
+	}
+
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	assertAPIContents(t, h, map[*test.APIResource][]string{
+		test.PVCs(): {"ns-1/pvc-1", "ns-1/pvc-2"},
+	})
+}
+
 func TestResetMetadataAndStatus(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,174 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing configures the export of OpenTelemetry traces from the Velero server and
+// its plugins, and provides the tracer that Velero's spans are started with.
+package tracing
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentationName is the name of the tracer that Velero's spans are started with.
+	instrumentationName = "github.com/vmware-tanzu/velero"
+
+	// The environment variables that the tracing configuration of the Velero server is passed to
+	// its plugin processes with.
+	endpointEnvVar    = "VELERO_TRACING_OTLP_ENDPOINT"
+	insecureEnvVar    = "VELERO_TRACING_OTLP_INSECURE"
+	sampleRatioEnvVar = "VELERO_TRACING_SAMPLE_RATIO"
+)
+
+// The attributes of Velero's spans.
+const (
+	// BackupNameKey is the name of the backup that a span is part of.
+	BackupNameKey = attribute.Key("velero.backup.name")
+	// RestoreNameKey is the name of the restore that a span is part of.
+	RestoreNameKey = attribute.Key("velero.restore.name")
+	// ResourceKey is the group-resource of the items that a span processes.
+	ResourceKey = attribute.Key("velero.resource")
+	// NamespaceKey is the namespace of the items that a span processes.
+	NamespaceKey = attribute.Key("velero.namespace")
+)
+
+// Config is the configuration of the export of traces.
+type Config struct {
+	// Endpoint is the host:port of the OTLP gRPC receiver that traces are exported to. Tracing
+	// is disabled if it's empty.
+	Endpoint string
+	// Insecure disables TLS for the connection to the receiver.
+	Insecure bool
+	// SampleRatio is the fraction of the traces started by Velero that are sampled.
+	SampleRatio float64
+}
+
+// Enabled returns whether traces are exported.
+func (c Config) Enabled() bool {
+	return c.Endpoint != ""
+}
+
+// Env returns the environment variables that pass c to a plugin process, to be read by
+// ConfigFromEnv. It returns nil if tracing is disabled.
+func (c Config) Env() []string {
+	if !c.Enabled() {
+		return nil
+	}
+
+	return []string{
+		endpointEnvVar + "=" + c.Endpoint,
+		insecureEnvVar + "=" + strconv.FormatBool(c.Insecure),
+		sampleRatioEnvVar + "=" + strconv.FormatFloat(c.SampleRatio, 'f', -1, 64),
+	}
+}
+
+// ConfigFromEnv returns the Config passed to this process in its environment by Config.Env.
+func ConfigFromEnv() Config {
+	config := Config{
+		Endpoint:    os.Getenv(endpointEnvVar),
+		SampleRatio: 1,
+	}
+	if insecure, err := strconv.ParseBool(os.Getenv(insecureEnvVar)); err == nil {
+		config.Insecure = insecure
+	}
+	if ratio, err := strconv.ParseFloat(os.Getenv(sampleRatioEnvVar), 64); err == nil {
+		config.SampleRatio = ratio
+	}
+
+	return config
+}
+
+// current holds the Config that tracing was last set up with. It's read when plugin processes are
+// started, which may happen concurrently with Setup.
+var current atomic.Value
+
+// Current returns the Config that tracing was last set up with by Setup.
+func Current() Config {
+	config, _ := current.Load().(Config)
+	return config
+}
+
+// Setup installs the global tracer provider and propagator for the process, which exports the
+// spans of the service to the OTLP receiver in config in batches. If tracing is disabled, it leaves
+// the no-op tracer provider in place. The returned function flushes and stops the export, and must
+// be called before the process exits.
+func Setup(ctx context.Context, service string, config Config) (func(context.Context) error, error) {
+	return setup(ctx, service, config, func(exporter sdktrace.SpanExporter) sdktrace.TracerProviderOption {
+		return sdktrace.WithBatcher(exporter)
+	})
+}
+
+// SetupPlugin is Setup for a plugin process. Each span is exported as soon as it ends, because the
+// Velero server may kill the plugin process without giving it a chance to flush a batch.
+func SetupPlugin(ctx context.Context, service string, config Config) (func(context.Context) error, error) {
+	return setup(ctx, service, config, sdktrace.WithSyncer)
+}
+
+// setup is Setup with withExporter registering the exporter with the tracer provider.
+func setup(ctx context.Context, service string, config Config, withExporter func(sdktrace.SpanExporter) sdktrace.TracerProviderOption) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	if !config.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating OTLP trace exporter")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		withExporter(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(service))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	current.Store(config)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer that Velero's spans are started with.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// EndSpan records err, if any, on span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -17,22 +17,29 @@ limitations under the License.
 package logging
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
 // LogCounterHook is a logrus hook that counts the number of log
-// statements that have been written at each logrus level.
+// statements that have been written at each logrus level. It also
+// keeps the warning and error statements, grouped by the namespace
+// of the item they're about, as a results.Result for each level.
 type LogCounterHook struct {
-	mu     sync.RWMutex
-	counts map[logrus.Level]int
+	mu      sync.RWMutex
+	counts  map[logrus.Level]int
+	entries map[logrus.Level]*results.Result
 }
 
 // NewLogCounterHook returns a pointer to an initialized LogCounterHook.
 func NewLogCounterHook() *LogCounterHook {
 	return &LogCounterHook{
-		counts: make(map[logrus.Level]int),
+		counts:  make(map[logrus.Level]int),
+		entries: make(map[logrus.Level]*results.Result),
 	}
 }
 
@@ -48,6 +55,43 @@ func (h *LogCounterHook) Fire(entry *logrus.Entry) error {
 
 	h.counts[entry.Level]++
 
+	if entry.Level > logrus.WarnLevel {
+		return nil
+	}
+
+	if h.entries[entry.Level] == nil {
+		h.entries[entry.Level] = &results.Result{}
+	}
+	result := h.entries[entry.Level]
+
+	message := entry.Message
+	if err, ok := entry.Data[logrus.ErrorKey]; ok {
+		message = fmt.Sprintf("%s: %v", message, err)
+	}
+
+	namespace, _ := entry.Data["namespace"].(string)
+	resource, _ := entry.Data["resource"].(string)
+	name, _ := entry.Data["name"].(string)
+	if resource != "" && name != "" {
+		if namespace != "" {
+			message = fmt.Sprintf("%s/%s/%s: %s", resource, namespace, name, message)
+		} else {
+			message = fmt.Sprintf("%s/%s: %s", resource, name, message)
+		}
+	}
+
+	switch {
+	case namespace != "":
+		if result.Namespaces == nil {
+			result.Namespaces = make(map[string][]string)
+		}
+		result.Namespaces[namespace] = append(result.Namespaces[namespace], message)
+	case resource != "":
+		result.Cluster = append(result.Cluster, message)
+	default:
+		result.Velero = append(result.Velero, message)
+	}
+
 	return nil
 }
 
@@ -59,3 +103,22 @@ func (h *LogCounterHook) GetCount(level logrus.Level) int {
 
 	return h.counts[level]
 }
+
+// GetEntries returns the log statements that have been written at
+// the specific level provided, which must be the warning level or
+// a more severe one. Statements about items in a namespace are
+// grouped by the namespace, statements about cluster-scoped
+// resources are in the result's Cluster list, and all others are
+// in its Velero list.
+func (h *LogCounterHook) GetEntries(level logrus.Level) results.Result {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if h.entries[level] == nil {
+		return results.Result{}
+	}
+
+	result := results.Result{}
+	result.Merge(h.entries[level])
+	return result
+}
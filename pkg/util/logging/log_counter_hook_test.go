@@ -0,0 +1,66 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func TestLogCounterHook(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+	hook := NewLogCounterHook()
+	logger.Hooks.Add(hook)
+
+	logger.Info("Backing up items")
+	logger.WithError(errors.New("connection refused")).Error("Error getting backup store")
+	logger.WithFields(logrus.Fields{"resource": "pods", "namespace": "ns-1", "name": "pod-1"}).
+		WithError(errors.New("timed out")).Error("Error backing up item")
+	logger.WithFields(logrus.Fields{"resource": "pods", "namespace": "ns-1", "name": "pod-2"}).Warn("No restic backupper")
+	logger.WithFields(logrus.Fields{"resource": "deployments.apps", "namespace": "ns-2"}).Error("Error listing items")
+	logger.WithFields(logrus.Fields{"resource": "persistentvolumes", "namespace": "", "name": "pv-1"}).Error("Error getting volume ID")
+
+	assert.Equal(t, 1, hook.GetCount(logrus.InfoLevel))
+	assert.Equal(t, 1, hook.GetCount(logrus.WarnLevel))
+	assert.Equal(t, 4, hook.GetCount(logrus.ErrorLevel))
+
+	assert.Equal(t, results.Result{
+		Velero: []string{"Error getting backup store: connection refused"},
+		Cluster: []string{
+			"persistentvolumes/pv-1: Error getting volume ID",
+		},
+		Namespaces: map[string][]string{
+			"ns-1": {"pods/ns-1/pod-1: Error backing up item: timed out"},
+			"ns-2": {"Error listing items"},
+		},
+	}, hook.GetEntries(logrus.ErrorLevel))
+
+	assert.Equal(t, results.Result{
+		Namespaces: map[string][]string{
+			"ns-1": {"pods/ns-1/pod-2: No restic backupper"},
+		},
+	}, hook.GetEntries(logrus.WarnLevel))
+
+	assert.Equal(t, results.Result{}, hook.GetEntries(logrus.InfoLevel))
+}